@@ -170,7 +170,13 @@ func (a *access) rolesForTeam(auth atc.TeamAuth) []string {
 }
 
 func (a *access) hasPermission(role string) bool {
-	switch a.requiredRole {
+	return HasPermission(a.requiredRole, role)
+}
+
+// HasPermission returns whether a user with the given role satisfies the
+// required role, e.g. an owner satisfies every role.
+func HasPermission(requiredRole string, role string) bool {
+	switch requiredRole {
 	case OwnerRole:
 		return role == OwnerRole
 	case MemberRole:
@@ -771,4 +771,18 @@ var _ = Describe("Accessor", func() {
 			})
 		})
 	})
+
+	DescribeTable("HasPermission",
+		func(requiredRole string, role string, expected bool) {
+			Expect(accessor.HasPermission(requiredRole, role)).To(Equal(expected))
+		},
+		Entry("owner has owner permission", "owner", "owner", true),
+		Entry("member does not have owner permission", "owner", "member", false),
+		Entry("owner has member permission", "member", "owner", true),
+		Entry("pipeline-operator does not have member permission", "member", "pipeline-operator", false),
+		Entry("member has pipeline-operator permission", "pipeline-operator", "member", true),
+		Entry("viewer does not have pipeline-operator permission", "pipeline-operator", "viewer", false),
+		Entry("viewer has viewer permission", "viewer", "viewer", true),
+		Entry("nobody has an unknown permission", "bogus", "owner", false),
+	)
 })
@@ -23,7 +23,11 @@ var DefaultRoles = map[string]string{
 	atc.BuildEvents:                   ViewerRole,
 	atc.BuildResources:                ViewerRole,
 	atc.AbortBuild:                    OperatorRole,
+	atc.ApproveBuild:                  ViewerRole,
+	atc.SetBuildBreakpoint:            MemberRole,
+	atc.ContinueBuild:                 MemberRole,
 	atc.GetBuildPreparation:           ViewerRole,
+	atc.GetBuildTests:                 ViewerRole,
 	atc.GetJob:                        ViewerRole,
 	atc.CreateJobBuild:                OperatorRole,
 	atc.RerunJobBuild:                 OperatorRole,
@@ -83,6 +87,7 @@ var DefaultRoles = map[string]string{
 	atc.GetInfo:                       ViewerRole,
 	atc.GetInfoCreds:                  ViewerRole,
 	atc.ListContainers:                ViewerRole,
+	atc.ListKeptContainers:            ViewerRole,
 	atc.GetContainer:                  ViewerRole,
 	atc.HijackContainer:               MemberRole,
 	atc.ListDestroyingContainers:      ViewerRole,
@@ -7,9 +7,11 @@ import (
 	"fmt"
 	"io/ioutil"
 	"net/http"
+	"strings"
 	"time"
 
 	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/api/accessor"
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/db/dbfakes"
 	. "github.com/concourse/concourse/atc/testhelpers"
@@ -598,6 +600,21 @@ var _ = Describe("Builds API", func() {
 					}`))
 						})
 					})
+
+					Context("when the build was triggered by the job's schedule", func() {
+						BeforeEach(func() {
+							fakeAccess.IsAuthorizedReturns(true)
+							build.TriggerReasonReturns(db.BuildTriggerReasonSchedule)
+						})
+
+						It("returns the trigger reason", func() {
+							var returnedBuild atc.Build
+							err := json.NewDecoder(response.Body).Decode(&returnedBuild)
+							Expect(err).NotTo(HaveOccurred())
+
+							Expect(returnedBuild.TriggerReason).To(Equal("schedule"))
+						})
+					})
 				})
 			})
 		})
@@ -1071,6 +1088,490 @@ var _ = Describe("Builds API", func() {
 		})
 	})
 
+	Describe("PUT /api/v1/builds/:build_id/approval", func() {
+		var (
+			body     string
+			response *http.Response
+		)
+
+		BeforeEach(func() {
+			body = `{"approved":true}`
+		})
+
+		JustBeforeEach(func() {
+			var err error
+
+			req, err := http.NewRequest("PUT", server.URL+"/api/v1/builds/128/approval", strings.NewReader(body))
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(req)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when not authenticated", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(false)
+			})
+
+			It("returns 401", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+			})
+		})
+
+		Context("when authenticated", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+			})
+
+			Context("when the build can not be found", func() {
+				BeforeEach(func() {
+					dbBuildFactory.BuildReturns(nil, false, nil)
+				})
+
+				It("returns 404", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+				})
+			})
+
+			Context("when the build is found", func() {
+				BeforeEach(func() {
+					build.TeamNameReturns("some-team")
+					dbBuildFactory.BuildReturns(build, true, nil)
+				})
+
+				Context("when not authorized", func() {
+					BeforeEach(func() {
+						fakeAccess.IsAuthorizedReturns(false)
+					})
+
+					It("returns 403", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+					})
+				})
+
+				Context("when authorized", func() {
+					BeforeEach(func() {
+						fakeAccess.IsAuthorizedReturns(true)
+						fakeAccess.ClaimsReturns(accessor.Claims{UserName: "some-user"})
+					})
+
+					Context("when the request body is malformed", func() {
+						BeforeEach(func() {
+							body = `{`
+						})
+
+						It("returns 400", func() {
+							Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+						})
+					})
+
+					Context("when both a step and a plan ID are given", func() {
+						BeforeEach(func() {
+							body = `{"approved":true,"step":"ship-it","plan_id":"some-plan"}`
+						})
+
+						It("returns 400", func() {
+							Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+						})
+					})
+
+					Context("when the build is not waiting for approval", func() {
+						BeforeEach(func() {
+							build.PendingApprovalReturns(db.BuildApproval{}, false, nil)
+						})
+
+						It("returns 409", func() {
+							Expect(response.StatusCode).To(Equal(http.StatusConflict))
+						})
+					})
+
+					Context("when a step is given", func() {
+						BeforeEach(func() {
+							body = `{"approved":true,"step":"ship-it"}`
+							build.PendingApprovalReturns(db.BuildApproval{PlanID: "some-plan"}, true, nil)
+							build.DecideApprovalReturns(true, nil)
+							fakeAccess.IsAdminReturns(true)
+						})
+
+						It("decides the approval pending for that step", func() {
+							Expect(response.StatusCode).To(Equal(http.StatusNoContent))
+							Expect(build.PendingApprovalArgsForCall(0)).To(Equal("ship-it"))
+
+							planID, _, _ := build.DecideApprovalArgsForCall(0)
+							Expect(planID).To(Equal(atc.PlanID("some-plan")))
+						})
+					})
+
+					Context("when a plan ID is given", func() {
+						BeforeEach(func() {
+							body = `{"approved":true,"plan_id":"some-plan"}`
+							build.DecideApprovalReturns(true, nil)
+							fakeAccess.IsAdminReturns(true)
+						})
+
+						Context("when the step is waiting for approval", func() {
+							BeforeEach(func() {
+								build.ApprovalReturns(db.BuildApproval{PlanID: "some-plan"}, true, nil)
+							})
+
+							It("decides the approval for that plan", func() {
+								Expect(response.StatusCode).To(Equal(http.StatusNoContent))
+								Expect(build.ApprovalArgsForCall(0)).To(Equal(atc.PlanID("some-plan")))
+								Expect(build.PendingApprovalCallCount()).To(BeZero())
+
+								planID, _, _ := build.DecideApprovalArgsForCall(0)
+								Expect(planID).To(Equal(atc.PlanID("some-plan")))
+							})
+						})
+
+						Context("when the approval has already been decided", func() {
+							BeforeEach(func() {
+								build.ApprovalReturns(db.BuildApproval{PlanID: "some-plan", Decided: true}, true, nil)
+							})
+
+							It("returns 409", func() {
+								Expect(response.StatusCode).To(Equal(http.StatusConflict))
+								Expect(build.DecideApprovalCallCount()).To(BeZero())
+							})
+						})
+
+						Context("when the build has completed", func() {
+							BeforeEach(func() {
+								build.ApprovalReturns(db.BuildApproval{PlanID: "some-plan"}, true, nil)
+								build.IsCompletedReturns(true)
+							})
+
+							It("returns 409", func() {
+								Expect(response.StatusCode).To(Equal(http.StatusConflict))
+								Expect(build.DecideApprovalCallCount()).To(BeZero())
+							})
+						})
+
+						Context("when the step never requested approval", func() {
+							BeforeEach(func() {
+								build.ApprovalReturns(db.BuildApproval{}, false, nil)
+							})
+
+							It("returns 409", func() {
+								Expect(response.StatusCode).To(Equal(http.StatusConflict))
+							})
+						})
+					})
+
+					Context("when the build is waiting for approval", func() {
+						BeforeEach(func() {
+							build.PendingApprovalReturns(db.BuildApproval{
+								PlanID: "some-plan",
+								Role:   "owner",
+							}, true, nil)
+						})
+
+						Context("when the user does not have the required role", func() {
+							BeforeEach(func() {
+								fakeAccess.TeamRolesReturns(map[string][]string{
+									"some-team": []string{"member"},
+								})
+							})
+
+							It("returns 403", func() {
+								Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+							})
+
+							It("does not decide the approval", func() {
+								Expect(build.DecideApprovalCallCount()).To(BeZero())
+							})
+						})
+
+						Context("when the user is an admin", func() {
+							BeforeEach(func() {
+								fakeAccess.IsAdminReturns(true)
+								build.DecideApprovalReturns(true, nil)
+							})
+
+							It("returns 204", func() {
+								Expect(response.StatusCode).To(Equal(http.StatusNoContent))
+							})
+						})
+
+						Context("when the user has the required role", func() {
+							BeforeEach(func() {
+								fakeAccess.TeamRolesReturns(map[string][]string{
+									"some-team": []string{"owner"},
+								})
+							})
+
+							Context("when deciding succeeds", func() {
+								BeforeEach(func() {
+									build.DecideApprovalReturns(true, nil)
+								})
+
+								It("returns 204", func() {
+									Expect(response.StatusCode).To(Equal(http.StatusNoContent))
+								})
+
+								It("decides the approval on behalf of the user", func() {
+									Expect(build.DecideApprovalCallCount()).To(Equal(1))
+									planID, approved, approver := build.DecideApprovalArgsForCall(0)
+									Expect(planID).To(Equal(atc.PlanID("some-plan")))
+									Expect(approved).To(BeTrue())
+									Expect(approver).To(Equal("some-user"))
+								})
+
+								Context("when rejecting", func() {
+									BeforeEach(func() {
+										body = `{"approved":false}`
+									})
+
+									It("rejects the approval", func() {
+										_, approved, _ := build.DecideApprovalArgsForCall(0)
+										Expect(approved).To(BeFalse())
+									})
+								})
+							})
+
+							Context("when it has already been decided", func() {
+								BeforeEach(func() {
+									build.DecideApprovalReturns(false, nil)
+								})
+
+								It("returns 409", func() {
+									Expect(response.StatusCode).To(Equal(http.StatusConflict))
+								})
+							})
+
+							Context("when deciding fails", func() {
+								BeforeEach(func() {
+									build.DecideApprovalReturns(false, errors.New("nope"))
+								})
+
+								It("returns 500", func() {
+									Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+								})
+							})
+						})
+
+						Context("when the approval does not specify a role", func() {
+							BeforeEach(func() {
+								build.PendingApprovalReturns(db.BuildApproval{PlanID: "some-plan"}, true, nil)
+								build.DecideApprovalReturns(true, nil)
+								fakeAccess.TeamRolesReturns(map[string][]string{
+									"some-team": []string{"member"},
+								})
+							})
+
+							It("allows a member to decide", func() {
+								Expect(response.StatusCode).To(Equal(http.StatusNoContent))
+							})
+						})
+					})
+				})
+			})
+		})
+	})
+
+	Describe("PUT /api/v1/builds/:build_id/breakpoints", func() {
+		var (
+			body     string
+			response *http.Response
+		)
+
+		BeforeEach(func() {
+			body = `{"step":"unit","position":"after"}`
+		})
+
+		JustBeforeEach(func() {
+			var err error
+
+			req, err := http.NewRequest("PUT", server.URL+"/api/v1/builds/128/breakpoints", strings.NewReader(body))
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(req)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when not authenticated", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(false)
+			})
+
+			It("returns 401", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+			})
+		})
+
+		Context("when authenticated", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+			})
+
+			Context("when the build can not be found", func() {
+				BeforeEach(func() {
+					dbBuildFactory.BuildReturns(nil, false, nil)
+				})
+
+				It("returns 404", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+				})
+			})
+
+			Context("when the build is found", func() {
+				BeforeEach(func() {
+					build.TeamNameReturns("some-team")
+					dbBuildFactory.BuildReturns(build, true, nil)
+				})
+
+				Context("when not authorized", func() {
+					BeforeEach(func() {
+						fakeAccess.IsAuthorizedReturns(false)
+					})
+
+					It("returns 403", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+					})
+				})
+
+				Context("when authorized", func() {
+					BeforeEach(func() {
+						fakeAccess.IsAuthorizedReturns(true)
+					})
+
+					It("returns 204", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusNoContent))
+					})
+
+					It("sets the breakpoint", func() {
+						Expect(build.SetBreakpointCallCount()).To(Equal(1))
+						step, position := build.SetBreakpointArgsForCall(0)
+						Expect(step).To(Equal("unit"))
+						Expect(position).To(Equal(atc.BreakpointAfter))
+					})
+
+					Context("when the request body is malformed", func() {
+						BeforeEach(func() {
+							body = `{`
+						})
+
+						It("returns 400", func() {
+							Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+						})
+					})
+
+					Context("when the step is missing", func() {
+						BeforeEach(func() {
+							body = `{"position":"before"}`
+						})
+
+						It("returns 400", func() {
+							Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+						})
+					})
+
+					Context("when the position is invalid", func() {
+						BeforeEach(func() {
+							body = `{"step":"unit","position":"during"}`
+						})
+
+						It("returns 400", func() {
+							Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+							Expect(build.SetBreakpointCallCount()).To(BeZero())
+						})
+					})
+
+					Context("when the build has completed", func() {
+						BeforeEach(func() {
+							build.IsCompletedReturns(true)
+						})
+
+						It("returns 409", func() {
+							Expect(response.StatusCode).To(Equal(http.StatusConflict))
+							Expect(build.SetBreakpointCallCount()).To(BeZero())
+						})
+					})
+
+					Context("when setting the breakpoint fails", func() {
+						BeforeEach(func() {
+							build.SetBreakpointReturns(errors.New("nope"))
+						})
+
+						It("returns 500", func() {
+							Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+						})
+					})
+				})
+			})
+		})
+	})
+
+	Describe("PUT /api/v1/builds/:build_id/continue", func() {
+		var response *http.Response
+
+		JustBeforeEach(func() {
+			var err error
+
+			req, err := http.NewRequest("PUT", server.URL+"/api/v1/builds/128/continue", nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(req)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when not authenticated", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(false)
+			})
+
+			It("returns 401", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+			})
+		})
+
+		Context("when authenticated and authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(true)
+				fakeAccess.ClaimsReturns(accessor.Claims{UserName: "some-user"})
+
+				build.TeamNameReturns("some-team")
+				dbBuildFactory.BuildReturns(build, true, nil)
+			})
+
+			Context("when the build is paused", func() {
+				BeforeEach(func() {
+					build.ContinueReturns(true, nil)
+				})
+
+				It("returns 204", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNoContent))
+				})
+
+				It("continues the build on behalf of the user", func() {
+					Expect(build.ContinueCallCount()).To(Equal(1))
+					Expect(build.ContinueArgsForCall(0)).To(Equal("some-user"))
+				})
+			})
+
+			Context("when the build is not paused", func() {
+				BeforeEach(func() {
+					build.ContinueReturns(false, nil)
+				})
+
+				It("returns 409", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusConflict))
+				})
+			})
+
+			Context("when continuing fails", func() {
+				BeforeEach(func() {
+					build.ContinueReturns(false, errors.New("nope"))
+				})
+
+				It("returns 500", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+		})
+	})
+
 	Describe("GET /api/v1/builds/:build_id/preparation", func() {
 		var response *http.Response
 
@@ -1282,6 +1783,107 @@ var _ = Describe("Builds API", func() {
 		})
 	})
 
+	Describe("GET /api/v1/builds/:build_id/tests", func() {
+		var response *http.Response
+
+		JustBeforeEach(func() {
+			var err error
+			response, err = client.Get(server.URL + "/api/v1/builds/3/tests")
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when the build is found", func() {
+			BeforeEach(func() {
+				build.JobNameReturns("job1")
+				build.TeamNameReturns("some-team")
+				build.PipelineReturns(fakePipeline, true, nil)
+				dbBuildFactory.BuildReturns(build, true, nil)
+			})
+
+			Context("when not authenticated and the pipeline is private", func() {
+				BeforeEach(func() {
+					fakeAccess.IsAuthenticatedReturns(false)
+					fakePipeline.PublicReturns(false)
+				})
+
+				It("returns 401", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+				})
+			})
+
+			Context("when authenticated, but not authorized", func() {
+				BeforeEach(func() {
+					fakeAccess.IsAuthenticatedReturns(true)
+					fakeAccess.IsAuthorizedReturns(false)
+				})
+
+				It("returns 403", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+				})
+			})
+
+			Context("when authenticated and authorized", func() {
+				BeforeEach(func() {
+					fakeAccess.IsAuthenticatedReturns(true)
+					fakeAccess.IsAuthorizedReturns(true)
+
+					build.TestResultsReturns([]atc.TestResult{
+						{Step: "unit", Suite: "math", Name: "adds", Status: atc.TestPassed, Duration: 0.5},
+						{Step: "unit", Suite: "math", Name: "subtracts", Status: atc.TestFailed, Message: "expected 1, got 2"},
+						{Step: "unit", Name: "divides", Status: atc.TestSkipped},
+					}, nil)
+				})
+
+				It("returns 200 OK", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusOK))
+				})
+
+				It("returns Content-Type 'application/json'", func() {
+					expectedHeaderEntries := map[string]string{
+						"Content-Type": "application/json",
+					}
+					Expect(response).Should(IncludeHeaderEntries(expectedHeaderEntries))
+				})
+
+				It("returns the results with their counts", func() {
+					body, err := ioutil.ReadAll(response.Body)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(body).To(MatchJSON(`{
+						"passed": 1,
+						"failed": 1,
+						"skipped": 1,
+						"tests": [
+							{"step": "unit", "suite": "math", "name": "adds", "status": "passed", "duration": 0.5},
+							{"step": "unit", "suite": "math", "name": "subtracts", "status": "failed", "message": "expected 1, got 2"},
+							{"step": "unit", "name": "divides", "status": "skipped"}
+						]
+					}`))
+				})
+
+				Context("when fetching the results fails", func() {
+					BeforeEach(func() {
+						build.TestResultsReturns(nil, errors.New("nope"))
+					})
+
+					It("returns 500", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+					})
+				})
+			})
+		})
+
+		Context("when the build is not found", func() {
+			BeforeEach(func() {
+				dbBuildFactory.BuildReturns(nil, false, nil)
+			})
+
+			It("returns Not Found", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+			})
+		})
+	})
+
 	Describe("GET /api/v1/builds/:build_id/plan", func() {
 		var plan *json.RawMessage
 
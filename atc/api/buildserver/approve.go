@@ -0,0 +1,101 @@
+package buildserver
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/api/accessor"
+	"github.com/concourse/concourse/atc/db"
+)
+
+func (s *Server) ApproveBuild(build db.Build) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		logger := s.logger.Session("approve", lager.Data{
+			"build": build.ID(),
+		})
+
+		var request atc.ApprovalRequest
+		err := json.NewDecoder(r.Body).Decode(&request)
+		if err != nil {
+			logger.Info("malformed-request", lager.Data{"error": err.Error()})
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		if request.Step != "" && request.PlanID != "" {
+			logger.Info("both-step-and-plan-id-given")
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		var approval db.BuildApproval
+		var found bool
+		if request.PlanID != "" {
+			approval, found, err = build.Approval(request.PlanID)
+			found = found && !approval.Decided && !build.IsCompleted()
+		} else {
+			approval, found, err = build.PendingApproval(request.Step)
+		}
+		if err != nil {
+			logger.Error("failed-to-get-pending-approval", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		if !found {
+			w.WriteHeader(http.StatusConflict)
+			return
+		}
+
+		acc := accessor.GetAccessor(r)
+		if !canApprove(acc, build.TeamName(), approval.Role) {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+
+		decided, err := build.DecideApproval(approval.PlanID, request.Approved, userName(acc.Claims()))
+		if err != nil {
+			logger.Error("failed-to-decide-approval", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		if !decided {
+			w.WriteHeader(http.StatusConflict)
+			return
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+func canApprove(acc accessor.Access, teamName string, requiredRole string) bool {
+	if acc.IsAdmin() {
+		return true
+	}
+
+	if requiredRole == "" {
+		requiredRole = accessor.MemberRole
+	}
+
+	for _, role := range acc.TeamRoles()[teamName] {
+		if accessor.HasPermission(requiredRole, role) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func userName(claims accessor.Claims) string {
+	switch {
+	case claims.UserName != "":
+		return claims.UserName
+	case claims.Name != "":
+		return claims.Name
+	default:
+		return claims.Sub
+	}
+}
@@ -0,0 +1,77 @@
+package buildserver
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/api/accessor"
+	"github.com/concourse/concourse/atc/db"
+)
+
+func (s *Server) SetBreakpoint(build db.Build) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		logger := s.logger.Session("set-breakpoint", lager.Data{
+			"build": build.ID(),
+		})
+
+		var request atc.BreakpointRequest
+		err := json.NewDecoder(r.Body).Decode(&request)
+		if err != nil {
+			logger.Info("malformed-request", lager.Data{"error": err.Error()})
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		if request.Step == "" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		switch request.Position {
+		case atc.BreakpointBefore, atc.BreakpointAfter:
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		if build.IsCompleted() {
+			w.WriteHeader(http.StatusConflict)
+			return
+		}
+
+		err = build.SetBreakpoint(request.Step, request.Position)
+		if err != nil {
+			logger.Error("failed-to-set-breakpoint", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+func (s *Server) ContinueBuild(build db.Build) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		logger := s.logger.Session("continue", lager.Data{
+			"build": build.ID(),
+		})
+
+		acc := accessor.GetAccessor(r)
+
+		continued, err := build.Continue(userName(acc.Claims()))
+		if err != nil {
+			logger.Error("failed-to-continue-build", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		if !continued {
+			w.WriteHeader(http.StatusConflict)
+			return
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
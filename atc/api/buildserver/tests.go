@@ -0,0 +1,31 @@
+package buildserver
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+)
+
+func (s *Server) GetBuildTests(build db.Build) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		logger := s.logger.Session("get-build-tests")
+
+		results, err := build.TestResults()
+		if err != nil {
+			logger.Error("failed-to-fetch-build-test-results", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		err = json.NewEncoder(w).Encode(atc.NewTestReport(results))
+		if err != nil {
+			logger.Error("failed-to-encode-build-test-results", err)
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	})
+}
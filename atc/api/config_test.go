@@ -386,7 +386,7 @@ var _ = Describe("Config API", func() {
 							Expect(dbTeam.SavePipelineCallCount()).To(Equal(1))
 
 							name, savedConfig, id, initiallyPaused := dbTeam.SavePipelineArgsForCall(0)
-							Expect(name).To(Equal("a-pipeline"))
+							Expect(name).To(Equal(atc.PipelineRef{Name: "a-pipeline"}))
 							Expect(savedConfig).To(Equal(pipelineConfig))
 							Expect(id).To(Equal(db.ConfigVersion(42)))
 							Expect(initiallyPaused).To(BeTrue())
@@ -484,7 +484,7 @@ var _ = Describe("Config API", func() {
 							Expect(dbTeam.SavePipelineCallCount()).To(Equal(1))
 
 							name, savedConfig, id, initiallyPaused := dbTeam.SavePipelineArgsForCall(0)
-							Expect(name).To(Equal("a-pipeline"))
+							Expect(name).To(Equal(atc.PipelineRef{Name: "a-pipeline"}))
 							Expect(savedConfig).To(Equal(pipelineConfig))
 							Expect(id).To(Equal(db.ConfigVersion(42)))
 							Expect(initiallyPaused).To(BeTrue())
@@ -531,7 +531,7 @@ jobs:
 								Expect(dbTeam.SavePipelineCallCount()).To(Equal(1))
 
 								name, savedConfig, id, initiallyPaused := dbTeam.SavePipelineArgsForCall(0)
-								Expect(name).To(Equal("a-pipeline"))
+								Expect(name).To(Equal(atc.PipelineRef{Name: "a-pipeline"}))
 								Expect(savedConfig).To(Equal(atc.Config{
 									Resources: []atc.ResourceConfig{
 										{
@@ -801,7 +801,7 @@ jobs:
 										Expect(dbTeam.SavePipelineCallCount()).To(Equal(1))
 
 										name, savedConfig, id, initiallyPaused := dbTeam.SavePipelineArgsForCall(0)
-										Expect(name).To(Equal("a-pipeline"))
+										Expect(name).To(Equal(atc.PipelineRef{Name: "a-pipeline"}))
 										Expect(savedConfig).To(Equal(payloadAsConfig))
 										Expect(id).To(Equal(db.ConfigVersion(42)))
 										Expect(initiallyPaused).To(BeTrue())
@@ -967,7 +967,7 @@ jobs:
 						Expect(dbTeam.SavePipelineCallCount()).To(Equal(1))
 
 						name, savedConfig, id, initiallyPaused := dbTeam.SavePipelineArgsForCall(0)
-						Expect(name).To(Equal("a-pipeline"))
+						Expect(name).To(Equal(atc.PipelineRef{Name: "a-pipeline"}))
 						Expect(savedConfig).To(Equal(atc.Config{
 							Jobs: atc.JobConfigs{
 								{
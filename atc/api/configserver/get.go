@@ -16,6 +16,13 @@ func (s *Server) GetConfig(w http.ResponseWriter, r *http.Request) {
 	pipelineName := rata.Param(r, "pipeline_name")
 	teamName := rata.Param(r, "team_name")
 
+	instanceVars, err := atc.InstanceVarsFromQueryParams(r.URL.Query())
+	if err != nil {
+		logger.Error("malformed-instance-vars", err)
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
 	team, found, err := s.teamFactory.FindTeam(teamName)
 	if err != nil {
 		logger.Error("failed-to-find-team", err)
@@ -29,7 +36,7 @@ func (s *Server) GetConfig(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	pipeline, found, err := team.Pipeline(pipelineName)
+	pipeline, found, err := team.Pipeline(atc.PipelineRef{Name: pipelineName, InstanceVars: instanceVars})
 	if err != nil {
 		logger.Error("failed-to-find-pipeline", err)
 		w.WriteHeader(http.StatusInternalServerError)
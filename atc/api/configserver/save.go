@@ -71,6 +71,15 @@ func (s *Server) SaveConfig(w http.ResponseWriter, r *http.Request) {
 	pipelineName := rata.Param(r, "pipeline_name")
 	teamName := rata.Param(r, "team_name")
 
+	instanceVars, err := atc.InstanceVarsFromQueryParams(query)
+	if err != nil {
+		session.Error("malformed-instance-vars", err)
+		s.handleBadRequest(w, err.Error())
+		return
+	}
+
+	pipelineRef := atc.PipelineRef{Name: pipelineName, InstanceVars: instanceVars}
+
 	if checkCredentials {
 		variables := creds.NewVariables(s.secretManager, teamName, pipelineName, false)
 
@@ -96,7 +105,7 @@ func (s *Server) SaveConfig(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	_, created, err := team.SavePipeline(pipelineName, config, version, true)
+	_, created, err := team.SavePipeline(pipelineRef, config, version, true)
 	if err != nil {
 		session.Error("failed-to-save-config", err)
 		w.WriteHeader(http.StatusInternalServerError)
@@ -3,6 +3,7 @@ package api_test
 import (
 	"bufio"
 	"bytes"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
@@ -231,6 +232,57 @@ var _ = Describe("Containers API", func() {
 				})
 			})
 
+			Describe("querying with the instance vars of a pipeline", func() {
+				BeforeEach(func() {
+					req.URL.RawQuery = url.Values{
+						"pipeline_name": []string{"some-pipeline"},
+						"job_name":      []string{"some-job"},
+						"instance_vars": []string{`{"branch":"master"}`},
+					}.Encode()
+				})
+
+				Context("when the pipeline instance exists", func() {
+					BeforeEach(func() {
+						fakePipeline := new(dbfakes.FakePipeline)
+						fakePipeline.IDReturns(pipelineID)
+						dbTeam.PipelineReturns(fakePipeline, true, nil)
+					})
+
+					It("queries with the id of the instance in the metadata", func() {
+						_, err := client.Do(req)
+						Expect(err).NotTo(HaveOccurred())
+
+						Expect(dbTeam.PipelineCallCount()).To(Equal(1))
+						Expect(dbTeam.PipelineArgsForCall(0)).To(Equal(atc.PipelineRef{
+							Name:         "some-pipeline",
+							InstanceVars: atc.InstanceVars{"branch": "master"},
+						}))
+
+						meta := dbTeam.FindContainersByMetadataArgsForCall(0)
+						Expect(meta).To(Equal(db.ContainerMetadata{
+							PipelineID:   pipelineID,
+							PipelineName: "some-pipeline",
+							JobName:      "some-job",
+						}))
+					})
+				})
+
+				Context("when the pipeline instance does not exist", func() {
+					BeforeEach(func() {
+						dbTeam.PipelineReturns(nil, false, nil)
+					})
+
+					It("returns no containers", func() {
+						response, err := client.Do(req)
+						Expect(err).NotTo(HaveOccurred())
+
+						Expect(response.StatusCode).To(Equal(http.StatusOK))
+						Expect(ioutil.ReadAll(response.Body)).To(MatchJSON(`[]`))
+						Expect(dbTeam.FindContainersByMetadataCallCount()).To(Equal(0))
+					})
+				})
+			})
+
 			Describe("querying with job id", func() {
 				BeforeEach(func() {
 					req.URL.RawQuery = url.Values{
@@ -361,7 +413,7 @@ var _ = Describe("Containers API", func() {
 					Expect(err).NotTo(HaveOccurred())
 
 					_, pipelineName, resourceName, secretManager, varSourcePool := dbTeam.FindCheckContainersArgsForCall(0)
-					Expect(pipelineName).To(Equal("some-pipeline"))
+					Expect(pipelineName).To(Equal(atc.PipelineRef{Name: "some-pipeline"}))
 					Expect(resourceName).To(Equal("some-resource"))
 					Expect(secretManager).To(Equal(fakeSecretManager))
 					Expect(varSourcePool).To(Equal(fakeVarSourcePool))
@@ -370,6 +422,79 @@ var _ = Describe("Containers API", func() {
 		})
 	})
 
+	Describe("GET /api/v1/teams/a-team/kept-containers", func() {
+		var response *http.Response
+
+		JustBeforeEach(func() {
+			var err error
+			response, err = client.Get(server.URL + "/api/v1/teams/a-team/kept-containers")
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when not authenticated", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(false)
+			})
+
+			It("returns 401 Unauthorized", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+			})
+		})
+
+		Context("when authenticated", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(true)
+			})
+
+			Context("when the team has kept containers", func() {
+				BeforeEach(func() {
+					keptContainer := new(dbfakes.FakeCreatedContainer)
+					keptContainer.HandleReturns("some-handle")
+					keptContainer.StateReturns(atc.ContainerStateCreated)
+					keptContainer.WorkerNameReturns("some-worker-name")
+					keptContainer.MetadataReturns(db.ContainerMetadata{
+						Type:     stepType,
+						StepName: stepName,
+						BuildID:  buildID,
+					})
+					keptContainer.KeepUntilReturns(time.Now().Add(2 * time.Hour))
+
+					dbTeam.FindKeptContainersReturns([]db.CreatedContainer{keptContainer}, nil)
+				})
+
+				It("returns 200", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusOK))
+				})
+
+				It("returns the containers with when they expire", func() {
+					var containers []atc.Container
+					err := json.NewDecoder(response.Body).Decode(&containers)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(containers).To(HaveLen(1))
+					Expect(containers[0].ID).To(Equal("some-handle"))
+					Expect(containers[0].StepName).To(Equal(stepName))
+					Expect(containers[0].BuildID).To(Equal(buildID))
+
+					expiresIn, err := time.ParseDuration(containers[0].ExpiresIn)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(expiresIn).To(BeNumerically("~", 2*time.Hour, time.Minute))
+				})
+			})
+
+			Context("when finding the kept containers fails", func() {
+				BeforeEach(func() {
+					dbTeam.FindKeptContainersReturns(nil, errors.New("nope"))
+				})
+
+				It("returns 500", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+		})
+	})
+
 	Describe("GET /api/v1/containers/:id", func() {
 		var handle = "some-handle"
 
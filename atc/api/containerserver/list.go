@@ -69,17 +69,24 @@ func createContainerLocatorFromRequest(team db.Team, r *http.Request, secretMana
 		}, nil
 	}
 
+	instanceVars, err := atc.InstanceVarsFromQueryParams(query)
+	if err != nil {
+		return nil, err
+	}
+
 	if query.Get("type") == "check" {
 		return &checkContainerLocator{
-			team:          team,
-			pipelineName:  query.Get("pipeline_name"),
+			team: team,
+			pipelineRef: atc.PipelineRef{
+				Name:         query.Get("pipeline_name"),
+				InstanceVars: instanceVars,
+			},
 			resourceName:  query.Get("resource_name"),
 			secretManager: secretManager,
 			varSourcePool: varSourcePool,
 		}, nil
 	}
 
-	var err error
 	var containerType db.ContainerType
 	if query.Get("type") != "" {
 		containerType, err = db.ContainerTypeFromString(query.Get("type"))
@@ -104,7 +111,8 @@ func createContainerLocatorFromRequest(team db.Team, r *http.Request, secretMana
 	}
 
 	return &stepContainerLocator{
-		team: team,
+		team:         team,
+		instanceVars: instanceVars,
 
 		metadata: db.ContainerMetadata{
 			Type: containerType,
@@ -134,23 +142,44 @@ func (l *allContainersLocator) Locate(logger lager.Logger) ([]db.Container, map[
 
 type checkContainerLocator struct {
 	team          db.Team
-	pipelineName  string
+	pipelineRef   atc.PipelineRef
 	resourceName  string
 	secretManager creds.Secrets
 	varSourcePool creds.VarSourcePool
 }
 
 func (l *checkContainerLocator) Locate(logger lager.Logger) ([]db.Container, map[int]time.Time, error) {
-	return l.team.FindCheckContainers(logger, l.pipelineName, l.resourceName, l.secretManager, l.varSourcePool)
+	return l.team.FindCheckContainers(logger, l.pipelineRef, l.resourceName, l.secretManager, l.varSourcePool)
 }
 
 type stepContainerLocator struct {
-	team     db.Team
-	metadata db.ContainerMetadata
+	team         db.Team
+	metadata     db.ContainerMetadata
+	instanceVars atc.InstanceVars
 }
 
 func (l *stepContainerLocator) Locate(logger lager.Logger) ([]db.Container, map[int]time.Time, error) {
-	containers, err := l.team.FindContainersByMetadata(l.metadata)
+	metadata := l.metadata
+
+	// containers only record the name of their pipeline, so an instance of a
+	// pipeline is told apart from the others by its id
+	if len(l.instanceVars) > 0 {
+		pipeline, found, err := l.team.Pipeline(atc.PipelineRef{
+			Name:         metadata.PipelineName,
+			InstanceVars: l.instanceVars,
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if !found {
+			return []db.Container{}, nil, nil
+		}
+
+		metadata.PipelineID = pipeline.ID()
+	}
+
+	containers, err := l.team.FindContainersByMetadata(metadata)
 	return containers, nil, err
 }
 
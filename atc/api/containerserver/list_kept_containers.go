@@ -0,0 +1,39 @@
+package containerserver
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/api/present"
+	"github.com/concourse/concourse/atc/db"
+)
+
+func (s *Server) ListKeptContainers(team db.Team) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hLog := s.logger.Session("list-kept-containers", lager.Data{
+			"team": team.Name(),
+		})
+
+		containers, err := team.FindKeptContainers()
+		if err != nil {
+			hLog.Error("failed-to-find-kept-containers", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		presentedContainers := make([]atc.Container, len(containers))
+		for i, container := range containers {
+			presentedContainers[i] = present.Container(container, container.KeepUntil())
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+
+		err = json.NewEncoder(w).Encode(presentedContainers)
+		if err != nil {
+			hLog.Error("failed-to-encode-containers", err)
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	})
+}
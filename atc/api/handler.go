@@ -118,10 +118,14 @@ func NewHandler(
 		atc.GetBuild:            buildHandlerFactory.HandlerFor(buildServer.GetBuild),
 		atc.BuildResources:      buildHandlerFactory.HandlerFor(buildServer.BuildResources),
 		atc.AbortBuild:          buildHandlerFactory.HandlerFor(buildServer.AbortBuild),
+		atc.ApproveBuild:        buildHandlerFactory.HandlerFor(buildServer.ApproveBuild),
+		atc.SetBuildBreakpoint:  buildHandlerFactory.HandlerFor(buildServer.SetBreakpoint),
+		atc.ContinueBuild:       buildHandlerFactory.HandlerFor(buildServer.ContinueBuild),
 		atc.GetBuildPlan:        buildHandlerFactory.HandlerFor(buildServer.GetBuildPlan),
 		atc.GetBuildPreparation: buildHandlerFactory.HandlerFor(buildServer.GetBuildPreparation),
 		atc.BuildEvents:         buildHandlerFactory.HandlerFor(buildServer.BuildEvents),
 		atc.ListBuildArtifacts:  buildHandlerFactory.HandlerFor(buildServer.GetBuildArtifacts),
+		atc.GetBuildTests:       buildHandlerFactory.HandlerFor(buildServer.GetBuildTests),
 
 		atc.GetCheck: http.HandlerFunc(checkServer.GetCheck),
 
@@ -198,6 +202,7 @@ func NewHandler(
 		atc.ListActiveUsersSince: http.HandlerFunc(usersServer.GetUsersSince),
 
 		atc.ListContainers:           teamHandlerFactory.HandlerFor(containerServer.ListContainers),
+		atc.ListKeptContainers:       teamHandlerFactory.HandlerFor(containerServer.ListKeptContainers),
 		atc.GetContainer:             teamHandlerFactory.HandlerFor(containerServer.GetContainer),
 		atc.HijackContainer:          teamHandlerFactory.HandlerFor(containerServer.HijackContainer),
 		atc.ListDestroyingContainers: http.HandlerFunc(containerServer.ListDestroyingContainers),
@@ -8,6 +8,7 @@ import (
 	"io"
 	"io/ioutil"
 	"net/http"
+	"net/url"
 	"time"
 
 	"github.com/concourse/concourse/atc"
@@ -367,8 +368,11 @@ var _ = Describe("Pipelines API", func() {
 	Describe("GET /api/v1/teams/:team_name/pipelines/:pipeline_name", func() {
 		var response *http.Response
 		var fakePipeline *dbfakes.FakePipeline
+		var query url.Values
 
 		BeforeEach(func() {
+			query = nil
+
 			fakePipeline = new(dbfakes.FakePipeline)
 			fakePipeline.IDReturns(4)
 			fakePipeline.NameReturns("some-specific-pipeline")
@@ -391,7 +395,7 @@ var _ = Describe("Pipelines API", func() {
 		})
 
 		JustBeforeEach(func() {
-			req, err := http.NewRequest("GET", server.URL+"/api/v1/teams/a-team/pipelines/some-specific-pipeline", nil)
+			req, err := http.NewRequest("GET", server.URL+"/api/v1/teams/a-team/pipelines/some-specific-pipeline?"+query.Encode(), nil)
 			Expect(err).NotTo(HaveOccurred())
 
 			req.Header.Set("Content-Type", "application/json")
@@ -455,6 +459,64 @@ var _ = Describe("Pipelines API", func() {
 						]
 					}`))
 			})
+
+			It("looks up the pipeline by name", func() {
+				Expect(fakeTeam.PipelineArgsForCall(0)).To(Equal(atc.PipelineRef{Name: "some-specific-pipeline"}))
+			})
+
+			Context("when instance vars are given", func() {
+				BeforeEach(func() {
+					query = atc.PipelineRef{
+						Name:         "some-specific-pipeline",
+						InstanceVars: atc.InstanceVars{"branch": "feature"},
+					}.QueryParams()
+
+					fakePipeline.InstanceVarsReturns(atc.InstanceVars{"branch": "feature"})
+				})
+
+				It("looks up the instance of the pipeline", func() {
+					Expect(fakeTeam.PipelineArgsForCall(0)).To(Equal(atc.PipelineRef{
+						Name:         "some-specific-pipeline",
+						InstanceVars: atc.InstanceVars{"branch": "feature"},
+					}))
+				})
+
+				It("returns the instance vars", func() {
+					var pipeline atc.Pipeline
+					err := json.NewDecoder(response.Body).Decode(&pipeline)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(pipeline.InstanceVars).To(Equal(atc.InstanceVars{"branch": "feature"}))
+				})
+			})
+
+			Context("when the pipeline was set by a job", func() {
+				BeforeEach(func() {
+					fakePipeline.ParentJobIDReturns(5)
+					fakePipeline.ParentBuildIDReturns(42)
+					fakePipeline.ParentTeamNameReturns("some-parent-team")
+				})
+
+				It("returns the parent job, build and team", func() {
+					var pipeline atc.Pipeline
+					err := json.NewDecoder(response.Body).Decode(&pipeline)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(pipeline.ParentJobID).To(Equal(5))
+					Expect(pipeline.ParentBuildID).To(Equal(42))
+					Expect(pipeline.ParentTeamName).To(Equal("some-parent-team"))
+				})
+			})
+
+			Context("when the instance vars are malformed", func() {
+				BeforeEach(func() {
+					query = url.Values{atc.InstanceVarsQueryParam: []string{"{not-json"}}
+				})
+
+				It("returns 400", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+				})
+			})
 		})
 
 		Context("when authenticated as another team", func() {
@@ -821,7 +883,7 @@ var _ = Describe("Pipelines API", func() {
 
 				It("injects the proper pipelineDB", func() {
 					pipelineName := fakeTeam.PipelineArgsForCall(0)
-					Expect(pipelineName).To(Equal("a-pipeline-name"))
+					Expect(pipelineName).To(Equal(atc.PipelineRef{Name: "a-pipeline-name"}))
 				})
 
 				It("deletes the named pipeline from the database", func() {
@@ -894,7 +956,7 @@ var _ = Describe("Pipelines API", func() {
 
 				It("injects the proper pipelineDB", func() {
 					pipelineName := fakeTeam.PipelineArgsForCall(0)
-					Expect(pipelineName).To(Equal("a-pipeline"))
+					Expect(pipelineName).To(Equal(atc.PipelineRef{Name: "a-pipeline"}))
 				})
 
 				Context("when pausing the pipeline succeeds", func() {
@@ -1020,7 +1082,7 @@ var _ = Describe("Pipelines API", func() {
 
 				It("injects the proper pipelineDB", func() {
 					pipelineName := fakeTeam.PipelineArgsForCall(0)
-					Expect(pipelineName).To(Equal("a-pipeline"))
+					Expect(pipelineName).To(Equal(atc.PipelineRef{Name: "a-pipeline"}))
 				})
 
 				Context("when unpausing the pipeline succeeds", func() {
@@ -1119,7 +1181,7 @@ var _ = Describe("Pipelines API", func() {
 				It("injects the proper pipelineDB", func() {
 					Expect(fakeTeam.PipelineCallCount()).To(Equal(1))
 					pipelineName := fakeTeam.PipelineArgsForCall(0)
-					Expect(pipelineName).To(Equal("a-pipeline"))
+					Expect(pipelineName).To(Equal(atc.PipelineRef{Name: "a-pipeline"}))
 				})
 
 				Context("when exposing the pipeline succeeds", func() {
@@ -1197,7 +1259,7 @@ var _ = Describe("Pipelines API", func() {
 
 				It("injects the proper pipeline", func() {
 					pipelineName := fakeTeam.PipelineArgsForCall(0)
-					Expect(pipelineName).To(Equal("a-pipeline"))
+					Expect(pipelineName).To(Equal(atc.PipelineRef{Name: "a-pipeline"}))
 				})
 
 				Context("when hiding the pipeline succeeds", func() {
@@ -1591,7 +1653,7 @@ var _ = Describe("Pipelines API", func() {
 
 				It("injects the proper pipeline", func() {
 					pipelineName := fakeTeam.PipelineArgsForCall(0)
-					Expect(pipelineName).To(Equal("a-pipeline"))
+					Expect(pipelineName).To(Equal(atc.PipelineRef{Name: "a-pipeline"}))
 				})
 
 				It("returns 204", func() {
@@ -19,13 +19,15 @@ func Build(build db.Build) atc.Build {
 	}
 
 	atcBuild := atc.Build{
-		ID:           build.ID(),
-		Name:         build.Name(),
-		JobName:      build.JobName(),
-		PipelineName: build.PipelineName(),
-		TeamName:     build.TeamName(),
-		Status:       string(build.Status()),
-		APIURL:       apiURL,
+		ID:            build.ID(),
+		Name:          build.Name(),
+		JobName:       build.JobName(),
+		PipelineName:  build.PipelineName(),
+		TeamName:      build.TeamName(),
+		Status:        string(build.Status()),
+		APIURL:        apiURL,
+		TriggerReason: string(build.TriggerReason()),
+		Paused:        build.IsPaused(),
 	}
 
 	if build.RerunOf() != 0 {
@@ -35,6 +35,7 @@ import (
 	"github.com/concourse/concourse/atc/db/migration"
 	"github.com/concourse/concourse/atc/engine"
 	"github.com/concourse/concourse/atc/engine/builder"
+	"github.com/concourse/concourse/atc/exec"
 	"github.com/concourse/concourse/atc/gc"
 	"github.com/concourse/concourse/atc/lidar"
 	"github.com/concourse/concourse/atc/lockrunner"
@@ -136,6 +137,8 @@ type RunCommand struct {
 
 	EnableGlobalResources bool `long:"enable-global-resources" description:"Enable equivalent resources across pipelines and teams to share a single version history."`
 
+	SetPipelineTeamAuthorizations []exec.SetPipelineTeamAuthorization `long:"set-pipeline-team-authorization" value-name:"BUILD-TEAM:TARGET-TEAM" description:"Allow the builds of a team to set pipelines in another team with the set_pipeline step. Builds of admin teams are always allowed to. Can be specified multiple times."`
+
 	LidarScannerInterval time.Duration `long:"lidar-scanner-interval" default:"1m" description:"Interval on which the resource scanner will run to see if new checks need to be scheduled"`
 	LidarCheckerInterval time.Duration `long:"lidar-checker-interval" default:"10s" description:"Interval on which the resource checker runs any scheduled checks"`
 
@@ -204,8 +207,13 @@ type RunCommand struct {
 
 	JobSchedulingMaxInFlight uint64 `long:"job-scheduling-max-in-flight" default:"32" description:"Maximum number of jobs to be scheduling at the same time"`
 
+	MaxKeepOnFailure time.Duration `long:"max-keep-on-failure" default:"24h" description:"Maximum time for which a task's keep_on_failure can keep its container after it fails, 0 means not capped"`
+
 	DefaultCpuLimit    *int    `long:"default-task-cpu-limit" description:"Default max number of cpu shares per task, 0 means unlimited"`
 	DefaultMemoryLimit *string `long:"default-task-memory-limit" description:"Default maximum memory per task, 0 means unlimited"`
+	DefaultCpuQuota    *int    `long:"default-task-cpu-quota" description:"Default hard limit on cpu time per task, as a percentage of one cpu, applied only on workers that support it, 0 means unlimited"`
+	DefaultPidsLimit   *int    `long:"default-task-pids-limit" description:"Default maximum number of processes per task, 0 means unlimited"`
+	DefaultDiskLimit   *string `long:"default-task-disk-limit" description:"Default maximum disk usage of a task's root filesystem, applied only on workers that support it, 0 means unlimited"`
 
 	Auditor struct {
 		EnableBuildAuditLog     bool `long:"enable-build-auditing" description:"Enable auditing for all api requests connected to builds."`
@@ -643,7 +651,7 @@ func (cmd *RunCommand) constructAPIMembers(
 	)
 
 	pool := worker.NewPool(workerProvider)
-	workerClient := worker.NewClient(pool, workerProvider, compressionLib)
+	workerClient := worker.NewClient(pool, workerProvider, compressionLib, clock.NewClock(), cmd.MaxKeepOnFailure)
 
 	credsManagers := cmd.CredentialManagers
 	dbPipelineFactory := db.NewPipelineFactory(dbConn, lockFactory)
@@ -871,7 +879,7 @@ func (cmd *RunCommand) constructBackendMembers(
 	)
 
 	pool := worker.NewPool(workerProvider)
-	workerClient := worker.NewClient(pool, workerProvider, compressionLib)
+	workerClient := worker.NewClient(pool, workerProvider, compressionLib, clock.NewClock(), cmd.MaxKeepOnFailure)
 
 	defaultLimits, err := cmd.parseDefaultLimits()
 	if err != nil {
@@ -883,20 +891,24 @@ func (cmd *RunCommand) constructBackendMembers(
 		return nil, err
 	}
 
+	dbBuildFactory := db.NewBuildFactory(dbConn, lockFactory, cmd.GC.OneOffBuildGracePeriod, cmd.GC.FailedGracePeriod)
+
 	engine := cmd.constructEngine(
 		pool,
 		workerClient,
 		resourceFactory,
 		teamFactory,
+		dbBuildFactory,
 		dbResourceCacheFactory,
 		dbResourceConfigFactory,
+		dbTaskCacheFactory,
+		dbVolumeRepository,
 		secretManager,
 		defaultLimits,
 		buildContainerStrategy,
 		lockFactory,
 	)
 
-	dbBuildFactory := db.NewBuildFactory(dbConn, lockFactory, cmd.GC.OneOffBuildGracePeriod, cmd.GC.FailedGracePeriod)
 	dbCheckFactory := db.NewCheckFactory(dbConn, lockFactory, secretManager, cmd.varSourcePool, cmd.GlobalResourceCheckTimeout)
 	dbPipelineFactory := db.NewPipelineFactory(dbConn, lockFactory)
 	dbJobFactory := db.NewJobFactory(dbConn, lockFactory)
@@ -957,6 +969,7 @@ func (cmd *RunCommand) constructBackendMembers(
 				dbJobFactory,
 				&scheduler.Scheduler{
 					Algorithm: alg,
+					Clock:     clock.NewClock(),
 					BuildStarter: scheduler.NewBuildStarter(
 						factory.NewBuildFactory(
 							atc.NewPlanFactory(time.Now().Unix()),
@@ -1301,8 +1314,11 @@ func (cmd *RunCommand) tlsConfig(logger lager.Logger, dbConn db.Conn) (*tls.Conf
 
 func (cmd *RunCommand) parseDefaultLimits() (atc.ContainerLimits, error) {
 	return atc.ParseContainerLimits(map[string]interface{}{
-		"cpu":    cmd.DefaultCpuLimit,
-		"memory": cmd.DefaultMemoryLimit,
+		"cpu":       cmd.DefaultCpuLimit,
+		"memory":    cmd.DefaultMemoryLimit,
+		"cpu_quota": cmd.DefaultCpuQuota,
+		"pids":      cmd.DefaultPidsLimit,
+		"disk":      cmd.DefaultDiskLimit,
 	})
 }
 
@@ -1560,8 +1576,11 @@ func (cmd *RunCommand) constructEngine(
 	workerClient worker.Client,
 	resourceFactory resource.ResourceFactory,
 	teamFactory db.TeamFactory,
+	buildFactory db.BuildFactory,
 	resourceCacheFactory db.ResourceCacheFactory,
 	resourceConfigFactory db.ResourceConfigFactory,
+	taskCacheFactory db.TaskCacheFactory,
+	volumeRepository db.VolumeRepository,
 	secretManager creds.Secrets,
 	defaultLimits atc.ContainerLimits,
 	strategy worker.ContainerPlacementStrategy,
@@ -1573,11 +1592,15 @@ func (cmd *RunCommand) constructEngine(
 		workerClient,
 		resourceFactory,
 		teamFactory,
+		buildFactory,
 		resourceCacheFactory,
 		resourceConfigFactory,
+		taskCacheFactory,
+		volumeRepository,
 		defaultLimits,
 		strategy,
 		lockFactory,
+		cmd.SetPipelineTeamAuthorizations,
 	)
 
 	stepBuilder := builder.NewStepBuilder(
@@ -1587,6 +1610,7 @@ func (cmd *RunCommand) constructEngine(
 		secretManager,
 		cmd.varSourcePool,
 		cmd.EnableRedactSecrets,
+		clock.NewClock(),
 	)
 
 	return engine.NewEngine(stepBuilder)
@@ -6,6 +6,7 @@ import (
 
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/atccmd"
+	"github.com/concourse/concourse/atc/exec"
 	"github.com/jessevdk/go-flags"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
@@ -45,6 +46,38 @@ func (s *CommandSuite) TestInvalidConcurrentRequestLimitAction() {
 	)
 }
 
+func (s *CommandSuite) TestSetPipelineTeamAuthorization() {
+	cmd := &atccmd.RunCommand{}
+	parser := flags.NewParser(cmd, flags.None)
+	// the remaining required flags are not given, so only the values matter
+	_, _ = parser.ParseArgs([]string{
+		"--client-secret",
+		"client-secret",
+		"--set-pipeline-team-authorization",
+		"main:some-team",
+		"--set-pipeline-team-authorization",
+		"main:other-team",
+	})
+
+	s.Equal([]exec.SetPipelineTeamAuthorization{
+		{BuildTeam: "main", TargetTeam: "some-team"},
+		{BuildTeam: "main", TargetTeam: "other-team"},
+	}, cmd.SetPipelineTeamAuthorizations)
+}
+
+func (s *CommandSuite) TestInvalidSetPipelineTeamAuthorization() {
+	cmd := &atccmd.RunCommand{}
+	parser := flags.NewParser(cmd, flags.None)
+	_, err := parser.ParseArgs([]string{
+		"--client-secret",
+		"client-secret",
+		"--set-pipeline-team-authorization",
+		"some-team",
+	})
+
+	s.Contains(err.Error(), "invalid team authorization 'some-team', expected BUILD-TEAM:TARGET-TEAM")
+}
+
 func TestSuite(t *testing.T) {
 	suite.Run(t, &CommandSuite{
 		Assertions: require.New(t),
@@ -63,7 +63,11 @@ func (a *auditor) ValidateAction(action string) bool {
 		atc.BuildEvents,
 		atc.BuildResources,
 		atc.AbortBuild,
+		atc.ApproveBuild,
+		atc.SetBuildBreakpoint,
+		atc.ContinueBuild,
 		atc.GetBuildPreparation,
+		atc.GetBuildTests,
 		atc.ListBuildsWithVersionAsInput,
 		atc.ListBuildsWithVersionAsOutput,
 		atc.CreateArtifact,
@@ -71,6 +75,7 @@ func (a *auditor) ValidateAction(action string) bool {
 		atc.ListBuildArtifacts:
 		return a.EnableBuildAuditLog
 	case atc.ListContainers,
+		atc.ListKeptContainers,
 		atc.GetContainer,
 		atc.HijackContainer,
 		atc.ListDestroyingContainers,
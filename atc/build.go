@@ -12,18 +12,44 @@ const (
 )
 
 type Build struct {
-	ID           int           `json:"id"`
-	TeamName     string        `json:"team_name"`
-	Name         string        `json:"name"`
-	Status       string        `json:"status"`
-	JobName      string        `json:"job_name,omitempty"`
-	APIURL       string        `json:"api_url"`
-	PipelineName string        `json:"pipeline_name,omitempty"`
-	StartTime    int64         `json:"start_time,omitempty"`
-	EndTime      int64         `json:"end_time,omitempty"`
-	ReapTime     int64         `json:"reap_time,omitempty"`
-	RerunNumber  int           `json:"rerun_number,omitempty"`
-	RerunOf      *RerunOfBuild `json:"rerun_of,omitempty"`
+	ID            int           `json:"id"`
+	TeamName      string        `json:"team_name"`
+	Name          string        `json:"name"`
+	Status        string        `json:"status"`
+	JobName       string        `json:"job_name,omitempty"`
+	APIURL        string        `json:"api_url"`
+	PipelineName  string        `json:"pipeline_name,omitempty"`
+	StartTime     int64         `json:"start_time,omitempty"`
+	EndTime       int64         `json:"end_time,omitempty"`
+	ReapTime      int64         `json:"reap_time,omitempty"`
+	RerunNumber   int           `json:"rerun_number,omitempty"`
+	RerunOf       *RerunOfBuild `json:"rerun_of,omitempty"`
+	TriggerReason string        `json:"trigger_reason,omitempty"`
+	Paused        bool          `json:"paused,omitempty"`
+}
+
+// ApprovalRequest is the body of a request to approve or reject a step the
+// build is waiting on. The step may be identified by its name or plan ID;
+// if neither is given, the step that has been waiting longest is decided.
+type ApprovalRequest struct {
+	Approved bool   `json:"approved"`
+	Step     string `json:"step,omitempty"`
+	PlanID   PlanID `json:"plan_id,omitempty"`
+}
+
+// BreakpointPosition is whether a build pauses before or after a step.
+type BreakpointPosition string
+
+const (
+	BreakpointBefore BreakpointPosition = "before"
+	BreakpointAfter  BreakpointPosition = "after"
+)
+
+// BreakpointRequest is the body of a request to pause a build before or
+// after the named step.
+type BreakpointRequest struct {
+	Step     string             `json:"step"`
+	Position BreakpointPosition `json:"position"`
 }
 
 type RerunOfBuild struct {
@@ -0,0 +1,482 @@
+// Package condition parses and evaluates the boolean expressions used by a
+// step's 'if' field, e.g.:
+//
+//	((branch)) == "release" && !((.:skip-deploy))
+//
+// Operands are vars, quoted strings, numbers, true, false and null. Operators
+// are ==, !=, !, && and ||, and parentheses may be used for grouping. A var
+// or literal used on its own is true unless it is false, null, zero, empty or
+// an empty list or map.
+package condition
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"gopkg.in/yaml.v2"
+
+	"github.com/concourse/concourse/vars"
+)
+
+// Condition is a parsed expression.
+type Condition struct {
+	root node
+}
+
+// Parse parses an expression, returning an error if it is malformed.
+func Parse(expr string) (Condition, error) {
+	tokens, err := tokenize(expr)
+	if err != nil {
+		return Condition{}, err
+	}
+
+	p := &parser{tokens: tokens}
+
+	root, err := p.parseOr()
+	if err != nil {
+		return Condition{}, err
+	}
+
+	if !p.done() {
+		return Condition{}, fmt.Errorf("unexpected %s", p.peek())
+	}
+
+	return Condition{root: root}, nil
+}
+
+// Evaluate evaluates the expression, looking up vars in the given variables.
+// It is an error for a var not to be defined.
+func (c Condition) Evaluate(variables vars.Variables) (bool, error) {
+	val, err := c.root.eval(variables)
+	if err != nil {
+		return false, err
+	}
+
+	return truthy(val), nil
+}
+
+type tokenKind int
+
+const (
+	tokenVar tokenKind = iota
+	tokenString
+	tokenNumber
+	tokenIdent
+	tokenOp
+)
+
+type token struct {
+	kind tokenKind
+	text string
+}
+
+func (t token) String() string {
+	switch t.kind {
+	case tokenVar:
+		return fmt.Sprintf("'((%s))'", t.text)
+	case tokenString:
+		return strconv.Quote(t.text)
+	default:
+		return fmt.Sprintf("'%s'", t.text)
+	}
+}
+
+var operators = []string{"==", "!=", "&&", "||", "!", "(", ")"}
+
+func tokenize(expr string) ([]token, error) {
+	var tokens []token
+
+	for i := 0; i < len(expr); {
+		c := expr[i]
+
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+
+		// a var starts at the innermost '((', so that e.g. '(((a)) || ((b)))'
+		// begins with a grouping paren
+		case strings.HasPrefix(expr[i:], "((") && !strings.HasPrefix(expr[i:], "((("):
+			end := strings.Index(expr[i:], "))")
+			if end == -1 {
+				return nil, fmt.Errorf("unterminated var at position %d", i)
+			}
+
+			name := strings.TrimSpace(expr[i+2 : i+end])
+			if name == "" {
+				return nil, fmt.Errorf("empty var at position %d", i)
+			}
+
+			tokens = append(tokens, token{tokenVar, name})
+			i += end + 2
+
+		case c == '"' || c == '\'':
+			end := i + 1
+			for end < len(expr) && expr[end] != c {
+				if expr[end] == '\\' && c == '"' {
+					end++
+				}
+				end++
+			}
+
+			if end >= len(expr) {
+				return nil, fmt.Errorf("unterminated string at position %d", i)
+			}
+
+			text := expr[i+1 : end]
+			if c == '"' {
+				var err error
+				text, err = strconv.Unquote(expr[i : end+1])
+				if err != nil {
+					return nil, fmt.Errorf("invalid string at position %d: %s", i, err)
+				}
+			}
+
+			tokens = append(tokens, token{tokenString, text})
+			i = end + 1
+
+		case c == '-' || (c >= '0' && c <= '9'):
+			end := i + 1
+			for end < len(expr) && (expr[end] == '.' || (expr[end] >= '0' && expr[end] <= '9')) {
+				end++
+			}
+
+			tokens = append(tokens, token{tokenNumber, expr[i:end]})
+			i = end
+
+		case unicode.IsLetter(rune(c)):
+			end := i + 1
+			for end < len(expr) && unicode.IsLetter(rune(expr[end])) {
+				end++
+			}
+
+			tokens = append(tokens, token{tokenIdent, expr[i:end]})
+			i = end
+
+		default:
+			var op string
+			for _, candidate := range operators {
+				if strings.HasPrefix(expr[i:], candidate) {
+					op = candidate
+					break
+				}
+			}
+
+			if op == "" {
+				return nil, fmt.Errorf("unexpected character %q at position %d", c, i)
+			}
+
+			tokens = append(tokens, token{tokenOp, op})
+			i += len(op)
+		}
+	}
+
+	if len(tokens) == 0 {
+		return nil, fmt.Errorf("empty expression")
+	}
+
+	return tokens, nil
+}
+
+type parser struct {
+	tokens []token
+	pos    int
+}
+
+func (p *parser) done() bool {
+	return p.pos >= len(p.tokens)
+}
+
+func (p *parser) peek() token {
+	return p.tokens[p.pos]
+}
+
+func (p *parser) acceptOp(op string) bool {
+	if !p.done() && p.peek().kind == tokenOp && p.peek().text == op {
+		p.pos++
+		return true
+	}
+
+	return false
+}
+
+func (p *parser) parseOr() (node, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+
+	for p.acceptOp("||") {
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+
+		left = orNode{left, right}
+	}
+
+	return left, nil
+}
+
+func (p *parser) parseAnd() (node, error) {
+	left, err := p.parseNot()
+	if err != nil {
+		return nil, err
+	}
+
+	for p.acceptOp("&&") {
+		right, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+
+		left = andNode{left, right}
+	}
+
+	return left, nil
+}
+
+func (p *parser) parseNot() (node, error) {
+	if p.acceptOp("!") {
+		operand, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+
+		return notNode{operand}, nil
+	}
+
+	return p.parseComparison()
+}
+
+func (p *parser) parseComparison() (node, error) {
+	left, err := p.parseOperand()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, op := range []string{"==", "!="} {
+		if p.acceptOp(op) {
+			right, err := p.parseOperand()
+			if err != nil {
+				return nil, err
+			}
+
+			return equalNode{left: left, right: right, negate: op == "!="}, nil
+		}
+	}
+
+	return left, nil
+}
+
+func (p *parser) parseOperand() (node, error) {
+	if p.done() {
+		return nil, fmt.Errorf("unexpected end of expression")
+	}
+
+	tok := p.peek()
+	p.pos++
+
+	switch tok.kind {
+	case tokenVar:
+		return varNode(tok.text), nil
+
+	case tokenString:
+		return literalNode{tok.text}, nil
+
+	case tokenNumber:
+		n, err := strconv.ParseFloat(tok.text, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid number '%s'", tok.text)
+		}
+
+		return literalNode{n}, nil
+
+	case tokenIdent:
+		switch tok.text {
+		case "true":
+			return literalNode{true}, nil
+		case "false":
+			return literalNode{false}, nil
+		case "null":
+			return literalNode{nil}, nil
+		default:
+			return nil, fmt.Errorf("unknown identifier '%s' (vars must be written as ((%s)) and strings must be quoted)", tok.text, tok.text)
+		}
+
+	case tokenOp:
+		if tok.text == "(" {
+			inner, err := p.parseOr()
+			if err != nil {
+				return nil, err
+			}
+
+			if !p.acceptOp(")") {
+				return nil, fmt.Errorf("missing ')'")
+			}
+
+			return inner, nil
+		}
+	}
+
+	return nil, fmt.Errorf("unexpected %s", tok)
+}
+
+type node interface {
+	eval(vars.Variables) (interface{}, error)
+}
+
+type literalNode struct {
+	value interface{}
+}
+
+func (n literalNode) eval(vars.Variables) (interface{}, error) {
+	return n.value, nil
+}
+
+type varNode string
+
+func (n varNode) eval(variables vars.Variables) (interface{}, error) {
+	result, err := vars.NewTemplate([]byte("(("+string(n)+"))")).Evaluate(variables, vars.EvaluateOpts{
+		ExpectAllKeys: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var val interface{}
+	err = yaml.Unmarshal(result, &val)
+	if err != nil {
+		return nil, err
+	}
+
+	return val, nil
+}
+
+type notNode struct {
+	operand node
+}
+
+func (n notNode) eval(variables vars.Variables) (interface{}, error) {
+	val, err := n.operand.eval(variables)
+	if err != nil {
+		return nil, err
+	}
+
+	return !truthy(val), nil
+}
+
+type andNode struct {
+	left, right node
+}
+
+func (n andNode) eval(variables vars.Variables) (interface{}, error) {
+	left, err := n.left.eval(variables)
+	if err != nil {
+		return nil, err
+	}
+
+	if !truthy(left) {
+		return false, nil
+	}
+
+	right, err := n.right.eval(variables)
+	if err != nil {
+		return nil, err
+	}
+
+	return truthy(right), nil
+}
+
+type orNode struct {
+	left, right node
+}
+
+func (n orNode) eval(variables vars.Variables) (interface{}, error) {
+	left, err := n.left.eval(variables)
+	if err != nil {
+		return nil, err
+	}
+
+	if truthy(left) {
+		return true, nil
+	}
+
+	right, err := n.right.eval(variables)
+	if err != nil {
+		return nil, err
+	}
+
+	return truthy(right), nil
+}
+
+type equalNode struct {
+	left, right node
+	negate      bool
+}
+
+func (n equalNode) eval(variables vars.Variables) (interface{}, error) {
+	left, err := n.left.eval(variables)
+	if err != nil {
+		return nil, err
+	}
+
+	right, err := n.right.eval(variables)
+	if err != nil {
+		return nil, err
+	}
+
+	return equal(left, right) != n.negate, nil
+}
+
+func equal(a, b interface{}) bool {
+	if an, ok := number(a); ok {
+		if bn, ok := number(b); ok {
+			return an == bn
+		}
+
+		return false
+	}
+
+	return reflect.DeepEqual(a, b)
+}
+
+func number(val interface{}) (float64, bool) {
+	switch v := val.(type) {
+	case int:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	case uint64:
+		return float64(v), true
+	case float64:
+		return v, true
+	default:
+		return 0, false
+	}
+}
+
+func truthy(val interface{}) bool {
+	if n, ok := number(val); ok {
+		return n != 0
+	}
+
+	switch v := val.(type) {
+	case nil:
+		return false
+	case bool:
+		return v
+	case string:
+		return v != ""
+	}
+
+	rv := reflect.ValueOf(val)
+	switch rv.Kind() {
+	case reflect.Slice, reflect.Map:
+		return rv.Len() > 0
+	}
+
+	return true
+}
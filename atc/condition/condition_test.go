@@ -0,0 +1,106 @@
+package condition_test
+
+import (
+	"github.com/concourse/concourse/atc/condition"
+	"github.com/concourse/concourse/vars"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Condition", func() {
+	variables := vars.StaticVariables{
+		"branch":  "release",
+		"empty":   "",
+		"zero":    0,
+		"count":   3,
+		"yes":     true,
+		"no":      false,
+		"nothing": nil,
+		"list":    []interface{}{"a"},
+		"none":    []interface{}{},
+		"obj":     map[string]interface{}{"field": "value"},
+	}
+
+	DescribeTable("Evaluate",
+		func(expr string, expected bool) {
+			cond, err := condition.Parse(expr)
+			Expect(err).NotTo(HaveOccurred())
+
+			result, err := cond.Evaluate(variables)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).To(Equal(expected))
+		},
+		Entry("true", "true", true),
+		Entry("false", "false", false),
+		Entry("a non-empty string var", "((branch))", true),
+		Entry("an empty string var", "((empty))", false),
+		Entry("a zero var", "((zero))", false),
+		Entry("a non-zero var", "((count))", true),
+		Entry("a true var", "((yes))", true),
+		Entry("a false var", "((no))", false),
+		Entry("a null var", "((nothing))", false),
+		Entry("a non-empty list", "((list))", true),
+		Entry("an empty list", "((none))", false),
+		Entry("a field of a var", `((obj.field)) == "value"`, true),
+		Entry("string equality", `((branch)) == "release"`, true),
+		Entry("single-quoted strings", `((branch)) == 'release'`, true),
+		Entry("string inequality", `((branch)) != "release"`, false),
+		Entry("number equality", "((count)) == 3", true),
+		Entry("number equality with decimals", "((count)) == 3.0", true),
+		Entry("numbers are not strings", `((count)) == "3"`, false),
+		Entry("null equality", "((nothing)) == null", true),
+		Entry("not", "!((no))", true),
+		Entry("double not", "!!((branch))", true),
+		Entry("and", `((yes)) && ((branch)) == "release"`, true),
+		Entry("and with a false operand", "((yes)) && ((no))", false),
+		Entry("or", "((no)) || ((yes))", true),
+		Entry("and binds tighter than or", "((yes)) || ((no)) && ((no))", true),
+		Entry("parentheses", "(((yes)) || ((no))) && ((no))", false),
+		Entry("not of a comparison", `!(((branch)) == "main")`, true),
+	)
+
+	It("does not evaluate the right-hand side of && when the left is false", func() {
+		cond, err := condition.Parse("((no)) && ((undefined))")
+		Expect(err).NotTo(HaveOccurred())
+
+		result, err := cond.Evaluate(variables)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result).To(BeFalse())
+	})
+
+	It("does not evaluate the right-hand side of || when the left is true", func() {
+		cond, err := condition.Parse("((yes)) || ((undefined))")
+		Expect(err).NotTo(HaveOccurred())
+
+		result, err := cond.Evaluate(variables)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result).To(BeTrue())
+	})
+
+	It("errors when a var is not defined", func() {
+		cond, err := condition.Parse("((undefined))")
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = cond.Evaluate(variables)
+		Expect(err).To(MatchError(ContainSubstring("undefined")))
+	})
+
+	DescribeTable("Parse errors",
+		func(expr string, message string) {
+			_, err := condition.Parse(expr)
+			Expect(err).To(MatchError(message))
+		},
+		Entry("empty", " ", "empty expression"),
+		Entry("unquoted string", "((branch)) == release", "unknown identifier 'release' (vars must be written as ((release)) and strings must be quoted)"),
+		Entry("unterminated var", "((branch", "unterminated var at position 0"),
+		Entry("unterminated string", `((branch)) == "release`, "unterminated string at position 14"),
+		Entry("missing operand", "((branch)) ==", "unexpected end of expression"),
+		Entry("missing paren", "(((yes))", "missing ')'"),
+		Entry("trailing tokens", "((yes)) ((no))", "unexpected '((no))'"),
+		Entry("chained comparison", "1 == 1 == 1", "unexpected '=='"),
+		Entry("unknown operator", "((yes)) & ((no))", "unexpected character '&' at position 8"),
+		Entry("single equals", "((yes)) = ((no))", "unexpected character '=' at position 8"),
+	)
+})
@@ -386,6 +386,29 @@ func (c *InParallelConfig) UnmarshalJSON(payload []byte) error {
 	return nil
 }
 
+// An AcrossVarConfig is a var and the set of values the step should be run
+// with, along with how many of those values may be run in parallel.
+type AcrossVarConfig struct {
+	Var         string        `json:"var"`
+	Values      []interface{} `json:"values,omitempty"`
+	MaxInFlight int           `json:"max_in_flight,omitempty"`
+}
+
+const (
+	RetryOnAny     = "any"
+	RetryOnErrored = "errored"
+	RetryOnFailed  = "failed"
+)
+
+// A RetryBackoff configures how long to wait between attempts of a step. The
+// delay starts at Initial and is multiplied by Multiplier (default 2) after
+// each attempt, up to Max.
+type RetryBackoff struct {
+	Initial    string  `json:"initial"`
+	Multiplier float64 `json:"multiplier,omitempty"`
+	Max        string  `json:"max,omitempty"`
+}
+
 // A PlanConfig is a flattened set of configuration corresponding to
 // a particular Plan, where Source and Version are populated lazily.
 type PlanConfig struct {
@@ -427,12 +450,22 @@ type PlanConfig struct {
 	Task string `json:"task,omitempty"`
 	// run task privileged
 	Privileged bool `json:"privileged,omitempty"`
+	// reuse the outputs of an earlier successful run of the task with identical inputs
+	Memoize bool `json:"memoize,omitempty"`
+	// files of test results to collect from the task's outputs
+	Reports []TestReportConfig `json:"reports,omitempty"`
+	// how long to keep the task's container for debugging if it fails
+	KeepOnFailure string `json:"keep_on_failure,omitempty"`
 	// inlined task config
 	TaskConfig *TaskConfig `json:"config,omitempty"`
 
 	// name of 'set_pipeline'
 	SetPipeline string   `json:"set_pipeline,omitempty"`
 	VarFiles    []string `json:"var_files,omitempty"`
+	// vars identifying the instance of the pipeline to set
+	InstanceVars InstanceVars `json:"instance_vars,omitempty"`
+	// name of the team to set the pipeline in, defaulting to the build's team
+	Team string `json:"team,omitempty"`
 
 	// config path, e.g. foo/build.yml. Multiple steps might have this field, e.g. Task step and SetPipeline step.
 	File string `json:"file,omitempty"`
@@ -453,6 +486,9 @@ type PlanConfig struct {
 	// used by Put to specify params for the subsequent Get
 	GetParams Params `json:"get_params,omitempty"`
 
+	// used by Put to skip the subsequent Get of the version it created
+	NoGet bool `json:"no_get,omitempty"`
+
 	// used by any step to specify which workers are eligible to run the step
 	Tags Tags `json:"tags,omitempty"`
 
@@ -477,12 +513,27 @@ type PlanConfig struct {
 	// used on any step to interrupt the step after a given duration
 	Timeout string `json:"timeout,omitempty"`
 
+	// used on any step to skip the step unless the given expression is true
+	If string `json:"if,omitempty"`
+
+	// used on any step to run the step for every combination of the given var values
+	Across []AcrossVarConfig `json:"across,omitempty"`
+
+	// used with across to abort the remaining combinations when one fails
+	FailFast bool `json:"fail_fast,omitempty"`
+
 	// not present in yaml
 	DependentGet string `json:"-" json:"-"`
 
 	// repeat the step up to N times, until it works
 	Attempts int `json:"attempts,omitempty"`
 
+	// used with attempts to wait between attempts
+	Backoff *RetryBackoff `json:"backoff,omitempty"`
+
+	// used with attempts to only retry when the step errored or failed
+	RetryOn string `json:"retry_on,omitempty"`
+
 	Version *VersionConfig `json:"version,omitempty"`
 
 	// name of 'load_var' step
@@ -491,8 +542,17 @@ type PlanConfig struct {
 	// format of input file.
 	Format string `json:"format,omitempty"`
 
+	// field to extract from the file loaded by a 'load_var' step
+	Field string `json:"field,omitempty"`
+
 	// if true, then it will not be redacted.
 	Reveal bool `json:"reveal,omitempty"`
+
+	// name of 'approve' step
+	Approve string `json:"approve,omitempty"`
+
+	// role a user must have in the build's team to approve an 'approve' step
+	Role string `json:"role,omitempty"`
 }
 
 func (config PlanConfig) Name() string {
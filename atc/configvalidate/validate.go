@@ -8,6 +8,7 @@ import (
 	"time"
 
 	. "github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/condition"
 	"github.com/concourse/concourse/atc/creds"
 )
 
@@ -276,6 +277,40 @@ func validateJobs(c Config) ([]ConfigWarning, error) {
 			}
 		}
 
+		if job.Schedule != nil {
+			_, err := job.Schedule.Parse()
+			if err != nil {
+				errorMessages = append(
+					errorMessages,
+					identifier+fmt.Sprintf(" has an invalid schedule: %s", err),
+				)
+			}
+		}
+
+		if job.BuildTimeout != "" {
+			duration, err := time.ParseDuration(job.BuildTimeout)
+			if err != nil || duration <= 0 {
+				errorMessages = append(
+					errorMessages,
+					identifier+fmt.Sprintf(" has an invalid build_timeout: '%s'", job.BuildTimeout),
+				)
+			}
+		}
+
+		artifacts := map[string]bool{}
+		for _, name := range job.Artifacts {
+			if name == "" {
+				errorMessages = append(errorMessages, identifier+" has an artifact with no name")
+			} else if artifacts[name] {
+				errorMessages = append(
+					errorMessages,
+					identifier+fmt.Sprintf(" has artifact '%s' listed more than once", name),
+				)
+			}
+
+			artifacts[name] = true
+		}
+
 		planWarnings, planErrMessages := validatePlan(c, identifier+".plan", PlanConfig{Do: &job.Plan})
 		warnings = append(warnings, planWarnings...)
 		errorMessages = append(errorMessages, planErrMessages...)
@@ -400,6 +435,10 @@ func validatePlan(c Config, identifier string, plan PlanConfig) ([]ConfigWarning
 		foundTypes.Find("load_var")
 	}
 
+	if plan.Approve != "" {
+		foundTypes.Find("approve")
+	}
+
 	if plan.Do != nil {
 		foundTypes.Find("do")
 	}
@@ -456,7 +495,7 @@ func validatePlan(c Config, identifier string, plan PlanConfig) ([]ConfigWarning
 		identifier = fmt.Sprintf("%s.get.%s", identifier, plan.Get)
 
 		errorMessages = append(errorMessages, validateInapplicableFields(
-			[]string{"privileged", "config", "file"},
+			[]string{"privileged", "memoize", "reports", "keep_on_failure", "no_get", "config", "file"},
 			plan, identifier)...,
 		)
 
@@ -531,10 +570,14 @@ func validatePlan(c Config, identifier string, plan PlanConfig) ([]ConfigWarning
 		identifier = fmt.Sprintf("%s.put.%s", identifier, plan.Put)
 
 		errorMessages = append(errorMessages, validateInapplicableFields(
-			[]string{"passed", "trigger", "privileged", "config", "file"},
+			[]string{"passed", "trigger", "privileged", "memoize", "reports", "keep_on_failure", "config", "file"},
 			plan, identifier)...,
 		)
 
+		if plan.NoGet && len(plan.GetParams) != 0 {
+			errorMessages = append(errorMessages, identifier+" specifies get_params but no_get skips the get")
+		}
+
 		if plan.Resource != "" {
 			_, found := c.Resources.Lookup(plan.Resource)
 			if !found {
@@ -587,8 +630,30 @@ func validatePlan(c Config, identifier string, plan PlanConfig) ([]ConfigWarning
 			}
 		}
 
+		for i, report := range plan.Reports {
+			subIdentifier := fmt.Sprintf("%s.reports[%d]", identifier, i)
+
+			switch report.Type {
+			case TestReportTypeJUnit, TestReportTypeTAP:
+			default:
+				errorMessages = append(errorMessages, subIdentifier+fmt.Sprintf(" has an unknown type '%s' (must be 'junit' or 'tap')", report.Type))
+			}
+
+			segs := strings.SplitN(report.Path, "/", 2)
+			if len(segs) != 2 || segs[0] == "" || segs[1] == "" {
+				errorMessages = append(errorMessages, subIdentifier+fmt.Sprintf(" has an invalid path '%s' (must be within an output, e.g. output/report.xml)", report.Path))
+			}
+		}
+
+		if plan.KeepOnFailure != "" {
+			duration, err := time.ParseDuration(plan.KeepOnFailure)
+			if err != nil || duration <= 0 {
+				errorMessages = append(errorMessages, identifier+fmt.Sprintf(" has an invalid keep_on_failure: '%s' (must be a positive duration, e.g. 2h)", plan.KeepOnFailure))
+			}
+		}
+
 		errorMessages = append(errorMessages, validateInapplicableFields(
-			[]string{"resource", "passed", "trigger"},
+			[]string{"resource", "passed", "trigger", "no_get"},
 			plan, identifier)...,
 		)
 
@@ -606,6 +671,20 @@ func validatePlan(c Config, identifier string, plan PlanConfig) ([]ConfigWarning
 			errorMessages = append(errorMessages, identifier+" does not specify any file")
 		}
 
+		if plan.Field != "" {
+			_, err := ParseFieldPath(plan.Field)
+			if err != nil {
+				errorMessages = append(errorMessages, identifier+fmt.Sprintf(" has an invalid field: %s", err))
+			}
+		}
+
+	case plan.Approve != "":
+		identifier = fmt.Sprintf("%s.approve.%s", identifier, plan.Approve)
+
+		if plan.Role != "" && !isApprovalRole(plan.Role) {
+			errorMessages = append(errorMessages, identifier+" has an unknown role: "+plan.Role)
+		}
+
 	case plan.Try != nil:
 		subIdentifier := fmt.Sprintf("%s.try", identifier)
 		planWarnings, planErrMessages := validatePlan(c, subIdentifier, *plan.Try)
@@ -656,11 +735,66 @@ func validatePlan(c Config, identifier string, plan PlanConfig) ([]ConfigWarning
 		}
 	}
 
+	if plan.If != "" {
+		_, err := condition.Parse(plan.If)
+		if err != nil {
+			subIdentifier := fmt.Sprintf("%s.if", identifier)
+			errorMessages = append(errorMessages, subIdentifier+fmt.Sprintf(" has an invalid expression: %s", err))
+		}
+	}
+
 	if plan.Attempts < 0 {
 		subIdentifier := fmt.Sprintf("%s.attempts", identifier)
 		errorMessages = append(errorMessages, subIdentifier+fmt.Sprintf(" has an invalid number of attempts (%d)", plan.Attempts))
 	}
 
+	if plan.RetryOn != "" {
+		subIdentifier := fmt.Sprintf("%s.retry_on", identifier)
+
+		switch plan.RetryOn {
+		case RetryOnAny, RetryOnErrored, RetryOnFailed:
+		default:
+			errorMessages = append(errorMessages, subIdentifier+fmt.Sprintf(" has an invalid value '%s' (must be '%s', '%s' or '%s')", plan.RetryOn, RetryOnAny, RetryOnErrored, RetryOnFailed))
+		}
+
+		if plan.Attempts == 0 {
+			errorMessages = append(errorMessages, subIdentifier+" has no effect without attempts")
+		}
+	}
+
+	if plan.Backoff != nil {
+		errorMessages = append(errorMessages, validateBackoff(identifier+".backoff", *plan.Backoff, plan.Attempts)...)
+	}
+
+	if plan.Across != nil {
+		warnings = append(warnings, ConfigWarning{
+			Type:    "pipeline",
+			Message: identifier + " : the across step modifier is experimental and subject to change",
+		})
+
+		acrossVarNames := map[string]bool{}
+		for i, acrossVar := range plan.Across {
+			subIdentifier := fmt.Sprintf("%s.across[%d]", identifier, i)
+
+			if acrossVar.Var == "" {
+				errorMessages = append(errorMessages, subIdentifier+" does not specify a var")
+			} else if acrossVarNames[acrossVar.Var] {
+				errorMessages = append(errorMessages, subIdentifier+fmt.Sprintf(" repeats the var '%s'", acrossVar.Var))
+			}
+			acrossVarNames[acrossVar.Var] = true
+
+			if len(acrossVar.Values) == 0 {
+				errorMessages = append(errorMessages, subIdentifier+" does not specify any values")
+			}
+
+			if acrossVar.MaxInFlight < 0 {
+				errorMessages = append(errorMessages, subIdentifier+fmt.Sprintf(" has an invalid max_in_flight (%d)", acrossVar.MaxInFlight))
+			}
+		}
+	} else if plan.FailFast {
+		errorMessages = append(errorMessages, identifier+" specifies fail_fast without across")
+	}
+
 	return warnings, errorMessages
 }
 
@@ -686,6 +820,22 @@ func validateInapplicableFields(inapplicableFields []string, plan PlanConfig, id
 			if plan.Privileged {
 				foundInapplicableFields = append(foundInapplicableFields, field)
 			}
+		case "memoize":
+			if plan.Memoize {
+				foundInapplicableFields = append(foundInapplicableFields, field)
+			}
+		case "reports":
+			if len(plan.Reports) != 0 {
+				foundInapplicableFields = append(foundInapplicableFields, field)
+			}
+		case "keep_on_failure":
+			if plan.KeepOnFailure != "" {
+				foundInapplicableFields = append(foundInapplicableFields, field)
+			}
+		case "no_get":
+			if plan.NoGet {
+				foundInapplicableFields = append(foundInapplicableFields, field)
+			}
 		case "config":
 			if plan.TaskConfig != nil {
 				foundInapplicableFields = append(foundInapplicableFields, field)
@@ -757,3 +907,38 @@ func validateVarSources(c Config) error {
 
 	return nil
 }
+
+func isApprovalRole(role string) bool {
+	switch role {
+	case "owner", "member", "pipeline-operator", "viewer":
+		return true
+	default:
+		return false
+	}
+}
+
+func validateBackoff(identifier string, backoff RetryBackoff, attempts int) []string {
+	var errorMessages []string
+
+	if attempts == 0 {
+		errorMessages = append(errorMessages, identifier+" has no effect without attempts")
+	}
+
+	if backoff.Initial == "" {
+		errorMessages = append(errorMessages, identifier+" does not specify an initial delay")
+	} else if _, err := time.ParseDuration(backoff.Initial); err != nil {
+		errorMessages = append(errorMessages, identifier+fmt.Sprintf(".initial has an invalid duration '%s'", backoff.Initial))
+	}
+
+	if backoff.Max != "" {
+		if _, err := time.ParseDuration(backoff.Max); err != nil {
+			errorMessages = append(errorMessages, identifier+fmt.Sprintf(".max has an invalid duration '%s'", backoff.Max))
+		}
+	}
+
+	if backoff.Multiplier != 0 && backoff.Multiplier < 1 {
+		errorMessages = append(errorMessages, identifier+fmt.Sprintf(".multiplier must be at least 1 (got %v)", backoff.Multiplier))
+	}
+
+	return errorMessages
+}
@@ -844,6 +844,23 @@ var _ = Describe("ValidateConfig", func() {
 				})
 			})
 
+			Context("when a put plan is memoized", func() {
+				BeforeEach(func() {
+					job.Plan = append(job.Plan, PlanConfig{
+						Put:     "some-resource",
+						Memoize: true,
+					})
+
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("returns an error", func() {
+					Expect(errorMessages).To(HaveLen(1))
+					Expect(errorMessages[0]).To(ContainSubstring("invalid jobs:"))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan[0].put.some-resource has invalid fields specified (memoize)"))
+				})
+			})
+
 			Context("when a task plan has invalid fields specified", func() {
 				BeforeEach(func() {
 					job.Plan = append(job.Plan, PlanConfig{
@@ -863,6 +880,144 @@ var _ = Describe("ValidateConfig", func() {
 				})
 			})
 
+			Context("when a task plan has invalid reports", func() {
+				BeforeEach(func() {
+					job.Plan = append(job.Plan, PlanConfig{
+						Task: "lol",
+						File: "task.yml",
+						Reports: []TestReportConfig{
+							{Type: "junit", Path: "output/report.xml"},
+							{Type: "nunit", Path: "output/report.xml"},
+							{Type: "tap", Path: "report.tap"},
+						},
+					})
+
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("returns an error", func() {
+					Expect(errorMessages).To(HaveLen(1))
+					Expect(errorMessages[0]).To(ContainSubstring("invalid jobs:"))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan[0].task.lol.reports[1] has an unknown type 'nunit' (must be 'junit' or 'tap')"))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan[0].task.lol.reports[2] has an invalid path 'report.tap' (must be within an output, e.g. output/report.xml)"))
+					Expect(errorMessages[0]).ToNot(ContainSubstring("reports[0]"))
+				})
+			})
+
+			Context("when a task plan has an invalid keep_on_failure", func() {
+				BeforeEach(func() {
+					job.Plan = append(job.Plan, PlanConfig{
+						Task:          "lol",
+						File:          "task.yml",
+						KeepOnFailure: "forever",
+					})
+
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("returns an error", func() {
+					Expect(errorMessages).To(HaveLen(1))
+					Expect(errorMessages[0]).To(ContainSubstring("invalid jobs:"))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan[0].task.lol has an invalid keep_on_failure: 'forever' (must be a positive duration, e.g. 2h)"))
+				})
+			})
+
+			Context("when a get plan sets keep_on_failure", func() {
+				BeforeEach(func() {
+					job.Plan = append(job.Plan, PlanConfig{
+						Get:           "some-resource",
+						KeepOnFailure: "1h",
+					})
+
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("returns an error", func() {
+					Expect(errorMessages).To(HaveLen(1))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan[0].get.some-resource has invalid fields specified (keep_on_failure)"))
+				})
+			})
+
+			Context("when a put plan sets no_get with get_params", func() {
+				BeforeEach(func() {
+					job.Plan = append(job.Plan, PlanConfig{
+						Put:       "some-resource",
+						NoGet:     true,
+						GetParams: Params{"depth": 1},
+					})
+
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("returns an error", func() {
+					Expect(errorMessages).To(HaveLen(1))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan[0].put.some-resource specifies get_params but no_get skips the get"))
+				})
+			})
+
+			Context("when a get plan sets no_get", func() {
+				BeforeEach(func() {
+					job.Plan = append(job.Plan, PlanConfig{
+						Get:   "some-resource",
+						NoGet: true,
+					})
+
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("returns an error", func() {
+					Expect(errorMessages).To(HaveLen(1))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan[0].get.some-resource has invalid fields specified (no_get)"))
+				})
+			})
+
+			Context("when a plan has an invalid across", func() {
+				BeforeEach(func() {
+					job.Plan = append(job.Plan, PlanConfig{
+						Task: "lol",
+						File: "task.yml",
+						Across: []AcrossVarConfig{
+							{
+								Var:         "foo",
+								Values:      []interface{}{"a", "b"},
+								MaxInFlight: -1,
+							},
+							{
+								Var: "foo",
+							},
+						},
+					})
+
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("returns an error", func() {
+					Expect(errorMessages).To(HaveLen(1))
+					Expect(errorMessages[0]).To(ContainSubstring("invalid jobs:"))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan[0].task.lol.across[0] has an invalid max_in_flight (-1)"))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan[0].task.lol.across[1] repeats the var 'foo'"))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan[0].task.lol.across[1] does not specify any values"))
+				})
+			})
+
+			Context("when a plan specifies fail_fast without across", func() {
+				BeforeEach(func() {
+					job.Plan = append(job.Plan, PlanConfig{
+						Task:     "lol",
+						File:     "task.yml",
+						FailFast: true,
+					})
+
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("returns an error", func() {
+					Expect(errorMessages).To(HaveLen(1))
+					Expect(errorMessages[0]).To(ContainSubstring("invalid jobs:"))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan[0].task.lol specifies fail_fast without across"))
+				})
+			})
+
 			Context("when a task plan has neither a config or a path set", func() {
 				BeforeEach(func() {
 					job.Plan = append(job.Plan, PlanConfig{
@@ -1294,6 +1449,37 @@ var _ = Describe("ValidateConfig", func() {
 				})
 			})
 
+			Context("when a plan has an invalid condition in a step", func() {
+				BeforeEach(func() {
+					job.Plan = append(job.Plan, PlanConfig{
+						Get: "some-resource",
+						If:  "((branch)) == release",
+					})
+
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("throws a validation error", func() {
+					Expect(errorMessages).To(HaveLen(1))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan[0].get.some-resource.if has an invalid expression: unknown identifier 'release'"))
+				})
+			})
+
+			Context("when a plan has a valid condition in a step", func() {
+				BeforeEach(func() {
+					job.Plan = append(job.Plan, PlanConfig{
+						Get: "some-resource",
+						If:  `((branch)) == "release"`,
+					})
+
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("does not return an error", func() {
+					Expect(errorMessages).To(BeEmpty())
+				})
+			})
+
 			Context("when a plan has an invalid timeout in a step", func() {
 				BeforeEach(func() {
 					job.Plan = append(job.Plan, PlanConfig{
@@ -1344,6 +1530,61 @@ var _ = Describe("ValidateConfig", func() {
 				})
 			})
 
+			Context("when a retry plan has an invalid retry_on", func() {
+				BeforeEach(func() {
+					job.Plan = append(job.Plan, PlanConfig{
+						Put:      "some-resource",
+						Attempts: 2,
+						RetryOn:  "always",
+					})
+
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("does return an error", func() {
+					Expect(errorMessages).To(HaveLen(1))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan[0].put.some-resource.retry_on has an invalid value 'always' (must be 'any', 'errored' or 'failed')"))
+				})
+			})
+
+			Context("when a plan has a backoff but no attempts", func() {
+				BeforeEach(func() {
+					job.Plan = append(job.Plan, PlanConfig{
+						Put:     "some-resource",
+						Backoff: &RetryBackoff{Initial: "10s"},
+					})
+
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("does return an error", func() {
+					Expect(errorMessages).To(HaveLen(1))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan[0].put.some-resource.backoff has no effect without attempts"))
+				})
+			})
+
+			Context("when a retry plan has an invalid backoff", func() {
+				BeforeEach(func() {
+					job.Plan = append(job.Plan, PlanConfig{
+						Put:      "some-resource",
+						Attempts: 2,
+						Backoff: &RetryBackoff{
+							Initial:    "soon",
+							Multiplier: 0.5,
+							Max:        "1m",
+						},
+					})
+
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("does return an error", func() {
+					Expect(errorMessages).To(HaveLen(1))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan[0].put.some-resource.backoff.initial has an invalid duration 'soon'"))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan[0].put.some-resource.backoff.multiplier must be at least 1 (got 0.5)"))
+				})
+			})
+
 			Context("when a put plan has a custom name but refers to a resource that does not exist", func() {
 				BeforeEach(func() {
 					job.Plan = append(job.Plan, PlanConfig{
@@ -1473,6 +1714,23 @@ var _ = Describe("ValidateConfig", func() {
 				})
 			})
 
+			Context("when a load_var has an invalid 'field'", func() {
+				BeforeEach(func() {
+					job.Plan = append(job.Plan, PlanConfig{
+						LoadVar: "a-var",
+						File:    "file1",
+						Field:   "foo[bar]",
+					})
+
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("returns an error", func() {
+					Expect(errorMessages).To(HaveLen(1))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan[0].load_var.a-var has an invalid field: invalid field 'foo[bar]': invalid index 'bar'"))
+				})
+			})
+
 			Context("when two load_var steps have same name", func() {
 				BeforeEach(func() {
 					job.Plan = append(job.Plan, PlanConfig{
@@ -1491,6 +1749,37 @@ var _ = Describe("ValidateConfig", func() {
 					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job has load_var steps with the same name: a-var"))
 				})
 			})
+
+			Context("when an approve step has an unknown role", func() {
+				BeforeEach(func() {
+					job.Plan = append(job.Plan, PlanConfig{
+						Approve: "ship-it",
+						Role:    "boss",
+					})
+
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("returns an error", func() {
+					Expect(errorMessages).To(HaveLen(1))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan[0].approve.ship-it has an unknown role: boss"))
+				})
+			})
+
+			Context("when an approve step has a known role", func() {
+				BeforeEach(func() {
+					job.Plan = append(job.Plan, PlanConfig{
+						Approve: "ship-it",
+						Role:    "owner",
+					})
+
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("does not return an error", func() {
+					Expect(errorMessages).To(HaveLen(0))
+				})
+			})
 		})
 
 		Context("when two jobs have the same name", func() {
@@ -1544,5 +1833,93 @@ var _ = Describe("ValidateConfig", func() {
 				Expect(errorMessages[0]).To(ContainSubstring("jobs.some-job has negative build_log_retention.days: -1"))
 			})
 		})
+
+		Context("when a job has a schedule", func() {
+			BeforeEach(func() {
+				config.Jobs[0].Schedule = &ScheduleConfig{
+					Cron:     "0 2 * * *",
+					Location: "America/New_York",
+				}
+			})
+
+			It("does not return an error", func() {
+				Expect(errorMessages).To(HaveLen(0))
+			})
+
+			Context("when the cron expression is invalid", func() {
+				BeforeEach(func() {
+					config.Jobs[0].Schedule.Cron = "0 25 * * *"
+				})
+
+				It("returns an error", func() {
+					Expect(errorMessages).To(HaveLen(1))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-job has an invalid schedule: hour field value 25 out of range (0-23)"))
+				})
+			})
+
+			Context("when the location is invalid", func() {
+				BeforeEach(func() {
+					config.Jobs[0].Schedule.Location = "Nowhere/Special"
+				})
+
+				It("returns an error", func() {
+					Expect(errorMessages).To(HaveLen(1))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-job has an invalid schedule: unknown time zone Nowhere/Special"))
+				})
+			})
+		})
+
+		Context("when a job has a build_timeout", func() {
+			BeforeEach(func() {
+				config.Jobs[0].BuildTimeout = "2h"
+			})
+
+			It("does not return an error", func() {
+				Expect(errorMessages).To(HaveLen(0))
+			})
+
+			Context("when the duration is invalid", func() {
+				BeforeEach(func() {
+					config.Jobs[0].BuildTimeout = "forever"
+				})
+
+				It("returns an error", func() {
+					Expect(errorMessages).To(HaveLen(1))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-job has an invalid build_timeout: 'forever'"))
+				})
+			})
+		})
+
+		Context("when a job keeps artifacts", func() {
+			BeforeEach(func() {
+				config.Jobs[0].Artifacts = []string{"binary", "reports"}
+			})
+
+			It("does not return an error", func() {
+				Expect(errorMessages).To(HaveLen(0))
+			})
+
+			Context("when an artifact has no name", func() {
+				BeforeEach(func() {
+					config.Jobs[0].Artifacts = []string{""}
+				})
+
+				It("returns an error", func() {
+					Expect(errorMessages).To(HaveLen(1))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-job has an artifact with no name"))
+				})
+			})
+
+			Context("when an artifact is listed twice", func() {
+				BeforeEach(func() {
+					config.Jobs[0].Artifacts = []string{"binary", "binary"}
+				})
+
+				It("returns an error", func() {
+					Expect(errorMessages).To(HaveLen(1))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-job has artifact 'binary' listed more than once"))
+				})
+			})
+		})
 	})
 })
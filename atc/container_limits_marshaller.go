@@ -2,7 +2,7 @@ package atc
 
 import (
 	"encoding/json"
-	"errors"
+	"fmt"
 	"math"
 	"regexp"
 	"strconv"
@@ -24,8 +24,7 @@ func (c *ContainerLimits) UnmarshalJSON(limit []byte) error {
 		return err
 	}
 
-	c.CPU = climits.CPU
-	c.Memory = climits.Memory
+	*c = climits
 
 	return nil
 }
@@ -37,67 +36,86 @@ func ParseContainerLimits(data interface{}) (ContainerLimits, error) {
 	}
 
 	var c ContainerLimits
-
-	var memoryBytes uint64
-	var uVal int
 	var err error
 
 	// the json unmarshaller returns numbers as float64 while yaml returns int
 	for key, val := range mapData {
-		if key == "memory" {
-			switch val.(type) {
-			case string:
-				memoryBytes, err = parseMemoryLimit(val.(string))
-				if err != nil {
-					return ContainerLimits{}, err
-				}
-			case *string:
-				if val.(*string) == nil {
-					c.Memory = nil
-					continue
-				}
-				memoryBytes, err = parseMemoryLimit(*val.(*string))
-				if err != nil {
-					return ContainerLimits{}, err
-				}
-			case float64:
-				memoryBytes = uint64(int(val.(float64)))
-			case int:
-				memoryBytes = uint64(val.(int))
-			}
-			c.Memory = &memoryBytes
-
-		} else if key == "cpu" {
-			switch val.(type) {
-			case float64:
-				uVal = int(val.(float64))
-			case int:
-				uVal = val.(int)
-			case *int:
-				if val.(*int) == nil {
-					c.CPU = nil
-					continue
-				}
-				uVal = *val.(*int)
-			default:
-				return ContainerLimits{}, errors.New("cpu limit must be an integer")
-			}
-			helper := uint64(uVal)
-			c.CPU = &helper
+		switch key {
+		case "memory":
+			c.Memory, err = parseSizeLimit("memory", val)
+		case "disk":
+			c.Disk, err = parseSizeLimit("disk", val)
+		case "cpu":
+			c.CPU, err = parseIntegerLimit("cpu", val)
+		case "cpu_quota":
+			c.CPUQuota, err = parseIntegerLimit("cpu_quota", val)
+		case "pids":
+			c.Pids, err = parseIntegerLimit("pids", val)
+		}
 
+		if err != nil {
+			return ContainerLimits{}, err
 		}
 	}
 
 	return c, nil
 }
 
-func parseMemoryLimit(limit string) (uint64, error) {
+func parseSizeLimit(name string, val interface{}) (*uint64, error) {
+	var bytes uint64
+	var err error
+
+	switch v := val.(type) {
+	case string:
+		bytes, err = parseMemoryLimit(name, v)
+		if err != nil {
+			return nil, err
+		}
+	case *string:
+		if v == nil {
+			return nil, nil
+		}
+		bytes, err = parseMemoryLimit(name, *v)
+		if err != nil {
+			return nil, err
+		}
+	case float64:
+		bytes = uint64(int(v))
+	case int:
+		bytes = uint64(v)
+	}
+
+	return &bytes, nil
+}
+
+func parseIntegerLimit(name string, val interface{}) (*uint64, error) {
+	var uVal int
+
+	switch v := val.(type) {
+	case float64:
+		uVal = int(v)
+	case int:
+		uVal = v
+	case *int:
+		if v == nil {
+			return nil, nil
+		}
+		uVal = *v
+	default:
+		return nil, fmt.Errorf("%s limit must be an integer", name)
+	}
+
+	helper := uint64(uVal)
+	return &helper, nil
+}
+
+func parseMemoryLimit(name string, limit string) (uint64, error) {
 	limit = strings.ToUpper(limit)
 	var sizeRegex *regexp.Regexp = regexp.MustCompile(MemoryRegex)
 	matches := sizeRegex.FindStringSubmatch(limit)
 
 	if len(matches) > 3 || len(matches) < 1 {
-		return 0, errors.New("could not parse container memory limit")
+		return 0, fmt.Errorf("could not parse container %s limit", name)
 	}
 
 	value, err := strconv.ParseUint(matches[1], 10, 64)
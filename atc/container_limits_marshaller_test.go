@@ -29,6 +29,26 @@ var _ = Describe("ContainerLimits", func() {
 		})
 	})
 
+	Context("when unmarshaling extended container_limits from YAML", func() {
+		It("produces the correct ContainerLimits object without error", func() {
+			var containerLimits ContainerLimits
+			bs := []byte(`{ cpu_quota: 150, pids: 1000, disk: 10GB }`)
+			err := yaml.Unmarshal(bs, &containerLimits)
+			Expect(err).NotTo(HaveOccurred())
+
+			quota := uint64(150)
+			pids := uint64(1000)
+			disk := uint64(10 * 1024 * 1024 * 1024)
+			expected := ContainerLimits{
+				CPUQuota: &quota,
+				Pids:     &pids,
+				Disk:     &disk,
+			}
+
+			Expect(containerLimits).To(Equal(expected))
+		})
+	})
+
 	Context("when unmarshaling a container_limits from JSON", func() {
 		It("produces the correct ContainerLimits without error", func() {
 			var containerLimits ContainerLimits
@@ -0,0 +1,249 @@
+// Package cron parses standard five-field cron expressions (minute, hour,
+// day of month, month, day of week) and computes when they next fire.
+package cron
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Schedule is a parsed cron expression.
+type Schedule struct {
+	minute, hour, dom, month, dow uint64
+
+	// Whether the day of month or day of week fields were restricted (i.e. not
+	// '*'). When both are restricted a day matches if either of them does, as
+	// in the standard cron implementation.
+	domRestricted, dowRestricted bool
+
+	// Location is the time zone in which the schedule is evaluated. Defaults
+	// to UTC.
+	Location *time.Location
+}
+
+type bounds struct {
+	name     string
+	min, max int
+	names    map[string]int
+}
+
+var (
+	minuteBounds = bounds{"minute", 0, 59, nil}
+	hourBounds   = bounds{"hour", 0, 23, nil}
+	domBounds    = bounds{"day of month", 1, 31, nil}
+	monthBounds  = bounds{"month", 1, 12, map[string]int{
+		"jan": 1, "feb": 2, "mar": 3, "apr": 4, "may": 5, "jun": 6,
+		"jul": 7, "aug": 8, "sep": 9, "oct": 10, "nov": 11, "dec": 12,
+	}}
+	dowBounds = bounds{"day of week", 0, 7, map[string]int{
+		"sun": 0, "mon": 1, "tue": 2, "wed": 3, "thu": 4, "fri": 5, "sat": 6,
+	}}
+)
+
+var descriptors = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+// Parse parses a five-field cron expression, or one of the @yearly,
+// @monthly, @weekly, @daily, @midnight and @hourly descriptors.
+func Parse(spec string) (*Schedule, error) {
+	spec = strings.TrimSpace(spec)
+	if expanded, found := descriptors[strings.ToLower(spec)]; found {
+		spec = expanded
+	}
+
+	fields := strings.Fields(spec)
+	if len(fields) != 5 {
+		return nil, fmt.Errorf("expected 5 fields, found %d: %q", len(fields), spec)
+	}
+
+	schedule := &Schedule{Location: time.UTC}
+
+	var err error
+	schedule.minute, err = parseField(fields[0], minuteBounds)
+	if err != nil {
+		return nil, err
+	}
+
+	schedule.hour, err = parseField(fields[1], hourBounds)
+	if err != nil {
+		return nil, err
+	}
+
+	schedule.dom, err = parseField(fields[2], domBounds)
+	if err != nil {
+		return nil, err
+	}
+
+	schedule.month, err = parseField(fields[3], monthBounds)
+	if err != nil {
+		return nil, err
+	}
+
+	schedule.dow, err = parseField(fields[4], dowBounds)
+	if err != nil {
+		return nil, err
+	}
+
+	// 7 is an alias for Sunday
+	if schedule.dow&(1<<7) != 0 {
+		schedule.dow = (schedule.dow | 1) &^ (1 << 7)
+	}
+
+	// as in cron, a day field starting with * (e.g. */2) doesn't count as
+	// restricting the day, so a day has to match both fields
+	schedule.domRestricted = !strings.HasPrefix(fields[2], "*")
+	schedule.dowRestricted = !strings.HasPrefix(fields[4], "*")
+
+	return schedule, nil
+}
+
+// Next returns the first time after t at which the schedule fires. It
+// returns the zero time if the schedule can never fire (e.g. February 30th).
+func (s *Schedule) Next(t time.Time) time.Time {
+	loc := s.Location
+	if loc == nil {
+		loc = time.UTC
+	}
+
+	origLoc := t.Location()
+
+	t = t.In(loc).Truncate(time.Minute).Add(time.Minute)
+
+	// give up after five years, which is long enough to find any valid date
+	// including leap days
+	limit := t.AddDate(5, 0, 0)
+
+	for t.Before(limit) {
+		if s.month&(1<<uint(t.Month())) == 0 {
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, loc)
+			continue
+		}
+
+		if !s.dayMatches(t) {
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, loc)
+			continue
+		}
+
+		if s.hour&(1<<uint(t.Hour())) == 0 {
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, loc)
+			continue
+		}
+
+		if s.minute&(1<<uint(t.Minute())) == 0 {
+			t = t.Add(time.Minute)
+			continue
+		}
+
+		return t.In(origLoc)
+	}
+
+	return time.Time{}
+}
+
+func (s *Schedule) dayMatches(t time.Time) bool {
+	domMatch := s.dom&(1<<uint(t.Day())) != 0
+	dowMatch := s.dow&(1<<uint(t.Weekday())) != 0
+
+	if s.domRestricted && s.dowRestricted {
+		return domMatch || dowMatch
+	}
+
+	return domMatch && dowMatch
+}
+
+func parseField(field string, b bounds) (uint64, error) {
+	var bits uint64
+	for _, part := range strings.Split(field, ",") {
+		partBits, err := parseRange(part, b)
+		if err != nil {
+			return 0, err
+		}
+
+		bits |= partBits
+	}
+
+	return bits, nil
+}
+
+func parseRange(expr string, b bounds) (uint64, error) {
+	rangeExpr := expr
+	step := 1
+
+	if i := strings.Index(expr, "/"); i != -1 {
+		var err error
+		step, err = strconv.Atoi(expr[i+1:])
+		if err != nil || step <= 0 {
+			return 0, fmt.Errorf("invalid step in %s field: %q", b.name, expr)
+		}
+
+		rangeExpr = expr[:i]
+	}
+
+	var start, end int
+	switch {
+	case rangeExpr == "*":
+		start, end = b.min, b.max
+	case strings.Contains(rangeExpr, "-"):
+		i := strings.Index(rangeExpr, "-")
+
+		var err error
+		start, err = parseValue(rangeExpr[:i], b)
+		if err != nil {
+			return 0, err
+		}
+
+		end, err = parseValue(rangeExpr[i+1:], b)
+		if err != nil {
+			return 0, err
+		}
+
+		if end < start {
+			return 0, fmt.Errorf("invalid range in %s field: %q", b.name, expr)
+		}
+	default:
+		var err error
+		start, err = parseValue(rangeExpr, b)
+		if err != nil {
+			return 0, err
+		}
+
+		end = start
+		if step != 1 {
+			// '5/15' means every 15 starting from 5
+			end = b.max
+		}
+	}
+
+	var bits uint64
+	for i := start; i <= end; i += step {
+		bits |= 1 << uint(i)
+	}
+
+	return bits, nil
+}
+
+func parseValue(value string, b bounds) (int, error) {
+	if n, found := b.names[strings.ToLower(value)]; found {
+		return n, nil
+	}
+
+	n, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid value in %s field: %q", b.name, value)
+	}
+
+	if n < b.min || n > b.max {
+		return 0, fmt.Errorf("%s field value %d out of range (%d-%d)", b.name, n, b.min, b.max)
+	}
+
+	return n, nil
+}
@@ -0,0 +1,71 @@
+package cron_test
+
+import (
+	"time"
+
+	"github.com/concourse/concourse/atc/cron"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Schedule", func() {
+	utc := func(value string) time.Time {
+		t, err := time.Parse("2006-01-02 15:04", value)
+		Expect(err).NotTo(HaveOccurred())
+		return t
+	}
+
+	DescribeTable("Next",
+		func(spec string, from string, expected string) {
+			schedule, err := cron.Parse(spec)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(schedule.Next(utc(from))).To(Equal(utc(expected)))
+		},
+		Entry("every minute", "* * * * *", "2020-03-31 10:15", "2020-03-31 10:16"),
+		Entry("a fixed time later today", "30 14 * * *", "2020-03-31 10:15", "2020-03-31 14:30"),
+		Entry("a fixed time that has passed today", "0 2 * * *", "2020-03-31 10:15", "2020-04-01 02:00"),
+		Entry("the exact time it fires", "0 2 * * *", "2020-04-01 02:00", "2020-04-02 02:00"),
+		Entry("steps", "*/20 * * * *", "2020-03-31 10:15", "2020-03-31 10:20"),
+		Entry("steps from an offset", "5/20 * * * *", "2020-03-31 10:30", "2020-03-31 10:45"),
+		Entry("ranges with steps", "0 9-17/4 * * *", "2020-03-31 13:01", "2020-03-31 17:00"),
+		Entry("lists", "0 6,18 * * *", "2020-03-31 07:00", "2020-03-31 18:00"),
+		Entry("week days", "0 0 * * mon-fri", "2020-04-03 12:00", "2020-04-06 00:00"),
+		Entry("sunday as 7", "0 0 * * 7", "2020-03-31 12:00", "2020-04-05 00:00"),
+		Entry("month names", "0 0 1 jun *", "2020-03-31 12:00", "2020-06-01 00:00"),
+		Entry("either day of month or day of week", "0 0 15 * fri", "2020-04-04 00:00", "2020-04-10 00:00"),
+		Entry("both a stepped day of month and day of week", "0 0 */2 * mon", "2020-03-31 00:00", "2020-04-13 00:00"),
+		Entry("both a day of month and a stepped day of week", "0 0 13 * */2", "2020-03-31 00:00", "2020-06-13 00:00"),
+		Entry("leap days", "0 0 29 2 *", "2020-03-01 00:00", "2024-02-29 00:00"),
+		Entry("descriptors", "@daily", "2020-03-31 10:15", "2020-04-01 00:00"),
+	)
+
+	It("evaluates the schedule in its location", func() {
+		schedule, err := cron.Parse("0 2 * * *")
+		Expect(err).NotTo(HaveOccurred())
+
+		schedule.Location, err = time.LoadLocation("America/New_York")
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(schedule.Next(utc("2020-03-31 10:15"))).To(Equal(utc("2020-04-01 06:00")))
+	})
+
+	It("returns the zero time when the schedule never fires", func() {
+		schedule, err := cron.Parse("0 0 30 2 *")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(schedule.Next(utc("2020-03-31 10:15"))).To(BeZero())
+	})
+
+	DescribeTable("invalid expressions",
+		func(spec string, message string) {
+			_, err := cron.Parse(spec)
+			Expect(err).To(MatchError(ContainSubstring(message)))
+		},
+		Entry("too few fields", "* * * *", "expected 5 fields, found 4"),
+		Entry("out of range", "60 * * * *", "minute field value 60 out of range (0-59)"),
+		Entry("bad values", "* * * foo *", `invalid value in month field: "foo"`),
+		Entry("bad steps", "*/0 * * * *", `invalid step in minute field: "*/0"`),
+		Entry("backwards ranges", "* 5-1 * * *", `invalid range in hour field: "5-1"`),
+	)
+})
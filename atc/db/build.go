@@ -47,6 +47,13 @@ const (
 	BuildStatusErrored   BuildStatus = "errored"
 )
 
+type BuildTriggerReason string
+
+const (
+	BuildTriggerReasonManual   BuildTriggerReason = "manual"
+	BuildTriggerReasonSchedule BuildTriggerReason = "schedule"
+)
+
 var buildsQuery = psql.Select(`
 		b.id,
 		b.name,
@@ -73,7 +80,12 @@ var buildsQuery = psql.Select(`
 		b.inputs_ready,
 		b.rerun_of,
 		r.name,
-		b.rerun_number
+		b.rerun_number,
+		b.trigger_reason,
+		EXISTS (
+			SELECT 1 FROM build_breakpoints bp
+			WHERE bp.build_id = b.id AND bp.hit_at IS NOT NULL AND NOT b.completed
+		)
 	`).
 	From("builds b").
 	JoinClause("LEFT OUTER JOIN jobs j ON b.job_id = j.id").
@@ -109,7 +121,9 @@ type Build interface {
 	EndTime() time.Time
 	ReapTime() time.Time
 	IsManuallyTriggered() bool
+	TriggerReason() BuildTriggerReason
 	IsScheduled() bool
+	IsPaused() bool
 	IsRunning() bool
 	IsCompleted() bool
 	InputsReady() bool
@@ -132,9 +146,25 @@ type Build interface {
 	Events(uint) (EventSource, error)
 	SaveEvent(event atc.Event) error
 
+	SetBreakpoint(step string, position atc.BreakpointPosition) error
+	HasBreakpoints() (bool, error)
+	HitBreakpoint(planID atc.PlanID, step string, position atc.BreakpointPosition) (bool, error)
+	IsPausedAt(planID atc.PlanID) (bool, error)
+	RemoveBreakpoint(planID atc.PlanID) error
+	Continue(continuer string) (bool, error)
+
+	RequestApproval(planID atc.PlanID, stepName string, role string) error
+	PendingApproval(stepName string) (BuildApproval, bool, error)
+	Approval(planID atc.PlanID) (BuildApproval, bool, error)
+	DecideApproval(planID atc.PlanID, approved bool, approver string) (bool, error)
+	WithdrawApproval(planID atc.PlanID) error
+
 	Artifacts() ([]WorkerArtifact, error)
 	Artifact(artifactID int) (WorkerArtifact, error)
 
+	SaveTestResults([]atc.TestResult) error
+	TestResults() ([]atc.TestResult, error)
+
 	SaveOutput(string, atc.Source, atc.VersionedResourceTypes, atc.Version, ResourceConfigMetadataFields, string, string) error
 	AdoptInputsAndPipes() ([]BuildInput, bool, error)
 	AdoptRerunInputsAndPipes() ([]BuildInput, bool, error)
@@ -142,6 +172,15 @@ type Build interface {
 	Resources() ([]BuildInput, []BuildOutput, error)
 	SaveImageResourceVersion(UsedResourceCache) error
 
+	SavePipeline(
+		pipelineRef atc.PipelineRef,
+		teamID int,
+		config atc.Config,
+		from ConfigVersion,
+		initiallyPaused bool,
+	) (Pipeline, bool, error)
+	SetPipelineParent(pipelineID int) error
+
 	Delete() (bool, error)
 	MarkAsAborted() error
 	IsAborted() bool
@@ -167,6 +206,7 @@ type build struct {
 	jobName string
 
 	isManuallyTriggered bool
+	triggerReason       BuildTriggerReason
 
 	rerunOf     int
 	rerunOfName string
@@ -184,6 +224,7 @@ type build struct {
 	drained   bool
 	aborted   bool
 	completed bool
+	paused    bool
 }
 
 func newEmptyBuild(conn Conn, lockFactory lock.LockFactory) *build {
@@ -203,17 +244,19 @@ func (r ResourceNotFoundInPipeline) Error() string {
 	return fmt.Sprintf("resource %s not found in pipeline %s", r.Resource, r.Pipeline)
 }
 
-func (b *build) ID() int                      { return b.id }
-func (b *build) Name() string                 { return b.name }
-func (b *build) JobID() int                   { return b.jobID }
-func (b *build) JobName() string              { return b.jobName }
-func (b *build) TeamID() int                  { return b.teamID }
-func (b *build) TeamName() string             { return b.teamName }
-func (b *build) IsManuallyTriggered() bool    { return b.isManuallyTriggered }
-func (b *build) Schema() string               { return b.schema }
-func (b *build) PrivatePlan() atc.Plan        { return b.privatePlan }
-func (b *build) PublicPlan() *json.RawMessage { return b.publicPlan }
-func (b *build) HasPlan() bool                { return string(*b.publicPlan) != "{}" }
+func (b *build) ID() int                           { return b.id }
+func (b *build) Name() string                      { return b.name }
+func (b *build) JobID() int                        { return b.jobID }
+func (b *build) JobName() string                   { return b.jobName }
+func (b *build) TeamID() int                       { return b.teamID }
+func (b *build) TeamName() string                  { return b.teamName }
+func (b *build) IsManuallyTriggered() bool         { return b.isManuallyTriggered }
+func (b *build) TriggerReason() BuildTriggerReason { return b.triggerReason }
+func (b *build) IsPaused() bool                    { return b.paused }
+func (b *build) Schema() string                    { return b.schema }
+func (b *build) PrivatePlan() atc.Plan             { return b.privatePlan }
+func (b *build) PublicPlan() *json.RawMessage      { return b.publicPlan }
+func (b *build) HasPlan() bool                     { return string(*b.publicPlan) != "{}" }
 func (b *build) IsNewerThanLastCheckOf(input Resource) bool {
 	return b.createTime.After(input.LastCheckEndTime())
 }
@@ -494,6 +537,11 @@ func (b *build) Finish(status BuildStatus) error {
 		if err != nil {
 			return err
 		}
+
+		err = b.archiveOrphanedPipelines(tx)
+		if err != nil {
+			return err
+		}
 	}
 
 	if b.jobID != 0 {
@@ -536,6 +584,113 @@ func (b *build) Finish(status BuildStatus) error {
 	return nil
 }
 
+// archiveOrphanedPipelines archives the pipelines which were set by a previous
+// build of the job, but were not set again by this build.
+func (b *build) archiveOrphanedPipelines(tx Tx) error {
+	rows, err := pipelinesQuery.
+		Where(sq.Eq{
+			"p.parent_job_id": b.jobID,
+			"p.archived":      false,
+		}).
+		Where(sq.Lt{"p.parent_build_id": b.id}).
+		RunWith(tx).
+		Query()
+	if err != nil {
+		return err
+	}
+
+	orphans, err := scanPipelines(b.conn, b.lockFactory, rows)
+	if err != nil {
+		return err
+	}
+
+	for _, orphan := range orphans {
+		err = orphan.(*pipeline).archive(tx)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// SavePipeline saves a pipeline set by the build, recording the build and its
+// job as the pipeline's parent.
+func (b *build) SavePipeline(
+	pipelineRef atc.PipelineRef,
+	teamID int,
+	config atc.Config,
+	from ConfigVersion,
+	initiallyPaused bool,
+) (Pipeline, bool, error) {
+	tx, err := b.conn.Begin()
+	if err != nil {
+		return nil, false, err
+	}
+
+	defer Rollback(tx)
+
+	var parentJobID sql.NullInt64
+	if b.jobID != 0 {
+		parentJobID = sql.NullInt64{Int64: int64(b.jobID), Valid: true}
+	}
+
+	parentBuildID := sql.NullInt64{Int64: int64(b.id), Valid: true}
+	parentTeamID := sql.NullInt64{Int64: int64(b.teamID), Valid: true}
+
+	t := &team{
+		id:          teamID,
+		conn:        b.conn,
+		lockFactory: b.lockFactory,
+	}
+
+	pipelineID, isNewPipeline, err := t.savePipeline(tx, pipelineRef, config, from, initiallyPaused, parentJobID, parentBuildID, parentTeamID)
+	if err != nil {
+		return nil, false, err
+	}
+
+	pipeline := newPipeline(b.conn, b.lockFactory)
+	err = scanPipeline(
+		pipeline,
+		pipelinesQuery.
+			Where(sq.Eq{"p.id": pipelineID}).
+			RunWith(tx).
+			QueryRow(),
+	)
+	if err != nil {
+		return nil, false, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, false, err
+	}
+
+	return pipeline, isNewPipeline, nil
+}
+
+// SetPipelineParent records the build, its job and its team as the parent of
+// a pipeline which the build set without changing its config, so that the
+// pipeline is not archived as if it were no longer set by the job.
+func (b *build) SetPipelineParent(pipelineID int) error {
+	var parentJobID sql.NullInt64
+	if b.jobID != 0 {
+		parentJobID = sql.NullInt64{Int64: int64(b.jobID), Valid: true}
+	}
+
+	_, err := psql.Update("pipelines").
+		Set("parent_job_id", parentJobID).
+		Set("parent_build_id", b.id).
+		Set("parent_team_id", b.teamID).
+		Where(sq.Eq{
+			"id": pipelineID,
+		}).
+		RunWith(b.conn).
+		Exec()
+
+	return err
+}
+
 func (b *build) SetDrained(drained bool) error {
 	_, err := psql.Update("builds").
 		Set("drained", drained).
@@ -693,17 +848,7 @@ func (b *build) Preparation() (BuildPreparation, bool, error) {
 		maxInFlightReachedStatus = BuildPreparationStatusBlocking
 	}
 
-	tf := NewTeamFactory(b.conn, b.lockFactory)
-	t, found, err := tf.FindTeam(b.teamName)
-	if err != nil {
-		return BuildPreparation{}, false, err
-	}
-
-	if !found {
-		return BuildPreparation{}, false, nil
-	}
-
-	pipeline, found, err := t.Pipeline(b.pipelineName)
+	pipeline, found, err := b.Pipeline()
 	if err != nil {
 		return BuildPreparation{}, false, err
 	}
@@ -1420,6 +1565,7 @@ func scanBuild(b *build, row scannable, encryptionStrategy encryption.Strategy)
 	var (
 		jobID, pipelineID, rerunOf, rerunNumber                             sql.NullInt64
 		schema, privatePlan, jobName, pipelineName, publicPlan, rerunOfName sql.NullString
+		triggerReason                                                       sql.NullString
 		createTime, startTime, endTime, reapTime                            pq.NullTime
 		nonce                                                               sql.NullString
 		drained, aborted, completed                                         bool
@@ -1453,6 +1599,8 @@ func scanBuild(b *build, row scannable, encryptionStrategy encryption.Strategy)
 		&rerunOf,
 		&rerunOfName,
 		&rerunNumber,
+		&triggerReason,
+		&b.paused,
 	)
 	if err != nil {
 		return err
@@ -1474,6 +1622,7 @@ func scanBuild(b *build, row scannable, encryptionStrategy encryption.Strategy)
 	b.rerunOf = int(rerunOf.Int64)
 	b.rerunOfName = rerunOfName.String
 	b.rerunNumber = int(rerunNumber.Int64)
+	b.triggerReason = BuildTriggerReason(triggerReason.String)
 
 	var (
 		noncense      *string
@@ -0,0 +1,176 @@
+package db
+
+import (
+	"database/sql"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/event"
+	"github.com/lib/pq"
+)
+
+// BuildApproval is a request for a user to approve or reject an 'approve'
+// step of a build.
+type BuildApproval struct {
+	PlanID      atc.PlanID
+	StepName    string
+	Role        string
+	RequestedAt time.Time
+
+	Decided   bool
+	Approved  bool
+	Approver  string
+	DecidedAt time.Time
+}
+
+var buildApprovalsQuery = psql.Select(
+	"a.plan_id",
+	"a.step_name",
+	"a.role",
+	"a.requested_at",
+	"a.approved",
+	"a.approver",
+	"a.decided_at",
+).From("build_approvals a")
+
+// RequestApproval records that the given step is waiting to be approved by a
+// user with the given role. Requesting approval for a step which has already
+// been requested is a no-op.
+func (b *build) RequestApproval(planID atc.PlanID, stepName string, role string) error {
+	_, err := psql.Insert("build_approvals").
+		Columns("build_id", "plan_id", "step_name", "role").
+		Values(b.id, string(planID), stepName, role).
+		Suffix("ON CONFLICT (build_id, plan_id) DO NOTHING").
+		RunWith(b.conn).
+		Exec()
+	return err
+}
+
+// PendingApproval returns the approval the build has been waiting on the
+// longest, if any. If a step name is given, only approvals requested by steps
+// with that name are considered.
+func (b *build) PendingApproval(stepName string) (BuildApproval, bool, error) {
+	query := buildApprovalsQuery.
+		Join("builds b ON b.id = a.build_id").
+		Where(sq.Eq{
+			"a.build_id":  b.id,
+			"a.approved":  nil,
+			"b.completed": false,
+		})
+
+	if stepName != "" {
+		query = query.Where(sq.Eq{"a.step_name": stepName})
+	}
+
+	return b.approval(query.
+		OrderBy("a.requested_at").
+		Limit(1))
+}
+
+// Approval returns the approval requested by the given step.
+func (b *build) Approval(planID atc.PlanID) (BuildApproval, bool, error) {
+	return b.approval(buildApprovalsQuery.
+		Where(sq.Eq{
+			"a.build_id": b.id,
+			"a.plan_id":  string(planID),
+		}))
+}
+
+// DecideApproval approves or rejects the given step on behalf of the
+// approver. It returns false if the approval was not requested or has
+// already been decided.
+func (b *build) DecideApproval(planID atc.PlanID, approved bool, approver string) (bool, error) {
+	tx, err := b.conn.Begin()
+	if err != nil {
+		return false, err
+	}
+
+	defer Rollback(tx)
+
+	var decidedAt time.Time
+	err = psql.Update("build_approvals").
+		Set("approved", approved).
+		Set("approver", approver).
+		Set("decided_at", sq.Expr("now()")).
+		Where(sq.Eq{
+			"build_id": b.id,
+			"plan_id":  string(planID),
+			"approved": nil,
+		}).
+		Suffix("RETURNING decided_at").
+		RunWith(tx).
+		QueryRow().
+		Scan(&decidedAt)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return false, nil
+		}
+		return false, err
+	}
+
+	err = b.saveEvent(tx, event.Approval{
+		Origin:   event.Origin{ID: event.OriginID(planID)},
+		Time:     decidedAt.Unix(),
+		Approved: approved,
+		Approver: approver,
+	})
+	if err != nil {
+		return false, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return false, err
+	}
+
+	err = b.conn.Bus().Notify(buildEventsChannel(b.id))
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// WithdrawApproval removes the approval requested by the given step if it
+// has not been decided, e.g. because the step was interrupted while waiting.
+func (b *build) WithdrawApproval(planID atc.PlanID) error {
+	_, err := psql.Delete("build_approvals").
+		Where(sq.Eq{
+			"build_id": b.id,
+			"plan_id":  string(planID),
+			"approved": nil,
+		}).
+		RunWith(b.conn).
+		Exec()
+	return err
+}
+
+func (b *build) approval(query sq.SelectBuilder) (BuildApproval, bool, error) {
+	var (
+		approval  BuildApproval
+		planID    string
+		approved  sql.NullBool
+		approver  sql.NullString
+		decidedAt pq.NullTime
+	)
+
+	err := query.
+		RunWith(b.conn).
+		QueryRow().
+		Scan(&planID, &approval.StepName, &approval.Role, &approval.RequestedAt, &approved, &approver, &decidedAt)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return BuildApproval{}, false, nil
+		}
+		return BuildApproval{}, false, err
+	}
+
+	approval.PlanID = atc.PlanID(planID)
+	approval.Decided = approved.Valid
+	approval.Approved = approved.Bool
+	approval.Approver = approver.String
+	approval.DecidedAt = decidedAt.Time
+
+	return approval, true, nil
+}
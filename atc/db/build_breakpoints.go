@@ -0,0 +1,224 @@
+package db
+
+import (
+	"database/sql"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/event"
+)
+
+// SetBreakpoint makes the build pause before or after the next run of the
+// named step. Setting a breakpoint which is already set is a no-op.
+func (b *build) SetBreakpoint(step string, position atc.BreakpointPosition) error {
+	tx, err := b.conn.Begin()
+	if err != nil {
+		return err
+	}
+
+	defer Rollback(tx)
+
+	_, err = psql.Insert("build_breakpoints").
+		Columns("build_id", "step", "position").
+		Values(b.id, step, string(position)).
+		Suffix("ON CONFLICT (build_id, step, position) DO NOTHING").
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		return err
+	}
+
+	_, err = psql.Update("builds").
+		Set("has_breakpoints", true).
+		Where(sq.Eq{"id": b.id}).
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// HasBreakpoints returns whether a breakpoint has ever been set on the build,
+// which lets steps skip looking for one to hit in most builds.
+func (b *build) HasBreakpoints() (bool, error) {
+	var hasBreakpoints bool
+	err := psql.Select("has_breakpoints").
+		From("builds").
+		Where(sq.Eq{"id": b.id}).
+		RunWith(b.conn).
+		QueryRow().
+		Scan(&hasBreakpoints)
+	if err != nil {
+		return false, err
+	}
+
+	return hasBreakpoints, nil
+}
+
+// HitBreakpoint pauses the build at the given step if a breakpoint is set for
+// it. It returns false if no breakpoint is set, or if another run of the step
+// has already hit it.
+func (b *build) HitBreakpoint(planID atc.PlanID, step string, position atc.BreakpointPosition) (bool, error) {
+	tx, err := b.conn.Begin()
+	if err != nil {
+		return false, err
+	}
+
+	defer Rollback(tx)
+
+	var hitAt time.Time
+	err = psql.Update("build_breakpoints").
+		Set("plan_id", string(planID)).
+		Set("hit_at", sq.Expr("now()")).
+		Where(sq.Eq{
+			"build_id": b.id,
+			"step":     step,
+			"position": string(position),
+			"hit_at":   nil,
+		}).
+		Suffix("RETURNING hit_at").
+		RunWith(tx).
+		QueryRow().
+		Scan(&hitAt)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return false, nil
+		}
+		return false, err
+	}
+
+	err = b.saveEvent(tx, event.Breakpoint{
+		Origin:   event.Origin{ID: event.OriginID(planID)},
+		Time:     hitAt.Unix(),
+		Step:     step,
+		Position: position,
+	})
+	if err != nil {
+		return false, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return false, err
+	}
+
+	err = b.conn.Bus().Notify(buildEventsChannel(b.id))
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// IsPausedAt returns whether the given step is still paused at a breakpoint
+// it hit.
+func (b *build) IsPausedAt(planID atc.PlanID) (bool, error) {
+	var count int
+	err := psql.Select("COUNT(*)").
+		From("build_breakpoints").
+		Where(sq.Eq{
+			"build_id": b.id,
+			"plan_id":  string(planID),
+		}).
+		Where(sq.NotEq{"hit_at": nil}).
+		RunWith(b.conn).
+		QueryRow().
+		Scan(&count)
+	if err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
+}
+
+// RemoveBreakpoint removes the breakpoint hit by the given step, e.g. because
+// the step was interrupted while paused.
+func (b *build) RemoveBreakpoint(planID atc.PlanID) error {
+	_, err := psql.Delete("build_breakpoints").
+		Where(sq.Eq{
+			"build_id": b.id,
+			"plan_id":  string(planID),
+		}).
+		RunWith(b.conn).
+		Exec()
+	return err
+}
+
+// Continue resumes each step paused at a breakpoint on behalf of the
+// continuer, removing the breakpoints they hit. It returns false if the build
+// was not paused.
+func (b *build) Continue(continuer string) (bool, error) {
+	tx, err := b.conn.Begin()
+	if err != nil {
+		return false, err
+	}
+
+	defer Rollback(tx)
+
+	rows, err := psql.Delete("build_breakpoints").
+		Where(sq.Eq{"build_id": b.id}).
+		Where(sq.NotEq{"hit_at": nil}).
+		Suffix("RETURNING plan_id, now()").
+		RunWith(tx).
+		Query()
+	if err != nil {
+		return false, err
+	}
+
+	var events []event.Continue
+	for rows.Next() {
+		var (
+			planID      string
+			continuedAt time.Time
+		)
+
+		err = rows.Scan(&planID, &continuedAt)
+		if err != nil {
+			_ = rows.Close()
+			return false, err
+		}
+
+		events = append(events, event.Continue{
+			Origin:    event.Origin{ID: event.OriginID(planID)},
+			Time:      continuedAt.Unix(),
+			Continuer: continuer,
+		})
+	}
+
+	err = rows.Err()
+	if err != nil {
+		_ = rows.Close()
+		return false, err
+	}
+
+	err = rows.Close()
+	if err != nil {
+		return false, err
+	}
+
+	if len(events) == 0 {
+		return false, nil
+	}
+
+	for _, ev := range events {
+		err = b.saveEvent(tx, ev)
+		if err != nil {
+			return false, err
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return false, err
+	}
+
+	err = b.conn.Bus().Notify(buildEventsChannel(b.id))
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
@@ -241,7 +241,7 @@ var _ = Describe("Build", func() {
 				},
 			}
 
-			pipeline, _, err = team.SavePipeline("some-pipeline", pipelineConfig, db.ConfigVersion(1), false)
+			pipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "some-pipeline"}, pipelineConfig, db.ConfigVersion(1), false)
 			Expect(err).ToNot(HaveOccurred())
 
 			var found bool
@@ -572,6 +572,156 @@ var _ = Describe("Build", func() {
 				Expect(noRequestJob.ScheduleRequestedTime()).Should(BeTemporally("==", requestedSchedule))
 			})
 		})
+
+		Context("when previous builds of the job set pipelines", func() {
+			var childPipeline, orphanedPipeline db.Pipeline
+			var newBuild db.Build
+
+			BeforeEach(func() {
+				previousBuild, err := job.CreateBuild()
+				Expect(err).NotTo(HaveOccurred())
+
+				childPipeline, _, err = previousBuild.SavePipeline(atc.PipelineRef{Name: "child-pipeline"}, team.ID(), atc.Config{}, db.ConfigVersion(0), false)
+				Expect(err).NotTo(HaveOccurred())
+
+				orphanedPipeline, _, err = previousBuild.SavePipeline(atc.PipelineRef{Name: "orphaned-pipeline"}, team.ID(), atc.Config{}, db.ConfigVersion(0), false)
+				Expect(err).NotTo(HaveOccurred())
+
+				newBuild, err = job.CreateBuild()
+				Expect(err).NotTo(HaveOccurred())
+
+				_, _, err = newBuild.SavePipeline(atc.PipelineRef{Name: "child-pipeline"}, team.ID(), atc.Config{}, childPipeline.ConfigVersion(), false)
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			Context("when the build succeeds", func() {
+				BeforeEach(func() {
+					err := newBuild.Finish(db.BuildStatusSucceeded)
+					Expect(err).NotTo(HaveOccurred())
+				})
+
+				It("archives the pipelines the build no longer sets", func() {
+					found, err := orphanedPipeline.Reload()
+					Expect(err).NotTo(HaveOccurred())
+					Expect(found).To(BeTrue())
+					Expect(orphanedPipeline.Archived()).To(BeTrue())
+				})
+
+				It("does not archive the pipelines the build set", func() {
+					found, err := childPipeline.Reload()
+					Expect(err).NotTo(HaveOccurred())
+					Expect(found).To(BeTrue())
+					Expect(childPipeline.Archived()).To(BeFalse())
+					Expect(childPipeline.ParentBuildID()).To(Equal(newBuild.ID()))
+				})
+			})
+
+			Context("when the build sets a pipeline again without changing it", func() {
+				BeforeEach(func() {
+					err := newBuild.SetPipelineParent(orphanedPipeline.ID())
+					Expect(err).NotTo(HaveOccurred())
+
+					err = newBuild.Finish(db.BuildStatusSucceeded)
+					Expect(err).NotTo(HaveOccurred())
+				})
+
+				It("does not archive the unchanged pipeline", func() {
+					found, err := orphanedPipeline.Reload()
+					Expect(err).NotTo(HaveOccurred())
+					Expect(found).To(BeTrue())
+					Expect(orphanedPipeline.Archived()).To(BeFalse())
+					Expect(orphanedPipeline.ParentJobID()).To(Equal(job.ID()))
+					Expect(orphanedPipeline.ParentBuildID()).To(Equal(newBuild.ID()))
+				})
+
+				Context("when a later build no longer sets it", func() {
+					BeforeEach(func() {
+						laterBuild, err := job.CreateBuild()
+						Expect(err).NotTo(HaveOccurred())
+
+						err = laterBuild.Finish(db.BuildStatusSucceeded)
+						Expect(err).NotTo(HaveOccurred())
+					})
+
+					It("archives it", func() {
+						found, err := orphanedPipeline.Reload()
+						Expect(err).NotTo(HaveOccurred())
+						Expect(found).To(BeTrue())
+						Expect(orphanedPipeline.Archived()).To(BeTrue())
+					})
+				})
+			})
+
+			Context("when the build fails", func() {
+				BeforeEach(func() {
+					err := newBuild.Finish(db.BuildStatusFailed)
+					Expect(err).NotTo(HaveOccurred())
+				})
+
+				It("does not archive any pipelines", func() {
+					found, err := orphanedPipeline.Reload()
+					Expect(err).NotTo(HaveOccurred())
+					Expect(found).To(BeTrue())
+					Expect(orphanedPipeline.Archived()).To(BeFalse())
+				})
+			})
+		})
+	})
+
+	Describe("SavePipeline", func() {
+		It("records the job and build that set the pipeline", func() {
+			pipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: "parent-pipeline"}, atc.Config{
+				Jobs: atc.JobConfigs{{Name: "some-job"}},
+			}, db.ConfigVersion(0), false)
+			Expect(err).NotTo(HaveOccurred())
+
+			job, found, err := pipeline.Job("some-job")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(found).To(BeTrue())
+
+			build, err := job.CreateBuild()
+			Expect(err).NotTo(HaveOccurred())
+
+			childPipeline, created, err := build.SavePipeline(atc.PipelineRef{Name: "child-pipeline"}, team.ID(), atc.Config{}, db.ConfigVersion(0), false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(created).To(BeTrue())
+
+			Expect(childPipeline.ParentJobID()).To(Equal(job.ID()))
+			Expect(childPipeline.ParentBuildID()).To(Equal(build.ID()))
+			Expect(childPipeline.ParentTeamName()).To(Equal(team.Name()))
+		})
+
+		Context("when the pipeline is set in another team", func() {
+			It("records the team of the build that set it", func() {
+				otherTeam, err := teamFactory.CreateTeam(atc.Team{Name: "some-other-team"})
+				Expect(err).NotTo(HaveOccurred())
+
+				build, err := team.CreateOneOffBuild()
+				Expect(err).NotTo(HaveOccurred())
+
+				childPipeline, _, err := build.SavePipeline(atc.PipelineRef{Name: "child-pipeline"}, otherTeam.ID(), atc.Config{}, db.ConfigVersion(0), false)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(childPipeline.TeamName()).To(Equal("some-other-team"))
+				Expect(childPipeline.ParentTeamName()).To(Equal(team.Name()))
+			})
+		})
+
+		Context("when the pipeline is later set by fly", func() {
+			It("no longer records a parent", func() {
+				build, err := team.CreateOneOffBuild()
+				Expect(err).NotTo(HaveOccurred())
+
+				childPipeline, _, err := build.SavePipeline(atc.PipelineRef{Name: "child-pipeline"}, team.ID(), atc.Config{}, db.ConfigVersion(0), false)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(childPipeline.ParentJobID()).To(BeZero())
+				Expect(childPipeline.ParentBuildID()).To(Equal(build.ID()))
+
+				updatedPipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: "child-pipeline"}, atc.Config{}, childPipeline.ConfigVersion(), false)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(updatedPipeline.ParentBuildID()).To(BeZero())
+				Expect(updatedPipeline.ParentTeamName()).To(BeEmpty())
+			})
+		})
 	})
 
 	Describe("Abort", func() {
@@ -716,6 +866,361 @@ var _ = Describe("Build", func() {
 		})
 	})
 
+	Describe("Approvals", func() {
+		var build db.Build
+
+		BeforeEach(func() {
+			var err error
+			build, err = team.CreateOneOffBuild()
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("has no pending approval", func() {
+			_, found, err := build.PendingApproval("")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(found).To(BeFalse())
+		})
+
+		Context("when approval is requested", func() {
+			BeforeEach(func() {
+				err := build.RequestApproval("some-plan", "ship-it", "owner")
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("is pending", func() {
+				approval, found, err := build.PendingApproval("")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(approval.PlanID).To(Equal(atc.PlanID("some-plan")))
+				Expect(approval.StepName).To(Equal("ship-it"))
+				Expect(approval.Role).To(Equal("owner"))
+				Expect(approval.Decided).To(BeFalse())
+			})
+
+			It("is pending for the step", func() {
+				approval, found, err := build.PendingApproval("ship-it")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(approval.PlanID).To(Equal(atc.PlanID("some-plan")))
+
+				_, found, err = build.PendingApproval("other-step")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+
+			Context("when another step requests approval", func() {
+				BeforeEach(func() {
+					err := build.RequestApproval("other-plan", "other-step", "member")
+					Expect(err).NotTo(HaveOccurred())
+				})
+
+				It("returns the approval requested first", func() {
+					approval, found, err := build.PendingApproval("")
+					Expect(err).NotTo(HaveOccurred())
+					Expect(found).To(BeTrue())
+					Expect(approval.PlanID).To(Equal(atc.PlanID("some-plan")))
+				})
+
+				It("returns the approval for the named step", func() {
+					approval, found, err := build.PendingApproval("other-step")
+					Expect(err).NotTo(HaveOccurred())
+					Expect(found).To(BeTrue())
+					Expect(approval.PlanID).To(Equal(atc.PlanID("other-plan")))
+				})
+			})
+
+			It("ignores duplicate requests", func() {
+				err := build.RequestApproval("some-plan", "ship-it", "member")
+				Expect(err).NotTo(HaveOccurred())
+
+				approval, found, err := build.Approval("some-plan")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(approval.Role).To(Equal("owner"))
+			})
+
+			It("is no longer pending once the build finishes", func() {
+				err := build.Finish(db.BuildStatusAborted)
+				Expect(err).NotTo(HaveOccurred())
+
+				_, found, err := build.PendingApproval("")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+
+			Context("when it is decided", func() {
+				var decided bool
+
+				BeforeEach(func() {
+					var err error
+					decided, err = build.DecideApproval("some-plan", false, "some-user")
+					Expect(err).NotTo(HaveOccurred())
+				})
+
+				It("records the decision", func() {
+					Expect(decided).To(BeTrue())
+
+					approval, found, err := build.Approval("some-plan")
+					Expect(err).NotTo(HaveOccurred())
+					Expect(found).To(BeTrue())
+					Expect(approval.Decided).To(BeTrue())
+					Expect(approval.Approved).To(BeFalse())
+					Expect(approval.Approver).To(Equal("some-user"))
+					Expect(approval.DecidedAt).NotTo(BeZero())
+
+					_, found, err = build.PendingApproval("")
+					Expect(err).NotTo(HaveOccurred())
+					Expect(found).To(BeFalse())
+				})
+
+				It("saves an approval event", func() {
+					events, err := build.Events(0)
+					Expect(err).NotTo(HaveOccurred())
+
+					defer db.Close(events)
+
+					env, err := events.Next()
+					Expect(err).NotTo(HaveOccurred())
+					Expect(env.Event).To(Equal(event.EventTypeApproval))
+
+					ev, err := event.ParseEvent(env.Version, env.Event, *env.Data)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(ev.(event.Approval).Origin.ID).To(Equal(event.OriginID("some-plan")))
+					Expect(ev.(event.Approval).Approved).To(BeFalse())
+					Expect(ev.(event.Approval).Approver).To(Equal("some-user"))
+				})
+
+				It("cannot be decided again", func() {
+					decided, err := build.DecideApproval("some-plan", true, "other-user")
+					Expect(err).NotTo(HaveOccurred())
+					Expect(decided).To(BeFalse())
+				})
+
+				It("cannot be withdrawn", func() {
+					err := build.WithdrawApproval("some-plan")
+					Expect(err).NotTo(HaveOccurred())
+
+					_, found, err := build.Approval("some-plan")
+					Expect(err).NotTo(HaveOccurred())
+					Expect(found).To(BeTrue())
+				})
+			})
+
+			Context("when it is withdrawn", func() {
+				BeforeEach(func() {
+					err := build.WithdrawApproval("some-plan")
+					Expect(err).NotTo(HaveOccurred())
+				})
+
+				It("is no longer pending", func() {
+					_, found, err := build.PendingApproval("")
+					Expect(err).NotTo(HaveOccurred())
+					Expect(found).To(BeFalse())
+				})
+
+				It("cannot be decided", func() {
+					decided, err := build.DecideApproval("some-plan", true, "some-user")
+					Expect(err).NotTo(HaveOccurred())
+					Expect(decided).To(BeFalse())
+				})
+			})
+		})
+	})
+
+	Describe("Breakpoints", func() {
+		var build db.Build
+
+		BeforeEach(func() {
+			var err error
+			build, err = team.CreateOneOffBuild()
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("has no breakpoints", func() {
+			hasBreakpoints, err := build.HasBreakpoints()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(hasBreakpoints).To(BeFalse())
+		})
+
+		It("does not hit a breakpoint which is not set", func() {
+			hit, err := build.HitBreakpoint("some-plan", "some-step", atc.BreakpointBefore)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(hit).To(BeFalse())
+		})
+
+		It("cannot be continued when not paused", func() {
+			continued, err := build.Continue("some-user")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(continued).To(BeFalse())
+		})
+
+		Context("when a breakpoint is set", func() {
+			BeforeEach(func() {
+				err := build.SetBreakpoint("some-step", atc.BreakpointAfter)
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("has breakpoints", func() {
+				hasBreakpoints, err := build.HasBreakpoints()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(hasBreakpoints).To(BeTrue())
+			})
+
+			It("ignores duplicate breakpoints", func() {
+				err := build.SetBreakpoint("some-step", atc.BreakpointAfter)
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("is not hit at a different position", func() {
+				hit, err := build.HitBreakpoint("some-plan", "some-step", atc.BreakpointBefore)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(hit).To(BeFalse())
+			})
+
+			Context("when it is hit", func() {
+				BeforeEach(func() {
+					hit, err := build.HitBreakpoint("some-plan", "some-step", atc.BreakpointAfter)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(hit).To(BeTrue())
+				})
+
+				It("pauses the build", func() {
+					paused, err := build.IsPausedAt("some-plan")
+					Expect(err).NotTo(HaveOccurred())
+					Expect(paused).To(BeTrue())
+
+					found, err := build.Reload()
+					Expect(err).NotTo(HaveOccurred())
+					Expect(found).To(BeTrue())
+					Expect(build.IsPaused()).To(BeTrue())
+				})
+
+				It("cannot be hit again", func() {
+					hit, err := build.HitBreakpoint("other-plan", "some-step", atc.BreakpointAfter)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(hit).To(BeFalse())
+				})
+
+				It("saves a breakpoint event", func() {
+					events, err := build.Events(0)
+					Expect(err).NotTo(HaveOccurred())
+
+					defer db.Close(events)
+
+					env, err := events.Next()
+					Expect(err).NotTo(HaveOccurred())
+					Expect(env.Event).To(Equal(event.EventTypeBreakpoint))
+
+					ev, err := event.ParseEvent(env.Version, env.Event, *env.Data)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(ev.(event.Breakpoint).Origin.ID).To(Equal(event.OriginID("some-plan")))
+					Expect(ev.(event.Breakpoint).Step).To(Equal("some-step"))
+					Expect(ev.(event.Breakpoint).Position).To(Equal(atc.BreakpointAfter))
+				})
+
+				Context("when the build is continued", func() {
+					BeforeEach(func() {
+						continued, err := build.Continue("some-user")
+						Expect(err).NotTo(HaveOccurred())
+						Expect(continued).To(BeTrue())
+					})
+
+					It("is no longer paused", func() {
+						paused, err := build.IsPausedAt("some-plan")
+						Expect(err).NotTo(HaveOccurred())
+						Expect(paused).To(BeFalse())
+
+						found, err := build.Reload()
+						Expect(err).NotTo(HaveOccurred())
+						Expect(found).To(BeTrue())
+						Expect(build.IsPaused()).To(BeFalse())
+					})
+
+					It("saves a continue event", func() {
+						events, err := build.Events(1)
+						Expect(err).NotTo(HaveOccurred())
+
+						defer db.Close(events)
+
+						env, err := events.Next()
+						Expect(err).NotTo(HaveOccurred())
+						Expect(env.Event).To(Equal(event.EventTypeContinue))
+
+						ev, err := event.ParseEvent(env.Version, env.Event, *env.Data)
+						Expect(err).NotTo(HaveOccurred())
+						Expect(ev.(event.Continue).Origin.ID).To(Equal(event.OriginID("some-plan")))
+						Expect(ev.(event.Continue).Continuer).To(Equal("some-user"))
+					})
+				})
+
+				Context("when the breakpoint is removed", func() {
+					BeforeEach(func() {
+						err := build.RemoveBreakpoint("some-plan")
+						Expect(err).NotTo(HaveOccurred())
+					})
+
+					It("is no longer paused", func() {
+						paused, err := build.IsPausedAt("some-plan")
+						Expect(err).NotTo(HaveOccurred())
+						Expect(paused).To(BeFalse())
+					})
+				})
+			})
+		})
+	})
+
+	Describe("TestResults", func() {
+		var build db.Build
+
+		BeforeEach(func() {
+			var err error
+			build, err = team.CreateOneOffBuild()
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("has no test results", func() {
+			results, err := build.TestResults()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(results).To(BeEmpty())
+		})
+
+		Context("when test results are saved", func() {
+			var saved []atc.TestResult
+
+			BeforeEach(func() {
+				saved = []atc.TestResult{
+					{Step: "unit", Suite: "math", Name: "adds", Status: atc.TestPassed, Duration: 0.5},
+					{Step: "unit", Suite: "math", Name: "subtracts", Status: atc.TestFailed, Message: "expected 1, got 2"},
+				}
+
+				err := build.SaveTestResults(saved)
+				Expect(err).NotTo(HaveOccurred())
+
+				err = build.SaveTestResults([]atc.TestResult{
+					{Step: "integration", Name: "connects", Status: atc.TestSkipped},
+				})
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("returns them in the order they were saved", func() {
+				results, err := build.TestResults()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(results).To(Equal(append(saved, atc.TestResult{
+					Step: "integration", Name: "connects", Status: atc.TestSkipped,
+				})))
+			})
+
+			It("does not return them for other builds", func() {
+				otherBuild, err := team.CreateOneOffBuild()
+				Expect(err).NotTo(HaveOccurred())
+
+				results, err := otherBuild.TestResults()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(results).To(BeEmpty())
+			})
+		})
+	})
+
 	Describe("SaveOutput", func() {
 		var pipeline db.Pipeline
 		var job db.Job
@@ -744,7 +1249,7 @@ var _ = Describe("Build", func() {
 			}
 
 			var err error
-			pipeline, _, err = team.SavePipeline("some-pipeline", pipelineConfig, db.ConfigVersion(1), false)
+			pipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "some-pipeline"}, pipelineConfig, db.ConfigVersion(1), false)
 			Expect(err).ToNot(HaveOccurred())
 
 			var found bool
@@ -828,7 +1333,7 @@ var _ = Describe("Build", func() {
 					},
 				}
 
-				otherPipeline, _, err := team.SavePipeline("some-other-pipeline", pipelineConfig, db.ConfigVersion(1), false)
+				otherPipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: "some-other-pipeline"}, pipelineConfig, db.ConfigVersion(1), false)
 				Expect(err).ToNot(HaveOccurred())
 
 				resource, found, err := otherPipeline.Resource("some-explicit-resource")
@@ -928,7 +1433,7 @@ var _ = Describe("Build", func() {
 					},
 				}
 
-				otherPipeline, _, err := team.SavePipeline("some-other-pipeline", pipelineConfig, db.ConfigVersion(1), false)
+				otherPipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: "some-other-pipeline"}, pipelineConfig, db.ConfigVersion(1), false)
 				Expect(err).ToNot(HaveOccurred())
 
 				resource, found, err := otherPipeline.Resource("some-explicit-resource")
@@ -1058,7 +1563,7 @@ var _ = Describe("Build", func() {
 				},
 			}
 
-			pipeline, _, err = team.SavePipeline("some-pipeline", pipelineConfig, db.ConfigVersion(1), false)
+			pipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "some-pipeline"}, pipelineConfig, db.ConfigVersion(1), false)
 			Expect(err).ToNot(HaveOccurred())
 
 			job, found, err = pipeline.Job("some-job")
@@ -1271,7 +1776,7 @@ var _ = Describe("Build", func() {
 		Context("when a job build", func() {
 			BeforeEach(func() {
 				var err error
-				createdPipeline, _, err = team.SavePipeline("some-pipeline", atc.Config{
+				createdPipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "some-pipeline"}, atc.Config{
 					Jobs: atc.JobConfigs{
 						{
 							Name: "some-job",
@@ -1369,7 +1874,7 @@ var _ = Describe("Build", func() {
 
 			BeforeEach(func() {
 				var err error
-				pipeline, _, err = team.SavePipeline("some-pipeline", atc.Config{
+				pipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "some-pipeline"}, atc.Config{
 					Resources: atc.ResourceConfigs{
 						{
 							Name: "some-resource",
@@ -1564,7 +2069,7 @@ var _ = Describe("Build", func() {
 							Expect(err).ToNot(HaveOccurred())
 							Expect(scheduled).To(BeTrue())
 
-							pipeline, _, err = team.SavePipeline("some-pipeline", atc.Config{
+							pipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "some-pipeline"}, atc.Config{
 								Resources: atc.ResourceConfigs{
 									{
 										Name: "some-resource",
@@ -1625,7 +2130,7 @@ var _ = Describe("Build", func() {
 					Context("when max running builds is de-reached", func() {
 						BeforeEach(func() {
 							var err error
-							pipeline, _, err = team.SavePipeline("some-pipeline", atc.Config{
+							pipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "some-pipeline"}, atc.Config{
 								Resources: atc.ResourceConfigs{
 									{
 										Name: "some-resource",
@@ -1756,7 +2261,7 @@ var _ = Describe("Build", func() {
 						},
 					}
 
-					pipeline, _, err = team.SavePipeline("some-pipeline", pipelineConfig, db.ConfigVersion(2), false)
+					pipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "some-pipeline"}, pipelineConfig, db.ConfigVersion(2), false)
 					Expect(err).ToNot(HaveOccurred())
 
 					err = job.SaveNextInputMapping(db.InputMapping{
@@ -1801,7 +2306,7 @@ var _ = Describe("Build", func() {
 						},
 					}
 
-					pipeline, _, err = team.SavePipeline("some-pipeline", pipelineConfig, db.ConfigVersion(2), false)
+					pipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "some-pipeline"}, pipelineConfig, db.ConfigVersion(2), false)
 					Expect(err).ToNot(HaveOccurred())
 
 					setupTx, err := dbConn.Begin()
@@ -1911,7 +2416,7 @@ var _ = Describe("Build", func() {
 			}
 
 			var err error
-			pipeline, _, err = team.SavePipeline("some-pipeline", pipelineConfig, db.ConfigVersion(1), false)
+			pipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "some-pipeline"}, pipelineConfig, db.ConfigVersion(1), false)
 			Expect(err).ToNot(HaveOccurred())
 
 			var found bool
@@ -2144,7 +2649,7 @@ var _ = Describe("Build", func() {
 			}
 
 			var err error
-			pipeline, _, err = team.SavePipeline("some-pipeline", pipelineConfig, db.ConfigVersion(1), false)
+			pipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "some-pipeline"}, pipelineConfig, db.ConfigVersion(1), false)
 			Expect(err).ToNot(HaveOccurred())
 
 			var found bool
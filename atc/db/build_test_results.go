@@ -0,0 +1,85 @@
+package db
+
+import (
+	sq "github.com/Masterminds/squirrel"
+	"github.com/concourse/concourse/atc"
+)
+
+// testResultsPerInsert keeps each insert well under postgres' limit of 65535
+// parameters per query.
+const testResultsPerInsert = 1000
+
+// SaveTestResults attaches the results of the tests run by one of the build's
+// steps to the build.
+func (b *build) SaveTestResults(results []atc.TestResult) error {
+	tx, err := b.conn.Begin()
+	if err != nil {
+		return err
+	}
+
+	defer Rollback(tx)
+
+	for start := 0; start < len(results); start += testResultsPerInsert {
+		end := start + testResultsPerInsert
+		if end > len(results) {
+			end = len(results)
+		}
+
+		insert := psql.Insert("build_test_results").
+			Columns("build_id", "step", "suite", "name", "status", "duration", "message")
+
+		for _, result := range results[start:end] {
+			insert = insert.Values(
+				b.id,
+				result.Step,
+				result.Suite,
+				result.Name,
+				string(result.Status),
+				result.Duration,
+				result.Message,
+			)
+		}
+
+		_, err = insert.RunWith(tx).Exec()
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+// TestResults returns the results of the tests run by the build, in the order
+// they were saved.
+func (b *build) TestResults() ([]atc.TestResult, error) {
+	rows, err := psql.Select("step", "suite", "name", "status", "duration", "message").
+		From("build_test_results").
+		Where(sq.Eq{"build_id": b.id}).
+		OrderBy("id").
+		RunWith(b.conn).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	results := []atc.TestResult{}
+	for rows.Next() {
+		var (
+			result atc.TestResult
+			status string
+		)
+
+		err = rows.Scan(&result.Step, &result.Suite, &result.Name, &status, &result.Duration, &result.Message)
+		if err != nil {
+			return nil, err
+		}
+
+		result.Status = atc.TestStatus(status)
+
+		results = append(results, result)
+	}
+
+	return results, nil
+}
@@ -1,7 +1,9 @@
 package db
 
 import (
+	"database/sql"
 	"errors"
+	"fmt"
 	"time"
 
 	sq "github.com/Masterminds/squirrel"
@@ -92,6 +94,7 @@ func (container *creatingContainer) Created() (CreatedContainer, error) {
 		container.workerName,
 		container.metadata,
 		time.Time{},
+		time.Time{},
 		container.conn,
 	), nil
 }
@@ -138,6 +141,8 @@ type CreatedContainer interface {
 	Destroying() (DestroyingContainer, error)
 	LastHijack() time.Time
 	UpdateLastHijack() error
+	KeepUntil() time.Time
+	Keep(time.Duration) (time.Time, error)
 }
 
 type createdContainer struct {
@@ -147,6 +152,7 @@ type createdContainer struct {
 	metadata   ContainerMetadata
 
 	lastHijack time.Time
+	keepUntil  time.Time
 
 	conn Conn
 }
@@ -157,6 +163,7 @@ func newCreatedContainer(
 	workerName string,
 	metadata ContainerMetadata,
 	lastHijack time.Time,
+	keepUntil time.Time,
 	conn Conn,
 ) *createdContainer {
 	return &createdContainer{
@@ -165,6 +172,7 @@ func newCreatedContainer(
 		workerName: workerName,
 		metadata:   metadata,
 		lastHijack: lastHijack,
+		keepUntil:  keepUntil,
 		conn:       conn,
 	}
 }
@@ -176,6 +184,7 @@ func (container *createdContainer) WorkerName() string          { return contain
 func (container *createdContainer) Metadata() ContainerMetadata { return container.metadata }
 
 func (container *createdContainer) LastHijack() time.Time { return container.lastHijack }
+func (container *createdContainer) KeepUntil() time.Time  { return container.keepUntil }
 
 func (container *createdContainer) Destroying() (DestroyingContainer, error) {
 
@@ -238,6 +247,33 @@ func (container *createdContainer) UpdateLastHijack() error {
 	return nil
 }
 
+// Keep prevents the container from being garbage collected for the given
+// duration, e.g. so that a failed task can be intercepted, and returns when it
+// will expire.
+func (container *createdContainer) Keep(duration time.Duration) (time.Time, error) {
+	var keepUntil time.Time
+	err := psql.Update("containers").
+		Set("keep_until", sq.Expr(fmt.Sprintf("now() + '%d seconds'::interval", int(duration.Seconds())))).
+		Where(sq.Eq{
+			"id":    container.id,
+			"state": atc.ContainerStateCreated,
+		}).
+		Suffix("RETURNING keep_until").
+		RunWith(container.conn).
+		QueryRow().
+		Scan(&keepUntil)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return time.Time{}, ErrContainerDisappeared
+		}
+		return time.Time{}, err
+	}
+
+	container.keepUntil = keepUntil
+
+	return keepUntil, nil
+}
+
 //go:generate counterfeiter . DestroyingContainer
 
 type DestroyingContainer interface {
@@ -293,7 +293,7 @@ func (repository *containerRepository) FindOrphanedContainers() ([]CreatingConta
 }
 
 func selectContainers(asOptional ...string) sq.SelectBuilder {
-	columns := []string{"id", "handle", "worker_name", "last_hijack", "state"}
+	columns := []string{"id", "handle", "worker_name", "last_hijack", "keep_until", "state"}
 	columns = append(columns, containerMetadataColumns...)
 
 	table := "containers"
@@ -315,12 +315,13 @@ func scanContainer(row sq.RowScanner, conn Conn) (CreatingContainer, CreatedCont
 		handle     string
 		workerName string
 		lastHijack pq.NullTime
+		keepUntil  pq.NullTime
 		state      string
 
 		metadata ContainerMetadata
 	)
 
-	columns := []interface{}{&id, &handle, &workerName, &lastHijack, &state}
+	columns := []interface{}{&id, &handle, &workerName, &lastHijack, &keepUntil, &state}
 	columns = append(columns, metadata.ScanTargets()...)
 
 	err := row.Scan(columns...)
@@ -344,6 +345,7 @@ func scanContainer(row sq.RowScanner, conn Conn) (CreatingContainer, CreatedCont
 			workerName,
 			metadata,
 			lastHijack.Time,
+			keepUntil.Time,
 			conn,
 		), nil, nil, nil
 	case atc.ContainerStateDestroying:
@@ -1,6 +1,8 @@
 package db_test
 
 import (
+	"time"
+
 	sq "github.com/Masterminds/squirrel"
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/db"
@@ -91,6 +93,60 @@ var _ = Describe("Container", func() {
 		})
 	})
 
+	Describe("Keep", func() {
+		var createdContainer db.CreatedContainer
+
+		BeforeEach(func() {
+			var err error
+			createdContainer, err = creatingContainer.Created()
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("is not kept by default", func() {
+			Expect(createdContainer.KeepUntil()).To(BeZero())
+
+			kept, err := defaultTeam.FindKeptContainers()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(kept).To(BeEmpty())
+		})
+
+		Context("when the container is kept", func() {
+			var keepUntil time.Time
+
+			BeforeEach(func() {
+				var err error
+				keepUntil, err = createdContainer.Keep(time.Hour)
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("returns when the container expires", func() {
+				Expect(keepUntil).To(BeTemporally("~", time.Now().Add(time.Hour), time.Minute))
+				Expect(createdContainer.KeepUntil()).To(Equal(keepUntil))
+			})
+
+			It("is listed with the team's kept containers", func() {
+				kept, err := defaultTeam.FindKeptContainers()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(kept).To(HaveLen(1))
+				Expect(kept[0].Handle()).To(Equal(createdContainer.Handle()))
+				Expect(kept[0].KeepUntil()).To(BeTemporally("~", keepUntil, time.Second))
+			})
+
+			It("is not listed once it expires", func() {
+				_, err := psql.Update("containers").
+					Set("keep_until", sq.Expr("now() - '1 second'::interval")).
+					Where(sq.Eq{"id": createdContainer.ID()}).
+					RunWith(dbConn).
+					Exec()
+				Expect(err).NotTo(HaveOccurred())
+
+				kept, err := defaultTeam.FindKeptContainers()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(kept).To(BeEmpty())
+			})
+		})
+	})
+
 	Describe("Destroying", func() {
 		Context("when the container is already in destroying state", func() {
 			var createdContainer db.CreatedContainer
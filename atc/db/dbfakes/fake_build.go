@@ -69,6 +69,21 @@ type FakeBuild struct {
 		result2 bool
 		result3 error
 	}
+	ApprovalStub        func(atc.PlanID) (db.BuildApproval, bool, error)
+	approvalMutex       sync.RWMutex
+	approvalArgsForCall []struct {
+		arg1 atc.PlanID
+	}
+	approvalReturns struct {
+		result1 db.BuildApproval
+		result2 bool
+		result3 error
+	}
+	approvalReturnsOnCall map[int]struct {
+		result1 db.BuildApproval
+		result2 bool
+		result3 error
+	}
 	ArtifactStub        func(int) (db.WorkerArtifact, error)
 	artifactMutex       sync.RWMutex
 	artifactArgsForCall []struct {
@@ -94,6 +109,34 @@ type FakeBuild struct {
 		result1 []db.WorkerArtifact
 		result2 error
 	}
+	ContinueStub        func(string) (bool, error)
+	continueMutex       sync.RWMutex
+	continueArgsForCall []struct {
+		arg1 string
+	}
+	continueReturns struct {
+		result1 bool
+		result2 error
+	}
+	continueReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
+	DecideApprovalStub        func(atc.PlanID, bool, string) (bool, error)
+	decideApprovalMutex       sync.RWMutex
+	decideApprovalArgsForCall []struct {
+		arg1 atc.PlanID
+		arg2 bool
+		arg3 string
+	}
+	decideApprovalReturns struct {
+		result1 bool
+		result2 error
+	}
+	decideApprovalReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
 	DeleteStub        func() (bool, error)
 	deleteMutex       sync.RWMutex
 	deleteArgsForCall []struct {
@@ -140,6 +183,18 @@ type FakeBuild struct {
 	finishReturnsOnCall map[int]struct {
 		result1 error
 	}
+	HasBreakpointsStub        func() (bool, error)
+	hasBreakpointsMutex       sync.RWMutex
+	hasBreakpointsArgsForCall []struct {
+	}
+	hasBreakpointsReturns struct {
+		result1 bool
+		result2 error
+	}
+	hasBreakpointsReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
 	HasPlanStub        func() bool
 	hasPlanMutex       sync.RWMutex
 	hasPlanArgsForCall []struct {
@@ -150,6 +205,21 @@ type FakeBuild struct {
 	hasPlanReturnsOnCall map[int]struct {
 		result1 bool
 	}
+	HitBreakpointStub        func(atc.PlanID, string, atc.BreakpointPosition) (bool, error)
+	hitBreakpointMutex       sync.RWMutex
+	hitBreakpointArgsForCall []struct {
+		arg1 atc.PlanID
+		arg2 string
+		arg3 atc.BreakpointPosition
+	}
+	hitBreakpointReturns struct {
+		result1 bool
+		result2 error
+	}
+	hitBreakpointReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
 	IDStub        func() int
 	iDMutex       sync.RWMutex
 	iDArgsForCall []struct {
@@ -233,6 +303,29 @@ type FakeBuild struct {
 	isNewerThanLastCheckOfReturnsOnCall map[int]struct {
 		result1 bool
 	}
+	IsPausedStub        func() bool
+	isPausedMutex       sync.RWMutex
+	isPausedArgsForCall []struct {
+	}
+	isPausedReturns struct {
+		result1 bool
+	}
+	isPausedReturnsOnCall map[int]struct {
+		result1 bool
+	}
+	IsPausedAtStub        func(atc.PlanID) (bool, error)
+	isPausedAtMutex       sync.RWMutex
+	isPausedAtArgsForCall []struct {
+		arg1 atc.PlanID
+	}
+	isPausedAtReturns struct {
+		result1 bool
+		result2 error
+	}
+	isPausedAtReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
 	IsRunningStub        func() bool
 	isRunningMutex       sync.RWMutex
 	isRunningArgsForCall []struct {
@@ -293,6 +386,21 @@ type FakeBuild struct {
 	nameReturnsOnCall map[int]struct {
 		result1 string
 	}
+	PendingApprovalStub        func(string) (db.BuildApproval, bool, error)
+	pendingApprovalMutex       sync.RWMutex
+	pendingApprovalArgsForCall []struct {
+		arg1 string
+	}
+	pendingApprovalReturns struct {
+		result1 db.BuildApproval
+		result2 bool
+		result3 error
+	}
+	pendingApprovalReturnsOnCall map[int]struct {
+		result1 db.BuildApproval
+		result2 bool
+		result3 error
+	}
 	PipelineStub        func() (db.Pipeline, bool, error)
 	pipelineMutex       sync.RWMutex
 	pipelineArgsForCall []struct {
@@ -383,6 +491,30 @@ type FakeBuild struct {
 		result1 bool
 		result2 error
 	}
+	RemoveBreakpointStub        func(atc.PlanID) error
+	removeBreakpointMutex       sync.RWMutex
+	removeBreakpointArgsForCall []struct {
+		arg1 atc.PlanID
+	}
+	removeBreakpointReturns struct {
+		result1 error
+	}
+	removeBreakpointReturnsOnCall map[int]struct {
+		result1 error
+	}
+	RequestApprovalStub        func(atc.PlanID, string, string) error
+	requestApprovalMutex       sync.RWMutex
+	requestApprovalArgsForCall []struct {
+		arg1 atc.PlanID
+		arg2 string
+		arg3 string
+	}
+	requestApprovalReturns struct {
+		result1 error
+	}
+	requestApprovalReturnsOnCall map[int]struct {
+		result1 error
+	}
 	RerunNumberStub        func() int
 	rerunNumberMutex       sync.RWMutex
 	rerunNumberArgsForCall []struct {
@@ -466,6 +598,36 @@ type FakeBuild struct {
 	saveOutputReturnsOnCall map[int]struct {
 		result1 error
 	}
+	SavePipelineStub        func(atc.PipelineRef, int, atc.Config, db.ConfigVersion, bool) (db.Pipeline, bool, error)
+	savePipelineMutex       sync.RWMutex
+	savePipelineArgsForCall []struct {
+		arg1 atc.PipelineRef
+		arg2 int
+		arg3 atc.Config
+		arg4 db.ConfigVersion
+		arg5 bool
+	}
+	savePipelineReturns struct {
+		result1 db.Pipeline
+		result2 bool
+		result3 error
+	}
+	savePipelineReturnsOnCall map[int]struct {
+		result1 db.Pipeline
+		result2 bool
+		result3 error
+	}
+	SaveTestResultsStub        func([]atc.TestResult) error
+	saveTestResultsMutex       sync.RWMutex
+	saveTestResultsArgsForCall []struct {
+		arg1 []atc.TestResult
+	}
+	saveTestResultsReturns struct {
+		result1 error
+	}
+	saveTestResultsReturnsOnCall map[int]struct {
+		result1 error
+	}
 	SchemaStub        func() string
 	schemaMutex       sync.RWMutex
 	schemaArgsForCall []struct {
@@ -476,6 +638,18 @@ type FakeBuild struct {
 	schemaReturnsOnCall map[int]struct {
 		result1 string
 	}
+	SetBreakpointStub        func(string, atc.BreakpointPosition) error
+	setBreakpointMutex       sync.RWMutex
+	setBreakpointArgsForCall []struct {
+		arg1 string
+		arg2 atc.BreakpointPosition
+	}
+	setBreakpointReturns struct {
+		result1 error
+	}
+	setBreakpointReturnsOnCall map[int]struct {
+		result1 error
+	}
 	SetDrainedStub        func(bool) error
 	setDrainedMutex       sync.RWMutex
 	setDrainedArgsForCall []struct {
@@ -498,6 +672,17 @@ type FakeBuild struct {
 	setInterceptibleReturnsOnCall map[int]struct {
 		result1 error
 	}
+	SetPipelineParentStub        func(int) error
+	setPipelineParentMutex       sync.RWMutex
+	setPipelineParentArgsForCall []struct {
+		arg1 int
+	}
+	setPipelineParentReturns struct {
+		result1 error
+	}
+	setPipelineParentReturnsOnCall map[int]struct {
+		result1 error
+	}
 	StartStub        func(atc.Plan) (bool, error)
 	startMutex       sync.RWMutex
 	startArgsForCall []struct {
@@ -551,6 +736,39 @@ type FakeBuild struct {
 	teamNameReturnsOnCall map[int]struct {
 		result1 string
 	}
+	TestResultsStub        func() ([]atc.TestResult, error)
+	testResultsMutex       sync.RWMutex
+	testResultsArgsForCall []struct {
+	}
+	testResultsReturns struct {
+		result1 []atc.TestResult
+		result2 error
+	}
+	testResultsReturnsOnCall map[int]struct {
+		result1 []atc.TestResult
+		result2 error
+	}
+	TriggerReasonStub        func() db.BuildTriggerReason
+	triggerReasonMutex       sync.RWMutex
+	triggerReasonArgsForCall []struct {
+	}
+	triggerReasonReturns struct {
+		result1 db.BuildTriggerReason
+	}
+	triggerReasonReturnsOnCall map[int]struct {
+		result1 db.BuildTriggerReason
+	}
+	WithdrawApprovalStub        func(atc.PlanID) error
+	withdrawApprovalMutex       sync.RWMutex
+	withdrawApprovalArgsForCall []struct {
+		arg1 atc.PlanID
+	}
+	withdrawApprovalReturns struct {
+		result1 error
+	}
+	withdrawApprovalReturnsOnCall map[int]struct {
+		result1 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -793,6 +1011,72 @@ func (fake *FakeBuild) AdoptRerunInputsAndPipesReturnsOnCall(i int, result1 []db
 	}{result1, result2, result3}
 }
 
+func (fake *FakeBuild) Approval(arg1 atc.PlanID) (db.BuildApproval, bool, error) {
+	fake.approvalMutex.Lock()
+	ret, specificReturn := fake.approvalReturnsOnCall[len(fake.approvalArgsForCall)]
+	fake.approvalArgsForCall = append(fake.approvalArgsForCall, struct {
+		arg1 atc.PlanID
+	}{arg1})
+	fake.recordInvocation("Approval", []interface{}{arg1})
+	fake.approvalMutex.Unlock()
+	if fake.ApprovalStub != nil {
+		return fake.ApprovalStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.approvalReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeBuild) ApprovalCallCount() int {
+	fake.approvalMutex.RLock()
+	defer fake.approvalMutex.RUnlock()
+	return len(fake.approvalArgsForCall)
+}
+
+func (fake *FakeBuild) ApprovalCalls(stub func(atc.PlanID) (db.BuildApproval, bool, error)) {
+	fake.approvalMutex.Lock()
+	defer fake.approvalMutex.Unlock()
+	fake.ApprovalStub = stub
+}
+
+func (fake *FakeBuild) ApprovalArgsForCall(i int) atc.PlanID {
+	fake.approvalMutex.RLock()
+	defer fake.approvalMutex.RUnlock()
+	argsForCall := fake.approvalArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeBuild) ApprovalReturns(result1 db.BuildApproval, result2 bool, result3 error) {
+	fake.approvalMutex.Lock()
+	defer fake.approvalMutex.Unlock()
+	fake.ApprovalStub = nil
+	fake.approvalReturns = struct {
+		result1 db.BuildApproval
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeBuild) ApprovalReturnsOnCall(i int, result1 db.BuildApproval, result2 bool, result3 error) {
+	fake.approvalMutex.Lock()
+	defer fake.approvalMutex.Unlock()
+	fake.ApprovalStub = nil
+	if fake.approvalReturnsOnCall == nil {
+		fake.approvalReturnsOnCall = make(map[int]struct {
+			result1 db.BuildApproval
+			result2 bool
+			result3 error
+		})
+	}
+	fake.approvalReturnsOnCall[i] = struct {
+		result1 db.BuildApproval
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeBuild) Artifact(arg1 int) (db.WorkerArtifact, error) {
 	fake.artifactMutex.Lock()
 	ret, specificReturn := fake.artifactReturnsOnCall[len(fake.artifactArgsForCall)]
@@ -911,6 +1195,134 @@ func (fake *FakeBuild) ArtifactsReturnsOnCall(i int, result1 []db.WorkerArtifact
 	}{result1, result2}
 }
 
+func (fake *FakeBuild) Continue(arg1 string) (bool, error) {
+	fake.continueMutex.Lock()
+	ret, specificReturn := fake.continueReturnsOnCall[len(fake.continueArgsForCall)]
+	fake.continueArgsForCall = append(fake.continueArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("Continue", []interface{}{arg1})
+	fake.continueMutex.Unlock()
+	if fake.ContinueStub != nil {
+		return fake.ContinueStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.continueReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeBuild) ContinueCallCount() int {
+	fake.continueMutex.RLock()
+	defer fake.continueMutex.RUnlock()
+	return len(fake.continueArgsForCall)
+}
+
+func (fake *FakeBuild) ContinueCalls(stub func(string) (bool, error)) {
+	fake.continueMutex.Lock()
+	defer fake.continueMutex.Unlock()
+	fake.ContinueStub = stub
+}
+
+func (fake *FakeBuild) ContinueArgsForCall(i int) string {
+	fake.continueMutex.RLock()
+	defer fake.continueMutex.RUnlock()
+	argsForCall := fake.continueArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeBuild) ContinueReturns(result1 bool, result2 error) {
+	fake.continueMutex.Lock()
+	defer fake.continueMutex.Unlock()
+	fake.ContinueStub = nil
+	fake.continueReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBuild) ContinueReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.continueMutex.Lock()
+	defer fake.continueMutex.Unlock()
+	fake.ContinueStub = nil
+	if fake.continueReturnsOnCall == nil {
+		fake.continueReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.continueReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBuild) DecideApproval(arg1 atc.PlanID, arg2 bool, arg3 string) (bool, error) {
+	fake.decideApprovalMutex.Lock()
+	ret, specificReturn := fake.decideApprovalReturnsOnCall[len(fake.decideApprovalArgsForCall)]
+	fake.decideApprovalArgsForCall = append(fake.decideApprovalArgsForCall, struct {
+		arg1 atc.PlanID
+		arg2 bool
+		arg3 string
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("DecideApproval", []interface{}{arg1, arg2, arg3})
+	fake.decideApprovalMutex.Unlock()
+	if fake.DecideApprovalStub != nil {
+		return fake.DecideApprovalStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.decideApprovalReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeBuild) DecideApprovalCallCount() int {
+	fake.decideApprovalMutex.RLock()
+	defer fake.decideApprovalMutex.RUnlock()
+	return len(fake.decideApprovalArgsForCall)
+}
+
+func (fake *FakeBuild) DecideApprovalCalls(stub func(atc.PlanID, bool, string) (bool, error)) {
+	fake.decideApprovalMutex.Lock()
+	defer fake.decideApprovalMutex.Unlock()
+	fake.DecideApprovalStub = stub
+}
+
+func (fake *FakeBuild) DecideApprovalArgsForCall(i int) (atc.PlanID, bool, string) {
+	fake.decideApprovalMutex.RLock()
+	defer fake.decideApprovalMutex.RUnlock()
+	argsForCall := fake.decideApprovalArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeBuild) DecideApprovalReturns(result1 bool, result2 error) {
+	fake.decideApprovalMutex.Lock()
+	defer fake.decideApprovalMutex.Unlock()
+	fake.DecideApprovalStub = nil
+	fake.decideApprovalReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBuild) DecideApprovalReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.decideApprovalMutex.Lock()
+	defer fake.decideApprovalMutex.Unlock()
+	fake.DecideApprovalStub = nil
+	if fake.decideApprovalReturnsOnCall == nil {
+		fake.decideApprovalReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.decideApprovalReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeBuild) Delete() (bool, error) {
 	fake.deleteMutex.Lock()
 	ret, specificReturn := fake.deleteReturnsOnCall[len(fake.deleteArgsForCall)]
@@ -1141,6 +1553,61 @@ func (fake *FakeBuild) FinishReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeBuild) HasBreakpoints() (bool, error) {
+	fake.hasBreakpointsMutex.Lock()
+	ret, specificReturn := fake.hasBreakpointsReturnsOnCall[len(fake.hasBreakpointsArgsForCall)]
+	fake.hasBreakpointsArgsForCall = append(fake.hasBreakpointsArgsForCall, struct {
+	}{})
+	fake.recordInvocation("HasBreakpoints", []interface{}{})
+	fake.hasBreakpointsMutex.Unlock()
+	if fake.HasBreakpointsStub != nil {
+		return fake.HasBreakpointsStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.hasBreakpointsReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeBuild) HasBreakpointsCallCount() int {
+	fake.hasBreakpointsMutex.RLock()
+	defer fake.hasBreakpointsMutex.RUnlock()
+	return len(fake.hasBreakpointsArgsForCall)
+}
+
+func (fake *FakeBuild) HasBreakpointsCalls(stub func() (bool, error)) {
+	fake.hasBreakpointsMutex.Lock()
+	defer fake.hasBreakpointsMutex.Unlock()
+	fake.HasBreakpointsStub = stub
+}
+
+func (fake *FakeBuild) HasBreakpointsReturns(result1 bool, result2 error) {
+	fake.hasBreakpointsMutex.Lock()
+	defer fake.hasBreakpointsMutex.Unlock()
+	fake.HasBreakpointsStub = nil
+	fake.hasBreakpointsReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBuild) HasBreakpointsReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.hasBreakpointsMutex.Lock()
+	defer fake.hasBreakpointsMutex.Unlock()
+	fake.HasBreakpointsStub = nil
+	if fake.hasBreakpointsReturnsOnCall == nil {
+		fake.hasBreakpointsReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.hasBreakpointsReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeBuild) HasPlan() bool {
 	fake.hasPlanMutex.Lock()
 	ret, specificReturn := fake.hasPlanReturnsOnCall[len(fake.hasPlanArgsForCall)]
@@ -1193,6 +1660,71 @@ func (fake *FakeBuild) HasPlanReturnsOnCall(i int, result1 bool) {
 	}{result1}
 }
 
+func (fake *FakeBuild) HitBreakpoint(arg1 atc.PlanID, arg2 string, arg3 atc.BreakpointPosition) (bool, error) {
+	fake.hitBreakpointMutex.Lock()
+	ret, specificReturn := fake.hitBreakpointReturnsOnCall[len(fake.hitBreakpointArgsForCall)]
+	fake.hitBreakpointArgsForCall = append(fake.hitBreakpointArgsForCall, struct {
+		arg1 atc.PlanID
+		arg2 string
+		arg3 atc.BreakpointPosition
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("HitBreakpoint", []interface{}{arg1, arg2, arg3})
+	fake.hitBreakpointMutex.Unlock()
+	if fake.HitBreakpointStub != nil {
+		return fake.HitBreakpointStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.hitBreakpointReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeBuild) HitBreakpointCallCount() int {
+	fake.hitBreakpointMutex.RLock()
+	defer fake.hitBreakpointMutex.RUnlock()
+	return len(fake.hitBreakpointArgsForCall)
+}
+
+func (fake *FakeBuild) HitBreakpointCalls(stub func(atc.PlanID, string, atc.BreakpointPosition) (bool, error)) {
+	fake.hitBreakpointMutex.Lock()
+	defer fake.hitBreakpointMutex.Unlock()
+	fake.HitBreakpointStub = stub
+}
+
+func (fake *FakeBuild) HitBreakpointArgsForCall(i int) (atc.PlanID, string, atc.BreakpointPosition) {
+	fake.hitBreakpointMutex.RLock()
+	defer fake.hitBreakpointMutex.RUnlock()
+	argsForCall := fake.hitBreakpointArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeBuild) HitBreakpointReturns(result1 bool, result2 error) {
+	fake.hitBreakpointMutex.Lock()
+	defer fake.hitBreakpointMutex.Unlock()
+	fake.HitBreakpointStub = nil
+	fake.hitBreakpointReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBuild) HitBreakpointReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.hitBreakpointMutex.Lock()
+	defer fake.hitBreakpointMutex.Unlock()
+	fake.HitBreakpointStub = nil
+	if fake.hitBreakpointReturnsOnCall == nil {
+		fake.hitBreakpointReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.hitBreakpointReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeBuild) ID() int {
 	fake.iDMutex.Lock()
 	ret, specificReturn := fake.iDReturnsOnCall[len(fake.iDArgsForCall)]
@@ -1620,26 +2152,141 @@ func (fake *FakeBuild) IsNewerThanLastCheckOfReturnsOnCall(i int, result1 bool)
 	}{result1}
 }
 
-func (fake *FakeBuild) IsRunning() bool {
-	fake.isRunningMutex.Lock()
-	ret, specificReturn := fake.isRunningReturnsOnCall[len(fake.isRunningArgsForCall)]
-	fake.isRunningArgsForCall = append(fake.isRunningArgsForCall, struct {
+func (fake *FakeBuild) IsPaused() bool {
+	fake.isPausedMutex.Lock()
+	ret, specificReturn := fake.isPausedReturnsOnCall[len(fake.isPausedArgsForCall)]
+	fake.isPausedArgsForCall = append(fake.isPausedArgsForCall, struct {
 	}{})
-	fake.recordInvocation("IsRunning", []interface{}{})
-	fake.isRunningMutex.Unlock()
-	if fake.IsRunningStub != nil {
-		return fake.IsRunningStub()
+	fake.recordInvocation("IsPaused", []interface{}{})
+	fake.isPausedMutex.Unlock()
+	if fake.IsPausedStub != nil {
+		return fake.IsPausedStub()
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.isRunningReturns
+	fakeReturns := fake.isPausedReturns
 	return fakeReturns.result1
 }
 
-func (fake *FakeBuild) IsRunningCallCount() int {
-	fake.isRunningMutex.RLock()
-	defer fake.isRunningMutex.RUnlock()
+func (fake *FakeBuild) IsPausedCallCount() int {
+	fake.isPausedMutex.RLock()
+	defer fake.isPausedMutex.RUnlock()
+	return len(fake.isPausedArgsForCall)
+}
+
+func (fake *FakeBuild) IsPausedCalls(stub func() bool) {
+	fake.isPausedMutex.Lock()
+	defer fake.isPausedMutex.Unlock()
+	fake.IsPausedStub = stub
+}
+
+func (fake *FakeBuild) IsPausedReturns(result1 bool) {
+	fake.isPausedMutex.Lock()
+	defer fake.isPausedMutex.Unlock()
+	fake.IsPausedStub = nil
+	fake.isPausedReturns = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeBuild) IsPausedReturnsOnCall(i int, result1 bool) {
+	fake.isPausedMutex.Lock()
+	defer fake.isPausedMutex.Unlock()
+	fake.IsPausedStub = nil
+	if fake.isPausedReturnsOnCall == nil {
+		fake.isPausedReturnsOnCall = make(map[int]struct {
+			result1 bool
+		})
+	}
+	fake.isPausedReturnsOnCall[i] = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeBuild) IsPausedAt(arg1 atc.PlanID) (bool, error) {
+	fake.isPausedAtMutex.Lock()
+	ret, specificReturn := fake.isPausedAtReturnsOnCall[len(fake.isPausedAtArgsForCall)]
+	fake.isPausedAtArgsForCall = append(fake.isPausedAtArgsForCall, struct {
+		arg1 atc.PlanID
+	}{arg1})
+	fake.recordInvocation("IsPausedAt", []interface{}{arg1})
+	fake.isPausedAtMutex.Unlock()
+	if fake.IsPausedAtStub != nil {
+		return fake.IsPausedAtStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.isPausedAtReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeBuild) IsPausedAtCallCount() int {
+	fake.isPausedAtMutex.RLock()
+	defer fake.isPausedAtMutex.RUnlock()
+	return len(fake.isPausedAtArgsForCall)
+}
+
+func (fake *FakeBuild) IsPausedAtCalls(stub func(atc.PlanID) (bool, error)) {
+	fake.isPausedAtMutex.Lock()
+	defer fake.isPausedAtMutex.Unlock()
+	fake.IsPausedAtStub = stub
+}
+
+func (fake *FakeBuild) IsPausedAtArgsForCall(i int) atc.PlanID {
+	fake.isPausedAtMutex.RLock()
+	defer fake.isPausedAtMutex.RUnlock()
+	argsForCall := fake.isPausedAtArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeBuild) IsPausedAtReturns(result1 bool, result2 error) {
+	fake.isPausedAtMutex.Lock()
+	defer fake.isPausedAtMutex.Unlock()
+	fake.IsPausedAtStub = nil
+	fake.isPausedAtReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBuild) IsPausedAtReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.isPausedAtMutex.Lock()
+	defer fake.isPausedAtMutex.Unlock()
+	fake.IsPausedAtStub = nil
+	if fake.isPausedAtReturnsOnCall == nil {
+		fake.isPausedAtReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.isPausedAtReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBuild) IsRunning() bool {
+	fake.isRunningMutex.Lock()
+	ret, specificReturn := fake.isRunningReturnsOnCall[len(fake.isRunningArgsForCall)]
+	fake.isRunningArgsForCall = append(fake.isRunningArgsForCall, struct {
+	}{})
+	fake.recordInvocation("IsRunning", []interface{}{})
+	fake.isRunningMutex.Unlock()
+	if fake.IsRunningStub != nil {
+		return fake.IsRunningStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.isRunningReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeBuild) IsRunningCallCount() int {
+	fake.isRunningMutex.RLock()
+	defer fake.isRunningMutex.RUnlock()
 	return len(fake.isRunningArgsForCall)
 }
 
@@ -1932,6 +2579,72 @@ func (fake *FakeBuild) NameReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *FakeBuild) PendingApproval(arg1 string) (db.BuildApproval, bool, error) {
+	fake.pendingApprovalMutex.Lock()
+	ret, specificReturn := fake.pendingApprovalReturnsOnCall[len(fake.pendingApprovalArgsForCall)]
+	fake.pendingApprovalArgsForCall = append(fake.pendingApprovalArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("PendingApproval", []interface{}{arg1})
+	fake.pendingApprovalMutex.Unlock()
+	if fake.PendingApprovalStub != nil {
+		return fake.PendingApprovalStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.pendingApprovalReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeBuild) PendingApprovalCallCount() int {
+	fake.pendingApprovalMutex.RLock()
+	defer fake.pendingApprovalMutex.RUnlock()
+	return len(fake.pendingApprovalArgsForCall)
+}
+
+func (fake *FakeBuild) PendingApprovalCalls(stub func(string) (db.BuildApproval, bool, error)) {
+	fake.pendingApprovalMutex.Lock()
+	defer fake.pendingApprovalMutex.Unlock()
+	fake.PendingApprovalStub = stub
+}
+
+func (fake *FakeBuild) PendingApprovalArgsForCall(i int) string {
+	fake.pendingApprovalMutex.RLock()
+	defer fake.pendingApprovalMutex.RUnlock()
+	argsForCall := fake.pendingApprovalArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeBuild) PendingApprovalReturns(result1 db.BuildApproval, result2 bool, result3 error) {
+	fake.pendingApprovalMutex.Lock()
+	defer fake.pendingApprovalMutex.Unlock()
+	fake.PendingApprovalStub = nil
+	fake.pendingApprovalReturns = struct {
+		result1 db.BuildApproval
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeBuild) PendingApprovalReturnsOnCall(i int, result1 db.BuildApproval, result2 bool, result3 error) {
+	fake.pendingApprovalMutex.Lock()
+	defer fake.pendingApprovalMutex.Unlock()
+	fake.PendingApprovalStub = nil
+	if fake.pendingApprovalReturnsOnCall == nil {
+		fake.pendingApprovalReturnsOnCall = make(map[int]struct {
+			result1 db.BuildApproval
+			result2 bool
+			result3 error
+		})
+	}
+	fake.pendingApprovalReturnsOnCall[i] = struct {
+		result1 db.BuildApproval
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeBuild) Pipeline() (db.Pipeline, bool, error) {
 	fake.pipelineMutex.Lock()
 	ret, specificReturn := fake.pipelineReturnsOnCall[len(fake.pipelineArgsForCall)]
@@ -2363,6 +3076,128 @@ func (fake *FakeBuild) ReloadReturnsOnCall(i int, result1 bool, result2 error) {
 	}{result1, result2}
 }
 
+func (fake *FakeBuild) RemoveBreakpoint(arg1 atc.PlanID) error {
+	fake.removeBreakpointMutex.Lock()
+	ret, specificReturn := fake.removeBreakpointReturnsOnCall[len(fake.removeBreakpointArgsForCall)]
+	fake.removeBreakpointArgsForCall = append(fake.removeBreakpointArgsForCall, struct {
+		arg1 atc.PlanID
+	}{arg1})
+	fake.recordInvocation("RemoveBreakpoint", []interface{}{arg1})
+	fake.removeBreakpointMutex.Unlock()
+	if fake.RemoveBreakpointStub != nil {
+		return fake.RemoveBreakpointStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.removeBreakpointReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeBuild) RemoveBreakpointCallCount() int {
+	fake.removeBreakpointMutex.RLock()
+	defer fake.removeBreakpointMutex.RUnlock()
+	return len(fake.removeBreakpointArgsForCall)
+}
+
+func (fake *FakeBuild) RemoveBreakpointCalls(stub func(atc.PlanID) error) {
+	fake.removeBreakpointMutex.Lock()
+	defer fake.removeBreakpointMutex.Unlock()
+	fake.RemoveBreakpointStub = stub
+}
+
+func (fake *FakeBuild) RemoveBreakpointArgsForCall(i int) atc.PlanID {
+	fake.removeBreakpointMutex.RLock()
+	defer fake.removeBreakpointMutex.RUnlock()
+	argsForCall := fake.removeBreakpointArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeBuild) RemoveBreakpointReturns(result1 error) {
+	fake.removeBreakpointMutex.Lock()
+	defer fake.removeBreakpointMutex.Unlock()
+	fake.RemoveBreakpointStub = nil
+	fake.removeBreakpointReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeBuild) RemoveBreakpointReturnsOnCall(i int, result1 error) {
+	fake.removeBreakpointMutex.Lock()
+	defer fake.removeBreakpointMutex.Unlock()
+	fake.RemoveBreakpointStub = nil
+	if fake.removeBreakpointReturnsOnCall == nil {
+		fake.removeBreakpointReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.removeBreakpointReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeBuild) RequestApproval(arg1 atc.PlanID, arg2 string, arg3 string) error {
+	fake.requestApprovalMutex.Lock()
+	ret, specificReturn := fake.requestApprovalReturnsOnCall[len(fake.requestApprovalArgsForCall)]
+	fake.requestApprovalArgsForCall = append(fake.requestApprovalArgsForCall, struct {
+		arg1 atc.PlanID
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("RequestApproval", []interface{}{arg1, arg2, arg3})
+	fake.requestApprovalMutex.Unlock()
+	if fake.RequestApprovalStub != nil {
+		return fake.RequestApprovalStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.requestApprovalReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeBuild) RequestApprovalCallCount() int {
+	fake.requestApprovalMutex.RLock()
+	defer fake.requestApprovalMutex.RUnlock()
+	return len(fake.requestApprovalArgsForCall)
+}
+
+func (fake *FakeBuild) RequestApprovalCalls(stub func(atc.PlanID, string, string) error) {
+	fake.requestApprovalMutex.Lock()
+	defer fake.requestApprovalMutex.Unlock()
+	fake.RequestApprovalStub = stub
+}
+
+func (fake *FakeBuild) RequestApprovalArgsForCall(i int) (atc.PlanID, string, string) {
+	fake.requestApprovalMutex.RLock()
+	defer fake.requestApprovalMutex.RUnlock()
+	argsForCall := fake.requestApprovalArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeBuild) RequestApprovalReturns(result1 error) {
+	fake.requestApprovalMutex.Lock()
+	defer fake.requestApprovalMutex.Unlock()
+	fake.RequestApprovalStub = nil
+	fake.requestApprovalReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeBuild) RequestApprovalReturnsOnCall(i int, result1 error) {
+	fake.requestApprovalMutex.Lock()
+	defer fake.requestApprovalMutex.Unlock()
+	fake.RequestApprovalStub = nil
+	if fake.requestApprovalReturnsOnCall == nil {
+		fake.requestApprovalReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.requestApprovalReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeBuild) RerunNumber() int {
 	fake.rerunNumberMutex.Lock()
 	ret, specificReturn := fake.rerunNumberReturnsOnCall[len(fake.rerunNumberArgsForCall)]
@@ -2763,6 +3598,141 @@ func (fake *FakeBuild) SaveOutputReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeBuild) SavePipeline(arg1 atc.PipelineRef, arg2 int, arg3 atc.Config, arg4 db.ConfigVersion, arg5 bool) (db.Pipeline, bool, error) {
+	fake.savePipelineMutex.Lock()
+	ret, specificReturn := fake.savePipelineReturnsOnCall[len(fake.savePipelineArgsForCall)]
+	fake.savePipelineArgsForCall = append(fake.savePipelineArgsForCall, struct {
+		arg1 atc.PipelineRef
+		arg2 int
+		arg3 atc.Config
+		arg4 db.ConfigVersion
+		arg5 bool
+	}{arg1, arg2, arg3, arg4, arg5})
+	fake.recordInvocation("SavePipeline", []interface{}{arg1, arg2, arg3, arg4, arg5})
+	fake.savePipelineMutex.Unlock()
+	if fake.SavePipelineStub != nil {
+		return fake.SavePipelineStub(arg1, arg2, arg3, arg4, arg5)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.savePipelineReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeBuild) SavePipelineCallCount() int {
+	fake.savePipelineMutex.RLock()
+	defer fake.savePipelineMutex.RUnlock()
+	return len(fake.savePipelineArgsForCall)
+}
+
+func (fake *FakeBuild) SavePipelineCalls(stub func(atc.PipelineRef, int, atc.Config, db.ConfigVersion, bool) (db.Pipeline, bool, error)) {
+	fake.savePipelineMutex.Lock()
+	defer fake.savePipelineMutex.Unlock()
+	fake.SavePipelineStub = stub
+}
+
+func (fake *FakeBuild) SavePipelineArgsForCall(i int) (atc.PipelineRef, int, atc.Config, db.ConfigVersion, bool) {
+	fake.savePipelineMutex.RLock()
+	defer fake.savePipelineMutex.RUnlock()
+	argsForCall := fake.savePipelineArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
+}
+
+func (fake *FakeBuild) SavePipelineReturns(result1 db.Pipeline, result2 bool, result3 error) {
+	fake.savePipelineMutex.Lock()
+	defer fake.savePipelineMutex.Unlock()
+	fake.SavePipelineStub = nil
+	fake.savePipelineReturns = struct {
+		result1 db.Pipeline
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeBuild) SavePipelineReturnsOnCall(i int, result1 db.Pipeline, result2 bool, result3 error) {
+	fake.savePipelineMutex.Lock()
+	defer fake.savePipelineMutex.Unlock()
+	fake.SavePipelineStub = nil
+	if fake.savePipelineReturnsOnCall == nil {
+		fake.savePipelineReturnsOnCall = make(map[int]struct {
+			result1 db.Pipeline
+			result2 bool
+			result3 error
+		})
+	}
+	fake.savePipelineReturnsOnCall[i] = struct {
+		result1 db.Pipeline
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeBuild) SaveTestResults(arg1 []atc.TestResult) error {
+	var arg1Copy []atc.TestResult
+	if arg1 != nil {
+		arg1Copy = make([]atc.TestResult, len(arg1))
+		copy(arg1Copy, arg1)
+	}
+	fake.saveTestResultsMutex.Lock()
+	ret, specificReturn := fake.saveTestResultsReturnsOnCall[len(fake.saveTestResultsArgsForCall)]
+	fake.saveTestResultsArgsForCall = append(fake.saveTestResultsArgsForCall, struct {
+		arg1 []atc.TestResult
+	}{arg1Copy})
+	fake.recordInvocation("SaveTestResults", []interface{}{arg1Copy})
+	fake.saveTestResultsMutex.Unlock()
+	if fake.SaveTestResultsStub != nil {
+		return fake.SaveTestResultsStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.saveTestResultsReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeBuild) SaveTestResultsCallCount() int {
+	fake.saveTestResultsMutex.RLock()
+	defer fake.saveTestResultsMutex.RUnlock()
+	return len(fake.saveTestResultsArgsForCall)
+}
+
+func (fake *FakeBuild) SaveTestResultsCalls(stub func([]atc.TestResult) error) {
+	fake.saveTestResultsMutex.Lock()
+	defer fake.saveTestResultsMutex.Unlock()
+	fake.SaveTestResultsStub = stub
+}
+
+func (fake *FakeBuild) SaveTestResultsArgsForCall(i int) []atc.TestResult {
+	fake.saveTestResultsMutex.RLock()
+	defer fake.saveTestResultsMutex.RUnlock()
+	argsForCall := fake.saveTestResultsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeBuild) SaveTestResultsReturns(result1 error) {
+	fake.saveTestResultsMutex.Lock()
+	defer fake.saveTestResultsMutex.Unlock()
+	fake.SaveTestResultsStub = nil
+	fake.saveTestResultsReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeBuild) SaveTestResultsReturnsOnCall(i int, result1 error) {
+	fake.saveTestResultsMutex.Lock()
+	defer fake.saveTestResultsMutex.Unlock()
+	fake.SaveTestResultsStub = nil
+	if fake.saveTestResultsReturnsOnCall == nil {
+		fake.saveTestResultsReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.saveTestResultsReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeBuild) Schema() string {
 	fake.schemaMutex.Lock()
 	ret, specificReturn := fake.schemaReturnsOnCall[len(fake.schemaArgsForCall)]
@@ -2815,6 +3785,67 @@ func (fake *FakeBuild) SchemaReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *FakeBuild) SetBreakpoint(arg1 string, arg2 atc.BreakpointPosition) error {
+	fake.setBreakpointMutex.Lock()
+	ret, specificReturn := fake.setBreakpointReturnsOnCall[len(fake.setBreakpointArgsForCall)]
+	fake.setBreakpointArgsForCall = append(fake.setBreakpointArgsForCall, struct {
+		arg1 string
+		arg2 atc.BreakpointPosition
+	}{arg1, arg2})
+	fake.recordInvocation("SetBreakpoint", []interface{}{arg1, arg2})
+	fake.setBreakpointMutex.Unlock()
+	if fake.SetBreakpointStub != nil {
+		return fake.SetBreakpointStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.setBreakpointReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeBuild) SetBreakpointCallCount() int {
+	fake.setBreakpointMutex.RLock()
+	defer fake.setBreakpointMutex.RUnlock()
+	return len(fake.setBreakpointArgsForCall)
+}
+
+func (fake *FakeBuild) SetBreakpointCalls(stub func(string, atc.BreakpointPosition) error) {
+	fake.setBreakpointMutex.Lock()
+	defer fake.setBreakpointMutex.Unlock()
+	fake.SetBreakpointStub = stub
+}
+
+func (fake *FakeBuild) SetBreakpointArgsForCall(i int) (string, atc.BreakpointPosition) {
+	fake.setBreakpointMutex.RLock()
+	defer fake.setBreakpointMutex.RUnlock()
+	argsForCall := fake.setBreakpointArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeBuild) SetBreakpointReturns(result1 error) {
+	fake.setBreakpointMutex.Lock()
+	defer fake.setBreakpointMutex.Unlock()
+	fake.SetBreakpointStub = nil
+	fake.setBreakpointReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeBuild) SetBreakpointReturnsOnCall(i int, result1 error) {
+	fake.setBreakpointMutex.Lock()
+	defer fake.setBreakpointMutex.Unlock()
+	fake.SetBreakpointStub = nil
+	if fake.setBreakpointReturnsOnCall == nil {
+		fake.setBreakpointReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.setBreakpointReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeBuild) SetDrained(arg1 bool) error {
 	fake.setDrainedMutex.Lock()
 	ret, specificReturn := fake.setDrainedReturnsOnCall[len(fake.setDrainedArgsForCall)]
@@ -2935,6 +3966,66 @@ func (fake *FakeBuild) SetInterceptibleReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeBuild) SetPipelineParent(arg1 int) error {
+	fake.setPipelineParentMutex.Lock()
+	ret, specificReturn := fake.setPipelineParentReturnsOnCall[len(fake.setPipelineParentArgsForCall)]
+	fake.setPipelineParentArgsForCall = append(fake.setPipelineParentArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.recordInvocation("SetPipelineParent", []interface{}{arg1})
+	fake.setPipelineParentMutex.Unlock()
+	if fake.SetPipelineParentStub != nil {
+		return fake.SetPipelineParentStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.setPipelineParentReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeBuild) SetPipelineParentCallCount() int {
+	fake.setPipelineParentMutex.RLock()
+	defer fake.setPipelineParentMutex.RUnlock()
+	return len(fake.setPipelineParentArgsForCall)
+}
+
+func (fake *FakeBuild) SetPipelineParentCalls(stub func(int) error) {
+	fake.setPipelineParentMutex.Lock()
+	defer fake.setPipelineParentMutex.Unlock()
+	fake.SetPipelineParentStub = stub
+}
+
+func (fake *FakeBuild) SetPipelineParentArgsForCall(i int) int {
+	fake.setPipelineParentMutex.RLock()
+	defer fake.setPipelineParentMutex.RUnlock()
+	argsForCall := fake.setPipelineParentArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeBuild) SetPipelineParentReturns(result1 error) {
+	fake.setPipelineParentMutex.Lock()
+	defer fake.setPipelineParentMutex.Unlock()
+	fake.SetPipelineParentStub = nil
+	fake.setPipelineParentReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeBuild) SetPipelineParentReturnsOnCall(i int, result1 error) {
+	fake.setPipelineParentMutex.Lock()
+	defer fake.setPipelineParentMutex.Unlock()
+	fake.SetPipelineParentStub = nil
+	if fake.setPipelineParentReturnsOnCall == nil {
+		fake.setPipelineParentReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.setPipelineParentReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeBuild) Start(arg1 atc.Plan) (bool, error) {
 	fake.startMutex.Lock()
 	ret, specificReturn := fake.startReturnsOnCall[len(fake.startArgsForCall)]
@@ -3206,6 +4297,173 @@ func (fake *FakeBuild) TeamNameReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *FakeBuild) TestResults() ([]atc.TestResult, error) {
+	fake.testResultsMutex.Lock()
+	ret, specificReturn := fake.testResultsReturnsOnCall[len(fake.testResultsArgsForCall)]
+	fake.testResultsArgsForCall = append(fake.testResultsArgsForCall, struct {
+	}{})
+	fake.recordInvocation("TestResults", []interface{}{})
+	fake.testResultsMutex.Unlock()
+	if fake.TestResultsStub != nil {
+		return fake.TestResultsStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.testResultsReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeBuild) TestResultsCallCount() int {
+	fake.testResultsMutex.RLock()
+	defer fake.testResultsMutex.RUnlock()
+	return len(fake.testResultsArgsForCall)
+}
+
+func (fake *FakeBuild) TestResultsCalls(stub func() ([]atc.TestResult, error)) {
+	fake.testResultsMutex.Lock()
+	defer fake.testResultsMutex.Unlock()
+	fake.TestResultsStub = stub
+}
+
+func (fake *FakeBuild) TestResultsReturns(result1 []atc.TestResult, result2 error) {
+	fake.testResultsMutex.Lock()
+	defer fake.testResultsMutex.Unlock()
+	fake.TestResultsStub = nil
+	fake.testResultsReturns = struct {
+		result1 []atc.TestResult
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBuild) TestResultsReturnsOnCall(i int, result1 []atc.TestResult, result2 error) {
+	fake.testResultsMutex.Lock()
+	defer fake.testResultsMutex.Unlock()
+	fake.TestResultsStub = nil
+	if fake.testResultsReturnsOnCall == nil {
+		fake.testResultsReturnsOnCall = make(map[int]struct {
+			result1 []atc.TestResult
+			result2 error
+		})
+	}
+	fake.testResultsReturnsOnCall[i] = struct {
+		result1 []atc.TestResult
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBuild) TriggerReason() db.BuildTriggerReason {
+	fake.triggerReasonMutex.Lock()
+	ret, specificReturn := fake.triggerReasonReturnsOnCall[len(fake.triggerReasonArgsForCall)]
+	fake.triggerReasonArgsForCall = append(fake.triggerReasonArgsForCall, struct {
+	}{})
+	fake.recordInvocation("TriggerReason", []interface{}{})
+	fake.triggerReasonMutex.Unlock()
+	if fake.TriggerReasonStub != nil {
+		return fake.TriggerReasonStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.triggerReasonReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeBuild) TriggerReasonCallCount() int {
+	fake.triggerReasonMutex.RLock()
+	defer fake.triggerReasonMutex.RUnlock()
+	return len(fake.triggerReasonArgsForCall)
+}
+
+func (fake *FakeBuild) TriggerReasonCalls(stub func() db.BuildTriggerReason) {
+	fake.triggerReasonMutex.Lock()
+	defer fake.triggerReasonMutex.Unlock()
+	fake.TriggerReasonStub = stub
+}
+
+func (fake *FakeBuild) TriggerReasonReturns(result1 db.BuildTriggerReason) {
+	fake.triggerReasonMutex.Lock()
+	defer fake.triggerReasonMutex.Unlock()
+	fake.TriggerReasonStub = nil
+	fake.triggerReasonReturns = struct {
+		result1 db.BuildTriggerReason
+	}{result1}
+}
+
+func (fake *FakeBuild) TriggerReasonReturnsOnCall(i int, result1 db.BuildTriggerReason) {
+	fake.triggerReasonMutex.Lock()
+	defer fake.triggerReasonMutex.Unlock()
+	fake.TriggerReasonStub = nil
+	if fake.triggerReasonReturnsOnCall == nil {
+		fake.triggerReasonReturnsOnCall = make(map[int]struct {
+			result1 db.BuildTriggerReason
+		})
+	}
+	fake.triggerReasonReturnsOnCall[i] = struct {
+		result1 db.BuildTriggerReason
+	}{result1}
+}
+
+func (fake *FakeBuild) WithdrawApproval(arg1 atc.PlanID) error {
+	fake.withdrawApprovalMutex.Lock()
+	ret, specificReturn := fake.withdrawApprovalReturnsOnCall[len(fake.withdrawApprovalArgsForCall)]
+	fake.withdrawApprovalArgsForCall = append(fake.withdrawApprovalArgsForCall, struct {
+		arg1 atc.PlanID
+	}{arg1})
+	fake.recordInvocation("WithdrawApproval", []interface{}{arg1})
+	fake.withdrawApprovalMutex.Unlock()
+	if fake.WithdrawApprovalStub != nil {
+		return fake.WithdrawApprovalStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.withdrawApprovalReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeBuild) WithdrawApprovalCallCount() int {
+	fake.withdrawApprovalMutex.RLock()
+	defer fake.withdrawApprovalMutex.RUnlock()
+	return len(fake.withdrawApprovalArgsForCall)
+}
+
+func (fake *FakeBuild) WithdrawApprovalCalls(stub func(atc.PlanID) error) {
+	fake.withdrawApprovalMutex.Lock()
+	defer fake.withdrawApprovalMutex.Unlock()
+	fake.WithdrawApprovalStub = stub
+}
+
+func (fake *FakeBuild) WithdrawApprovalArgsForCall(i int) atc.PlanID {
+	fake.withdrawApprovalMutex.RLock()
+	defer fake.withdrawApprovalMutex.RUnlock()
+	argsForCall := fake.withdrawApprovalArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeBuild) WithdrawApprovalReturns(result1 error) {
+	fake.withdrawApprovalMutex.Lock()
+	defer fake.withdrawApprovalMutex.Unlock()
+	fake.WithdrawApprovalStub = nil
+	fake.withdrawApprovalReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeBuild) WithdrawApprovalReturnsOnCall(i int, result1 error) {
+	fake.withdrawApprovalMutex.Lock()
+	defer fake.withdrawApprovalMutex.Unlock()
+	fake.WithdrawApprovalStub = nil
+	if fake.withdrawApprovalReturnsOnCall == nil {
+		fake.withdrawApprovalReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.withdrawApprovalReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeBuild) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -3217,10 +4475,16 @@ func (fake *FakeBuild) Invocations() map[string][][]interface{} {
 	defer fake.adoptInputsAndPipesMutex.RUnlock()
 	fake.adoptRerunInputsAndPipesMutex.RLock()
 	defer fake.adoptRerunInputsAndPipesMutex.RUnlock()
+	fake.approvalMutex.RLock()
+	defer fake.approvalMutex.RUnlock()
 	fake.artifactMutex.RLock()
 	defer fake.artifactMutex.RUnlock()
 	fake.artifactsMutex.RLock()
 	defer fake.artifactsMutex.RUnlock()
+	fake.continueMutex.RLock()
+	defer fake.continueMutex.RUnlock()
+	fake.decideApprovalMutex.RLock()
+	defer fake.decideApprovalMutex.RUnlock()
 	fake.deleteMutex.RLock()
 	defer fake.deleteMutex.RUnlock()
 	fake.endTimeMutex.RLock()
@@ -3229,8 +4493,12 @@ func (fake *FakeBuild) Invocations() map[string][][]interface{} {
 	defer fake.eventsMutex.RUnlock()
 	fake.finishMutex.RLock()
 	defer fake.finishMutex.RUnlock()
+	fake.hasBreakpointsMutex.RLock()
+	defer fake.hasBreakpointsMutex.RUnlock()
 	fake.hasPlanMutex.RLock()
 	defer fake.hasPlanMutex.RUnlock()
+	fake.hitBreakpointMutex.RLock()
+	defer fake.hitBreakpointMutex.RUnlock()
 	fake.iDMutex.RLock()
 	defer fake.iDMutex.RUnlock()
 	fake.inputsReadyMutex.RLock()
@@ -3247,6 +4515,10 @@ func (fake *FakeBuild) Invocations() map[string][][]interface{} {
 	defer fake.isManuallyTriggeredMutex.RUnlock()
 	fake.isNewerThanLastCheckOfMutex.RLock()
 	defer fake.isNewerThanLastCheckOfMutex.RUnlock()
+	fake.isPausedMutex.RLock()
+	defer fake.isPausedMutex.RUnlock()
+	fake.isPausedAtMutex.RLock()
+	defer fake.isPausedAtMutex.RUnlock()
 	fake.isRunningMutex.RLock()
 	defer fake.isRunningMutex.RUnlock()
 	fake.isScheduledMutex.RLock()
@@ -3259,6 +4531,8 @@ func (fake *FakeBuild) Invocations() map[string][][]interface{} {
 	defer fake.markAsAbortedMutex.RUnlock()
 	fake.nameMutex.RLock()
 	defer fake.nameMutex.RUnlock()
+	fake.pendingApprovalMutex.RLock()
+	defer fake.pendingApprovalMutex.RUnlock()
 	fake.pipelineMutex.RLock()
 	defer fake.pipelineMutex.RUnlock()
 	fake.pipelineIDMutex.RLock()
@@ -3275,6 +4549,10 @@ func (fake *FakeBuild) Invocations() map[string][][]interface{} {
 	defer fake.reapTimeMutex.RUnlock()
 	fake.reloadMutex.RLock()
 	defer fake.reloadMutex.RUnlock()
+	fake.removeBreakpointMutex.RLock()
+	defer fake.removeBreakpointMutex.RUnlock()
+	fake.requestApprovalMutex.RLock()
+	defer fake.requestApprovalMutex.RUnlock()
 	fake.rerunNumberMutex.RLock()
 	defer fake.rerunNumberMutex.RUnlock()
 	fake.rerunOfMutex.RLock()
@@ -3289,12 +4567,20 @@ func (fake *FakeBuild) Invocations() map[string][][]interface{} {
 	defer fake.saveImageResourceVersionMutex.RUnlock()
 	fake.saveOutputMutex.RLock()
 	defer fake.saveOutputMutex.RUnlock()
+	fake.savePipelineMutex.RLock()
+	defer fake.savePipelineMutex.RUnlock()
+	fake.saveTestResultsMutex.RLock()
+	defer fake.saveTestResultsMutex.RUnlock()
 	fake.schemaMutex.RLock()
 	defer fake.schemaMutex.RUnlock()
+	fake.setBreakpointMutex.RLock()
+	defer fake.setBreakpointMutex.RUnlock()
 	fake.setDrainedMutex.RLock()
 	defer fake.setDrainedMutex.RUnlock()
 	fake.setInterceptibleMutex.RLock()
 	defer fake.setInterceptibleMutex.RUnlock()
+	fake.setPipelineParentMutex.RLock()
+	defer fake.setPipelineParentMutex.RUnlock()
 	fake.startMutex.RLock()
 	defer fake.startMutex.RUnlock()
 	fake.startTimeMutex.RLock()
@@ -3305,6 +4591,12 @@ func (fake *FakeBuild) Invocations() map[string][][]interface{} {
 	defer fake.teamIDMutex.RUnlock()
 	fake.teamNameMutex.RLock()
 	defer fake.teamNameMutex.RUnlock()
+	fake.testResultsMutex.RLock()
+	defer fake.testResultsMutex.RUnlock()
+	fake.triggerReasonMutex.RLock()
+	defer fake.triggerReasonMutex.RUnlock()
+	fake.withdrawApprovalMutex.RLock()
+	defer fake.withdrawApprovalMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
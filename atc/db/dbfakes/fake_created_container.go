@@ -41,6 +41,29 @@ type FakeCreatedContainer struct {
 	iDReturnsOnCall map[int]struct {
 		result1 int
 	}
+	KeepStub        func(time.Duration) (time.Time, error)
+	keepMutex       sync.RWMutex
+	keepArgsForCall []struct {
+		arg1 time.Duration
+	}
+	keepReturns struct {
+		result1 time.Time
+		result2 error
+	}
+	keepReturnsOnCall map[int]struct {
+		result1 time.Time
+		result2 error
+	}
+	KeepUntilStub        func() time.Time
+	keepUntilMutex       sync.RWMutex
+	keepUntilArgsForCall []struct {
+	}
+	keepUntilReturns struct {
+		result1 time.Time
+	}
+	keepUntilReturnsOnCall map[int]struct {
+		result1 time.Time
+	}
 	LastHijackStub        func() time.Time
 	lastHijackMutex       sync.RWMutex
 	lastHijackArgsForCall []struct {
@@ -254,6 +277,121 @@ func (fake *FakeCreatedContainer) IDReturnsOnCall(i int, result1 int) {
 	}{result1}
 }
 
+func (fake *FakeCreatedContainer) Keep(arg1 time.Duration) (time.Time, error) {
+	fake.keepMutex.Lock()
+	ret, specificReturn := fake.keepReturnsOnCall[len(fake.keepArgsForCall)]
+	fake.keepArgsForCall = append(fake.keepArgsForCall, struct {
+		arg1 time.Duration
+	}{arg1})
+	fake.recordInvocation("Keep", []interface{}{arg1})
+	fake.keepMutex.Unlock()
+	if fake.KeepStub != nil {
+		return fake.KeepStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.keepReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeCreatedContainer) KeepCallCount() int {
+	fake.keepMutex.RLock()
+	defer fake.keepMutex.RUnlock()
+	return len(fake.keepArgsForCall)
+}
+
+func (fake *FakeCreatedContainer) KeepCalls(stub func(time.Duration) (time.Time, error)) {
+	fake.keepMutex.Lock()
+	defer fake.keepMutex.Unlock()
+	fake.KeepStub = stub
+}
+
+func (fake *FakeCreatedContainer) KeepArgsForCall(i int) time.Duration {
+	fake.keepMutex.RLock()
+	defer fake.keepMutex.RUnlock()
+	argsForCall := fake.keepArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeCreatedContainer) KeepReturns(result1 time.Time, result2 error) {
+	fake.keepMutex.Lock()
+	defer fake.keepMutex.Unlock()
+	fake.KeepStub = nil
+	fake.keepReturns = struct {
+		result1 time.Time
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCreatedContainer) KeepReturnsOnCall(i int, result1 time.Time, result2 error) {
+	fake.keepMutex.Lock()
+	defer fake.keepMutex.Unlock()
+	fake.KeepStub = nil
+	if fake.keepReturnsOnCall == nil {
+		fake.keepReturnsOnCall = make(map[int]struct {
+			result1 time.Time
+			result2 error
+		})
+	}
+	fake.keepReturnsOnCall[i] = struct {
+		result1 time.Time
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCreatedContainer) KeepUntil() time.Time {
+	fake.keepUntilMutex.Lock()
+	ret, specificReturn := fake.keepUntilReturnsOnCall[len(fake.keepUntilArgsForCall)]
+	fake.keepUntilArgsForCall = append(fake.keepUntilArgsForCall, struct {
+	}{})
+	fake.recordInvocation("KeepUntil", []interface{}{})
+	fake.keepUntilMutex.Unlock()
+	if fake.KeepUntilStub != nil {
+		return fake.KeepUntilStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.keepUntilReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeCreatedContainer) KeepUntilCallCount() int {
+	fake.keepUntilMutex.RLock()
+	defer fake.keepUntilMutex.RUnlock()
+	return len(fake.keepUntilArgsForCall)
+}
+
+func (fake *FakeCreatedContainer) KeepUntilCalls(stub func() time.Time) {
+	fake.keepUntilMutex.Lock()
+	defer fake.keepUntilMutex.Unlock()
+	fake.KeepUntilStub = stub
+}
+
+func (fake *FakeCreatedContainer) KeepUntilReturns(result1 time.Time) {
+	fake.keepUntilMutex.Lock()
+	defer fake.keepUntilMutex.Unlock()
+	fake.KeepUntilStub = nil
+	fake.keepUntilReturns = struct {
+		result1 time.Time
+	}{result1}
+}
+
+func (fake *FakeCreatedContainer) KeepUntilReturnsOnCall(i int, result1 time.Time) {
+	fake.keepUntilMutex.Lock()
+	defer fake.keepUntilMutex.Unlock()
+	fake.KeepUntilStub = nil
+	if fake.keepUntilReturnsOnCall == nil {
+		fake.keepUntilReturnsOnCall = make(map[int]struct {
+			result1 time.Time
+		})
+	}
+	fake.keepUntilReturnsOnCall[i] = struct {
+		result1 time.Time
+	}{result1}
+}
+
 func (fake *FakeCreatedContainer) LastHijack() time.Time {
 	fake.lastHijackMutex.Lock()
 	ret, specificReturn := fake.lastHijackReturnsOnCall[len(fake.lastHijackArgsForCall)]
@@ -523,6 +661,10 @@ func (fake *FakeCreatedContainer) Invocations() map[string][][]interface{} {
 	defer fake.handleMutex.RUnlock()
 	fake.iDMutex.RLock()
 	defer fake.iDMutex.RUnlock()
+	fake.keepMutex.RLock()
+	defer fake.keepMutex.RUnlock()
+	fake.keepUntilMutex.RLock()
+	defer fake.keepUntilMutex.RUnlock()
 	fake.lastHijackMutex.RLock()
 	defer fake.lastHijackMutex.RUnlock()
 	fake.metadataMutex.RLock()
@@ -130,6 +130,17 @@ type FakeJob struct {
 	ensurePendingBuildExistsReturnsOnCall map[int]struct {
 		result1 error
 	}
+	EnsureScheduledBuildExistsStub        func(time.Time) error
+	ensureScheduledBuildExistsMutex       sync.RWMutex
+	ensureScheduledBuildExistsArgsForCall []struct {
+		arg1 time.Time
+	}
+	ensureScheduledBuildExistsReturns struct {
+		result1 error
+	}
+	ensureScheduledBuildExistsReturnsOnCall map[int]struct {
+		result1 error
+	}
 	FinishedAndNextBuildStub        func() (db.Build, db.Build, error)
 	finishedAndNextBuildMutex       sync.RWMutex
 	finishedAndNextBuildArgsForCall []struct {
@@ -244,6 +255,16 @@ type FakeJob struct {
 	nameReturnsOnCall map[int]struct {
 		result1 string
 	}
+	NextScheduledTriggerStub        func() time.Time
+	nextScheduledTriggerMutex       sync.RWMutex
+	nextScheduledTriggerArgsForCall []struct {
+	}
+	nextScheduledTriggerReturns struct {
+		result1 time.Time
+	}
+	nextScheduledTriggerReturnsOnCall map[int]struct {
+		result1 time.Time
+	}
 	OutputsStub        func() ([]atc.JobOutput, error)
 	outputsMutex       sync.RWMutex
 	outputsArgsForCall []struct {
@@ -367,6 +388,16 @@ type FakeJob struct {
 	saveNextInputMappingReturnsOnCall map[int]struct {
 		result1 error
 	}
+	ScheduleStub        func() *atc.ScheduleConfig
+	scheduleMutex       sync.RWMutex
+	scheduleArgsForCall []struct {
+	}
+	scheduleReturns struct {
+		result1 *atc.ScheduleConfig
+	}
+	scheduleReturnsOnCall map[int]struct {
+		result1 *atc.ScheduleConfig
+	}
 	ScheduleBuildStub        func(db.Build) (bool, error)
 	scheduleBuildMutex       sync.RWMutex
 	scheduleBuildArgsForCall []struct {
@@ -463,6 +494,17 @@ type FakeJob struct {
 	updateLastScheduledReturnsOnCall map[int]struct {
 		result1 error
 	}
+	UpdateNextScheduledTriggerStub        func(time.Time) error
+	updateNextScheduledTriggerMutex       sync.RWMutex
+	updateNextScheduledTriggerArgsForCall []struct {
+		arg1 time.Time
+	}
+	updateNextScheduledTriggerReturns struct {
+		result1 error
+	}
+	updateNextScheduledTriggerReturnsOnCall map[int]struct {
+		result1 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -1009,6 +1051,66 @@ func (fake *FakeJob) EnsurePendingBuildExistsReturnsOnCall(i int, result1 error)
 	}{result1}
 }
 
+func (fake *FakeJob) EnsureScheduledBuildExists(arg1 time.Time) error {
+	fake.ensureScheduledBuildExistsMutex.Lock()
+	ret, specificReturn := fake.ensureScheduledBuildExistsReturnsOnCall[len(fake.ensureScheduledBuildExistsArgsForCall)]
+	fake.ensureScheduledBuildExistsArgsForCall = append(fake.ensureScheduledBuildExistsArgsForCall, struct {
+		arg1 time.Time
+	}{arg1})
+	fake.recordInvocation("EnsureScheduledBuildExists", []interface{}{arg1})
+	fake.ensureScheduledBuildExistsMutex.Unlock()
+	if fake.EnsureScheduledBuildExistsStub != nil {
+		return fake.EnsureScheduledBuildExistsStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.ensureScheduledBuildExistsReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeJob) EnsureScheduledBuildExistsCallCount() int {
+	fake.ensureScheduledBuildExistsMutex.RLock()
+	defer fake.ensureScheduledBuildExistsMutex.RUnlock()
+	return len(fake.ensureScheduledBuildExistsArgsForCall)
+}
+
+func (fake *FakeJob) EnsureScheduledBuildExistsCalls(stub func(time.Time) error) {
+	fake.ensureScheduledBuildExistsMutex.Lock()
+	defer fake.ensureScheduledBuildExistsMutex.Unlock()
+	fake.EnsureScheduledBuildExistsStub = stub
+}
+
+func (fake *FakeJob) EnsureScheduledBuildExistsArgsForCall(i int) time.Time {
+	fake.ensureScheduledBuildExistsMutex.RLock()
+	defer fake.ensureScheduledBuildExistsMutex.RUnlock()
+	argsForCall := fake.ensureScheduledBuildExistsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeJob) EnsureScheduledBuildExistsReturns(result1 error) {
+	fake.ensureScheduledBuildExistsMutex.Lock()
+	defer fake.ensureScheduledBuildExistsMutex.Unlock()
+	fake.EnsureScheduledBuildExistsStub = nil
+	fake.ensureScheduledBuildExistsReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeJob) EnsureScheduledBuildExistsReturnsOnCall(i int, result1 error) {
+	fake.ensureScheduledBuildExistsMutex.Lock()
+	defer fake.ensureScheduledBuildExistsMutex.Unlock()
+	fake.EnsureScheduledBuildExistsStub = nil
+	if fake.ensureScheduledBuildExistsReturnsOnCall == nil {
+		fake.ensureScheduledBuildExistsReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.ensureScheduledBuildExistsReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeJob) FinishedAndNextBuild() (db.Build, db.Build, error) {
 	fake.finishedAndNextBuildMutex.Lock()
 	ret, specificReturn := fake.finishedAndNextBuildReturnsOnCall[len(fake.finishedAndNextBuildArgsForCall)]
@@ -1550,6 +1652,58 @@ func (fake *FakeJob) NameReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *FakeJob) NextScheduledTrigger() time.Time {
+	fake.nextScheduledTriggerMutex.Lock()
+	ret, specificReturn := fake.nextScheduledTriggerReturnsOnCall[len(fake.nextScheduledTriggerArgsForCall)]
+	fake.nextScheduledTriggerArgsForCall = append(fake.nextScheduledTriggerArgsForCall, struct {
+	}{})
+	fake.recordInvocation("NextScheduledTrigger", []interface{}{})
+	fake.nextScheduledTriggerMutex.Unlock()
+	if fake.NextScheduledTriggerStub != nil {
+		return fake.NextScheduledTriggerStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.nextScheduledTriggerReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeJob) NextScheduledTriggerCallCount() int {
+	fake.nextScheduledTriggerMutex.RLock()
+	defer fake.nextScheduledTriggerMutex.RUnlock()
+	return len(fake.nextScheduledTriggerArgsForCall)
+}
+
+func (fake *FakeJob) NextScheduledTriggerCalls(stub func() time.Time) {
+	fake.nextScheduledTriggerMutex.Lock()
+	defer fake.nextScheduledTriggerMutex.Unlock()
+	fake.NextScheduledTriggerStub = stub
+}
+
+func (fake *FakeJob) NextScheduledTriggerReturns(result1 time.Time) {
+	fake.nextScheduledTriggerMutex.Lock()
+	defer fake.nextScheduledTriggerMutex.Unlock()
+	fake.NextScheduledTriggerStub = nil
+	fake.nextScheduledTriggerReturns = struct {
+		result1 time.Time
+	}{result1}
+}
+
+func (fake *FakeJob) NextScheduledTriggerReturnsOnCall(i int, result1 time.Time) {
+	fake.nextScheduledTriggerMutex.Lock()
+	defer fake.nextScheduledTriggerMutex.Unlock()
+	fake.NextScheduledTriggerStub = nil
+	if fake.nextScheduledTriggerReturnsOnCall == nil {
+		fake.nextScheduledTriggerReturnsOnCall = make(map[int]struct {
+			result1 time.Time
+		})
+	}
+	fake.nextScheduledTriggerReturnsOnCall[i] = struct {
+		result1 time.Time
+	}{result1}
+}
+
 func (fake *FakeJob) Outputs() ([]atc.JobOutput, error) {
 	fake.outputsMutex.Lock()
 	ret, specificReturn := fake.outputsReturnsOnCall[len(fake.outputsArgsForCall)]
@@ -2154,6 +2308,58 @@ func (fake *FakeJob) SaveNextInputMappingReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeJob) Schedule() *atc.ScheduleConfig {
+	fake.scheduleMutex.Lock()
+	ret, specificReturn := fake.scheduleReturnsOnCall[len(fake.scheduleArgsForCall)]
+	fake.scheduleArgsForCall = append(fake.scheduleArgsForCall, struct {
+	}{})
+	fake.recordInvocation("Schedule", []interface{}{})
+	fake.scheduleMutex.Unlock()
+	if fake.ScheduleStub != nil {
+		return fake.ScheduleStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.scheduleReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeJob) ScheduleCallCount() int {
+	fake.scheduleMutex.RLock()
+	defer fake.scheduleMutex.RUnlock()
+	return len(fake.scheduleArgsForCall)
+}
+
+func (fake *FakeJob) ScheduleCalls(stub func() *atc.ScheduleConfig) {
+	fake.scheduleMutex.Lock()
+	defer fake.scheduleMutex.Unlock()
+	fake.ScheduleStub = stub
+}
+
+func (fake *FakeJob) ScheduleReturns(result1 *atc.ScheduleConfig) {
+	fake.scheduleMutex.Lock()
+	defer fake.scheduleMutex.Unlock()
+	fake.ScheduleStub = nil
+	fake.scheduleReturns = struct {
+		result1 *atc.ScheduleConfig
+	}{result1}
+}
+
+func (fake *FakeJob) ScheduleReturnsOnCall(i int, result1 *atc.ScheduleConfig) {
+	fake.scheduleMutex.Lock()
+	defer fake.scheduleMutex.Unlock()
+	fake.ScheduleStub = nil
+	if fake.scheduleReturnsOnCall == nil {
+		fake.scheduleReturnsOnCall = make(map[int]struct {
+			result1 *atc.ScheduleConfig
+		})
+	}
+	fake.scheduleReturnsOnCall[i] = struct {
+		result1 *atc.ScheduleConfig
+	}{result1}
+}
+
 func (fake *FakeJob) ScheduleBuild(arg1 db.Build) (bool, error) {
 	fake.scheduleBuildMutex.Lock()
 	ret, specificReturn := fake.scheduleBuildReturnsOnCall[len(fake.scheduleBuildArgsForCall)]
@@ -2657,6 +2863,66 @@ func (fake *FakeJob) UpdateLastScheduledReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeJob) UpdateNextScheduledTrigger(arg1 time.Time) error {
+	fake.updateNextScheduledTriggerMutex.Lock()
+	ret, specificReturn := fake.updateNextScheduledTriggerReturnsOnCall[len(fake.updateNextScheduledTriggerArgsForCall)]
+	fake.updateNextScheduledTriggerArgsForCall = append(fake.updateNextScheduledTriggerArgsForCall, struct {
+		arg1 time.Time
+	}{arg1})
+	fake.recordInvocation("UpdateNextScheduledTrigger", []interface{}{arg1})
+	fake.updateNextScheduledTriggerMutex.Unlock()
+	if fake.UpdateNextScheduledTriggerStub != nil {
+		return fake.UpdateNextScheduledTriggerStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.updateNextScheduledTriggerReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeJob) UpdateNextScheduledTriggerCallCount() int {
+	fake.updateNextScheduledTriggerMutex.RLock()
+	defer fake.updateNextScheduledTriggerMutex.RUnlock()
+	return len(fake.updateNextScheduledTriggerArgsForCall)
+}
+
+func (fake *FakeJob) UpdateNextScheduledTriggerCalls(stub func(time.Time) error) {
+	fake.updateNextScheduledTriggerMutex.Lock()
+	defer fake.updateNextScheduledTriggerMutex.Unlock()
+	fake.UpdateNextScheduledTriggerStub = stub
+}
+
+func (fake *FakeJob) UpdateNextScheduledTriggerArgsForCall(i int) time.Time {
+	fake.updateNextScheduledTriggerMutex.RLock()
+	defer fake.updateNextScheduledTriggerMutex.RUnlock()
+	argsForCall := fake.updateNextScheduledTriggerArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeJob) UpdateNextScheduledTriggerReturns(result1 error) {
+	fake.updateNextScheduledTriggerMutex.Lock()
+	defer fake.updateNextScheduledTriggerMutex.Unlock()
+	fake.UpdateNextScheduledTriggerStub = nil
+	fake.updateNextScheduledTriggerReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeJob) UpdateNextScheduledTriggerReturnsOnCall(i int, result1 error) {
+	fake.updateNextScheduledTriggerMutex.Lock()
+	defer fake.updateNextScheduledTriggerMutex.Unlock()
+	fake.UpdateNextScheduledTriggerStub = nil
+	if fake.updateNextScheduledTriggerReturnsOnCall == nil {
+		fake.updateNextScheduledTriggerReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.updateNextScheduledTriggerReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeJob) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -2678,6 +2944,8 @@ func (fake *FakeJob) Invocations() map[string][][]interface{} {
 	defer fake.disableManualTriggerMutex.RUnlock()
 	fake.ensurePendingBuildExistsMutex.RLock()
 	defer fake.ensurePendingBuildExistsMutex.RUnlock()
+	fake.ensureScheduledBuildExistsMutex.RLock()
+	defer fake.ensureScheduledBuildExistsMutex.RUnlock()
 	fake.finishedAndNextBuildMutex.RLock()
 	defer fake.finishedAndNextBuildMutex.RUnlock()
 	fake.firstLoggedBuildIDMutex.RLock()
@@ -2698,6 +2966,8 @@ func (fake *FakeJob) Invocations() map[string][][]interface{} {
 	defer fake.maxInFlightMutex.RUnlock()
 	fake.nameMutex.RLock()
 	defer fake.nameMutex.RUnlock()
+	fake.nextScheduledTriggerMutex.RLock()
+	defer fake.nextScheduledTriggerMutex.RUnlock()
 	fake.outputsMutex.RLock()
 	defer fake.outputsMutex.RUnlock()
 	fake.pauseMutex.RLock()
@@ -2720,6 +2990,8 @@ func (fake *FakeJob) Invocations() map[string][][]interface{} {
 	defer fake.rerunBuildMutex.RUnlock()
 	fake.saveNextInputMappingMutex.RLock()
 	defer fake.saveNextInputMappingMutex.RUnlock()
+	fake.scheduleMutex.RLock()
+	defer fake.scheduleMutex.RUnlock()
 	fake.scheduleBuildMutex.RLock()
 	defer fake.scheduleBuildMutex.RUnlock()
 	fake.scheduleRequestedTimeMutex.RLock()
@@ -2738,6 +3010,8 @@ func (fake *FakeJob) Invocations() map[string][][]interface{} {
 	defer fake.updateFirstLoggedBuildIDMutex.RUnlock()
 	fake.updateLastScheduledMutex.RLock()
 	defer fake.updateLastScheduledMutex.RUnlock()
+	fake.updateNextScheduledTriggerMutex.RLock()
+	defer fake.updateNextScheduledTriggerMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
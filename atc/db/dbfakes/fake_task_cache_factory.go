@@ -25,6 +25,21 @@ type FakeTaskCacheFactory struct {
 		result2 bool
 		result3 error
 	}
+	FindMemoStub        func(int, string, string) (bool, error)
+	findMemoMutex       sync.RWMutex
+	findMemoArgsForCall []struct {
+		arg1 int
+		arg2 string
+		arg3 string
+	}
+	findMemoReturns struct {
+		result1 bool
+		result2 error
+	}
+	findMemoReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
 	FindOrCreateStub        func(int, string, string) (db.UsedTaskCache, error)
 	findOrCreateMutex       sync.RWMutex
 	findOrCreateArgsForCall []struct {
@@ -40,6 +55,19 @@ type FakeTaskCacheFactory struct {
 		result1 db.UsedTaskCache
 		result2 error
 	}
+	MemoizeStub        func(int, string, string) error
+	memoizeMutex       sync.RWMutex
+	memoizeArgsForCall []struct {
+		arg1 int
+		arg2 string
+		arg3 string
+	}
+	memoizeReturns struct {
+		result1 error
+	}
+	memoizeReturnsOnCall map[int]struct {
+		result1 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -112,6 +140,71 @@ func (fake *FakeTaskCacheFactory) FindReturnsOnCall(i int, result1 db.UsedTaskCa
 	}{result1, result2, result3}
 }
 
+func (fake *FakeTaskCacheFactory) FindMemo(arg1 int, arg2 string, arg3 string) (bool, error) {
+	fake.findMemoMutex.Lock()
+	ret, specificReturn := fake.findMemoReturnsOnCall[len(fake.findMemoArgsForCall)]
+	fake.findMemoArgsForCall = append(fake.findMemoArgsForCall, struct {
+		arg1 int
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("FindMemo", []interface{}{arg1, arg2, arg3})
+	fake.findMemoMutex.Unlock()
+	if fake.FindMemoStub != nil {
+		return fake.FindMemoStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.findMemoReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTaskCacheFactory) FindMemoCallCount() int {
+	fake.findMemoMutex.RLock()
+	defer fake.findMemoMutex.RUnlock()
+	return len(fake.findMemoArgsForCall)
+}
+
+func (fake *FakeTaskCacheFactory) FindMemoCalls(stub func(int, string, string) (bool, error)) {
+	fake.findMemoMutex.Lock()
+	defer fake.findMemoMutex.Unlock()
+	fake.FindMemoStub = stub
+}
+
+func (fake *FakeTaskCacheFactory) FindMemoArgsForCall(i int) (int, string, string) {
+	fake.findMemoMutex.RLock()
+	defer fake.findMemoMutex.RUnlock()
+	argsForCall := fake.findMemoArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeTaskCacheFactory) FindMemoReturns(result1 bool, result2 error) {
+	fake.findMemoMutex.Lock()
+	defer fake.findMemoMutex.Unlock()
+	fake.FindMemoStub = nil
+	fake.findMemoReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTaskCacheFactory) FindMemoReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.findMemoMutex.Lock()
+	defer fake.findMemoMutex.Unlock()
+	fake.FindMemoStub = nil
+	if fake.findMemoReturnsOnCall == nil {
+		fake.findMemoReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.findMemoReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeTaskCacheFactory) FindOrCreate(arg1 int, arg2 string, arg3 string) (db.UsedTaskCache, error) {
 	fake.findOrCreateMutex.Lock()
 	ret, specificReturn := fake.findOrCreateReturnsOnCall[len(fake.findOrCreateArgsForCall)]
@@ -177,13 +270,79 @@ func (fake *FakeTaskCacheFactory) FindOrCreateReturnsOnCall(i int, result1 db.Us
 	}{result1, result2}
 }
 
+func (fake *FakeTaskCacheFactory) Memoize(arg1 int, arg2 string, arg3 string) error {
+	fake.memoizeMutex.Lock()
+	ret, specificReturn := fake.memoizeReturnsOnCall[len(fake.memoizeArgsForCall)]
+	fake.memoizeArgsForCall = append(fake.memoizeArgsForCall, struct {
+		arg1 int
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("Memoize", []interface{}{arg1, arg2, arg3})
+	fake.memoizeMutex.Unlock()
+	if fake.MemoizeStub != nil {
+		return fake.MemoizeStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.memoizeReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeTaskCacheFactory) MemoizeCallCount() int {
+	fake.memoizeMutex.RLock()
+	defer fake.memoizeMutex.RUnlock()
+	return len(fake.memoizeArgsForCall)
+}
+
+func (fake *FakeTaskCacheFactory) MemoizeCalls(stub func(int, string, string) error) {
+	fake.memoizeMutex.Lock()
+	defer fake.memoizeMutex.Unlock()
+	fake.MemoizeStub = stub
+}
+
+func (fake *FakeTaskCacheFactory) MemoizeArgsForCall(i int) (int, string, string) {
+	fake.memoizeMutex.RLock()
+	defer fake.memoizeMutex.RUnlock()
+	argsForCall := fake.memoizeArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeTaskCacheFactory) MemoizeReturns(result1 error) {
+	fake.memoizeMutex.Lock()
+	defer fake.memoizeMutex.Unlock()
+	fake.MemoizeStub = nil
+	fake.memoizeReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeTaskCacheFactory) MemoizeReturnsOnCall(i int, result1 error) {
+	fake.memoizeMutex.Lock()
+	defer fake.memoizeMutex.Unlock()
+	fake.MemoizeStub = nil
+	if fake.memoizeReturnsOnCall == nil {
+		fake.memoizeReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.memoizeReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeTaskCacheFactory) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
 	fake.findMutex.RLock()
 	defer fake.findMutex.RUnlock()
+	fake.findMemoMutex.RLock()
+	defer fake.findMemoMutex.RUnlock()
 	fake.findOrCreateMutex.RLock()
 	defer fake.findOrCreateMutex.RUnlock()
+	fake.memoizeMutex.RLock()
+	defer fake.memoizeMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
@@ -109,11 +109,11 @@ type FakeTeam struct {
 	deleteReturnsOnCall map[int]struct {
 		result1 error
 	}
-	FindCheckContainersStub        func(lager.Logger, string, string, creds.Secrets, creds.VarSourcePool) ([]db.Container, map[int]time.Time, error)
+	FindCheckContainersStub        func(lager.Logger, atc.PipelineRef, string, creds.Secrets, creds.VarSourcePool) ([]db.Container, map[int]time.Time, error)
 	findCheckContainersMutex       sync.RWMutex
 	findCheckContainersArgsForCall []struct {
 		arg1 lager.Logger
-		arg2 string
+		arg2 atc.PipelineRef
 		arg3 string
 		arg4 creds.Secrets
 		arg5 creds.VarSourcePool
@@ -171,6 +171,18 @@ type FakeTeam struct {
 		result2 bool
 		result3 error
 	}
+	FindKeptContainersStub        func() ([]db.CreatedContainer, error)
+	findKeptContainersMutex       sync.RWMutex
+	findKeptContainersArgsForCall []struct {
+	}
+	findKeptContainersReturns struct {
+		result1 []db.CreatedContainer
+		result2 error
+	}
+	findKeptContainersReturnsOnCall map[int]struct {
+		result1 []db.CreatedContainer
+		result2 error
+	}
 	FindVolumeForWorkerArtifactStub        func(int) (db.CreatedVolume, bool, error)
 	findVolumeForWorkerArtifactMutex       sync.RWMutex
 	findVolumeForWorkerArtifactArgsForCall []struct {
@@ -274,10 +286,10 @@ type FakeTeam struct {
 	orderPipelinesReturnsOnCall map[int]struct {
 		result1 error
 	}
-	PipelineStub        func(string) (db.Pipeline, bool, error)
+	PipelineStub        func(atc.PipelineRef) (db.Pipeline, bool, error)
 	pipelineMutex       sync.RWMutex
 	pipelineArgsForCall []struct {
-		arg1 string
+		arg1 atc.PipelineRef
 	}
 	pipelineReturns struct {
 		result1 db.Pipeline
@@ -339,10 +351,10 @@ type FakeTeam struct {
 	renameReturnsOnCall map[int]struct {
 		result1 error
 	}
-	SavePipelineStub        func(string, atc.Config, db.ConfigVersion, bool) (db.Pipeline, bool, error)
+	SavePipelineStub        func(atc.PipelineRef, atc.Config, db.ConfigVersion, bool) (db.Pipeline, bool, error)
 	savePipelineMutex       sync.RWMutex
 	savePipelineArgsForCall []struct {
-		arg1 string
+		arg1 atc.PipelineRef
 		arg2 atc.Config
 		arg3 db.ConfigVersion
 		arg4 bool
@@ -859,12 +871,12 @@ func (fake *FakeTeam) DeleteReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
-func (fake *FakeTeam) FindCheckContainers(arg1 lager.Logger, arg2 string, arg3 string, arg4 creds.Secrets, arg5 creds.VarSourcePool) ([]db.Container, map[int]time.Time, error) {
+func (fake *FakeTeam) FindCheckContainers(arg1 lager.Logger, arg2 atc.PipelineRef, arg3 string, arg4 creds.Secrets, arg5 creds.VarSourcePool) ([]db.Container, map[int]time.Time, error) {
 	fake.findCheckContainersMutex.Lock()
 	ret, specificReturn := fake.findCheckContainersReturnsOnCall[len(fake.findCheckContainersArgsForCall)]
 	fake.findCheckContainersArgsForCall = append(fake.findCheckContainersArgsForCall, struct {
 		arg1 lager.Logger
-		arg2 string
+		arg2 atc.PipelineRef
 		arg3 string
 		arg4 creds.Secrets
 		arg5 creds.VarSourcePool
@@ -887,13 +899,13 @@ func (fake *FakeTeam) FindCheckContainersCallCount() int {
 	return len(fake.findCheckContainersArgsForCall)
 }
 
-func (fake *FakeTeam) FindCheckContainersCalls(stub func(lager.Logger, string, string, creds.Secrets, creds.VarSourcePool) ([]db.Container, map[int]time.Time, error)) {
+func (fake *FakeTeam) FindCheckContainersCalls(stub func(lager.Logger, atc.PipelineRef, string, creds.Secrets, creds.VarSourcePool) ([]db.Container, map[int]time.Time, error)) {
 	fake.findCheckContainersMutex.Lock()
 	defer fake.findCheckContainersMutex.Unlock()
 	fake.FindCheckContainersStub = stub
 }
 
-func (fake *FakeTeam) FindCheckContainersArgsForCall(i int) (lager.Logger, string, string, creds.Secrets, creds.VarSourcePool) {
+func (fake *FakeTeam) FindCheckContainersArgsForCall(i int) (lager.Logger, atc.PipelineRef, string, creds.Secrets, creds.VarSourcePool) {
 	fake.findCheckContainersMutex.RLock()
 	defer fake.findCheckContainersMutex.RUnlock()
 	argsForCall := fake.findCheckContainersArgsForCall[i]
@@ -1124,6 +1136,61 @@ func (fake *FakeTeam) FindCreatedContainerByHandleReturnsOnCall(i int, result1 d
 	}{result1, result2, result3}
 }
 
+func (fake *FakeTeam) FindKeptContainers() ([]db.CreatedContainer, error) {
+	fake.findKeptContainersMutex.Lock()
+	ret, specificReturn := fake.findKeptContainersReturnsOnCall[len(fake.findKeptContainersArgsForCall)]
+	fake.findKeptContainersArgsForCall = append(fake.findKeptContainersArgsForCall, struct {
+	}{})
+	fake.recordInvocation("FindKeptContainers", []interface{}{})
+	fake.findKeptContainersMutex.Unlock()
+	if fake.FindKeptContainersStub != nil {
+		return fake.FindKeptContainersStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.findKeptContainersReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTeam) FindKeptContainersCallCount() int {
+	fake.findKeptContainersMutex.RLock()
+	defer fake.findKeptContainersMutex.RUnlock()
+	return len(fake.findKeptContainersArgsForCall)
+}
+
+func (fake *FakeTeam) FindKeptContainersCalls(stub func() ([]db.CreatedContainer, error)) {
+	fake.findKeptContainersMutex.Lock()
+	defer fake.findKeptContainersMutex.Unlock()
+	fake.FindKeptContainersStub = stub
+}
+
+func (fake *FakeTeam) FindKeptContainersReturns(result1 []db.CreatedContainer, result2 error) {
+	fake.findKeptContainersMutex.Lock()
+	defer fake.findKeptContainersMutex.Unlock()
+	fake.FindKeptContainersStub = nil
+	fake.findKeptContainersReturns = struct {
+		result1 []db.CreatedContainer
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) FindKeptContainersReturnsOnCall(i int, result1 []db.CreatedContainer, result2 error) {
+	fake.findKeptContainersMutex.Lock()
+	defer fake.findKeptContainersMutex.Unlock()
+	fake.FindKeptContainersStub = nil
+	if fake.findKeptContainersReturnsOnCall == nil {
+		fake.findKeptContainersReturnsOnCall = make(map[int]struct {
+			result1 []db.CreatedContainer
+			result2 error
+		})
+	}
+	fake.findKeptContainersReturnsOnCall[i] = struct {
+		result1 []db.CreatedContainer
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeTeam) FindVolumeForWorkerArtifact(arg1 int) (db.CreatedVolume, bool, error) {
 	fake.findVolumeForWorkerArtifactMutex.Lock()
 	ret, specificReturn := fake.findVolumeForWorkerArtifactReturnsOnCall[len(fake.findVolumeForWorkerArtifactArgsForCall)]
@@ -1618,11 +1685,11 @@ func (fake *FakeTeam) OrderPipelinesReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
-func (fake *FakeTeam) Pipeline(arg1 string) (db.Pipeline, bool, error) {
+func (fake *FakeTeam) Pipeline(arg1 atc.PipelineRef) (db.Pipeline, bool, error) {
 	fake.pipelineMutex.Lock()
 	ret, specificReturn := fake.pipelineReturnsOnCall[len(fake.pipelineArgsForCall)]
 	fake.pipelineArgsForCall = append(fake.pipelineArgsForCall, struct {
-		arg1 string
+		arg1 atc.PipelineRef
 	}{arg1})
 	fake.recordInvocation("Pipeline", []interface{}{arg1})
 	fake.pipelineMutex.Unlock()
@@ -1642,13 +1709,13 @@ func (fake *FakeTeam) PipelineCallCount() int {
 	return len(fake.pipelineArgsForCall)
 }
 
-func (fake *FakeTeam) PipelineCalls(stub func(string) (db.Pipeline, bool, error)) {
+func (fake *FakeTeam) PipelineCalls(stub func(atc.PipelineRef) (db.Pipeline, bool, error)) {
 	fake.pipelineMutex.Lock()
 	defer fake.pipelineMutex.Unlock()
 	fake.PipelineStub = stub
 }
 
-func (fake *FakeTeam) PipelineArgsForCall(i int) string {
+func (fake *FakeTeam) PipelineArgsForCall(i int) atc.PipelineRef {
 	fake.pipelineMutex.RLock()
 	defer fake.pipelineMutex.RUnlock()
 	argsForCall := fake.pipelineArgsForCall[i]
@@ -1920,11 +1987,11 @@ func (fake *FakeTeam) RenameReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
-func (fake *FakeTeam) SavePipeline(arg1 string, arg2 atc.Config, arg3 db.ConfigVersion, arg4 bool) (db.Pipeline, bool, error) {
+func (fake *FakeTeam) SavePipeline(arg1 atc.PipelineRef, arg2 atc.Config, arg3 db.ConfigVersion, arg4 bool) (db.Pipeline, bool, error) {
 	fake.savePipelineMutex.Lock()
 	ret, specificReturn := fake.savePipelineReturnsOnCall[len(fake.savePipelineArgsForCall)]
 	fake.savePipelineArgsForCall = append(fake.savePipelineArgsForCall, struct {
-		arg1 string
+		arg1 atc.PipelineRef
 		arg2 atc.Config
 		arg3 db.ConfigVersion
 		arg4 bool
@@ -1947,13 +2014,13 @@ func (fake *FakeTeam) SavePipelineCallCount() int {
 	return len(fake.savePipelineArgsForCall)
 }
 
-func (fake *FakeTeam) SavePipelineCalls(stub func(string, atc.Config, db.ConfigVersion, bool) (db.Pipeline, bool, error)) {
+func (fake *FakeTeam) SavePipelineCalls(stub func(atc.PipelineRef, atc.Config, db.ConfigVersion, bool) (db.Pipeline, bool, error)) {
 	fake.savePipelineMutex.Lock()
 	defer fake.savePipelineMutex.Unlock()
 	fake.SavePipelineStub = stub
 }
 
-func (fake *FakeTeam) SavePipelineArgsForCall(i int) (string, atc.Config, db.ConfigVersion, bool) {
+func (fake *FakeTeam) SavePipelineArgsForCall(i int) (atc.PipelineRef, atc.Config, db.ConfigVersion, bool) {
 	fake.savePipelineMutex.RLock()
 	defer fake.savePipelineMutex.RUnlock()
 	argsForCall := fake.savePipelineArgsForCall[i]
@@ -2195,6 +2262,8 @@ func (fake *FakeTeam) Invocations() map[string][][]interface{} {
 	defer fake.findContainersByMetadataMutex.RUnlock()
 	fake.findCreatedContainerByHandleMutex.RLock()
 	defer fake.findCreatedContainerByHandleMutex.RUnlock()
+	fake.findKeptContainersMutex.RLock()
+	defer fake.findKeptContainersMutex.RUnlock()
 	fake.findVolumeForWorkerArtifactMutex.RLock()
 	defer fake.findVolumeForWorkerArtifactMutex.RUnlock()
 	fake.findWorkerForContainerMutex.RLock()
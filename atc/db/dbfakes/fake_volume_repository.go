@@ -204,6 +204,20 @@ type FakeVolumeRepository struct {
 		result2 bool
 		result3 error
 	}
+	FindTaskCacheVolumesStub        func(int, db.UsedTaskCache) ([]db.CreatedVolume, error)
+	findTaskCacheVolumesMutex       sync.RWMutex
+	findTaskCacheVolumesArgsForCall []struct {
+		arg1 int
+		arg2 db.UsedTaskCache
+	}
+	findTaskCacheVolumesReturns struct {
+		result1 []db.CreatedVolume
+		result2 error
+	}
+	findTaskCacheVolumesReturnsOnCall map[int]struct {
+		result1 []db.CreatedVolume
+		result2 error
+	}
 	FindVolumesForContainerStub        func(db.CreatedContainer) ([]db.CreatedVolume, error)
 	findVolumesForContainerMutex       sync.RWMutex
 	findVolumesForContainerArgsForCall []struct {
@@ -1147,6 +1161,70 @@ func (fake *FakeVolumeRepository) FindTaskCacheVolumeReturnsOnCall(i int, result
 	}{result1, result2, result3}
 }
 
+func (fake *FakeVolumeRepository) FindTaskCacheVolumes(arg1 int, arg2 db.UsedTaskCache) ([]db.CreatedVolume, error) {
+	fake.findTaskCacheVolumesMutex.Lock()
+	ret, specificReturn := fake.findTaskCacheVolumesReturnsOnCall[len(fake.findTaskCacheVolumesArgsForCall)]
+	fake.findTaskCacheVolumesArgsForCall = append(fake.findTaskCacheVolumesArgsForCall, struct {
+		arg1 int
+		arg2 db.UsedTaskCache
+	}{arg1, arg2})
+	fake.recordInvocation("FindTaskCacheVolumes", []interface{}{arg1, arg2})
+	fake.findTaskCacheVolumesMutex.Unlock()
+	if fake.FindTaskCacheVolumesStub != nil {
+		return fake.FindTaskCacheVolumesStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.findTaskCacheVolumesReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeVolumeRepository) FindTaskCacheVolumesCallCount() int {
+	fake.findTaskCacheVolumesMutex.RLock()
+	defer fake.findTaskCacheVolumesMutex.RUnlock()
+	return len(fake.findTaskCacheVolumesArgsForCall)
+}
+
+func (fake *FakeVolumeRepository) FindTaskCacheVolumesCalls(stub func(int, db.UsedTaskCache) ([]db.CreatedVolume, error)) {
+	fake.findTaskCacheVolumesMutex.Lock()
+	defer fake.findTaskCacheVolumesMutex.Unlock()
+	fake.FindTaskCacheVolumesStub = stub
+}
+
+func (fake *FakeVolumeRepository) FindTaskCacheVolumesArgsForCall(i int) (int, db.UsedTaskCache) {
+	fake.findTaskCacheVolumesMutex.RLock()
+	defer fake.findTaskCacheVolumesMutex.RUnlock()
+	argsForCall := fake.findTaskCacheVolumesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeVolumeRepository) FindTaskCacheVolumesReturns(result1 []db.CreatedVolume, result2 error) {
+	fake.findTaskCacheVolumesMutex.Lock()
+	defer fake.findTaskCacheVolumesMutex.Unlock()
+	fake.FindTaskCacheVolumesStub = nil
+	fake.findTaskCacheVolumesReturns = struct {
+		result1 []db.CreatedVolume
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeVolumeRepository) FindTaskCacheVolumesReturnsOnCall(i int, result1 []db.CreatedVolume, result2 error) {
+	fake.findTaskCacheVolumesMutex.Lock()
+	defer fake.findTaskCacheVolumesMutex.Unlock()
+	fake.FindTaskCacheVolumesStub = nil
+	if fake.findTaskCacheVolumesReturnsOnCall == nil {
+		fake.findTaskCacheVolumesReturnsOnCall = make(map[int]struct {
+			result1 []db.CreatedVolume
+			result2 error
+		})
+	}
+	fake.findTaskCacheVolumesReturnsOnCall[i] = struct {
+		result1 []db.CreatedVolume
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeVolumeRepository) FindVolumesForContainer(arg1 db.CreatedContainer) ([]db.CreatedVolume, error) {
 	fake.findVolumesForContainerMutex.Lock()
 	ret, specificReturn := fake.findVolumesForContainerReturnsOnCall[len(fake.findVolumesForContainerArgsForCall)]
@@ -1618,6 +1696,8 @@ func (fake *FakeVolumeRepository) Invocations() map[string][][]interface{} {
 	defer fake.findResourceCertsVolumeMutex.RUnlock()
 	fake.findTaskCacheVolumeMutex.RLock()
 	defer fake.findTaskCacheVolumeMutex.RUnlock()
+	fake.findTaskCacheVolumesMutex.RLock()
+	defer fake.findTaskCacheVolumesMutex.RUnlock()
 	fake.findVolumesForContainerMutex.RLock()
 	defer fake.findVolumesForContainerMutex.RUnlock()
 	fake.getDestroyingVolumesMutex.RLock()
@@ -39,6 +39,8 @@ type Job interface {
 	ScheduleRequestedTime() time.Time
 	MaxInFlight() int
 	DisableManualTrigger() bool
+	Schedule() *atc.ScheduleConfig
+	NextScheduledTrigger() time.Time
 
 	Config() (atc.JobConfig, error)
 	Inputs() ([]atc.JobInput, error)
@@ -62,6 +64,8 @@ type Job interface {
 	FinishedAndNextBuild() (Build, Build, error)
 	UpdateFirstLoggedBuildID(newFirstLoggedBuildID int) error
 	EnsurePendingBuildExists() error
+	EnsureScheduledBuildExists(next time.Time) error
+	UpdateNextScheduledTrigger(time.Time) error
 	GetPendingBuilds() ([]Build, error)
 
 	GetNextBuildInputs() ([]BuildInput, error)
@@ -76,7 +80,7 @@ type Job interface {
 	HasNewInputs() bool
 }
 
-var jobsQuery = psql.Select("j.id", "j.name", "j.config", "j.paused", "j.public", "j.first_logged_build_id", "j.pipeline_id", "p.name", "p.team_id", "t.name", "j.nonce", "j.tags", "j.has_new_inputs", "j.schedule_requested", "j.max_in_flight", "j.disable_manual_trigger").
+var jobsQuery = psql.Select("j.id", "j.name", "j.config", "j.paused", "j.public", "j.first_logged_build_id", "j.pipeline_id", "p.name", "p.team_id", "t.name", "j.nonce", "j.tags", "j.has_new_inputs", "j.schedule_requested", "j.max_in_flight", "j.disable_manual_trigger", "j.schedule", "j.next_scheduled_trigger").
 	From("jobs j, pipelines p").
 	LeftJoin("teams t ON p.team_id = t.id").
 	Where(sq.Expr("j.pipeline_id = p.id"))
@@ -106,6 +110,8 @@ type job struct {
 	scheduleRequestedTime time.Time
 	maxInFlight           int
 	disableManualTrigger  bool
+	schedule              *atc.ScheduleConfig
+	nextScheduledTrigger  time.Time
 
 	config    *atc.JobConfig
 	rawConfig []byte
@@ -167,6 +173,8 @@ func (j *job) HasNewInputs() bool               { return j.hasNewInputs }
 func (j *job) ScheduleRequestedTime() time.Time { return j.scheduleRequestedTime }
 func (j *job) MaxInFlight() int                 { return j.maxInFlight }
 func (j *job) DisableManualTrigger() bool       { return j.disableManualTrigger }
+func (j *job) Schedule() *atc.ScheduleConfig    { return j.schedule }
+func (j *job) NextScheduledTrigger() time.Time  { return j.nextScheduledTrigger }
 
 func (j *job) Config() (atc.JobConfig, error) {
 	if j.config != nil {
@@ -556,18 +564,70 @@ func (j *job) EnsurePendingBuildExists() error {
 
 	defer Rollback(tx)
 
+	err = j.ensurePendingBuildExists(tx, sql.NullString{})
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// EnsureScheduledBuildExists creates a pending build triggered by the job's
+// schedule, unless a pending build already exists, and records when the
+// schedule is next due.
+func (j *job) EnsureScheduledBuildExists(next time.Time) error {
+	tx, err := j.conn.Begin()
+	if err != nil {
+		return err
+	}
+
+	defer Rollback(tx)
+
+	err = j.ensurePendingBuildExists(tx, sql.NullString{
+		String: string(BuildTriggerReasonSchedule),
+		Valid:  true,
+	})
+	if err != nil {
+		return err
+	}
+
+	err = updateNextScheduledTrigger(tx, j.id, next)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (j *job) UpdateNextScheduledTrigger(next time.Time) error {
+	tx, err := j.conn.Begin()
+	if err != nil {
+		return err
+	}
+
+	defer Rollback(tx)
+
+	err = updateNextScheduledTrigger(tx, j.id, next)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (j *job) ensurePendingBuildExists(tx Tx, triggerReason sql.NullString) error {
 	buildName, err := j.getNewBuildName(tx)
 	if err != nil {
 		return err
 	}
 
 	rows, err := tx.Query(`
-		INSERT INTO builds (name, job_id, pipeline_id, team_id, status, needs_v6_migration)
-		SELECT $1, $2, $3, $4, 'pending', false
+		INSERT INTO builds (name, job_id, pipeline_id, team_id, status, needs_v6_migration, trigger_reason)
+		SELECT $1, $2, $3, $4, 'pending', false, $5
 		WHERE NOT EXISTS
 			(SELECT id FROM builds WHERE job_id = $2 AND status = 'pending')
 		RETURNING id
-	`, buildName, j.id, j.pipelineID, j.teamID)
+	`, buildName, j.id, j.pipelineID, j.teamID, triggerReason)
 	if err != nil {
 		return err
 	}
@@ -600,8 +660,6 @@ func (j *job) EnsurePendingBuildExists() error {
 		if err != nil {
 			return err
 		}
-
-		return tx.Commit()
 	}
 
 	return nil
@@ -670,6 +728,7 @@ func (j *job) CreateBuild() (Build, error) {
 		"team_id":            j.teamID,
 		"status":             BuildStatusPending,
 		"manually_triggered": true,
+		"trigger_reason":     BuildTriggerReasonManual,
 	})
 	if err != nil {
 		return nil, err
@@ -965,8 +1024,15 @@ func (j *job) getNextPendingBuildBySerialGroup(tx Tx, serialGroups []string) (Bu
 }
 
 func (j *job) updatePausedJob(pause bool) error {
-	result, err := psql.Update("jobs").
-		Set("paused", pause).
+	query := psql.Update("jobs").
+		Set("paused", pause)
+
+	if !pause {
+		// skip any scheduled triggers that were missed while paused
+		query = query.Set("next_scheduled_trigger", nil)
+	}
+
+	result, err := query.
 		Where(sq.Eq{"id": j.id}).
 		RunWith(j.conn).
 		Exec()
@@ -1222,14 +1288,29 @@ func (j *job) getNextBuildInputs(tx Tx) ([]BuildInput, error) {
 
 func scanJob(j *job, row scannable) error {
 	var (
-		nonce sql.NullString
+		nonce                sql.NullString
+		schedule             sql.NullString
+		nextScheduledTrigger pq.NullTime
 	)
 
-	err := row.Scan(&j.id, &j.name, &j.rawConfig, &j.paused, &j.public, &j.firstLoggedBuildID, &j.pipelineID, &j.pipelineName, &j.teamID, &j.teamName, &nonce, pq.Array(&j.tags), &j.hasNewInputs, &j.scheduleRequestedTime, &j.maxInFlight, &j.disableManualTrigger)
+	err := row.Scan(&j.id, &j.name, &j.rawConfig, &j.paused, &j.public, &j.firstLoggedBuildID, &j.pipelineID, &j.pipelineName, &j.teamID, &j.teamName, &nonce, pq.Array(&j.tags), &j.hasNewInputs, &j.scheduleRequestedTime, &j.maxInFlight, &j.disableManualTrigger, &schedule, &nextScheduledTrigger)
 	if err != nil {
 		return err
 	}
 
+	j.schedule = nil
+	if schedule.Valid {
+		var config atc.ScheduleConfig
+		err = json.Unmarshal([]byte(schedule.String), &config)
+		if err != nil {
+			return err
+		}
+
+		j.schedule = &config
+	}
+
+	j.nextScheduledTrigger = nextScheduledTrigger.Time
+
 	if nonce.Valid {
 		j.nonce = &nonce.String
 	}
@@ -1279,6 +1360,22 @@ func requestSchedule(tx Tx, jobID int) error {
 	return nil
 }
 
+func updateNextScheduledTrigger(tx Tx, jobID int, next time.Time) error {
+	var nextTrigger pq.NullTime
+	if !next.IsZero() {
+		nextTrigger = pq.NullTime{Time: next, Valid: true}
+	}
+
+	_, err := psql.Update("jobs").
+		Set("next_scheduled_trigger", nextTrigger).
+		Where(sq.Eq{
+			"id": jobID,
+		}).
+		RunWith(tx).
+		Exec()
+	return err
+}
+
 // The SELECT query orders the jobs for updating to prevent deadlocking.
 // Updating multiple rows using a SELECT subquery does not preserve the same
 // order for the updates, which can lead to deadlocking.
@@ -80,7 +80,10 @@ func (j *jobFactory) AllActiveJobs() (atc.Dashboard, error) {
 
 func (j *jobFactory) JobsToSchedule() (Jobs, error) {
 	rows, err := jobsQuery.
-		Where(sq.Expr("j.schedule_requested > j.last_scheduled")).
+		Where(sq.Or{
+			sq.Expr("j.schedule_requested > j.last_scheduled"),
+			sq.Expr("j.next_scheduled_trigger <= now()"),
+		}).
 		Where(sq.Eq{
 			"j.active": true,
 			"j.paused": false,
@@ -1,6 +1,8 @@
 package db_test
 
 import (
+	"time"
+
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/db"
 	. "github.com/onsi/ginkgo"
@@ -21,7 +23,7 @@ var _ = Describe("Job Factory", func() {
 			otherTeam, err := teamFactory.CreateTeam(atc.Team{Name: "other-team"})
 			Expect(err).NotTo(HaveOccurred())
 
-			publicPipeline, _, err = otherTeam.SavePipeline("public-pipeline", atc.Config{
+			publicPipeline, _, err = otherTeam.SavePipeline(atc.PipelineRef{Name: "public-pipeline"}, atc.Config{
 				Jobs: atc.JobConfigs{
 					{
 						Name: "public-pipeline-job-1",
@@ -85,7 +87,7 @@ var _ = Describe("Job Factory", func() {
 			Expect(err).ToNot(HaveOccurred())
 			Expect(publicPipeline.Expose()).To(Succeed())
 
-			_, _, err = otherTeam.SavePipeline("private-pipeline", atc.Config{
+			_, _, err = otherTeam.SavePipeline(atc.PipelineRef{Name: "private-pipeline"}, atc.Config{
 				Jobs: atc.JobConfigs{
 					{
 						Name: "private-pipeline-job",
@@ -331,7 +333,7 @@ var _ = Describe("Job Factory", func() {
 
 		Context("when the job has a requested schedule time later than the last scheduled", func() {
 			BeforeEach(func() {
-				pipeline1, _, err := defaultTeam.SavePipeline("fake-pipeline", atc.Config{
+				pipeline1, _, err := defaultTeam.SavePipeline(atc.PipelineRef{Name: "fake-pipeline"}, atc.Config{
 					Jobs: atc.JobConfigs{
 						{Name: "job-name"},
 					},
@@ -357,7 +359,7 @@ var _ = Describe("Job Factory", func() {
 
 		Context("when the job has a requested schedule time earlier than the last scheduled", func() {
 			BeforeEach(func() {
-				pipeline1, _, err := defaultTeam.SavePipeline("fake-pipeline", atc.Config{
+				pipeline1, _, err := defaultTeam.SavePipeline(atc.PipelineRef{Name: "fake-pipeline"}, atc.Config{
 					Jobs: atc.JobConfigs{
 						{Name: "job-name"},
 					},
@@ -380,9 +382,58 @@ var _ = Describe("Job Factory", func() {
 			})
 		})
 
+		Context("when the job's next scheduled trigger is due", func() {
+			BeforeEach(func() {
+				pipeline1, _, err := defaultTeam.SavePipeline(atc.PipelineRef{Name: "fake-pipeline"}, atc.Config{
+					Jobs: atc.JobConfigs{
+						{
+							Name:     "job-name",
+							Schedule: &atc.ScheduleConfig{Cron: "0 2 * * *"},
+						},
+					},
+				}, db.ConfigVersion(1), false)
+				Expect(err).ToNot(HaveOccurred())
+
+				var found bool
+				job1, found, err = pipeline1.Job("job-name")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeTrue())
+
+				_, err = dbConn.Exec("UPDATE jobs SET last_scheduled = now() WHERE id = $1;", job1.ID())
+				Expect(err).ToNot(HaveOccurred())
+
+				err = job1.UpdateNextScheduledTrigger(time.Now().Add(-time.Minute))
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("fetches that job", func() {
+				jobs, err := jobFactory.JobsToSchedule()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(len(jobs)).To(Equal(1))
+				Expect(jobs[0].Name()).To(Equal(job1.Name()))
+			})
+
+			Context("when the pipeline is paused", func() {
+				BeforeEach(func() {
+					pipeline, found, err := job1.Pipeline()
+					Expect(err).ToNot(HaveOccurred())
+					Expect(found).To(BeTrue())
+
+					err = pipeline.Pause()
+					Expect(err).ToNot(HaveOccurred())
+				})
+
+				It("does not fetch that job", func() {
+					jobs, err := jobFactory.JobsToSchedule()
+					Expect(err).ToNot(HaveOccurred())
+					Expect(len(jobs)).To(Equal(0))
+				})
+			})
+		})
+
 		Context("when the job has a requested schedule time is the same as the last scheduled", func() {
 			BeforeEach(func() {
-				pipeline1, _, err := defaultTeam.SavePipeline("fake-pipeline", atc.Config{
+				pipeline1, _, err := defaultTeam.SavePipeline(atc.PipelineRef{Name: "fake-pipeline"}, atc.Config{
 					Jobs: atc.JobConfigs{
 						{Name: "job-name"},
 					},
@@ -414,7 +465,7 @@ var _ = Describe("Job Factory", func() {
 
 		Context("when there are multiple jobs with different times", func() {
 			BeforeEach(func() {
-				pipeline1, _, err := defaultTeam.SavePipeline("fake-pipeline", atc.Config{
+				pipeline1, _, err := defaultTeam.SavePipeline(atc.PipelineRef{Name: "fake-pipeline"}, atc.Config{
 					Jobs: atc.JobConfigs{
 						{Name: "job-name"},
 					},
@@ -432,7 +483,7 @@ var _ = Describe("Job Factory", func() {
 				team, err := teamFactory.CreateTeam(atc.Team{Name: "some-team"})
 				Expect(err).ToNot(HaveOccurred())
 
-				pipeline2, _, err := team.SavePipeline("fake-pipeline-two", atc.Config{
+				pipeline2, _, err := team.SavePipeline(atc.PipelineRef{Name: "fake-pipeline-two"}, atc.Config{
 					Jobs: atc.JobConfigs{
 						{Name: "job-fake"},
 					},
@@ -443,7 +494,7 @@ var _ = Describe("Job Factory", func() {
 				Expect(err).ToNot(HaveOccurred())
 				Expect(found).To(BeTrue())
 
-				pipeline3, _, err := team.SavePipeline("fake-pipeline-three", atc.Config{
+				pipeline3, _, err := team.SavePipeline(atc.PipelineRef{Name: "fake-pipeline-three"}, atc.Config{
 					Jobs: atc.JobConfigs{
 						{Name: "job-fake-two"},
 					},
@@ -472,7 +523,7 @@ var _ = Describe("Job Factory", func() {
 
 		Context("when the job is paused but has a later schedule requested time", func() {
 			BeforeEach(func() {
-				pipeline1, _, err := defaultTeam.SavePipeline("fake-pipeline", atc.Config{
+				pipeline1, _, err := defaultTeam.SavePipeline(atc.PipelineRef{Name: "fake-pipeline"}, atc.Config{
 					Jobs: atc.JobConfigs{
 						{Name: "job-name"},
 					},
@@ -500,7 +551,7 @@ var _ = Describe("Job Factory", func() {
 
 		Context("when the job is inactive but has a later schedule requested time", func() {
 			BeforeEach(func() {
-				pipeline1, _, err := defaultTeam.SavePipeline("fake-pipeline", atc.Config{
+				pipeline1, _, err := defaultTeam.SavePipeline(atc.PipelineRef{Name: "fake-pipeline"}, atc.Config{
 					Jobs: atc.JobConfigs{
 						{Name: "job-name"},
 					},
@@ -515,7 +566,7 @@ var _ = Describe("Job Factory", func() {
 				err = job1.RequestSchedule()
 				Expect(err).ToNot(HaveOccurred())
 
-				_, _, err = defaultTeam.SavePipeline("fake-pipeline", atc.Config{}, pipeline1.ConfigVersion(), false)
+				_, _, err = defaultTeam.SavePipeline(atc.PipelineRef{Name: "fake-pipeline"}, atc.Config{}, pipeline1.ConfigVersion(), false)
 				Expect(err).ToNot(HaveOccurred())
 			})
 
@@ -528,7 +579,7 @@ var _ = Describe("Job Factory", func() {
 
 		Context("when the pipeline is paused but it's job has a later schedule requested time", func() {
 			BeforeEach(func() {
-				pipeline1, _, err := defaultTeam.SavePipeline("fake-pipeline", atc.Config{
+				pipeline1, _, err := defaultTeam.SavePipeline(atc.PipelineRef{Name: "fake-pipeline"}, atc.Config{
 					Jobs: atc.JobConfigs{
 						{Name: "job-name"},
 					},
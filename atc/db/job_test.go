@@ -23,7 +23,7 @@ var _ = Describe("Job", func() {
 		Expect(err).ToNot(HaveOccurred())
 
 		var created bool
-		pipeline, created, err = team.SavePipeline("fake-pipeline", atc.Config{
+		pipeline, created, err = team.SavePipeline(atc.PipelineRef{Name: "fake-pipeline"}, atc.Config{
 			Jobs: atc.JobConfigs{
 				{
 					Name: "some-job",
@@ -179,7 +179,7 @@ var _ = Describe("Job", func() {
 		BeforeEach(func() {
 			var created bool
 			var err error
-			otherPipeline, created, err = team.SavePipeline("other-pipeline", atc.Config{
+			otherPipeline, created, err = team.SavePipeline(atc.PipelineRef{Name: "other-pipeline"}, atc.Config{
 				Jobs: atc.JobConfigs{
 					{Name: "some-job"},
 				},
@@ -409,7 +409,7 @@ var _ = Describe("Job", func() {
 					},
 				},
 			}
-			pipeline, _, err = team.SavePipeline("some-pipeline", config, db.ConfigVersion(1), false)
+			pipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "some-pipeline"}, config, db.ConfigVersion(1), false)
 			Expect(err).ToNot(HaveOccurred())
 
 			job, found, err = pipeline.Job("some-job")
@@ -646,7 +646,7 @@ var _ = Describe("Job", func() {
 		saveMaxInFlightPipeline := func() {
 			BeforeEach(func() {
 				var err error
-				pipeline, _, err = team.SavePipeline("fake-pipeline", atc.Config{
+				pipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "fake-pipeline"}, atc.Config{
 					Jobs: atc.JobConfigs{
 						{
 							Name: "some-job",
@@ -719,7 +719,7 @@ var _ = Describe("Job", func() {
 		saveSerialGroupsPipeline := func() {
 			BeforeEach(func() {
 				var err error
-				pipeline, _, err = team.SavePipeline("fake-pipeline", atc.Config{
+				pipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "fake-pipeline"}, atc.Config{
 					Jobs: atc.JobConfigs{
 						{
 							Name: "some-job",
@@ -817,7 +817,7 @@ var _ = Describe("Job", func() {
 
 			Context("when the job config doesn't specify max in flight", func() {
 				BeforeEach(func() {
-					pipeline, created, err := team.SavePipeline("other-pipeline", atc.Config{
+					pipeline, created, err := team.SavePipeline(atc.PipelineRef{Name: "other-pipeline"}, atc.Config{
 						Jobs: atc.JobConfigs{
 							{
 								Name: "some-job",
@@ -1222,7 +1222,7 @@ var _ = Describe("Job", func() {
 			Expect(setupTx.Commit()).To(Succeed())
 
 			var created bool
-			pipeline, created, err = team.SavePipeline("build-inputs-pipeline", atc.Config{
+			pipeline, created, err = team.SavePipeline(atc.PipelineRef{Name: "build-inputs-pipeline"}, atc.Config{
 				Jobs: atc.JobConfigs{
 					{
 						Name: "some-job",
@@ -1453,7 +1453,7 @@ var _ = Describe("Job", func() {
 				},
 			}
 
-			pipeline2, _, err = team.SavePipeline("some-pipeline-2", config, 1, false)
+			pipeline2, _, err = team.SavePipeline(atc.PipelineRef{Name: "some-pipeline-2"}, config, 1, false)
 			Expect(err).ToNot(HaveOccurred())
 
 			resource2, found, err = pipeline2.Resource("some-resource")
@@ -1636,7 +1636,7 @@ var _ = Describe("Job", func() {
 				},
 			}
 			var err error
-			otherPipeline, _, err = team.SavePipeline("some-other-pipeline", pipelineConfig, db.ConfigVersion(1), false)
+			otherPipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "some-other-pipeline"}, pipelineConfig, db.ConfigVersion(1), false)
 			Expect(err).ToNot(HaveOccurred())
 
 			build1DB, err = job.CreateBuild()
@@ -1911,6 +1911,81 @@ var _ = Describe("Job", func() {
 		})
 	})
 
+	Describe("EnsureScheduledBuildExists", func() {
+		var next time.Time
+
+		BeforeEach(func() {
+			next = time.Now().Add(time.Hour).Truncate(time.Second)
+		})
+
+		It("creates a build triggered by the schedule", func() {
+			err := job.EnsureScheduledBuildExists(next)
+			Expect(err).NotTo(HaveOccurred())
+
+			pendingBuilds, err := job.GetPendingBuilds()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(pendingBuilds).To(HaveLen(1))
+			Expect(pendingBuilds[0].TriggerReason()).To(Equal(db.BuildTriggerReasonSchedule))
+			Expect(pendingBuilds[0].IsManuallyTriggered()).To(BeFalse())
+		})
+
+		It("saves the next scheduled trigger", func() {
+			err := job.EnsureScheduledBuildExists(next)
+			Expect(err).NotTo(HaveOccurred())
+
+			found, err := job.Reload()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(job.NextScheduledTrigger()).To(BeTemporally("==", next))
+		})
+
+		Context("when a pending build already exists", func() {
+			BeforeEach(func() {
+				err := job.EnsurePendingBuildExists()
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("does not create another build", func() {
+				err := job.EnsureScheduledBuildExists(next)
+				Expect(err).NotTo(HaveOccurred())
+
+				pendingBuilds, err := job.GetPendingBuilds()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(pendingBuilds).To(HaveLen(1))
+			})
+
+			It("still saves the next scheduled trigger", func() {
+				err := job.EnsureScheduledBuildExists(next)
+				Expect(err).NotTo(HaveOccurred())
+
+				found, err := job.Reload()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(job.NextScheduledTrigger()).To(BeTemporally("==", next))
+			})
+		})
+
+		Context("when the job is unpaused", func() {
+			BeforeEach(func() {
+				err := job.EnsureScheduledBuildExists(next)
+				Expect(err).NotTo(HaveOccurred())
+
+				err = job.Pause()
+				Expect(err).NotTo(HaveOccurred())
+
+				err = job.Unpause()
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("skips the triggers missed while paused", func() {
+				found, err := job.Reload()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(job.NextScheduledTrigger()).To(BeZero())
+			})
+		})
+	})
+
 	Describe("Clear task cache", func() {
 		Context("when task cache exists", func() {
 			var (
@@ -2078,7 +2153,7 @@ var _ = Describe("Job", func() {
 		var inputsJob db.Job
 
 		BeforeEach(func() {
-			inputsPipeline, _, err := team.SavePipeline("inputs-pipeline", atc.Config{
+			inputsPipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: "inputs-pipeline"}, atc.Config{
 				Jobs: atc.JobConfigs{
 					{
 						Name: "some-job",
@@ -2189,7 +2264,7 @@ var _ = Describe("Job", func() {
 		var outputsJob db.Job
 
 		BeforeEach(func() {
-			outputsPipeline, _, err := team.SavePipeline("outputs-pipeline", atc.Config{
+			outputsPipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: "outputs-pipeline"}, atc.Config{
 				Jobs: atc.JobConfigs{
 					{
 						Name: "some-job",
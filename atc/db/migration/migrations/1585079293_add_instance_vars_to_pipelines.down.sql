@@ -0,0 +1,10 @@
+BEGIN;
+  DELETE FROM pipelines
+    WHERE instance_vars IS NOT NULL;
+
+  DROP INDEX IF EXISTS pipelines_name_team_id_instance_vars_uniq;
+
+  ALTER TABLE pipelines
+    DROP COLUMN IF EXISTS "instance_vars",
+    ADD CONSTRAINT pipelines_name_team_id UNIQUE (name, team_id);
+COMMIT;
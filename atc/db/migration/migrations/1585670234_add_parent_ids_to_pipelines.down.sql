@@ -0,0 +1,7 @@
+BEGIN;
+  DROP INDEX IF EXISTS pipelines_parent_job_id;
+
+  ALTER TABLE pipelines
+    DROP COLUMN "parent_job_id",
+    DROP COLUMN "parent_build_id";
+COMMIT;
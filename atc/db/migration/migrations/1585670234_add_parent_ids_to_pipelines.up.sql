@@ -0,0 +1,7 @@
+BEGIN;
+  ALTER TABLE pipelines
+    ADD COLUMN "parent_job_id" integer REFERENCES jobs (id) ON DELETE SET NULL,
+    ADD COLUMN "parent_build_id" integer REFERENCES builds (id) ON DELETE SET NULL;
+
+  CREATE INDEX pipelines_parent_job_id ON pipelines (parent_job_id);
+COMMIT;
@@ -0,0 +1,8 @@
+BEGIN;
+  ALTER TABLE builds
+    DROP COLUMN "trigger_reason";
+
+  ALTER TABLE jobs
+    DROP COLUMN "schedule",
+    DROP COLUMN "next_scheduled_trigger";
+COMMIT;
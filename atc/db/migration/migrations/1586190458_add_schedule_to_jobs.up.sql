@@ -0,0 +1,8 @@
+BEGIN;
+  ALTER TABLE jobs
+    ADD COLUMN "schedule" text,
+    ADD COLUMN "next_scheduled_trigger" timestamp with time zone;
+
+  ALTER TABLE builds
+    ADD COLUMN "trigger_reason" text;
+COMMIT;
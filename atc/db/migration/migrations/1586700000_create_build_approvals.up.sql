@@ -0,0 +1,13 @@
+BEGIN;
+  CREATE TABLE build_approvals (
+    "build_id" integer NOT NULL REFERENCES builds (id) ON DELETE CASCADE,
+    "plan_id" text NOT NULL,
+    "step_name" text NOT NULL,
+    "role" text NOT NULL,
+    "requested_at" timestamp with time zone NOT NULL DEFAULT now(),
+    "approved" boolean,
+    "approver" text,
+    "decided_at" timestamp with time zone,
+    PRIMARY KEY ("build_id", "plan_id")
+  );
+COMMIT;
@@ -0,0 +1,3 @@
+BEGIN;
+  DROP TABLE build_test_results;
+COMMIT;
@@ -0,0 +1,14 @@
+BEGIN;
+  CREATE TABLE build_test_results (
+    "id" serial PRIMARY KEY,
+    "build_id" integer NOT NULL REFERENCES builds (id) ON DELETE CASCADE,
+    "step" text NOT NULL,
+    "suite" text NOT NULL DEFAULT '',
+    "name" text NOT NULL,
+    "status" text NOT NULL,
+    "duration" double precision NOT NULL DEFAULT 0,
+    "message" text NOT NULL DEFAULT ''
+  );
+
+  CREATE INDEX build_test_results_build_id_idx ON build_test_results (build_id);
+COMMIT;
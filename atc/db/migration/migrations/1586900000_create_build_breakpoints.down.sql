@@ -0,0 +1,5 @@
+BEGIN;
+  ALTER TABLE builds DROP COLUMN "has_breakpoints";
+
+  DROP TABLE build_breakpoints;
+COMMIT;
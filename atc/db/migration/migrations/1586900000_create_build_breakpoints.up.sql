@@ -0,0 +1,12 @@
+BEGIN;
+  CREATE TABLE build_breakpoints (
+    "build_id" integer NOT NULL REFERENCES builds (id) ON DELETE CASCADE,
+    "step" text NOT NULL,
+    "position" text NOT NULL,
+    "plan_id" text,
+    "hit_at" timestamp with time zone,
+    PRIMARY KEY ("build_id", "step", "position")
+  );
+
+  ALTER TABLE builds ADD COLUMN "has_breakpoints" boolean NOT NULL DEFAULT false;
+COMMIT;
@@ -0,0 +1,3 @@
+BEGIN;
+  ALTER TABLE containers DROP COLUMN "keep_until";
+COMMIT;
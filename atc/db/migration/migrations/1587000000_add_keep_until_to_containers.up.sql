@@ -0,0 +1,3 @@
+BEGIN;
+  ALTER TABLE containers ADD COLUMN "keep_until" timestamp with time zone;
+COMMIT;
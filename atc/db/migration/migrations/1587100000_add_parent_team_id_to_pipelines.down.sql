@@ -0,0 +1,4 @@
+BEGIN;
+  ALTER TABLE pipelines
+    DROP COLUMN "parent_team_id";
+COMMIT;
@@ -0,0 +1,4 @@
+BEGIN;
+  ALTER TABLE pipelines
+    ADD COLUMN "parent_team_id" integer REFERENCES teams (id) ON DELETE SET NULL;
+COMMIT;
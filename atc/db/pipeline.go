@@ -38,6 +38,10 @@ type Cause struct {
 type Pipeline interface {
 	ID() int
 	Name() string
+	InstanceVars() atc.InstanceVars
+	ParentJobID() int
+	ParentBuildID() int
+	ParentTeamName() string
 	TeamID() int
 	TeamName() string
 	Groups() atc.GroupConfigs
@@ -95,17 +99,21 @@ type Pipeline interface {
 }
 
 type pipeline struct {
-	id            int
-	name          string
-	teamID        int
-	teamName      string
-	groups        atc.GroupConfigs
-	varSources    atc.VarSourceConfigs
-	configVersion ConfigVersion
-	paused        bool
-	public        bool
-	archived      bool
-	lastUpdated   time.Time
+	id             int
+	name           string
+	instanceVars   atc.InstanceVars
+	parentJobID    int
+	parentBuildID  int
+	parentTeamName string
+	teamID         int
+	teamName       string
+	groups         atc.GroupConfigs
+	varSources     atc.VarSourceConfigs
+	configVersion  ConfigVersion
+	paused         bool
+	public         bool
+	archived       bool
+	lastUpdated    time.Time
 
 	conn        Conn
 	lockFactory lock.LockFactory
@@ -117,6 +125,7 @@ type ConfigVersion int
 var pipelinesQuery = psql.Select(`
 		p.id,
 		p.name,
+		p.instance_vars,
 		p.groups,
 		p.var_sources,
 		p.nonce,
@@ -126,10 +135,14 @@ var pipelinesQuery = psql.Select(`
 		p.paused,
 		p.public,
 		p.archived,
-		p.last_updated
+		p.last_updated,
+		p.parent_job_id,
+		p.parent_build_id,
+		pt.name
 	`).
 	From("pipelines p").
-	LeftJoin("teams t ON p.team_id = t.id")
+	LeftJoin("teams t ON p.team_id = t.id").
+	LeftJoin("teams pt ON p.parent_team_id = pt.id")
 
 func newPipeline(conn Conn, lockFactory lock.LockFactory) *pipeline {
 	return &pipeline{
@@ -144,6 +157,10 @@ func (p *pipeline) TeamID() int              { return p.teamID }
 func (p *pipeline) TeamName() string         { return p.teamName }
 func (p *pipeline) Groups() atc.GroupConfigs { return p.groups }
 
+func (p *pipeline) InstanceVars() atc.InstanceVars   { return p.instanceVars }
+func (p *pipeline) ParentJobID() int                 { return p.parentJobID }
+func (p *pipeline) ParentBuildID() int               { return p.parentBuildID }
+func (p *pipeline) ParentTeamName() string           { return p.parentTeamName }
 func (p *pipeline) VarSources() atc.VarSourceConfigs { return p.varSources }
 func (p *pipeline) ConfigVersion() ConfigVersion     { return p.configVersion }
 func (p *pipeline) Public() bool                     { return p.public }
@@ -623,6 +640,18 @@ func (p *pipeline) Unpause() error {
 		return err
 	}
 
+	// skip any scheduled triggers that were missed while paused
+	_, err = psql.Update("jobs").
+		Set("next_scheduled_trigger", nil).
+		Where(sq.Eq{
+			"pipeline_id": p.id,
+		}).
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		return err
+	}
+
 	err = requestScheduleForJobsInPipeline(tx, p.id)
 	if err != nil {
 		return err
@@ -639,7 +668,16 @@ func (p *pipeline) Archive() error {
 
 	defer Rollback(tx)
 
-	_, err = psql.Update("pipelines").
+	err = p.archive(tx)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (p *pipeline) archive(tx Tx) error {
+	_, err := psql.Update("pipelines").
 		Set("archived", true).
 		Set("last_updated", sq.Expr("now()")).
 		Set("paused", true).
@@ -664,12 +702,7 @@ func (p *pipeline) Archive() error {
 		return err
 	}
 
-	err = p.clearConfigForResourceTypesInPipeline(tx)
-	if err != nil {
-		return err
-	}
-
-	return tx.Commit()
+	return p.clearConfigForResourceTypesInPipeline(tx)
 }
 
 func (p *pipeline) Hide() error {
@@ -699,6 +732,11 @@ func (p *pipeline) Expose() error {
 func (p *pipeline) Rename(name string) error {
 	_, err := psql.Update("pipelines").
 		Set("name", name).
+		// join the instances of the pipeline with the new name, if any
+		Set("ordering", sq.Expr(`COALESCE(
+			(SELECT o.ordering FROM pipelines o WHERE o.name = ? AND o.team_id = pipelines.team_id AND o.id <> pipelines.id LIMIT 1),
+			ordering
+		)`, name)).
 		Where(sq.Eq{
 			"id": p.id,
 		}).
@@ -1094,6 +1132,23 @@ func (p *pipeline) getBuildsFrom(tx Tx, col string) (map[string]Build, error) {
 // var_sources, a vars.MultiVars containing all pipeline specific var_sources
 // plug the global variables, otherwise just return the global variables.
 func (p *pipeline) Variables(logger lager.Logger, globalSecrets creds.Secrets, varSourcePool creds.VarSourcePool) (vars.Variables, error) {
+	pipelineVars, err := p.pipelineVariables(logger, globalSecrets, varSourcePool)
+	if err != nil {
+		return nil, err
+	}
+
+	// Instance vars take precedence, as they identify the pipeline.
+	if len(p.instanceVars) > 0 {
+		return vars.NewMultiVars([]vars.Variables{
+			vars.StaticVariables(p.instanceVars),
+			pipelineVars,
+		}), nil
+	}
+
+	return pipelineVars, nil
+}
+
+func (p *pipeline) pipelineVariables(logger lager.Logger, globalSecrets creds.Secrets, varSourcePool creds.VarSourcePool) (vars.Variables, error) {
 	globalVars := creds.NewVariables(globalSecrets, p.TeamName(), p.Name(), false)
 	namedVarsMap := vars.NamedVariables{}
 
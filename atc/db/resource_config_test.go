@@ -28,8 +28,7 @@ var _ = Describe("ResourceConfig", func() {
 
 			var created bool
 			var err error
-			pipeline, created, err = defaultTeam.SavePipeline(
-				"pipeline-one-resource",
+			pipeline, created, err = defaultTeam.SavePipeline(atc.PipelineRef{Name: "pipeline-one-resource"},
 				config,
 				0,
 				false,
@@ -20,8 +20,7 @@ var _ = Describe("Resource", func() {
 			err     error
 		)
 
-		pipeline, created, err = defaultTeam.SavePipeline(
-			"pipeline-with-resources",
+		pipeline, created, err = defaultTeam.SavePipeline(atc.PipelineRef{Name: "pipeline-with-resources"},
 			atc.Config{
 				Resources: atc.ResourceConfigs{
 					{
@@ -389,8 +388,7 @@ var _ = Describe("Resource", func() {
 				},
 			}
 
-			pipeline, created, err = defaultTeam.SavePipeline(
-				"pipeline-with-same-resources",
+			pipeline, created, err = defaultTeam.SavePipeline(atc.PipelineRef{Name: "pipeline-with-same-resources"},
 				config,
 				0,
 				false,
@@ -609,8 +607,7 @@ var _ = Describe("Resource", func() {
 
 					BeforeEach(func() {
 						config.Resources[2].Source = atc.Source{"some": "other-repo"}
-						newPipeline, _, err := defaultTeam.SavePipeline(
-							"pipeline-with-same-resources",
+						newPipeline, _, err := defaultTeam.SavePipeline(atc.PipelineRef{Name: "pipeline-with-same-resources"},
 							config,
 							pipeline.ConfigVersion(),
 							false,
@@ -641,8 +638,7 @@ var _ = Describe("Resource", func() {
 
 					BeforeEach(func() {
 						config.ResourceTypes[0].UniqueVersionHistory = false
-						newPipeline, _, err := defaultTeam.SavePipeline(
-							"pipeline-with-same-resources",
+						newPipeline, _, err := defaultTeam.SavePipeline(atc.PipelineRef{Name: "pipeline-with-same-resources"},
 							config,
 							pipeline.ConfigVersion(),
 							false,
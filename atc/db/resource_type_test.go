@@ -18,8 +18,7 @@ var _ = Describe("ResourceType", func() {
 			err     error
 		)
 
-		pipeline, created, err = defaultTeam.SavePipeline(
-			"pipeline-with-types",
+		pipeline, created, err = defaultTeam.SavePipeline(atc.PipelineRef{Name: "pipeline-with-types"},
 			atc.Config{
 				ResourceTypes: atc.ResourceTypes{
 					{
@@ -106,8 +105,7 @@ var _ = Describe("ResourceType", func() {
 					err     error
 				)
 
-				pipeline, created, err = defaultTeam.SavePipeline(
-					"pipeline-with-types",
+				pipeline, created, err = defaultTeam.SavePipeline(atc.PipelineRef{Name: "pipeline-with-types"},
 					atc.Config{
 						ResourceTypes: atc.ResourceTypes{
 							{
@@ -137,8 +135,7 @@ var _ = Describe("ResourceType", func() {
 					err     error
 				)
 
-				pipeline, created, err = defaultTeam.SavePipeline(
-					"pipeline-with-types",
+				pipeline, created, err = defaultTeam.SavePipeline(atc.PipelineRef{Name: "pipeline-with-types"},
 					atc.Config{
 						Resources: atc.ResourceConfigs{
 							{
@@ -183,8 +180,7 @@ var _ = Describe("ResourceType", func() {
 					err     error
 				)
 
-				otherPipeline, created, err := defaultTeam.SavePipeline(
-					"pipeline-with-duplicate-type-name",
+				otherPipeline, created, err := defaultTeam.SavePipeline(atc.PipelineRef{Name: "pipeline-with-duplicate-type-name"},
 					atc.Config{
 						ResourceTypes: atc.ResourceTypes{
 							{
@@ -202,8 +198,7 @@ var _ = Describe("ResourceType", func() {
 				Expect(created).To(BeTrue())
 				Expect(otherPipeline).NotTo(BeNil())
 
-				pipeline, created, err = defaultTeam.SavePipeline(
-					"pipeline-with-types",
+				pipeline, created, err = defaultTeam.SavePipeline(atc.PipelineRef{Name: "pipeline-with-types"},
 					atc.Config{
 						Resources: atc.ResourceConfigs{
 							{
@@ -1,10 +1,27 @@
 package db
 
+import (
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+)
+
+const taskMemoPrefix = "memoize/"
+
+// TaskMemoPath returns the task cache path under which the given output of a
+// memoized step is kept.
+func TaskMemoPath(key string, output string) string {
+	return fmt.Sprintf("%s%s/%s", taskMemoPrefix, key, output)
+}
+
 //go:generate counterfeiter . TaskCacheFactory
 
 type TaskCacheFactory interface {
 	Find(jobID int, stepName string, path string) (UsedTaskCache, bool, error)
 	FindOrCreate(jobID int, stepName string, path string) (UsedTaskCache, error)
+
+	FindMemo(jobID int, stepName string, key string) (bool, error)
+	Memoize(jobID int, stepName string, key string) error
 }
 
 type taskCacheFactory struct {
@@ -50,3 +67,54 @@ func (f *taskCacheFactory) FindOrCreate(jobID int, stepName string, path string)
 
 	return utc, nil
 }
+
+// FindMemo returns whether the step has been memoized with the given key.
+func (f *taskCacheFactory) FindMemo(jobID int, stepName string, key string) (bool, error) {
+	_, found, err := usedTaskCache{
+		jobID:    jobID,
+		stepName: stepName,
+		path:     taskMemoPrefix + key,
+	}.find(f.conn)
+
+	return found, err
+}
+
+// Memoize records that the step succeeded with the given key. The task caches
+// memoized for any other key are removed, releasing their volumes for gc.
+//
+// The step's outputs should be initialized as task caches under
+// TaskMemoPath before calling this, so that a memo is never found without
+// its outputs.
+func (f *taskCacheFactory) Memoize(jobID int, stepName string, key string) error {
+	tx, err := f.conn.Begin()
+	if err != nil {
+		return err
+	}
+
+	defer Rollback(tx)
+
+	_, err = usedTaskCache{
+		jobID:    jobID,
+		stepName: stepName,
+		path:     taskMemoPrefix + key,
+	}.findOrCreate(tx)
+	if err != nil {
+		return err
+	}
+
+	_, err = psql.Delete("task_caches").
+		Where(sq.Eq{
+			"job_id":    jobID,
+			"step_name": stepName,
+		}).
+		Where(sq.Like{"path": taskMemoPrefix + "%"}).
+		Where(sq.NotEq{"path": taskMemoPrefix + key}).
+		Where(sq.NotLike{"path": TaskMemoPath(key, "%")}).
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
@@ -86,4 +86,65 @@ var _ = Describe("TaskCacheFactory", func() {
 			})
 		})
 	})
+
+	Describe("Memoize", func() {
+		It("records the memo", func() {
+			err := taskCacheFactory.Memoize(defaultJob.ID(), "some-step", "some-key")
+			Expect(err).ToNot(HaveOccurred())
+
+			found, err := taskCacheFactory.FindMemo(defaultJob.ID(), "some-step", "some-key")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+		})
+
+		It("does not memoize other steps", func() {
+			err := taskCacheFactory.Memoize(defaultJob.ID(), "some-step", "some-key")
+			Expect(err).ToNot(HaveOccurred())
+
+			found, err := taskCacheFactory.FindMemo(defaultJob.ID(), "some-other-step", "some-key")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeFalse())
+		})
+
+		Context("when the step was memoized with another key", func() {
+			BeforeEach(func() {
+				_, err := taskCacheFactory.FindOrCreate(defaultJob.ID(), "some-step", db.TaskMemoPath("some-old-key", "some-output"))
+				Expect(err).ToNot(HaveOccurred())
+
+				err = taskCacheFactory.Memoize(defaultJob.ID(), "some-step", "some-old-key")
+				Expect(err).ToNot(HaveOccurred())
+
+				_, err = taskCacheFactory.FindOrCreate(defaultJob.ID(), "some-step", db.TaskMemoPath("some-key", "some-output"))
+				Expect(err).ToNot(HaveOccurred())
+
+				_, err = taskCacheFactory.FindOrCreate(defaultJob.ID(), "some-step", "some-cache-path")
+				Expect(err).ToNot(HaveOccurred())
+
+				err = taskCacheFactory.Memoize(defaultJob.ID(), "some-step", "some-key")
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("removes the old memo and its outputs", func() {
+				found, err := taskCacheFactory.FindMemo(defaultJob.ID(), "some-step", "some-old-key")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeFalse())
+
+				_, found, err = taskCacheFactory.Find(defaultJob.ID(), "some-step", db.TaskMemoPath("some-old-key", "some-output"))
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+
+			It("keeps the new memo's outputs", func() {
+				_, found, err := taskCacheFactory.Find(defaultJob.ID(), "some-step", db.TaskMemoPath("some-key", "some-output"))
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeTrue())
+			})
+
+			It("keeps the step's caches", func() {
+				_, found, err := taskCacheFactory.Find(defaultJob.ID(), "some-step", "some-cache-path")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeTrue())
+			})
+		})
+	})
 })
@@ -33,13 +33,13 @@ type Team interface {
 	Rename(string) error
 
 	SavePipeline(
-		pipelineName string,
+		pipelineRef atc.PipelineRef,
 		config atc.Config,
 		from ConfigVersion,
 		initiallyPaused bool,
 	) (Pipeline, bool, error)
 
-	Pipeline(pipelineName string) (Pipeline, bool, error)
+	Pipeline(pipelineRef atc.PipelineRef) (Pipeline, bool, error)
 	Pipelines() ([]Pipeline, error)
 	PublicPipelines() ([]Pipeline, error)
 	OrderPipelines([]string) error
@@ -60,8 +60,9 @@ type Team interface {
 	IsContainerWithinTeam(string, bool) (bool, error)
 
 	FindContainerByHandle(string) (Container, bool, error)
-	FindCheckContainers(lager.Logger, string, string, creds.Secrets, creds.VarSourcePool) ([]Container, map[int]time.Time, error)
+	FindCheckContainers(lager.Logger, atc.PipelineRef, string, creds.Secrets, creds.VarSourcePool) ([]Container, map[int]time.Time, error)
 	FindContainersByMetadata(ContainerMetadata) ([]Container, error)
+	FindKeptContainers() ([]CreatedContainer, error)
 	FindCreatedContainerByHandle(string) (CreatedContainer, bool, error)
 	FindWorkerForContainer(handle string) (Worker, bool, error)
 	FindWorkerForVolume(handle string) (Worker, bool, error)
@@ -324,6 +325,44 @@ func (t *team) FindContainersByMetadata(metadata ContainerMetadata) ([]Container
 	return containers, nil
 }
 
+// FindKeptContainers returns the team's containers which are being kept for
+// debugging and have not yet expired.
+func (t *team) FindKeptContainers() ([]CreatedContainer, error) {
+	rows, err := selectContainers().
+		Where(sq.Eq{
+			"team_id": t.id,
+			"state":   atc.ContainerStateCreated,
+		}).
+		Where(sq.Expr("keep_until > now()")).
+		OrderBy("keep_until").
+		RunWith(t.conn).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	containers := []CreatedContainer{}
+	for rows.Next() {
+		_, created, _, _, err := scanContainer(rows, t.conn)
+		if err != nil {
+			return nil, err
+		}
+
+		if created != nil {
+			containers = append(containers, created)
+		}
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, err
+	}
+
+	return containers, nil
+}
+
 func (t *team) FindCreatedContainerByHandle(
 	handle string,
 ) (CreatedContainer, bool, error) {
@@ -340,7 +379,7 @@ func (t *team) FindCreatedContainerByHandle(
 }
 
 func (t *team) SavePipeline(
-	pipelineName string,
+	pipelineRef atc.PipelineRef,
 	config atc.Config,
 	from ConfigVersion,
 	initiallyPaused bool,
@@ -352,51 +391,108 @@ func (t *team) SavePipeline(
 
 	defer Rollback(tx)
 
-	var existingConfig bool
-	err = tx.QueryRow(`SELECT EXISTS (
-		SELECT 1
-		FROM pipelines
-		WHERE name = $1
-		AND team_id = $2
-	)`, pipelineName, t.id).Scan(&existingConfig)
+	pipelineID, isNewPipeline, err := t.savePipeline(tx, pipelineRef, config, from, initiallyPaused, sql.NullInt64{}, sql.NullInt64{}, sql.NullInt64{})
 	if err != nil {
 		return nil, false, err
 	}
 
-	groupsPayload, err := json.Marshal(config.Groups)
+	pipeline := newPipeline(t.conn, t.lockFactory)
+	err = scanPipeline(
+		pipeline,
+		pipelinesQuery.
+			Where(sq.Eq{"p.id": pipelineID}).
+			RunWith(tx).
+			QueryRow(),
+	)
 	if err != nil {
 		return nil, false, err
 	}
 
-	varSourcesPayload, err := json.Marshal(config.VarSources)
+	err = tx.Commit()
 	if err != nil {
 		return nil, false, err
 	}
 
+	return pipeline, isNewPipeline, nil
+}
+
+// savePipeline saves the pipeline's config within the given transaction,
+// recording the job, build and team which set it, if any. It returns the
+// pipeline's ID and whether it was newly created.
+func (t *team) savePipeline(
+	tx Tx,
+	pipelineRef atc.PipelineRef,
+	config atc.Config,
+	from ConfigVersion,
+	initiallyPaused bool,
+	parentJobID sql.NullInt64,
+	parentBuildID sql.NullInt64,
+	parentTeamID sql.NullInt64,
+) (int, bool, error) {
+	instanceVarsPayload, err := instanceVarsPayload(pipelineRef.InstanceVars)
+	if err != nil {
+		return 0, false, err
+	}
+
+	var existingConfig bool
+	err = psql.Select("1").
+		Prefix("SELECT EXISTS (").
+		From("pipelines").
+		Where(sq.Eq{
+			"name":    pipelineRef.Name,
+			"team_id": t.id,
+		}).
+		Where(instanceVarsEq("instance_vars", instanceVarsPayload)).
+		Suffix(")").
+		RunWith(tx).
+		QueryRow().
+		Scan(&existingConfig)
+	if err != nil {
+		return 0, false, err
+	}
+
+	groupsPayload, err := json.Marshal(config.Groups)
+	if err != nil {
+		return 0, false, err
+	}
+
+	varSourcesPayload, err := json.Marshal(config.VarSources)
+	if err != nil {
+		return 0, false, err
+	}
+
 	encryptedVarSourcesPayload, nonce, err := t.conn.EncryptionStrategy().Encrypt(varSourcesPayload)
 	if err != nil {
-		return nil, false, err
+		return 0, false, err
 	}
 
 	var pipelineID int
 	if !existingConfig {
 		err = psql.Insert("pipelines").
 			SetMap(map[string]interface{}{
-				"name":         pipelineName,
-				"groups":       groupsPayload,
-				"var_sources":  encryptedVarSourcesPayload,
-				"nonce":        nonce,
-				"version":      sq.Expr("nextval('config_version_seq')"),
-				"ordering":     sq.Expr("currval('pipelines_id_seq')"),
-				"paused":       initiallyPaused,
-				"last_updated": sq.Expr("now()"),
-				"team_id":      t.id,
+				"name":          pipelineRef.Name,
+				"instance_vars": instanceVarsPayload,
+				"groups":        groupsPayload,
+				"var_sources":   encryptedVarSourcesPayload,
+				"nonce":         nonce,
+				"version":       sq.Expr("nextval('config_version_seq')"),
+				// instances of a pipeline are ordered together
+				"ordering": sq.Expr(`COALESCE(
+					(SELECT ordering FROM pipelines WHERE name = ? AND team_id = ? LIMIT 1),
+					currval('pipelines_id_seq')
+				)`, pipelineRef.Name, t.id),
+				"paused":          initiallyPaused,
+				"last_updated":    sq.Expr("now()"),
+				"team_id":         t.id,
+				"parent_job_id":   parentJobID,
+				"parent_build_id": parentBuildID,
+				"parent_team_id":  parentTeamID,
 			}).
 			Suffix("RETURNING id").
 			RunWith(tx).
 			QueryRow().Scan(&pipelineID)
 		if err != nil {
-			return nil, false, err
+			return 0, false, err
 		}
 	} else {
 		err := psql.Update("pipelines").
@@ -406,32 +502,36 @@ func (t *team) SavePipeline(
 			Set("nonce", nonce).
 			Set("version", sq.Expr("nextval('config_version_seq')")).
 			Set("last_updated", sq.Expr("now()")).
+			Set("parent_job_id", parentJobID).
+			Set("parent_build_id", parentBuildID).
+			Set("parent_team_id", parentTeamID).
 			Where(sq.Eq{
-				"name":    pipelineName,
+				"name":    pipelineRef.Name,
 				"version": from,
 				"team_id": t.id,
 			}).
+			Where(instanceVarsEq("instance_vars", instanceVarsPayload)).
 			Suffix("RETURNING id").
 			RunWith(tx).
 			QueryRow().
 			Scan(&pipelineID)
 		if err != nil {
 			if err == sql.ErrNoRows {
-				return nil, false, ErrConfigComparisonFailed
+				return 0, false, ErrConfigComparisonFailed
 			}
 
-			return nil, false, err
+			return 0, false, err
 		}
 
 		err = t.resetDependentTableStates(tx, pipelineID)
 		if err != nil {
-			return nil, false, err
+			return 0, false, err
 		}
 	}
 
 	resourceNameToID, err := t.saveResources(tx, config.Resources, pipelineID)
 	if err != nil {
-		return nil, false, err
+		return 0, false, err
 	}
 
 	_, err = psql.Update("resources").
@@ -443,69 +543,58 @@ func (t *team) SavePipeline(
 		RunWith(tx).
 		Exec()
 	if err != nil {
-		return nil, false, err
+		return 0, false, err
 	}
 
 	err = t.saveResourceTypes(tx, config.ResourceTypes, pipelineID)
 	if err != nil {
-		return nil, false, err
+		return 0, false, err
 	}
 
 	err = t.updateName(tx, config.Jobs, pipelineID)
 	if err != nil {
-		return nil, false, err
+		return 0, false, err
 	}
 
 	jobNameToID, err := t.saveJobsAndSerialGroups(tx, config.Jobs, config.Groups, pipelineID)
 	if err != nil {
-		return nil, false, err
+		return 0, false, err
 	}
 
 	err = removeUnusedWorkerTaskCaches(tx, pipelineID, config.Jobs)
 	if err != nil {
-		return nil, false, err
+		return 0, false, err
 	}
 
 	err = t.insertJobPipes(tx, config.Jobs, resourceNameToID, jobNameToID, pipelineID)
 	if err != nil {
-		return nil, false, err
-	}
-
-	pipeline := newPipeline(t.conn, t.lockFactory)
-	err = scanPipeline(
-		pipeline,
-		pipelinesQuery.
-			Where(sq.Eq{"p.id": pipelineID}).
-			RunWith(tx).
-			QueryRow(),
-	)
-	if err != nil {
-		return nil, false, err
+		return 0, false, err
 	}
 
 	err = requestScheduleForJobsInPipeline(tx, pipelineID)
 	if err != nil {
-		return nil, false, err
+		return 0, false, err
 	}
 
-	err = tx.Commit()
+	return pipelineID, !existingConfig, nil
+}
+
+func (t *team) Pipeline(pipelineRef atc.PipelineRef) (Pipeline, bool, error) {
+	instanceVarsPayload, err := instanceVarsPayload(pipelineRef.InstanceVars)
 	if err != nil {
 		return nil, false, err
 	}
 
-	return pipeline, !existingConfig, nil
-}
-
-func (t *team) Pipeline(pipelineName string) (Pipeline, bool, error) {
 	pipeline := newPipeline(t.conn, t.lockFactory)
 
-	err := scanPipeline(
+	err = scanPipeline(
 		pipeline,
 		pipelinesQuery.
 			Where(sq.Eq{
 				"p.team_id": t.id,
-				"p.name":    pipelineName,
+				"p.name":    pipelineRef.Name,
 			}).
+			Where(instanceVarsEq("p.instance_vars", instanceVarsPayload)).
 			RunWith(t.conn).
 			QueryRow(),
 	)
@@ -524,7 +613,7 @@ func (t *team) Pipelines() ([]Pipeline, error) {
 		Where(sq.Eq{
 			"team_id": t.id,
 		}).
-		OrderBy("ordering").
+		OrderBy("ordering", "p.name", "p.id").
 		RunWith(t.conn).
 		Query()
 	if err != nil {
@@ -545,7 +634,7 @@ func (t *team) PublicPipelines() ([]Pipeline, error) {
 			"team_id": t.id,
 			"public":  true,
 		}).
-		OrderBy("team_id ASC", "ordering ASC").
+		OrderBy("team_id ASC", "ordering ASC", "p.name", "p.id").
 		RunWith(t.conn).
 		Query()
 	if err != nil {
@@ -738,8 +827,8 @@ func (t *team) UpdateProviderAuth(auth atc.TeamAuth) error {
 	return tx.Commit()
 }
 
-func (t *team) FindCheckContainers(logger lager.Logger, pipelineName string, resourceName string, secretManager creds.Secrets, varSourcePool creds.VarSourcePool) ([]Container, map[int]time.Time, error) {
-	pipeline, found, err := t.Pipeline(pipelineName)
+func (t *team) FindCheckContainers(logger lager.Logger, pipelineRef atc.PipelineRef, resourceName string, secretManager creds.Secrets, varSourcePool creds.VarSourcePool) ([]Container, map[int]time.Time, error) {
+	pipeline, found, err := t.Pipeline(pipelineRef)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -950,11 +1039,23 @@ func (t *team) saveJob(tx Tx, job atc.JobConfig, pipelineID int, groups []string
 		return 0, err
 	}
 
+	var schedule sql.NullString
+	if job.Schedule != nil {
+		schedulePayload, err := json.Marshal(job.Schedule)
+		if err != nil {
+			return 0, err
+		}
+
+		schedule = sql.NullString{String: string(schedulePayload), Valid: true}
+	}
+
+	// the next scheduled trigger is reset whenever the schedule changes so that
+	// the scheduler will compute it again
 	var jobID int
 	err = psql.Insert("jobs").
-		Columns("name", "pipeline_id", "config", "public", "max_in_flight", "interruptible", "active", "nonce", "tags").
-		Values(job.Name, pipelineID, encryptedPayload, job.Public, job.MaxInFlight(), job.Interruptible, true, nonce, pq.Array(groups)).
-		Suffix("ON CONFLICT (name, pipeline_id) DO UPDATE SET config = EXCLUDED.config, public = EXCLUDED.public, max_in_flight = EXCLUDED.max_in_flight, interruptible = EXCLUDED.interruptible, active = EXCLUDED.active, nonce = EXCLUDED.nonce, tags = EXCLUDED.tags").
+		Columns("name", "pipeline_id", "config", "public", "max_in_flight", "interruptible", "disable_manual_trigger", "schedule", "active", "nonce", "tags").
+		Values(job.Name, pipelineID, encryptedPayload, job.Public, job.MaxInFlight(), job.Interruptible, job.DisableManualTrigger, schedule, true, nonce, pq.Array(groups)).
+		Suffix("ON CONFLICT (name, pipeline_id) DO UPDATE SET config = EXCLUDED.config, public = EXCLUDED.public, max_in_flight = EXCLUDED.max_in_flight, interruptible = EXCLUDED.interruptible, disable_manual_trigger = EXCLUDED.disable_manual_trigger, schedule = EXCLUDED.schedule, next_scheduled_trigger = CASE WHEN jobs.schedule IS DISTINCT FROM EXCLUDED.schedule THEN NULL ELSE jobs.next_scheduled_trigger END, active = EXCLUDED.active, nonce = EXCLUDED.nonce, tags = EXCLUDED.tags").
 		Suffix("RETURNING id").
 		RunWith(tx).
 		QueryRow().
@@ -1093,18 +1194,32 @@ func (t *team) findContainer(whereClause sq.Sqlizer) (CreatingContainer, Created
 
 func scanPipeline(p *pipeline, scan scannable) error {
 	var (
-		groups      sql.NullString
-		varSources  sql.NullString
-		nonce       sql.NullString
-		nonceStr    *string
-		lastUpdated pq.NullTime
+		instanceVars   sql.NullString
+		groups         sql.NullString
+		varSources     sql.NullString
+		nonce          sql.NullString
+		nonceStr       *string
+		lastUpdated    pq.NullTime
+		parentJobID    sql.NullInt64
+		parentBuildID  sql.NullInt64
+		parentTeamName sql.NullString
 	)
-	err := scan.Scan(&p.id, &p.name, &groups, &varSources, &nonce, &p.configVersion, &p.teamID, &p.teamName, &p.paused, &p.public, &p.archived, &lastUpdated)
+	err := scan.Scan(&p.id, &p.name, &instanceVars, &groups, &varSources, &nonce, &p.configVersion, &p.teamID, &p.teamName, &p.paused, &p.public, &p.archived, &lastUpdated, &parentJobID, &parentBuildID, &parentTeamName)
 	if err != nil {
 		return err
 	}
 
 	p.lastUpdated = lastUpdated.Time
+	p.parentJobID = int(parentJobID.Int64)
+	p.parentBuildID = int(parentBuildID.Int64)
+	p.parentTeamName = parentTeamName.String
+
+	if instanceVars.Valid {
+		err = json.Unmarshal([]byte(instanceVars.String), &p.instanceVars)
+		if err != nil {
+			return err
+		}
+	}
 
 	if groups.Valid {
 		var pipelineGroups atc.GroupConfigs
@@ -1137,6 +1252,30 @@ func scanPipeline(p *pipeline, scan scannable) error {
 	return nil
 }
 
+// instanceVarsPayload returns the JSON payload to store for the given instance
+// vars, or nil for a pipeline that is not instanced.
+func instanceVarsPayload(instanceVars atc.InstanceVars) (*string, error) {
+	if len(instanceVars) == 0 {
+		return nil, nil
+	}
+
+	payload, err := json.Marshal(instanceVars)
+	if err != nil {
+		return nil, err
+	}
+
+	str := string(payload)
+	return &str, nil
+}
+
+func instanceVarsEq(column string, payload *string) sq.Sqlizer {
+	if payload == nil {
+		return sq.Eq{column: nil}
+	}
+
+	return sq.Expr(column+" = ?::jsonb", *payload)
+}
+
 func scanPipelines(conn Conn, lockFactory lock.LockFactory, rows *sql.Rows) ([]Pipeline, error) {
 	defer Close(rows)
 
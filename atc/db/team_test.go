@@ -44,7 +44,7 @@ var _ = Describe("Team", func() {
 		var otherTeamPipeline db.Pipeline
 
 		BeforeEach(func() {
-			otherTeamPipeline, _, err = otherTeam.SavePipeline("fake-pipeline", atc.Config{
+			otherTeamPipeline, _, err = otherTeam.SavePipeline(atc.PipelineRef{Name: "fake-pipeline"}, atc.Config{
 				Jobs: atc.JobConfigs{
 					{Name: "job-name"},
 				},
@@ -523,7 +523,7 @@ var _ = Describe("Team", func() {
 					otherTeam, err = teamFactory.CreateTeam(atc.Team{Name: "other-team"})
 					Expect(err).NotTo(HaveOccurred())
 
-					otherPipeline, _, err := otherTeam.SavePipeline("other-pipeline", atc.Config{
+					otherPipeline, _, err := otherTeam.SavePipeline(atc.PipelineRef{Name: "other-pipeline"}, atc.Config{
 						Jobs: atc.JobConfigs{
 							{
 								Name: "some-job",
@@ -876,14 +876,14 @@ var _ = Describe("Team", func() {
 		Context("when the team has configured pipelines", func() {
 			BeforeEach(func() {
 				var err error
-				pipeline1, _, err = team.SavePipeline("fake-pipeline", atc.Config{
+				pipeline1, _, err = team.SavePipeline(atc.PipelineRef{Name: "fake-pipeline"}, atc.Config{
 					Jobs: atc.JobConfigs{
 						{Name: "job-name"},
 					},
 				}, db.ConfigVersion(1), false)
 				Expect(err).ToNot(HaveOccurred())
 
-				pipeline2, _, err = team.SavePipeline("fake-pipeline-two", atc.Config{
+				pipeline2, _, err = team.SavePipeline(atc.PipelineRef{Name: "fake-pipeline-two"}, atc.Config{
 					Jobs: atc.JobConfigs{
 						{Name: "job-fake"},
 					},
@@ -895,7 +895,45 @@ var _ = Describe("Team", func() {
 				Expect(pipelines[0].Name()).To(Equal(pipeline1.Name()))
 				Expect(pipelines[1].Name()).To(Equal(pipeline2.Name()))
 			})
+
+			Context("when another instance of the first pipeline is configured", func() {
+				var instance db.Pipeline
+
+				BeforeEach(func() {
+					var err error
+					instance, _, err = team.SavePipeline(atc.PipelineRef{
+						Name:         "fake-pipeline",
+						InstanceVars: atc.InstanceVars{"branch": "feature"},
+					}, atc.Config{
+						Jobs: atc.JobConfigs{
+							{Name: "job-name"},
+						},
+					}, db.ConfigVersion(0), false)
+					Expect(err).ToNot(HaveOccurred())
+				})
+
+				It("groups the instances together", func() {
+					Expect(pipelines).To(HaveLen(3))
+					Expect(pipelines[0].ID()).To(Equal(pipeline1.ID()))
+					Expect(pipelines[1].ID()).To(Equal(instance.ID()))
+					Expect(pipelines[2].ID()).To(Equal(pipeline2.ID()))
+				})
+
+				Context("when the instance is renamed to the second pipeline", func() {
+					BeforeEach(func() {
+						Expect(instance.Rename("fake-pipeline-two")).To(Succeed())
+					})
+
+					It("moves it to the instances of its new name", func() {
+						Expect(pipelines).To(HaveLen(3))
+						Expect(pipelines[0].ID()).To(Equal(pipeline1.ID()))
+						Expect(pipelines[1].Name()).To(Equal("fake-pipeline-two"))
+						Expect(pipelines[2].Name()).To(Equal("fake-pipeline-two"))
+					})
+				})
+			})
 		})
+
 		Context("when the team has no configured pipelines", func() {
 			It("returns no pipelines", func() {
 				Expect(pipelines).To(Equal([]db.Pipeline{}))
@@ -918,14 +956,14 @@ var _ = Describe("Team", func() {
 		Context("when the team has configured pipelines", func() {
 			BeforeEach(func() {
 				var err error
-				_, _, err = team.SavePipeline("fake-pipeline", atc.Config{
+				_, _, err = team.SavePipeline(atc.PipelineRef{Name: "fake-pipeline"}, atc.Config{
 					Jobs: atc.JobConfigs{
 						{Name: "job-name"},
 					},
 				}, db.ConfigVersion(1), false)
 				Expect(err).ToNot(HaveOccurred())
 
-				pipeline2, _, err = team.SavePipeline("fake-pipeline-two", atc.Config{
+				pipeline2, _, err = team.SavePipeline(atc.PipelineRef{Name: "fake-pipeline-two"}, atc.Config{
 					Jobs: atc.JobConfigs{
 						{Name: "job-fake"},
 					},
@@ -959,14 +997,14 @@ var _ = Describe("Team", func() {
 
 		BeforeEach(func() {
 			var err error
-			pipeline1, _, err = team.SavePipeline("pipeline-name-a", atc.Config{}, 0, false)
+			pipeline1, _, err = team.SavePipeline(atc.PipelineRef{Name: "pipeline-name-a"}, atc.Config{}, 0, false)
 			Expect(err).ToNot(HaveOccurred())
-			pipeline2, _, err = team.SavePipeline("pipeline-name-b", atc.Config{}, 0, false)
+			pipeline2, _, err = team.SavePipeline(atc.PipelineRef{Name: "pipeline-name-b"}, atc.Config{}, 0, false)
 			Expect(err).ToNot(HaveOccurred())
 
-			otherPipeline1, _, err = otherTeam.SavePipeline("pipeline-name-a", atc.Config{}, 0, false)
+			otherPipeline1, _, err = otherTeam.SavePipeline(atc.PipelineRef{Name: "pipeline-name-a"}, atc.Config{}, 0, false)
 			Expect(err).ToNot(HaveOccurred())
-			otherPipeline2, _, err = otherTeam.SavePipeline("pipeline-name-b", atc.Config{}, 0, false)
+			otherPipeline2, _, err = otherTeam.SavePipeline(atc.PipelineRef{Name: "pipeline-name-b"}, atc.Config{}, 0, false)
 			Expect(err).ToNot(HaveOccurred())
 		})
 
@@ -1122,7 +1160,7 @@ var _ = Describe("Team", func() {
 					},
 				}
 				var err error
-				pipeline, _, err = team.SavePipeline("some-pipeline", config, db.ConfigVersion(1), false)
+				pipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "some-pipeline"}, config, db.ConfigVersion(1), false)
 				Expect(err).ToNot(HaveOccurred())
 
 				job, found, err := pipeline.Job("some-job")
@@ -1273,7 +1311,7 @@ var _ = Describe("Team", func() {
 					},
 				},
 			}
-			pipeline, _, err = team.SavePipeline("some-pipeline", config, db.ConfigVersion(1), false)
+			pipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "some-pipeline"}, config, db.ConfigVersion(1), false)
 			Expect(err).ToNot(HaveOccurred())
 
 			job, found, err := pipeline.Job("some-job")
@@ -1379,7 +1417,7 @@ var _ = Describe("Team", func() {
 					},
 				},
 			}
-			pipeline, _, err = team.SavePipeline("some-pipeline", config, db.ConfigVersion(1), false)
+			pipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "some-pipeline"}, config, db.ConfigVersion(1), false)
 			Expect(err).ToNot(HaveOccurred())
 
 			job, found, err := pipeline.Job("some-job")
@@ -1637,26 +1675,26 @@ var _ = Describe("Team", func() {
 		})
 
 		It("returns true for created", func() {
-			_, created, err := team.SavePipeline(pipelineName, config, 0, false)
+			_, created, err := team.SavePipeline(atc.PipelineRef{Name: pipelineName}, config, 0, false)
 			Expect(err).ToNot(HaveOccurred())
 			Expect(created).To(BeTrue())
 		})
 
 		It("caches the team id", func() {
-			_, _, err := team.SavePipeline(pipelineName, config, 0, false)
+			_, _, err := team.SavePipeline(atc.PipelineRef{Name: pipelineName}, config, 0, false)
 			Expect(err).ToNot(HaveOccurred())
 
-			pipeline, found, err := team.Pipeline(pipelineName)
+			pipeline, found, err := team.Pipeline(atc.PipelineRef{Name: pipelineName})
 			Expect(err).ToNot(HaveOccurred())
 			Expect(found).To(BeTrue())
 			Expect(pipeline.TeamID()).To(Equal(team.ID()))
 		})
 
 		It("can be saved as paused", func() {
-			_, _, err := team.SavePipeline(pipelineName, config, 0, true)
+			_, _, err := team.SavePipeline(atc.PipelineRef{Name: pipelineName}, config, 0, true)
 			Expect(err).ToNot(HaveOccurred())
 
-			pipeline, found, err := team.Pipeline(pipelineName)
+			pipeline, found, err := team.Pipeline(atc.PipelineRef{Name: pipelineName})
 			Expect(err).ToNot(HaveOccurred())
 			Expect(found).To(BeTrue())
 
@@ -1664,10 +1702,10 @@ var _ = Describe("Team", func() {
 		})
 
 		It("can be saved as unpaused", func() {
-			_, _, err := team.SavePipeline(pipelineName, config, 0, false)
+			_, _, err := team.SavePipeline(atc.PipelineRef{Name: pipelineName}, config, 0, false)
 			Expect(err).ToNot(HaveOccurred())
 
-			pipeline, found, err := team.Pipeline(pipelineName)
+			pipeline, found, err := team.Pipeline(atc.PipelineRef{Name: pipelineName})
 			Expect(err).ToNot(HaveOccurred())
 			Expect(found).To(BeTrue())
 
@@ -1675,21 +1713,145 @@ var _ = Describe("Team", func() {
 		})
 
 		It("is not archived by default", func() {
-			_, _, err := team.SavePipeline(pipelineName, config, 0, true)
+			_, _, err := team.SavePipeline(atc.PipelineRef{Name: pipelineName}, config, 0, true)
 			Expect(err).ToNot(HaveOccurred())
 
-			pipeline, found, err := team.Pipeline(pipelineName)
+			pipeline, found, err := team.Pipeline(atc.PipelineRef{Name: pipelineName})
 			Expect(err).ToNot(HaveOccurred())
 			Expect(found).To(BeTrue())
 
 			Expect(pipeline.Archived()).To(BeFalse())
 		})
 
+		It("saves the job's disable_manual_trigger", func() {
+			config.Jobs[0].DisableManualTrigger = true
+
+			pipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: pipelineName}, config, 0, false)
+			Expect(err).ToNot(HaveOccurred())
+
+			job, found, err := pipeline.Job("some-job")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(job.DisableManualTrigger()).To(BeTrue())
+		})
+
+		Context("when a job has a schedule", func() {
+			var (
+				pipeline db.Pipeline
+				job      db.Job
+				next     time.Time
+			)
+
+			BeforeEach(func() {
+				config.Jobs[0].Schedule = &atc.ScheduleConfig{Cron: "0 2 * * *"}
+
+				var err error
+				pipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: pipelineName}, config, 0, false)
+				Expect(err).ToNot(HaveOccurred())
+
+				var found bool
+				job, found, err = pipeline.Job("some-job")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeTrue())
+
+				next = time.Now().Add(time.Hour).Truncate(time.Second)
+				err = job.UpdateNextScheduledTrigger(next)
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("saves the schedule", func() {
+				Expect(job.Schedule()).To(Equal(&atc.ScheduleConfig{Cron: "0 2 * * *"}))
+			})
+
+			It("clears the schedule when it is removed", func() {
+				config.Jobs[0].Schedule = nil
+
+				_, _, err := team.SavePipeline(atc.PipelineRef{Name: pipelineName}, config, pipeline.ConfigVersion(), false)
+				Expect(err).ToNot(HaveOccurred())
+
+				found, err := job.Reload()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(job.Schedule()).To(BeNil())
+			})
+
+			It("keeps the next scheduled trigger when the schedule is unchanged", func() {
+				_, _, err := team.SavePipeline(atc.PipelineRef{Name: pipelineName}, config, pipeline.ConfigVersion(), false)
+				Expect(err).ToNot(HaveOccurred())
+
+				found, err := job.Reload()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(job.NextScheduledTrigger()).To(BeTemporally("==", next))
+			})
+
+			It("resets the next scheduled trigger when the schedule changes", func() {
+				config.Jobs[0].Schedule = &atc.ScheduleConfig{Cron: "0 3 * * *"}
+
+				_, _, err := team.SavePipeline(atc.PipelineRef{Name: pipelineName}, config, pipeline.ConfigVersion(), false)
+				Expect(err).ToNot(HaveOccurred())
+
+				found, err := job.Reload()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(job.NextScheduledTrigger()).To(BeZero())
+			})
+		})
+
+		Context("when instance vars are specified", func() {
+			var instanceRef atc.PipelineRef
+
+			BeforeEach(func() {
+				instanceRef = atc.PipelineRef{
+					Name:         pipelineName,
+					InstanceVars: atc.InstanceVars{"branch": "feature"},
+				}
+			})
+
+			It("saves an instance separate from the pipeline without instance vars", func() {
+				pipeline, created, err := team.SavePipeline(atc.PipelineRef{Name: pipelineName}, config, 0, false)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(created).To(BeTrue())
+
+				instance, created, err := team.SavePipeline(instanceRef, otherConfig, 0, false)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(created).To(BeTrue())
+
+				Expect(instance.ID()).ToNot(Equal(pipeline.ID()))
+				Expect(instance.InstanceVars()).To(Equal(atc.InstanceVars{"branch": "feature"}))
+				Expect(pipeline.InstanceVars()).To(BeNil())
+			})
+
+			It("finds the instance by its instance vars", func() {
+				instance, _, err := team.SavePipeline(instanceRef, config, 0, false)
+				Expect(err).ToNot(HaveOccurred())
+
+				found, ok, err := team.Pipeline(instanceRef)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(ok).To(BeTrue())
+				Expect(found.ID()).To(Equal(instance.ID()))
+
+				_, ok, err = team.Pipeline(atc.PipelineRef{Name: pipelineName})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(ok).To(BeFalse())
+			})
+
+			It("updates the existing instance", func() {
+				instance, _, err := team.SavePipeline(instanceRef, config, 0, false)
+				Expect(err).ToNot(HaveOccurred())
+
+				updated, created, err := team.SavePipeline(instanceRef, otherConfig, instance.ConfigVersion(), false)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(created).To(BeFalse())
+				Expect(updated.ID()).To(Equal(instance.ID()))
+			})
+		})
+
 		It("requests schedule on the pipeline", func() {
-			requestedPipeline, _, err := team.SavePipeline(pipelineName, config, 0, false)
+			requestedPipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: pipelineName}, config, 0, false)
 			Expect(err).ToNot(HaveOccurred())
 
-			otherPipeline, _, err := team.SavePipeline("other-pipeline", otherConfig, 0, false)
+			otherPipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: "other-pipeline"}, otherConfig, 0, false)
 			Expect(err).ToNot(HaveOccurred())
 
 			requestedJob, found, err := requestedPipeline.Job("some-job")
@@ -1707,7 +1869,7 @@ var _ = Describe("Team", func() {
 				"source-other-config": "some-other-value",
 			}
 
-			_, _, err = team.SavePipeline(pipelineName, config, requestedPipeline.ConfigVersion(), false)
+			_, _, err = team.SavePipeline(atc.PipelineRef{Name: pipelineName}, config, requestedPipeline.ConfigVersion(), false)
 			Expect(err).ToNot(HaveOccurred())
 
 			found, err = requestedJob.Reload()
@@ -1723,7 +1885,7 @@ var _ = Describe("Team", func() {
 		})
 
 		It("creates all of the resources from the pipeline in the database", func() {
-			savedPipeline, _, err := team.SavePipeline(pipelineName, config, 0, false)
+			savedPipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: pipelineName}, config, 0, false)
 			Expect(err).ToNot(HaveOccurred())
 
 			resource, found, err := savedPipeline.Resource("some-resource")
@@ -1736,14 +1898,14 @@ var _ = Describe("Team", func() {
 		})
 
 		It("updates resource config", func() {
-			pipeline, _, err := team.SavePipeline(pipelineName, config, 0, false)
+			pipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: pipelineName}, config, 0, false)
 			Expect(err).ToNot(HaveOccurred())
 
 			config.Resources[0].Source = atc.Source{
 				"source-other-config": "some-other-value",
 			}
 
-			savedPipeline, _, err := team.SavePipeline(pipelineName, config, pipeline.ConfigVersion(), false)
+			savedPipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: pipelineName}, config, pipeline.ConfigVersion(), false)
 			Expect(err).ToNot(HaveOccurred())
 
 			resource, found, err := savedPipeline.Resource("some-resource")
@@ -1756,7 +1918,7 @@ var _ = Describe("Team", func() {
 		})
 
 		It("clears out api pinned version when resaving a pinned version on the pipeline config", func() {
-			pipeline, _, err := team.SavePipeline(pipelineName, config, 0, false)
+			pipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: pipelineName}, config, 0, false)
 			Expect(err).ToNot(HaveOccurred())
 
 			resource, found, err := pipeline.Resource("some-resource")
@@ -1800,7 +1962,7 @@ var _ = Describe("Team", func() {
 				"version": "v2",
 			}
 
-			savedPipeline, _, err := team.SavePipeline(pipelineName, config, pipeline.ConfigVersion(), false)
+			savedPipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: pipelineName}, config, pipeline.ConfigVersion(), false)
 			Expect(err).ToNot(HaveOccurred())
 
 			resource, found, err = savedPipeline.Resource("some-resource")
@@ -1811,7 +1973,7 @@ var _ = Describe("Team", func() {
 		})
 
 		It("does not clear the api pinned version when resaving pipeline config", func() {
-			pipeline, _, err := team.SavePipeline(pipelineName, config, 0, false)
+			pipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: pipelineName}, config, 0, false)
 			Expect(err).ToNot(HaveOccurred())
 
 			resource, found, err := pipeline.Resource("some-resource")
@@ -1851,7 +2013,7 @@ var _ = Describe("Team", func() {
 			Expect(reloaded).To(BeTrue())
 			Expect(resource.APIPinnedVersion()).To(Equal(atc.Version{"version": "v1"}))
 
-			savedPipeline, _, err := team.SavePipeline(pipelineName, config, pipeline.ConfigVersion(), false)
+			savedPipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: pipelineName}, config, pipeline.ConfigVersion(), false)
 			Expect(err).ToNot(HaveOccurred())
 
 			resource, found, err = savedPipeline.Resource("some-resource")
@@ -1861,7 +2023,7 @@ var _ = Describe("Team", func() {
 		})
 
 		It("marks resource as inactive if it is no longer in config", func() {
-			pipeline, _, err := team.SavePipeline(pipelineName, config, 0, false)
+			pipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: pipelineName}, config, 0, false)
 			Expect(err).ToNot(HaveOccurred())
 
 			config.Resources = []atc.ResourceConfig{}
@@ -1905,7 +2067,7 @@ var _ = Describe("Team", func() {
 				},
 			}
 
-			savedPipeline, _, err := team.SavePipeline(pipelineName, config, pipeline.ConfigVersion(), false)
+			savedPipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: pipelineName}, config, pipeline.ConfigVersion(), false)
 			Expect(err).ToNot(HaveOccurred())
 
 			_, found, err := savedPipeline.Resource("some-other-resource")
@@ -1914,7 +2076,7 @@ var _ = Describe("Team", func() {
 		})
 
 		It("creates all of the resource types from the pipeline in the database", func() {
-			savedPipeline, _, err := team.SavePipeline(pipelineName, config, 0, false)
+			savedPipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: pipelineName}, config, 0, false)
 			Expect(err).ToNot(HaveOccurred())
 
 			resourceType, found, err := savedPipeline.ResourceType("some-resource-type")
@@ -1927,14 +2089,14 @@ var _ = Describe("Team", func() {
 		})
 
 		It("updates resource type config from the pipeline in the database", func() {
-			pipeline, _, err := team.SavePipeline(pipelineName, config, 0, false)
+			pipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: pipelineName}, config, 0, false)
 			Expect(err).ToNot(HaveOccurred())
 
 			config.ResourceTypes[0].Source = atc.Source{
 				"source-other-config": "some-other-value",
 			}
 
-			savedPipeline, _, err := team.SavePipeline(pipelineName, config, pipeline.ConfigVersion(), false)
+			savedPipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: pipelineName}, config, pipeline.ConfigVersion(), false)
 			Expect(err).ToNot(HaveOccurred())
 
 			resourceType, found, err := savedPipeline.ResourceType("some-resource-type")
@@ -1947,12 +2109,12 @@ var _ = Describe("Team", func() {
 		})
 
 		It("marks resource type as inactive if it is no longer in config", func() {
-			pipeline, _, err := team.SavePipeline(pipelineName, config, 0, false)
+			pipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: pipelineName}, config, 0, false)
 			Expect(err).ToNot(HaveOccurred())
 
 			config.ResourceTypes = []atc.ResourceType{}
 
-			savedPipeline, _, err := team.SavePipeline(pipelineName, config, pipeline.ConfigVersion(), false)
+			savedPipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: pipelineName}, config, pipeline.ConfigVersion(), false)
 			Expect(err).ToNot(HaveOccurred())
 
 			_, found, err := savedPipeline.ResourceType("some-resource-type")
@@ -1961,7 +2123,7 @@ var _ = Describe("Team", func() {
 		})
 
 		It("creates all of the jobs from the pipeline in the database", func() {
-			savedPipeline, _, err := team.SavePipeline(pipelineName, config, 0, false)
+			savedPipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: pipelineName}, config, 0, false)
 			Expect(err).ToNot(HaveOccurred())
 
 			job, found, err := savedPipeline.Job("some-job")
@@ -1971,12 +2133,12 @@ var _ = Describe("Team", func() {
 		})
 
 		It("updates job config", func() {
-			pipeline, _, err := team.SavePipeline(pipelineName, config, 0, false)
+			pipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: pipelineName}, config, 0, false)
 			Expect(err).ToNot(HaveOccurred())
 
 			config.Jobs[0].Public = false
 
-			_, _, err = team.SavePipeline(pipelineName, config, pipeline.ConfigVersion(), false)
+			_, _, err = team.SavePipeline(atc.PipelineRef{Name: pipelineName}, config, pipeline.ConfigVersion(), false)
 			Expect(err).ToNot(HaveOccurred())
 
 			job, found, err := pipeline.Job("some-job")
@@ -1986,12 +2148,12 @@ var _ = Describe("Team", func() {
 		})
 
 		It("marks job inactive when it is no longer in pipeline", func() {
-			pipeline, _, err := team.SavePipeline(pipelineName, config, 0, false)
+			pipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: pipelineName}, config, 0, false)
 			Expect(err).ToNot(HaveOccurred())
 
 			config.Jobs = []atc.JobConfig{}
 
-			savedPipeline, _, err := team.SavePipeline(pipelineName, config, pipeline.ConfigVersion(), false)
+			savedPipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: pipelineName}, config, pipeline.ConfigVersion(), false)
 			Expect(err).ToNot(HaveOccurred())
 
 			_, found, err := savedPipeline.Job("some-job")
@@ -2040,7 +2202,7 @@ var _ = Describe("Team", func() {
 			})
 
 			It("should handle when there are multiple name changes", func() {
-				pipeline, _, err := team.SavePipeline(pipelineName, config, 0, false)
+				pipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: pipelineName}, config, 0, false)
 				Expect(err).ToNot(HaveOccurred())
 
 				job, _, _ := pipeline.Job("some-job")
@@ -2052,7 +2214,7 @@ var _ = Describe("Team", func() {
 				config.Jobs[3].Name = "new-other-job"
 				config.Jobs[3].OldName = "new-job"
 
-				updatedPipeline, _, err := team.SavePipeline(pipelineName, config, pipeline.ConfigVersion(), false)
+				updatedPipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: pipelineName}, config, pipeline.ConfigVersion(), false)
 				Expect(err).ToNot(HaveOccurred())
 
 				updatedJob, _, _ := updatedPipeline.Job("new-job")
@@ -2063,7 +2225,7 @@ var _ = Describe("Team", func() {
 			})
 
 			It("should return an error when there is a swap with job name", func() {
-				pipeline, _, err := team.SavePipeline(pipelineName, config, 0, false)
+				pipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: pipelineName}, config, 0, false)
 				Expect(err).ToNot(HaveOccurred())
 
 				config.Jobs[0].Name = "new-job"
@@ -2072,31 +2234,31 @@ var _ = Describe("Team", func() {
 				config.Jobs[1].Name = "some-job"
 				config.Jobs[1].OldName = "new-job"
 
-				_, _, err = team.SavePipeline(pipelineName, config, pipeline.ConfigVersion(), false)
+				_, _, err = team.SavePipeline(atc.PipelineRef{Name: pipelineName}, config, pipeline.ConfigVersion(), false)
 				Expect(err).To(HaveOccurred())
 			})
 
 			Context("when new job name is in database but is inactive", func() {
 				It("should successfully update job name", func() {
-					pipeline, _, err := team.SavePipeline(pipelineName, config, 0, false)
+					pipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: pipelineName}, config, 0, false)
 					Expect(err).ToNot(HaveOccurred())
 
 					config.Jobs = config.Jobs[:len(config.Jobs)-1]
 
-					_, _, err = team.SavePipeline(pipelineName, config, pipeline.ConfigVersion(), false)
+					_, _, err = team.SavePipeline(atc.PipelineRef{Name: pipelineName}, config, pipeline.ConfigVersion(), false)
 					Expect(err).ToNot(HaveOccurred())
 
 					config.Jobs[0].Name = "new-job"
 					config.Jobs[0].OldName = "some-job"
 
-					_, _, err = team.SavePipeline(pipelineName, config, pipeline.ConfigVersion()+1, false)
+					_, _, err = team.SavePipeline(atc.PipelineRef{Name: pipelineName}, config, pipeline.ConfigVersion()+1, false)
 					Expect(err).ToNot(HaveOccurred())
 				})
 			})
 		})
 
 		It("removes task caches for jobs that are no longer in pipeline", func() {
-			pipeline, _, err := team.SavePipeline(pipelineName, config, 0, false)
+			pipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: pipelineName}, config, 0, false)
 			Expect(err).ToNot(HaveOccurred())
 
 			job, found, err := pipeline.Job("some-job")
@@ -2112,7 +2274,7 @@ var _ = Describe("Team", func() {
 
 			config.Jobs = []atc.JobConfig{}
 
-			_, _, err = team.SavePipeline(pipelineName, config, pipeline.ConfigVersion(), false)
+			_, _, err = team.SavePipeline(atc.PipelineRef{Name: pipelineName}, config, pipeline.ConfigVersion(), false)
 			Expect(err).ToNot(HaveOccurred())
 
 			_, found, err = taskCacheFactory.Find(job.ID(), "some-task", "some-path")
@@ -2121,7 +2283,7 @@ var _ = Describe("Team", func() {
 		})
 
 		It("removes task caches for tasks that are no longer exist", func() {
-			pipeline, _, err := team.SavePipeline(pipelineName, config, 0, false)
+			pipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: pipelineName}, config, 0, false)
 			Expect(err).ToNot(HaveOccurred())
 
 			job, found, err := pipeline.Job("some-job")
@@ -2147,7 +2309,7 @@ var _ = Describe("Team", func() {
 				},
 			}
 
-			_, _, err = team.SavePipeline(pipelineName, config, pipeline.ConfigVersion(), false)
+			_, _, err = team.SavePipeline(atc.PipelineRef{Name: pipelineName}, config, pipeline.ConfigVersion(), false)
 			Expect(err).ToNot(HaveOccurred())
 
 			_, found, err = taskCacheFactory.Find(job.ID(), "some-task", "some-path")
@@ -2156,10 +2318,10 @@ var _ = Describe("Team", func() {
 		})
 
 		It("should not remove task caches in other pipeline", func() {
-			pipeline, _, err := team.SavePipeline(pipelineName, config, 0, false)
+			pipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: pipelineName}, config, 0, false)
 			Expect(err).ToNot(HaveOccurred())
 
-			otherPipeline, _, err := team.SavePipeline("other-pipeline", config, 0, false)
+			otherPipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: "other-pipeline"}, config, 0, false)
 			Expect(err).ToNot(HaveOccurred())
 
 			job, found, err := pipeline.Job("some-job")
@@ -2196,7 +2358,7 @@ var _ = Describe("Team", func() {
 				},
 			}
 
-			_, _, err = team.SavePipeline(pipelineName, config, pipeline.ConfigVersion(), false)
+			_, _, err = team.SavePipeline(atc.PipelineRef{Name: pipelineName}, config, pipeline.ConfigVersion(), false)
 			Expect(err).ToNot(HaveOccurred())
 
 			_, found, err = taskCacheFactory.Find(job.ID(), "some-task", "some-path")
@@ -2209,7 +2371,7 @@ var _ = Describe("Team", func() {
 		})
 
 		It("creates all of the serial groups from the jobs in the database", func() {
-			savedPipeline, _, err := team.SavePipeline(pipelineName, config, 0, false)
+			savedPipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: pipelineName}, config, 0, false)
 			Expect(err).ToNot(HaveOccurred())
 
 			serialGroups := []SerialGroup{}
@@ -2240,7 +2402,7 @@ var _ = Describe("Team", func() {
 		})
 
 		It("saves tags in the jobs table", func() {
-			savedPipeline, _, err := team.SavePipeline(pipelineName, otherConfig, 0, false)
+			savedPipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: pipelineName}, otherConfig, 0, false)
 			Expect(err).ToNot(HaveOccurred())
 
 			job, found, err := savedPipeline.Job("some-other-job")
@@ -2251,7 +2413,7 @@ var _ = Describe("Team", func() {
 		})
 
 		It("updates tags in the jobs table", func() {
-			savedPipeline, _, err := team.SavePipeline(pipelineName, otherConfig, 0, false)
+			savedPipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: pipelineName}, otherConfig, 0, false)
 			Expect(err).ToNot(HaveOccurred())
 
 			job, found, err := savedPipeline.Job("some-other-job")
@@ -2271,7 +2433,7 @@ var _ = Describe("Team", func() {
 				},
 			}
 
-			savedPipeline, _, err = team.SavePipeline(pipelineName, otherConfig, savedPipeline.ConfigVersion(), false)
+			savedPipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: pipelineName}, otherConfig, savedPipeline.ConfigVersion(), false)
 			Expect(err).ToNot(HaveOccurred())
 
 			job, found, err = savedPipeline.Job("some-other-job")
@@ -2282,10 +2444,10 @@ var _ = Describe("Team", func() {
 		})
 
 		It("it returns created as false when updated", func() {
-			pipeline, _, err := team.SavePipeline(pipelineName, config, 0, false)
+			pipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: pipelineName}, config, 0, false)
 			Expect(err).ToNot(HaveOccurred())
 
-			_, created, err := team.SavePipeline(pipelineName, config, pipeline.ConfigVersion(), false)
+			_, created, err := team.SavePipeline(atc.PipelineRef{Name: pipelineName}, config, pipeline.ConfigVersion(), false)
 			Expect(err).ToNot(HaveOccurred())
 			Expect(created).To(BeFalse())
 		})
@@ -2382,7 +2544,7 @@ var _ = Describe("Team", func() {
 				},
 			}
 
-			pipeline, _, err := team.SavePipeline(pipelineName, config, 0, true)
+			pipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: pipelineName}, config, 0, true)
 			Expect(err).ToNot(HaveOccurred())
 
 			rows, err := psql.Select("name", "job_id", "resource_id", "passed_job_id").
@@ -2530,7 +2692,7 @@ var _ = Describe("Team", func() {
 				},
 			}
 
-			_, _, err = team.SavePipeline(pipelineName, config, pipeline.ConfigVersion(), false)
+			_, _, err = team.SavePipeline(atc.PipelineRef{Name: pipelineName}, config, pipeline.ConfigVersion(), false)
 			Expect(err).ToNot(HaveOccurred())
 
 			rows, err = psql.Select("name", "job_id", "resource_id", "passed_job_id").
@@ -2575,47 +2737,47 @@ var _ = Describe("Team", func() {
 
 		Context("updating an existing pipeline", func() {
 			It("maintains paused if the pipeline is paused", func() {
-				_, _, err := team.SavePipeline(pipelineName, config, 0, true)
+				_, _, err := team.SavePipeline(atc.PipelineRef{Name: pipelineName}, config, 0, true)
 				Expect(err).ToNot(HaveOccurred())
 
-				pipeline, found, err := team.Pipeline(pipelineName)
+				pipeline, found, err := team.Pipeline(atc.PipelineRef{Name: pipelineName})
 				Expect(err).ToNot(HaveOccurred())
 				Expect(found).To(BeTrue())
 				Expect(pipeline.Paused()).To(BeTrue())
 
-				_, _, err = team.SavePipeline(pipelineName, config, pipeline.ConfigVersion(), false)
+				_, _, err = team.SavePipeline(atc.PipelineRef{Name: pipelineName}, config, pipeline.ConfigVersion(), false)
 				Expect(err).ToNot(HaveOccurred())
 
-				pipeline, found, err = team.Pipeline(pipelineName)
+				pipeline, found, err = team.Pipeline(atc.PipelineRef{Name: pipelineName})
 				Expect(err).ToNot(HaveOccurred())
 				Expect(found).To(BeTrue())
 				Expect(pipeline.Paused()).To(BeTrue())
 			})
 
 			It("maintains unpaused if the pipeline is unpaused", func() {
-				_, _, err := team.SavePipeline(pipelineName, config, 0, false)
+				_, _, err := team.SavePipeline(atc.PipelineRef{Name: pipelineName}, config, 0, false)
 				Expect(err).ToNot(HaveOccurred())
 
-				pipeline, found, err := team.Pipeline(pipelineName)
+				pipeline, found, err := team.Pipeline(atc.PipelineRef{Name: pipelineName})
 				Expect(err).ToNot(HaveOccurred())
 				Expect(found).To(BeTrue())
 				Expect(pipeline.Paused()).To(BeFalse())
 
-				_, _, err = team.SavePipeline(pipelineName, config, pipeline.ConfigVersion(), true)
+				_, _, err = team.SavePipeline(atc.PipelineRef{Name: pipelineName}, config, pipeline.ConfigVersion(), true)
 				Expect(err).ToNot(HaveOccurred())
 
-				pipeline, found, err = team.Pipeline(pipelineName)
+				pipeline, found, err = team.Pipeline(atc.PipelineRef{Name: pipelineName})
 				Expect(err).ToNot(HaveOccurred())
 				Expect(found).To(BeTrue())
 				Expect(pipeline.Paused()).To(BeFalse())
 			})
 
 			It("resets to unarchived", func() {
-				team.SavePipeline(pipelineName, config, 0, false)
-				pipeline, _, _ := team.Pipeline(pipelineName)
+				team.SavePipeline(atc.PipelineRef{Name: pipelineName}, config, 0, false)
+				pipeline, _, _ := team.Pipeline(atc.PipelineRef{Name: pipelineName})
 				pipeline.Archive()
 
-				team.SavePipeline(pipelineName, config, db.ConfigVersion(0), true)
+				team.SavePipeline(atc.PipelineRef{Name: pipelineName}, config, db.ConfigVersion(0), true)
 				pipeline.Reload()
 				Expect(pipeline.Archived()).To(BeFalse(), "the pipeline remained archived")
 			})
@@ -2625,12 +2787,12 @@ var _ = Describe("Team", func() {
 			pipelineName := "a-pipeline-name"
 			otherPipelineName := "an-other-pipeline-name"
 
-			_, _, err := team.SavePipeline(pipelineName, config, 0, false)
+			_, _, err := team.SavePipeline(atc.PipelineRef{Name: pipelineName}, config, 0, false)
 			Expect(err).ToNot(HaveOccurred())
-			_, _, err = team.SavePipeline(otherPipelineName, otherConfig, 0, false)
+			_, _, err = team.SavePipeline(atc.PipelineRef{Name: otherPipelineName}, otherConfig, 0, false)
 			Expect(err).ToNot(HaveOccurred())
 
-			pipeline, found, err := team.Pipeline(pipelineName)
+			pipeline, found, err := team.Pipeline(atc.PipelineRef{Name: pipelineName})
 			Expect(err).ToNot(HaveOccurred())
 			Expect(found).To(BeTrue())
 			Expect(pipeline.Name()).To(Equal(pipelineName))
@@ -2650,7 +2812,7 @@ var _ = Describe("Team", func() {
 				Jobs:          jobConfigs,
 			}, config)
 
-			otherPipeline, found, err := team.Pipeline(otherPipelineName)
+			otherPipeline, found, err := team.Pipeline(atc.PipelineRef{Name: otherPipelineName})
 			Expect(err).ToNot(HaveOccurred())
 			Expect(found).To(BeTrue())
 			Expect(otherPipeline.Name()).To(Equal(otherPipelineName))
@@ -2677,10 +2839,10 @@ var _ = Describe("Team", func() {
 			otherPipelineName := "an-other-pipeline-name"
 
 			By("being able to save the config")
-			pipeline, _, err := team.SavePipeline(pipelineName, config, 0, false)
+			pipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: pipelineName}, config, 0, false)
 			Expect(err).ToNot(HaveOccurred())
 
-			otherPipeline, _, err := team.SavePipeline(otherPipelineName, otherConfig, 0, false)
+			otherPipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: otherPipelineName}, otherConfig, 0, false)
 			Expect(err).ToNot(HaveOccurred())
 
 			By("returning the saved config to later gets")
@@ -2754,22 +2916,22 @@ var _ = Describe("Team", func() {
 			})
 
 			By("not allowing non-sequential updates")
-			_, _, err = team.SavePipeline(pipelineName, updatedConfig, pipeline.ConfigVersion()-1, false)
+			_, _, err = team.SavePipeline(atc.PipelineRef{Name: pipelineName}, updatedConfig, pipeline.ConfigVersion()-1, false)
 			Expect(err).To(Equal(db.ErrConfigComparisonFailed))
 
-			_, _, err = team.SavePipeline(pipelineName, updatedConfig, pipeline.ConfigVersion()+10, false)
+			_, _, err = team.SavePipeline(atc.PipelineRef{Name: pipelineName}, updatedConfig, pipeline.ConfigVersion()+10, false)
 			Expect(err).To(Equal(db.ErrConfigComparisonFailed))
 
-			_, _, err = team.SavePipeline(otherPipelineName, updatedConfig, otherPipeline.ConfigVersion()-1, false)
+			_, _, err = team.SavePipeline(atc.PipelineRef{Name: otherPipelineName}, updatedConfig, otherPipeline.ConfigVersion()-1, false)
 			Expect(err).To(Equal(db.ErrConfigComparisonFailed))
 
-			_, _, err = team.SavePipeline(otherPipelineName, updatedConfig, otherPipeline.ConfigVersion()+10, false)
+			_, _, err = team.SavePipeline(atc.PipelineRef{Name: otherPipelineName}, updatedConfig, otherPipeline.ConfigVersion()+10, false)
 			Expect(err).To(Equal(db.ErrConfigComparisonFailed))
 
 			By("being able to update the config with a valid con")
-			pipeline, _, err = team.SavePipeline(pipelineName, updatedConfig, pipeline.ConfigVersion(), false)
+			pipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: pipelineName}, updatedConfig, pipeline.ConfigVersion(), false)
 			Expect(err).ToNot(HaveOccurred())
-			otherPipeline, _, err = team.SavePipeline(otherPipelineName, updatedConfig, otherPipeline.ConfigVersion(), false)
+			otherPipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: otherPipelineName}, updatedConfig, otherPipeline.ConfigVersion(), false)
 			Expect(err).ToNot(HaveOccurred())
 
 			By("returning the updated config")
@@ -2830,7 +2992,7 @@ var _ = Describe("Team", func() {
 
 			pipelineName := "a-pipeline-name"
 
-			pipeline, _, err := team.SavePipeline(pipelineName, config, 0, false)
+			pipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: pipelineName}, config, 0, false)
 			Expect(err).ToNot(HaveOccurred())
 
 			resourceTypes, err := pipeline.ResourceTypes()
@@ -2848,27 +3010,27 @@ var _ = Describe("Team", func() {
 
 		Context("when there are multiple teams", func() {
 			It("can allow pipelines with the same name across teams", func() {
-				teamPipeline, _, err := team.SavePipeline("steve", config, 0, true)
+				teamPipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: "steve"}, config, 0, true)
 				Expect(err).ToNot(HaveOccurred())
 				Expect(teamPipeline.Paused()).To(BeTrue())
 
 				By("allowing you to save a pipeline with the same name in another team")
-				otherTeamPipeline, _, err := otherTeam.SavePipeline("steve", otherConfig, 0, true)
+				otherTeamPipeline, _, err := otherTeam.SavePipeline(atc.PipelineRef{Name: "steve"}, otherConfig, 0, true)
 				Expect(err).ToNot(HaveOccurred())
 				Expect(otherTeamPipeline.Paused()).To(BeTrue())
 
 				By("updating the pipeline config for the correct team's pipeline")
-				teamPipeline, _, err = team.SavePipeline("steve", otherConfig, teamPipeline.ConfigVersion(), false)
+				teamPipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "steve"}, otherConfig, teamPipeline.ConfigVersion(), false)
 				Expect(err).ToNot(HaveOccurred())
 
-				_, _, err = otherTeam.SavePipeline("steve", config, otherTeamPipeline.ConfigVersion(), false)
+				_, _, err = otherTeam.SavePipeline(atc.PipelineRef{Name: "steve"}, config, otherTeamPipeline.ConfigVersion(), false)
 				Expect(err).ToNot(HaveOccurred())
 
 				By("cannot cross update configs")
-				_, _, err = team.SavePipeline("steve", otherConfig, otherTeamPipeline.ConfigVersion(), false)
+				_, _, err = team.SavePipeline(atc.PipelineRef{Name: "steve"}, otherConfig, otherTeamPipeline.ConfigVersion(), false)
 				Expect(err).To(HaveOccurred())
 
-				_, _, err = team.SavePipeline("steve", otherConfig, otherTeamPipeline.ConfigVersion(), true)
+				_, _, err = team.SavePipeline(atc.PipelineRef{Name: "steve"}, otherConfig, otherTeamPipeline.ConfigVersion(), true)
 				Expect(err).To(HaveOccurred())
 			})
 		})
@@ -2920,7 +3082,7 @@ var _ = Describe("Team", func() {
 					})
 
 					It("returns check container for resource", func() {
-						containers, checkContainersExpiresAt, err := defaultTeam.FindCheckContainers(logger, "default-pipeline", "some-resource", fakeSecretManager, fakeVarSourcePool)
+						containers, checkContainersExpiresAt, err := defaultTeam.FindCheckContainers(logger, atc.PipelineRef{Name: "default-pipeline"}, "some-resource", fakeSecretManager, fakeVarSourcePool)
 						Expect(err).ToNot(HaveOccurred())
 						Expect(containers).To(HaveLen(1))
 						Expect(containers[0].ID()).To(Equal(resourceContainer.ID()))
@@ -2938,7 +3100,7 @@ var _ = Describe("Team", func() {
 						)
 
 						BeforeEach(func() {
-							otherPipeline, _, err = defaultTeam.SavePipeline("other-pipeline", atc.Config{
+							otherPipeline, _, err = defaultTeam.SavePipeline(atc.PipelineRef{Name: "other-pipeline"}, atc.Config{
 								Resources: atc.ResourceConfigs{
 									{
 										Name: "some-resource",
@@ -2973,7 +3135,7 @@ var _ = Describe("Team", func() {
 						})
 
 						It("returns the same check container", func() {
-							containers, checkContainersExpiresAt, err := defaultTeam.FindCheckContainers(logger, "other-pipeline", "some-resource", fakeSecretManager, fakeVarSourcePool)
+							containers, checkContainersExpiresAt, err := defaultTeam.FindCheckContainers(logger, atc.PipelineRef{Name: "other-pipeline"}, "some-resource", fakeSecretManager, fakeVarSourcePool)
 							Expect(err).ToNot(HaveOccurred())
 							Expect(containers).To(HaveLen(1))
 							Expect(containers[0].ID()).To(Equal(otherResourceContainer.ID()))
@@ -2986,7 +3148,7 @@ var _ = Describe("Team", func() {
 
 				Context("when check container does not exist", func() {
 					It("returns empty list", func() {
-						containers, checkContainersExpiresAt, err := defaultTeam.FindCheckContainers(logger, "default-pipeline", "some-resource", fakeSecretManager, fakeVarSourcePool)
+						containers, checkContainersExpiresAt, err := defaultTeam.FindCheckContainers(logger, atc.PipelineRef{Name: "default-pipeline"}, "some-resource", fakeSecretManager, fakeVarSourcePool)
 						Expect(err).ToNot(HaveOccurred())
 						Expect(containers).To(BeEmpty())
 						Expect(checkContainersExpiresAt).To(BeEmpty())
@@ -2996,7 +3158,7 @@ var _ = Describe("Team", func() {
 
 			Context("when resource does not exist", func() {
 				It("returns empty list", func() {
-					containers, checkContainersExpiresAt, err := defaultTeam.FindCheckContainers(logger, "default-pipeline", "non-existent-resource", fakeSecretManager, fakeVarSourcePool)
+					containers, checkContainersExpiresAt, err := defaultTeam.FindCheckContainers(logger, atc.PipelineRef{Name: "default-pipeline"}, "non-existent-resource", fakeSecretManager, fakeVarSourcePool)
 					Expect(err).ToNot(HaveOccurred())
 					Expect(containers).To(BeEmpty())
 					Expect(checkContainersExpiresAt).To(BeEmpty())
@@ -3006,7 +3168,7 @@ var _ = Describe("Team", func() {
 
 		Context("when pipeline does not exist", func() {
 			It("returns empty list", func() {
-				containers, checkContainersExpiresAt, err := defaultTeam.FindCheckContainers(logger, "non-existent-pipeline", "some-resource", fakeSecretManager, fakeVarSourcePool)
+				containers, checkContainersExpiresAt, err := defaultTeam.FindCheckContainers(logger, atc.PipelineRef{Name: "non-existent-pipeline"}, "some-resource", fakeSecretManager, fakeVarSourcePool)
 				Expect(err).ToNot(HaveOccurred())
 				Expect(containers).To(BeEmpty())
 				Expect(checkContainersExpiresAt).To(BeEmpty())
@@ -23,6 +23,7 @@ type VolumeRepository interface {
 	FindResourceCacheVolume(workerName string, resourceCache UsedResourceCache) (CreatedVolume, bool, error)
 
 	FindTaskCacheVolume(teamID int, workerName string, taskCache UsedTaskCache) (CreatedVolume, bool, error)
+	FindTaskCacheVolumes(teamID int, taskCache UsedTaskCache) ([]CreatedVolume, error)
 	CreateTaskCacheVolume(teamID int, uwtc *UsedWorkerTaskCache) (CreatingVolume, error)
 
 	FindResourceCertsVolume(workerName string, uwrc *UsedWorkerResourceCerts) (CreatingVolume, CreatedVolume, error)
@@ -384,6 +385,45 @@ func (repository *volumeRepository) FindTaskCacheVolume(teamID int, workerName s
 	return createdVolume, true, nil
 }
 
+// FindTaskCacheVolumes returns the volumes for the task cache on every
+// worker.
+func (repository *volumeRepository) FindTaskCacheVolumes(teamID int, taskCache UsedTaskCache) ([]CreatedVolume, error) {
+	query, args, err := psql.Select(volumeColumns...).
+		From("volumes v").
+		LeftJoin("workers w ON v.worker_name = w.name").
+		LeftJoin("containers c ON v.container_id = c.id").
+		LeftJoin("volumes pv ON v.parent_id = pv.id").
+		LeftJoin("worker_resource_caches wrc ON wrc.id = v.worker_resource_cache_id").
+		Join("worker_task_caches wtc ON wtc.id = v.worker_task_cache_id").
+		Where(sq.Eq{
+			"wtc.task_cache_id": taskCache.ID(),
+			"v.team_id":         teamID,
+			"v.state":           VolumeStateCreated,
+		}).ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := repository.conn.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer Close(rows)
+
+	var createdVolumes []CreatedVolume
+
+	for rows.Next() {
+		_, createdVolume, _, _, err := scanVolume(rows, repository.conn)
+		if err != nil {
+			return nil, err
+		}
+
+		createdVolumes = append(createdVolumes, createdVolume)
+	}
+
+	return createdVolumes, nil
+}
+
 func (repository *volumeRepository) CreateTaskCacheVolume(teamID int, uwtc *UsedWorkerTaskCache) (CreatingVolume, error) {
 	volume, err := repository.createVolume(
 		teamID,
@@ -540,6 +540,58 @@ var _ = Describe("VolumeRepository", func() {
 		})
 	})
 
+	Describe("FindTaskCacheVolumes", func() {
+		var usedTaskCache db.UsedTaskCache
+
+		BeforeEach(func() {
+			var err error
+			usedTaskCache, err = taskCacheFactory.FindOrCreate(defaultJob.ID(), "some-step", "some-cache-path")
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		Context("when there are no volumes for the task cache", func() {
+			It("returns none", func() {
+				volumes, err := volumeRepository.FindTaskCacheVolumes(defaultTeam.ID(), usedTaskCache)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(volumes).To(BeEmpty())
+			})
+		})
+
+		Context("when there is a created volume for the task cache", func() {
+			var existingVolume db.CreatedVolume
+
+			BeforeEach(func() {
+				creatingContainer, err := defaultWorker.CreateContainer(db.NewBuildStepContainerOwner(build.ID(), "some-plan", defaultTeam.ID()), db.ContainerMetadata{})
+				Expect(err).NotTo(HaveOccurred())
+
+				creatingVolume, err := volumeRepository.CreateContainerVolume(defaultTeam.ID(), defaultWorker.Name(), creatingContainer, "some-output")
+				Expect(err).NotTo(HaveOccurred())
+
+				existingVolume, err = creatingVolume.Created()
+				Expect(err).NotTo(HaveOccurred())
+
+				err = existingVolume.InitializeTaskCache(defaultJob.ID(), "some-step", "some-cache-path")
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("returns it", func() {
+				volumes, err := volumeRepository.FindTaskCacheVolumes(defaultTeam.ID(), usedTaskCache)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(volumes).To(HaveLen(1))
+				Expect(volumes[0].Handle()).To(Equal(existingVolume.Handle()))
+			})
+
+			It("does not return it for other teams", func() {
+				otherTeam, err := teamFactory.CreateTeam(atc.Team{Name: "some-other-team"})
+				Expect(err).NotTo(HaveOccurred())
+
+				volumes, err := volumeRepository.FindTaskCacheVolumes(otherTeam.ID(), usedTaskCache)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(volumes).To(BeEmpty())
+			})
+		})
+	})
+
 	Describe("RemoveDestroyingVolumes", func() {
 		var failedErr error
 		var numDeleted int
@@ -20,10 +20,18 @@ func NewArtifactLifecycle(conn Conn) *artifactLifecycle {
 	}
 }
 
+// RemoveExpiredArtifacts removes artifacts created more than 12 hours ago.
+// Artifacts kept by a job's build live for as long as the build's logs, and
+// are only removed once the build has been reaped.
 func (lifecycle *artifactLifecycle) RemoveExpiredArtifacts() error {
-
 	_, err := psql.Delete("worker_artifacts").
 		Where(sq.Expr("created_at < NOW() - interval '12 hours'")).
+		Where(sq.Expr(`NOT EXISTS (
+			SELECT 1 FROM builds b
+			WHERE b.id = worker_artifacts.build_id
+			AND b.job_id IS NOT NULL
+			AND b.reap_time IS NULL
+		)`)).
 		RunWith(lifecycle.conn).
 		Exec()
 
@@ -51,5 +51,39 @@ var _ = Describe("WorkerArtifactLifecycle", func() {
 				Expect(count).To(Equal(1))
 			})
 		})
+
+		Context("when the artifact was kept by a job's build", func() {
+			var build db.Build
+
+			BeforeEach(func() {
+				var err error
+				build, err = defaultJob.CreateBuild()
+				Expect(err).ToNot(HaveOccurred())
+
+				_, err = dbConn.Exec("INSERT INTO worker_artifacts(name, build_id, created_at) VALUES('some-name', $1, NOW() - '13 hours'::interval)", build.ID())
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("keeps the artifact for as long as the build", func() {
+				var count int
+				err := dbConn.QueryRow("SELECT count(*) from worker_artifacts").Scan(&count)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(count).To(Equal(1))
+			})
+
+			Context("once the build has been reaped", func() {
+				BeforeEach(func() {
+					err := defaultPipeline.DeleteBuildEventsByBuildIDs([]int{build.ID()})
+					Expect(err).ToNot(HaveOccurred())
+				})
+
+				It("removes the record", func() {
+					var count int
+					err := dbConn.QueryRow("SELECT count(*) from worker_artifacts").Scan(&count)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(count).To(Equal(0))
+				})
+			})
+		})
 	})
 })
@@ -1,6 +1,7 @@
 package builder
 
 import (
+	"code.cloudfoundry.org/clock"
 	"code.cloudfoundry.org/lager"
 	"errors"
 	"fmt"
@@ -25,6 +26,7 @@ type StepFactory interface {
 	CheckStep(atc.Plan, exec.StepMetadata, db.ContainerMetadata, exec.CheckDelegate) exec.Step
 	SetPipelineStep(atc.Plan, exec.StepMetadata, exec.BuildStepDelegate) exec.Step
 	LoadVarStep(atc.Plan, exec.StepMetadata, exec.BuildStepDelegate) exec.Step
+	ApproveStep(atc.Plan, db.Build, exec.BuildStepDelegate) exec.Step
 	ArtifactInputStep(atc.Plan, db.Build, exec.BuildStepDelegate) exec.Step
 	ArtifactOutputStep(atc.Plan, db.Build, exec.BuildStepDelegate) exec.Step
 }
@@ -46,6 +48,7 @@ func NewStepBuilder(
 	secrets creds.Secrets,
 	varSourcePool creds.VarSourcePool,
 	redactSecrets bool,
+	clock clock.Clock,
 ) *stepBuilder {
 	return &stepBuilder{
 		stepFactory:     stepFactory,
@@ -54,6 +57,7 @@ func NewStepBuilder(
 		globalSecrets:   secrets,
 		varSourcePool:   varSourcePool,
 		redactSecrets:   redactSecrets,
+		clock:           clock,
 	}
 }
 
@@ -64,6 +68,7 @@ type stepBuilder struct {
 	globalSecrets   creds.Secrets
 	varSourcePool   creds.VarSourcePool
 	redactSecrets   bool
+	clock           clock.Clock
 }
 
 func (builder *stepBuilder) BuildStep(logger lager.Logger, build db.Build) (exec.Step, error) {
@@ -139,6 +144,10 @@ func (builder *stepBuilder) buildStep(build db.Build, plan atc.Plan, credVarsTra
 		return builder.buildParallelStep(build, plan, credVarsTracker)
 	}
 
+	if plan.Across != nil {
+		return builder.buildAcrossStep(build, plan, credVarsTracker)
+	}
+
 	if plan.Do != nil {
 		return builder.buildDoStep(build, plan, credVarsTracker)
 	}
@@ -151,6 +160,14 @@ func (builder *stepBuilder) buildStep(build db.Build, plan atc.Plan, credVarsTra
 		return builder.buildTryStep(build, plan, credVarsTracker)
 	}
 
+	if plan.If != nil {
+		return builder.buildIfStep(build, plan, credVarsTracker)
+	}
+
+	if plan.BuildTimeout != nil {
+		return builder.buildBuildTimeoutStep(build, plan, credVarsTracker)
+	}
+
 	if plan.OnAbort != nil {
 		return builder.buildOnAbortStep(build, plan, credVarsTracker)
 	}
@@ -183,6 +200,10 @@ func (builder *stepBuilder) buildStep(build db.Build, plan atc.Plan, credVarsTra
 		return builder.buildLoadVarStep(build, plan, credVarsTracker)
 	}
 
+	if plan.Approve != nil {
+		return builder.buildApproveStep(build, plan, credVarsTracker)
+	}
+
 	if plan.Get != nil {
 		return builder.buildGetStep(build, plan, credVarsTracker)
 	}
@@ -232,6 +253,25 @@ func (builder *stepBuilder) buildParallelStep(build db.Build, plan atc.Plan, cre
 	return exec.InParallel(steps, plan.InParallel.Limit, plan.InParallel.FailFast)
 }
 
+func (builder *stepBuilder) buildAcrossStep(build db.Build, plan atc.Plan, credVarsTracker vars.CredVarsTracker) exec.Step {
+
+	var steps []exec.Step
+
+	for _, scopedPlan := range plan.Across.Steps {
+		scope := credVarsTracker.NewLocalScope()
+		for i, acrossVar := range plan.Across.Vars {
+			scope.AddLocalVar(acrossVar.Var, scopedPlan.Values[i], false)
+		}
+
+		innerPlan := scopedPlan.Step
+		innerPlan.Attempts = plan.Attempts
+		step := builder.buildStep(build, innerPlan, scope)
+		steps = append(steps, step)
+	}
+
+	return exec.Across(plan.Across.Vars, steps, plan.Across.FailFast)
+}
+
 func (builder *stepBuilder) buildDoStep(build db.Build, plan atc.Plan, credVarsTracker vars.CredVarsTracker) exec.Step {
 
 	var step exec.Step = exec.IdentityStep{}
@@ -260,6 +300,22 @@ func (builder *stepBuilder) buildTryStep(build db.Build, plan atc.Plan, credVars
 	return exec.Try(step)
 }
 
+func (builder *stepBuilder) buildIfStep(build db.Build, plan atc.Plan, credVarsTracker vars.CredVarsTracker) exec.Step {
+	innerPlan := plan.If.Step
+	innerPlan.Attempts = plan.Attempts
+	step := builder.buildStep(build, innerPlan, credVarsTracker)
+	delegate := builder.delegateFactory.BuildStepDelegate(build, plan.ID, credVarsTracker)
+	return exec.LogError(exec.If(step, plan.If.Condition, delegate), delegate)
+}
+
+func (builder *stepBuilder) buildBuildTimeoutStep(build db.Build, plan atc.Plan, credVarsTracker vars.CredVarsTracker) exec.Step {
+	innerPlan := plan.BuildTimeout.Step
+	innerPlan.Attempts = plan.Attempts
+	step := builder.buildStep(build, innerPlan, credVarsTracker)
+	delegate := builder.delegateFactory.BuildStepDelegate(build, plan.ID, credVarsTracker)
+	return exec.LogError(exec.BuildTimeout(step, plan.BuildTimeout.Duration, build.StartTime(), builder.clock), delegate)
+}
+
 func (builder *stepBuilder) buildOnAbortStep(build db.Build, plan atc.Plan, credVarsTracker vars.CredVarsTracker) exec.Step {
 	plan.OnAbort.Step.Attempts = plan.Attempts
 	step := builder.buildStep(build, plan.OnAbort.Step, credVarsTracker)
@@ -310,7 +366,9 @@ func (builder *stepBuilder) buildRetryStep(build db.Build, plan atc.Plan, credVa
 		steps = append(steps, step)
 	}
 
-	return exec.Retry(steps...)
+	delegate := builder.delegateFactory.BuildStepDelegate(build, plan.ID, credVarsTracker)
+
+	return exec.Retry(steps, plan.RetryOn, plan.RetryBackoff, delegate, builder.clock)
 }
 
 func (builder *stepBuilder) buildGetStep(build db.Build, plan atc.Plan, credVarsTracker vars.CredVarsTracker) exec.Step {
@@ -327,12 +385,16 @@ func (builder *stepBuilder) buildGetStep(build db.Build, plan atc.Plan, credVars
 		builder.externalURL,
 	)
 
-	return builder.stepFactory.GetStep(
+	delegate := builder.delegateFactory.GetDelegate(build, plan.ID, credVarsTracker)
+
+	step := builder.stepFactory.GetStep(
 		plan,
 		stepMetadata,
 		containerMetadata,
-		builder.delegateFactory.GetDelegate(build, plan.ID, credVarsTracker),
+		delegate,
 	)
+
+	return exec.Breakpoint(step, plan.ID, plan.Get.Name, build, delegate, builder.clock)
 }
 
 func (builder *stepBuilder) buildPutStep(build db.Build, plan atc.Plan, credVarsTracker vars.CredVarsTracker) exec.Step {
@@ -349,12 +411,16 @@ func (builder *stepBuilder) buildPutStep(build db.Build, plan atc.Plan, credVars
 		builder.externalURL,
 	)
 
-	return builder.stepFactory.PutStep(
+	delegate := builder.delegateFactory.PutDelegate(build, plan.ID, credVarsTracker)
+
+	step := builder.stepFactory.PutStep(
 		plan,
 		stepMetadata,
 		containerMetadata,
-		builder.delegateFactory.PutDelegate(build, plan.ID, credVarsTracker),
+		delegate,
 	)
+
+	return exec.Breakpoint(step, plan.ID, plan.Put.Name, build, delegate, builder.clock)
 }
 
 func (builder *stepBuilder) buildCheckStep(check db.Check, plan atc.Plan, credVarsTracker vars.CredVarsTracker) exec.Step {
@@ -396,12 +462,16 @@ func (builder *stepBuilder) buildTaskStep(build db.Build, plan atc.Plan, credVar
 		builder.externalURL,
 	)
 
-	return builder.stepFactory.TaskStep(
+	delegate := builder.delegateFactory.TaskDelegate(build, plan.ID, credVarsTracker)
+
+	step := builder.stepFactory.TaskStep(
 		plan,
 		stepMetadata,
 		containerMetadata,
-		builder.delegateFactory.TaskDelegate(build, plan.ID, credVarsTracker),
+		delegate,
 	)
+
+	return exec.Breakpoint(step, plan.ID, plan.Task.Name, build, delegate, builder.clock)
 }
 
 func (builder *stepBuilder) buildSetPipelineStep(build db.Build, plan atc.Plan, credVarsTracker vars.CredVarsTracker) exec.Step {
@@ -411,11 +481,15 @@ func (builder *stepBuilder) buildSetPipelineStep(build db.Build, plan atc.Plan,
 		builder.externalURL,
 	)
 
-	return builder.stepFactory.SetPipelineStep(
+	delegate := builder.delegateFactory.BuildStepDelegate(build, plan.ID, credVarsTracker)
+
+	step := builder.stepFactory.SetPipelineStep(
 		plan,
 		stepMetadata,
-		builder.delegateFactory.BuildStepDelegate(build, plan.ID, credVarsTracker),
+		delegate,
 	)
+
+	return exec.Breakpoint(step, plan.ID, plan.SetPipeline.Name, build, delegate, builder.clock)
 }
 
 func (builder *stepBuilder) buildLoadVarStep(build db.Build, plan atc.Plan, credVarsTracker vars.CredVarsTracker) exec.Step {
@@ -425,9 +499,22 @@ func (builder *stepBuilder) buildLoadVarStep(build db.Build, plan atc.Plan, cred
 		builder.externalURL,
 	)
 
-	return builder.stepFactory.LoadVarStep(
+	delegate := builder.delegateFactory.BuildStepDelegate(build, plan.ID, credVarsTracker)
+
+	step := builder.stepFactory.LoadVarStep(
 		plan,
 		stepMetadata,
+		delegate,
+	)
+
+	return exec.Breakpoint(step, plan.ID, plan.LoadVar.Name, build, delegate, builder.clock)
+}
+
+func (builder *stepBuilder) buildApproveStep(build db.Build, plan atc.Plan, credVarsTracker vars.CredVarsTracker) exec.Step {
+
+	return builder.stepFactory.ApproveStep(
+		plan,
+		build,
 		builder.delegateFactory.BuildStepDelegate(build, plan.ID, credVarsTracker),
 	)
 }
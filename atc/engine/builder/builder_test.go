@@ -1,6 +1,10 @@
 package builder_test
 
 import (
+	"context"
+	"time"
+
+	"code.cloudfoundry.org/clock/fakeclock"
 	"code.cloudfoundry.org/lager"
 	"code.cloudfoundry.org/lager/lagertest"
 	. "github.com/onsi/ginkgo"
@@ -13,6 +17,8 @@ import (
 	"github.com/concourse/concourse/atc/engine/builder"
 	"github.com/concourse/concourse/atc/engine/builder/builderfakes"
 	"github.com/concourse/concourse/atc/exec"
+	"github.com/concourse/concourse/atc/exec/execfakes"
+	"github.com/concourse/concourse/vars"
 )
 
 type StepBuilder interface {
@@ -51,6 +57,7 @@ var _ = Describe("Builder", func() {
 				fakeSecretManager,
 				fakeVarSourcePool,
 				false,
+				fakeclock.NewFakeClock(time.Now()),
 			)
 
 			planFactory = atc.NewPlanFactory(123)
@@ -75,6 +82,8 @@ var _ = Describe("Builder", func() {
 
 				expectedPlan     atc.Plan
 				expectedMetadata exec.StepMetadata
+
+				builtStep exec.Step
 			)
 
 			BeforeEach(func() {
@@ -109,7 +118,7 @@ var _ = Describe("Builder", func() {
 			JustBeforeEach(func() {
 				fakeBuild.PrivatePlanReturns(expectedPlan)
 
-				_, err = stepBuilder.BuildStep(logger, fakeBuild)
+				builtStep, err = stepBuilder.BuildStep(logger, fakeBuild)
 			})
 
 			Context("when the build has the wrong schema", func() {
@@ -333,6 +342,18 @@ var _ = Describe("Builder", func() {
 						Expect(*expectedPlan.Retry).To(HaveLen(3))
 					})
 
+					It("creates a build step delegate for each retry", func() {
+						Expect(fakeDelegateFactory.BuildStepDelegateCallCount()).To(Equal(2))
+
+						var planIDs []atc.PlanID
+						for i := 0; i < 2; i++ {
+							_, planID, _ := fakeDelegateFactory.BuildStepDelegateArgsForCall(i)
+							planIDs = append(planIDs, planID)
+						}
+
+						Expect(planIDs).To(ConsistOf(expectedPlan.ID, retryPlanTwo.ID))
+					})
+
 					It("constructs the first get correctly", func() {
 						plan, stepMetadata, containerMetadata, _ := fakeStepFactory.GetStepArgsForCall(0)
 						expectedPlan := getPlan
@@ -533,6 +554,31 @@ var _ = Describe("Builder", func() {
 								BuildName:    "42",
 							}))
 						})
+
+						Context("when the task is run", func() {
+							var fakeTaskStep *execfakes.FakeStep
+
+							BeforeEach(func() {
+								fakeTaskStep = new(execfakes.FakeStep)
+								fakeStepFactory.TaskStepReturns(fakeTaskStep)
+							})
+
+							It("checks for breakpoints before and after the task", func() {
+								fakeBuild.HasBreakpointsReturns(true, nil)
+
+								Expect(builtStep.Run(context.Background(), new(execfakes.FakeRunState))).To(Succeed())
+								Expect(fakeTaskStep.RunCallCount()).To(Equal(1))
+
+								Expect(fakeBuild.HitBreakpointCallCount()).To(Equal(2))
+								planID, name, position := fakeBuild.HitBreakpointArgsForCall(0)
+								Expect(planID).To(Equal(expectedPlan.ID))
+								Expect(name).To(Equal("some-task"))
+								Expect(position).To(Equal(atc.BreakpointBefore))
+
+								_, _, position = fakeBuild.HitBreakpointArgsForCall(1)
+								Expect(position).To(Equal(atc.BreakpointAfter))
+							})
+						})
 					})
 
 					Context("that contains a set_pipeline step", func() {
@@ -567,6 +613,110 @@ var _ = Describe("Builder", func() {
 						})
 					})
 
+					Context("that contains a conditional step", func() {
+						BeforeEach(func() {
+							expectedPlan = planFactory.NewPlan(atc.IfPlan{
+								Condition: "((deploy))",
+								Step: planFactory.NewPlan(atc.TaskPlan{
+									Name: "some-task",
+								}),
+							})
+						})
+
+						It("constructs the nested step", func() {
+							Expect(fakeStepFactory.TaskStepCallCount()).To(Equal(1))
+							plan, _, _, _ := fakeStepFactory.TaskStepArgsForCall(0)
+							Expect(plan).To(Equal(expectedPlan.If.Step))
+						})
+
+						It("creates a delegate for the conditional step", func() {
+							Expect(fakeDelegateFactory.BuildStepDelegateCallCount()).To(Equal(1))
+							_, planID, _ := fakeDelegateFactory.BuildStepDelegateArgsForCall(0)
+							Expect(planID).To(Equal(expectedPlan.ID))
+						})
+					})
+
+					Context("that has a build timeout", func() {
+						BeforeEach(func() {
+							expectedPlan = planFactory.NewPlan(atc.BuildTimeoutPlan{
+								Duration: "2h",
+								Step: planFactory.NewPlan(atc.TaskPlan{
+									Name: "some-task",
+								}),
+							})
+						})
+
+						It("constructs the nested step", func() {
+							Expect(fakeStepFactory.TaskStepCallCount()).To(Equal(1))
+							plan, _, _, _ := fakeStepFactory.TaskStepArgsForCall(0)
+							Expect(plan).To(Equal(expectedPlan.BuildTimeout.Step))
+						})
+
+						It("creates a delegate for the build timeout", func() {
+							Expect(fakeDelegateFactory.BuildStepDelegateCallCount()).To(Equal(1))
+							_, planID, _ := fakeDelegateFactory.BuildStepDelegateArgsForCall(0)
+							Expect(planID).To(Equal(expectedPlan.ID))
+						})
+					})
+
+					Context("that contains an approve step", func() {
+						BeforeEach(func() {
+							expectedPlan = planFactory.NewPlan(atc.ApprovePlan{
+								Name: "ship-it",
+								Role: "owner",
+							})
+						})
+
+						It("constructs approve correctly", func() {
+							plan, build, _ := fakeStepFactory.ApproveStepArgsForCall(0)
+							Expect(plan).To(Equal(expectedPlan))
+							Expect(build).To(Equal(fakeBuild))
+						})
+					})
+
+					Context("that contains an across step", func() {
+						BeforeEach(func() {
+							expectedPlan = planFactory.NewPlan(atc.AcrossPlan{
+								Vars: []atc.AcrossVar{
+									{
+										Var:    "go",
+										Values: []interface{}{"1.13", "1.14"},
+									},
+								},
+								Steps: []atc.VarScopedPlan{
+									{
+										Step:   planFactory.NewPlan(atc.TaskPlan{Name: "some-task"}),
+										Values: []interface{}{"1.13"},
+									},
+									{
+										Step:   planFactory.NewPlan(atc.TaskPlan{Name: "some-task"}),
+										Values: []interface{}{"1.14"},
+									},
+								},
+							})
+						})
+
+						It("constructs a step for every combination", func() {
+							Expect(fakeStepFactory.TaskStepCallCount()).To(Equal(2))
+						})
+
+						It("scopes each step's vars to its combination", func() {
+							Expect(fakeDelegateFactory.TaskDelegateCallCount()).To(Equal(2))
+
+							_, _, credVarsTracker := fakeDelegateFactory.TaskDelegateArgsForCall(0)
+							val, found, err := credVarsTracker.Get(vars.VariableDefinition{Name: ".:go"})
+							Expect(err).ToNot(HaveOccurred())
+							Expect(found).To(BeTrue())
+							Expect(val).To(Equal("1.13"))
+
+							_, _, credVarsTracker = fakeDelegateFactory.TaskDelegateArgsForCall(1)
+							val, found, err = credVarsTracker.Get(vars.VariableDefinition{Name: ".:go"})
+							Expect(err).ToNot(HaveOccurred())
+							Expect(found).To(BeTrue())
+							Expect(val).To(Equal("1.14"))
+						})
+					})
+
 					Context("that contains outputs", func() {
 						var (
 							putPlan          atc.Plan
@@ -829,6 +979,7 @@ var _ = Describe("Builder", func() {
 				fakeSecretManager,
 				fakeVarSourcePool,
 				false,
+				fakeclock.NewFakeClock(time.Now()),
 			)
 
 			planFactory = atc.NewPlanFactory(123)
@@ -13,6 +13,7 @@ import (
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/event"
 	"github.com/concourse/concourse/atc/exec"
+	"github.com/concourse/concourse/atc/metric"
 	"github.com/concourse/concourse/atc/runtime"
 	"github.com/concourse/concourse/vars"
 )
@@ -280,6 +281,62 @@ func (d *taskDelegate) Starting(logger lager.Logger) {
 	logger.Debug("starting")
 }
 
+func (d *taskDelegate) Memoized(logger lager.Logger, key string) {
+	err := d.build.SaveEvent(event.MemoizedTask{
+		Origin: d.eventOrigin,
+		Time:   time.Now().Unix(),
+		Key:    key,
+	})
+	if err != nil {
+		logger.Error("failed-to-save-memoized-task-event", err)
+		return
+	}
+
+	logger.Info("memoized", lager.Data{"key": key})
+}
+
+func (d *taskDelegate) ContainerKept(logger lager.Logger, handle string, expiresAt time.Time) {
+	err := d.build.SaveEvent(event.ContainerKept{
+		Origin:    d.eventOrigin,
+		Time:      time.Now().Unix(),
+		Handle:    handle,
+		ExpiresAt: expiresAt.Unix(),
+	})
+	if err != nil {
+		logger.Error("failed-to-save-container-kept-event", err)
+		return
+	}
+
+	logger.Info("container-kept", lager.Data{"handle": handle, "expires-at": expiresAt})
+}
+
+func (d *taskDelegate) SaveTestResults(logger lager.Logger, stepName string, results []atc.TestResult) error {
+	for i := range results {
+		results[i].Step = stepName
+	}
+
+	err := d.build.SaveTestResults(results)
+	if err != nil {
+		return err
+	}
+
+	report := atc.NewTestReport(results)
+
+	metric.TestsReported{
+		PipelineName: d.build.PipelineName(),
+		JobName:      d.build.JobName(),
+		BuildName:    d.build.Name(),
+		BuildID:      d.build.ID(),
+		TeamName:     d.build.TeamName(),
+		StepName:     stepName,
+		Passed:       report.Passed,
+		Failed:       report.Failed,
+		Skipped:      report.Skipped,
+	}.Emit(logger)
+
+	return nil
+}
+
 func (d *taskDelegate) Finished(logger lager.Logger, exitStatus exec.ExitStatus) {
 	// PR#4398: close to flush stdout and stderr
 	d.Stdout().(io.Closer).Close()
@@ -491,6 +548,44 @@ func (delegate *buildStepDelegate) Finished(logger lager.Logger, succeeded bool)
 	logger.Info("finished")
 }
 
+func (delegate *buildStepDelegate) Skipped(logger lager.Logger) {
+	err := delegate.build.SaveEvent(event.Skipped{
+		Origin: event.Origin{
+			ID: event.OriginID(delegate.planID),
+		},
+		Time: delegate.clock.Now().Unix(),
+	})
+	if err != nil {
+		logger.Error("failed-to-save-skipped-event", err)
+		return
+	}
+
+	logger.Info("skipped")
+}
+
+func (delegate *buildStepDelegate) Retrying(logger lager.Logger, attempt int, reason string, delay time.Duration) {
+	ev := event.Retry{
+		Origin: event.Origin{
+			ID: event.OriginID(delegate.planID),
+		},
+		Time:    delegate.clock.Now().Unix(),
+		Attempt: attempt,
+		Reason:  reason,
+	}
+
+	if delay > 0 {
+		ev.Delay = delay.String()
+	}
+
+	err := delegate.build.SaveEvent(ev)
+	if err != nil {
+		logger.Error("failed-to-save-retry-event", err)
+		return
+	}
+
+	logger.Info("retrying", lager.Data{"attempt": attempt, "reason": reason, "delay": delay.String()})
+}
+
 func (delegate *buildStepDelegate) Errored(logger lager.Logger, message string) {
 	err := delegate.build.SaveEvent(event.Error{
 		Message: message,
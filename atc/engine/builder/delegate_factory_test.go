@@ -288,6 +288,67 @@ var _ = Describe("DelegateFactory", func() {
 				Expect(event.EventType()).To(Equal(atc.EventType("finish-task")))
 			})
 		})
+
+		Describe("Memoized", func() {
+			JustBeforeEach(func() {
+				delegate.Memoized(logger, "some-key")
+			})
+
+			It("saves an event with the key", func() {
+				Expect(fakeBuild.SaveEventCallCount()).To(Equal(1))
+				ev := fakeBuild.SaveEventArgsForCall(0)
+				Expect(ev).To(BeAssignableToTypeOf(event.MemoizedTask{}))
+				Expect(ev.(event.MemoizedTask).Origin).To(Equal(event.Origin{ID: "some-plan-id"}))
+				Expect(ev.(event.MemoizedTask).Key).To(Equal("some-key"))
+			})
+		})
+
+		Describe("ContainerKept", func() {
+			JustBeforeEach(func() {
+				delegate.ContainerKept(logger, "some-handle", time.Unix(1600000000, 0))
+			})
+
+			It("saves an event with the handle and expiry", func() {
+				Expect(fakeBuild.SaveEventCallCount()).To(Equal(1))
+				ev := fakeBuild.SaveEventArgsForCall(0)
+				Expect(ev).To(BeAssignableToTypeOf(event.ContainerKept{}))
+				Expect(ev.(event.ContainerKept).Origin).To(Equal(event.Origin{ID: "some-plan-id"}))
+				Expect(ev.(event.ContainerKept).Handle).To(Equal("some-handle"))
+				Expect(ev.(event.ContainerKept).ExpiresAt).To(Equal(int64(1600000000)))
+			})
+		})
+
+		Describe("SaveTestResults", func() {
+			var saveErr error
+
+			JustBeforeEach(func() {
+				saveErr = delegate.SaveTestResults(logger, "some-step", []atc.TestResult{
+					{Name: "adds", Status: atc.TestPassed},
+					{Name: "subtracts", Status: atc.TestFailed},
+				})
+			})
+
+			It("saves the results for the step", func() {
+				Expect(saveErr).ToNot(HaveOccurred())
+				Expect(fakeBuild.SaveTestResultsCallCount()).To(Equal(1))
+				Expect(fakeBuild.SaveTestResultsArgsForCall(0)).To(Equal([]atc.TestResult{
+					{Step: "some-step", Name: "adds", Status: atc.TestPassed},
+					{Step: "some-step", Name: "subtracts", Status: atc.TestFailed},
+				}))
+			})
+
+			Context("when saving fails", func() {
+				disaster := errors.New("nope")
+
+				BeforeEach(func() {
+					fakeBuild.SaveTestResultsReturns(disaster)
+				})
+
+				It("returns the error", func() {
+					Expect(saveErr).To(Equal(disaster))
+				})
+			})
+		})
 	})
 
 	Describe("CheckDelegate", func() {
@@ -350,6 +411,37 @@ var _ = Describe("DelegateFactory", func() {
 			})
 		})
 
+		Describe("Skipped", func() {
+			JustBeforeEach(func() {
+				delegate.Skipped(logger)
+			})
+
+			It("saves an event", func() {
+				Expect(fakeBuild.SaveEventCallCount()).To(Equal(1))
+				Expect(fakeBuild.SaveEventArgsForCall(0)).To(Equal(event.Skipped{
+					Origin: event.Origin{ID: event.OriginID("some-plan-id")},
+					Time:   123456789,
+				}))
+			})
+		})
+
+		Describe("Retrying", func() {
+			JustBeforeEach(func() {
+				delegate.Retrying(logger, 1, "failed", 10*time.Second)
+			})
+
+			It("saves an event", func() {
+				Expect(fakeBuild.SaveEventCallCount()).To(Equal(1))
+				Expect(fakeBuild.SaveEventArgsForCall(0)).To(Equal(event.Retry{
+					Origin:  event.Origin{ID: event.OriginID("some-plan-id")},
+					Time:    123456789,
+					Attempt: 1,
+					Reason:  "failed",
+					Delay:   "10s",
+				}))
+			})
+		})
+
 		Describe("ImageVersionDetermined", func() {
 			var fakeResourceCache *dbfakes.FakeUsedResourceCache
 
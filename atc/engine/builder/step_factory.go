@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"path/filepath"
 
+	"code.cloudfoundry.org/clock"
+
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/db/lock"
@@ -18,11 +20,15 @@ type stepFactory struct {
 	client                worker.Client
 	resourceFactory       resource.ResourceFactory
 	teamFactory           db.TeamFactory
+	buildFactory          db.BuildFactory
 	resourceCacheFactory  db.ResourceCacheFactory
 	resourceConfigFactory db.ResourceConfigFactory
+	taskCacheFactory      db.TaskCacheFactory
+	volumeRepository      db.VolumeRepository
 	defaultLimits         atc.ContainerLimits
 	strategy              worker.ContainerPlacementStrategy
 	lockFactory           lock.LockFactory
+	setPipelineTeamAuths  []exec.SetPipelineTeamAuthorization
 }
 
 func NewStepFactory(
@@ -30,22 +36,30 @@ func NewStepFactory(
 	client worker.Client,
 	resourceFactory resource.ResourceFactory,
 	teamFactory db.TeamFactory,
+	buildFactory db.BuildFactory,
 	resourceCacheFactory db.ResourceCacheFactory,
 	resourceConfigFactory db.ResourceConfigFactory,
+	taskCacheFactory db.TaskCacheFactory,
+	volumeRepository db.VolumeRepository,
 	defaultLimits atc.ContainerLimits,
 	strategy worker.ContainerPlacementStrategy,
 	lockFactory lock.LockFactory,
+	setPipelineTeamAuths []exec.SetPipelineTeamAuthorization,
 ) *stepFactory {
 	return &stepFactory{
 		pool:                  pool,
 		client:                client,
 		resourceFactory:       resourceFactory,
 		teamFactory:           teamFactory,
+		buildFactory:          buildFactory,
 		resourceCacheFactory:  resourceCacheFactory,
 		resourceConfigFactory: resourceConfigFactory,
+		taskCacheFactory:      taskCacheFactory,
+		volumeRepository:      volumeRepository,
 		defaultLimits:         defaultLimits,
 		strategy:              strategy,
 		lockFactory:           lockFactory,
+		setPipelineTeamAuths:  setPipelineTeamAuths,
 	}
 }
 
@@ -135,8 +149,11 @@ func (factory *stepFactory) TaskStep(
 		containerMetadata,
 		factory.strategy,
 		factory.client,
+		factory.resourceFactory,
 		delegate,
 		factory.lockFactory,
+		factory.taskCacheFactory,
+		factory.volumeRepository,
 	)
 
 	return exec.LogError(taskStep, delegate)
@@ -153,7 +170,9 @@ func (factory *stepFactory) SetPipelineStep(
 		stepMetadata,
 		delegate,
 		factory.teamFactory,
+		factory.buildFactory,
 		factory.client,
+		factory.setPipelineTeamAuths,
 	)
 
 	return exec.LogError(spStep, delegate)
@@ -175,6 +194,22 @@ func (factory *stepFactory) LoadVarStep(
 	return exec.LogError(loadVarStep, delegate)
 }
 
+func (factory *stepFactory) ApproveStep(
+	plan atc.Plan,
+	build db.Build,
+	delegate exec.BuildStepDelegate,
+) exec.Step {
+	approveStep := exec.NewApproveStep(
+		plan.ID,
+		*plan.Approve,
+		build,
+		delegate,
+		clock.NewClock(),
+	)
+
+	return exec.LogError(approveStep, delegate)
+}
+
 func (factory *stepFactory) ArtifactInputStep(
 	plan atc.Plan,
 	build db.Build,
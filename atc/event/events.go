@@ -73,6 +73,25 @@ func ShadowTaskConfig(config atc.TaskConfig) TaskConfig {
 	}
 }
 
+type MemoizedTask struct {
+	Time   int64  `json:"time"`
+	Origin Origin `json:"origin"`
+	Key    string `json:"key"`
+}
+
+func (MemoizedTask) EventType() atc.EventType  { return EventTypeMemoizedTask }
+func (MemoizedTask) Version() atc.EventVersion { return "1.0" }
+
+type ContainerKept struct {
+	Time      int64  `json:"time"`
+	Origin    Origin `json:"origin"`
+	Handle    string `json:"handle"`
+	ExpiresAt int64  `json:"expires_at"`
+}
+
+func (ContainerKept) EventType() atc.EventType  { return EventTypeContainerKept }
+func (ContainerKept) Version() atc.EventVersion { return "1.0" }
+
 type StartTask struct {
 	Time       int64      `json:"time"`
 	Origin     Origin     `json:"origin"`
@@ -191,3 +210,51 @@ type Finish struct {
 
 func (Finish) EventType() atc.EventType  { return EventTypeFinish }
 func (Finish) Version() atc.EventVersion { return "1.0" }
+
+type Skipped struct {
+	Origin Origin `json:"origin"`
+	Time   int64  `json:"time"`
+}
+
+func (Skipped) EventType() atc.EventType  { return EventTypeSkipped }
+func (Skipped) Version() atc.EventVersion { return "1.0" }
+
+type Retry struct {
+	Origin  Origin `json:"origin"`
+	Time    int64  `json:"time"`
+	Attempt int    `json:"attempt"`
+	Reason  string `json:"reason"`
+	Delay   string `json:"delay,omitempty"`
+}
+
+func (Retry) EventType() atc.EventType  { return EventTypeRetry }
+func (Retry) Version() atc.EventVersion { return "1.0" }
+
+type Approval struct {
+	Origin   Origin `json:"origin"`
+	Time     int64  `json:"time"`
+	Approved bool   `json:"approved"`
+	Approver string `json:"approver"`
+}
+
+func (Approval) EventType() atc.EventType  { return EventTypeApproval }
+func (Approval) Version() atc.EventVersion { return "1.0" }
+
+type Breakpoint struct {
+	Origin   Origin                 `json:"origin"`
+	Time     int64                  `json:"time"`
+	Step     string                 `json:"step"`
+	Position atc.BreakpointPosition `json:"position"`
+}
+
+func (Breakpoint) EventType() atc.EventType  { return EventTypeBreakpoint }
+func (Breakpoint) Version() atc.EventVersion { return "1.0" }
+
+type Continue struct {
+	Origin    Origin `json:"origin"`
+	Time      int64  `json:"time"`
+	Continuer string `json:"continuer"`
+}
+
+func (Continue) EventType() atc.EventType  { return EventTypeContinue }
+func (Continue) Version() atc.EventVersion { return "1.0" }
@@ -37,6 +37,8 @@ func init() {
 	RegisterEvent(InitializeTask{})
 	RegisterEvent(StartTask{})
 	RegisterEvent(FinishTask{})
+	RegisterEvent(MemoizedTask{})
+	RegisterEvent(ContainerKept{})
 	RegisterEvent(InitializeGet{})
 	RegisterEvent(StartGet{})
 	RegisterEvent(FinishGet{})
@@ -46,6 +48,11 @@ func init() {
 	RegisterEvent(Status{})
 	RegisterEvent(Log{})
 	RegisterEvent(Error{})
+	RegisterEvent(Approval{})
+	RegisterEvent(Skipped{})
+	RegisterEvent(Retry{})
+	RegisterEvent(Breakpoint{})
+	RegisterEvent(Continue{})
 
 	// deprecated:
 	RegisterEvent(InitializeV10{})
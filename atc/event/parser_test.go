@@ -59,6 +59,8 @@ var _ = Describe("ParseEvent", func() {
 		Entry("InitializeTask", event.InitializeTask{}),
 		Entry("StartTask", event.StartTask{}),
 		Entry("FinishTask", event.FinishTask{}),
+		Entry("MemoizedTask", event.MemoizedTask{}),
+		Entry("ContainerKept", event.ContainerKept{}),
 		Entry("InitializeGet", event.InitializeGet{}),
 		Entry("StartGet", event.StartGet{}),
 		Entry("FinishGet", event.FinishGet{}),
@@ -68,5 +70,10 @@ var _ = Describe("ParseEvent", func() {
 		Entry("Status", event.Status{}),
 		Entry("Log", event.Log{}),
 		Entry("Error", event.Error{}),
+		Entry("Approval", event.Approval{}),
+		Entry("Skipped", event.Skipped{}),
+		Entry("Retry", event.Retry{}),
+		Entry("Breakpoint", event.Breakpoint{}),
+		Entry("Continue", event.Continue{}),
 	)
 })
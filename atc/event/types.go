@@ -18,6 +18,12 @@ const (
 	// task execution finished
 	EventTypeFinishTask atc.EventType = "finish-task"
 
+	// task not run because an earlier run with the same inputs was reused
+	EventTypeMemoizedTask atc.EventType = "memoized-task"
+
+	// a failed task's container is being kept for debugging
+	EventTypeContainerKept atc.EventType = "container-kept"
+
 	// initialize getting something
 	EventTypeInitializeGet atc.EventType = "initialize-get"
 
@@ -45,6 +51,21 @@ const (
 	// finished step
 	EventTypeFinish atc.EventType = "finish"
 
+	// step skipped because its condition was false
+	EventTypeSkipped atc.EventType = "skipped"
+
 	// error occurred
 	EventTypeError atc.EventType = "error"
+
+	// step being retried after an attempt errored or failed
+	EventTypeRetry atc.EventType = "retry"
+
+	// approval given or refused for an approve step
+	EventTypeApproval atc.EventType = "approval"
+
+	// build paused at a breakpoint before or after a step
+	EventTypeBreakpoint atc.EventType = "breakpoint"
+
+	// build continued after pausing at a breakpoint
+	EventTypeContinue atc.EventType = "continue"
 )
@@ -0,0 +1,60 @@
+package exec
+
+import (
+	"context"
+
+	"github.com/concourse/concourse/atc"
+)
+
+// AcrossStep is a step of steps to run once for every combination of values
+// of its vars.
+type AcrossStep struct {
+	step Step
+}
+
+// Across constructs an AcrossStep. The steps must be ordered by combination,
+// such that the first var's values change the slowest, with each step already
+// scoped to the values of its combination.
+//
+// Each var's combinations are run as an InParallelStep limited by the var's
+// max in flight, nested within the combinations of the vars before it.
+func Across(vars []atc.AcrossVar, steps []Step, failFast bool) AcrossStep {
+	return AcrossStep{
+		step: acrossParallel(vars, steps, failFast),
+	}
+}
+
+func acrossParallel(vars []atc.AcrossVar, steps []Step, failFast bool) Step {
+	if len(vars) == 0 {
+		return steps[0]
+	}
+
+	numValues := len(vars[0].Values)
+	if numValues == 0 {
+		return IdentityStep{}
+	}
+
+	chunkSize := len(steps) / numValues
+
+	var chunks []Step
+	for i := 0; i < numValues; i++ {
+		chunk := steps[i*chunkSize : (i+1)*chunkSize]
+		chunks = append(chunks, acrossParallel(vars[1:], chunk, failFast))
+	}
+
+	return InParallel(chunks, vars[0].MaxInFlight, failFast)
+}
+
+// Run executes the step for every combination of values, bounded by each
+// var's max in flight. By default, every combination runs in parallel.
+//
+// Fail fast can be used to stop running any further combinations as soon as
+// one of them fails or errors.
+func (step AcrossStep) Run(ctx context.Context, state RunState) error {
+	return step.step.Run(ctx, state)
+}
+
+// Succeeded is true if the step succeeded for every combination.
+func (step AcrossStep) Succeeded() bool {
+	return step.step.Succeeded()
+}
@@ -0,0 +1,150 @@
+package exec_test
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	. "github.com/concourse/concourse/atc/exec"
+	"github.com/concourse/concourse/atc/exec/execfakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Across", func() {
+	var (
+		ctx    context.Context
+		cancel func()
+
+		acrossVars []atc.AcrossVar
+		fakeSteps  []*execfakes.FakeStep
+		failFast   bool
+
+		state *execfakes.FakeRunState
+
+		step    Step
+		stepErr error
+	)
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(context.Background())
+
+		acrossVars = []atc.AcrossVar{
+			{
+				Var:    "go",
+				Values: []interface{}{"1.13", "1.14"},
+			},
+			{
+				Var:    "db",
+				Values: []interface{}{"postgres", "mysql"},
+			},
+		}
+
+		fakeSteps = nil
+		for i := 0; i < 4; i++ {
+			fakeStep := new(execfakes.FakeStep)
+			fakeStep.SucceededReturns(true)
+			fakeSteps = append(fakeSteps, fakeStep)
+		}
+
+		failFast = false
+
+		state = new(execfakes.FakeRunState)
+	})
+
+	AfterEach(func() {
+		cancel()
+	})
+
+	JustBeforeEach(func() {
+		var steps []Step
+		for _, fakeStep := range fakeSteps {
+			steps = append(steps, fakeStep)
+		}
+
+		step = Across(acrossVars, steps, failFast)
+		stepErr = step.Run(ctx, state)
+	})
+
+	It("runs every combination", func() {
+		Expect(stepErr).ToNot(HaveOccurred())
+
+		for _, fakeStep := range fakeSteps {
+			Expect(fakeStep.RunCallCount()).To(Equal(1))
+		}
+	})
+
+	It("succeeds", func() {
+		Expect(step.Succeeded()).To(BeTrue())
+	})
+
+	Context("when a var has a max in flight", func() {
+		var lock *sync.Mutex
+		var running, maxRunning int
+
+		BeforeEach(func() {
+			acrossVars[0].MaxInFlight = 1
+			acrossVars[1].MaxInFlight = 1
+
+			lock = new(sync.Mutex)
+			running, maxRunning = 0, 0
+
+			for _, fakeStep := range fakeSteps {
+				fakeStep.RunStub = func(context.Context, RunState) error {
+					lock.Lock()
+					running++
+					if running > maxRunning {
+						maxRunning = running
+					}
+					lock.Unlock()
+
+					time.Sleep(10 * time.Millisecond)
+
+					lock.Lock()
+					running--
+					lock.Unlock()
+
+					return nil
+				}
+			}
+		})
+
+		It("limits the combinations running at once", func() {
+			Expect(maxRunning).To(Equal(1))
+		})
+	})
+
+	Context("when a combination fails", func() {
+		BeforeEach(func() {
+			fakeSteps[1].SucceededReturns(false)
+		})
+
+		It("does not succeed", func() {
+			Expect(step.Succeeded()).To(BeFalse())
+		})
+
+		Context("with fail fast", func() {
+			BeforeEach(func() {
+				acrossVars[0].MaxInFlight = 1
+				acrossVars[1].MaxInFlight = 1
+				failFast = true
+			})
+
+			It("does not run the remaining combinations", func() {
+				Expect(fakeSteps[0].RunCallCount()).To(Equal(1))
+				Expect(fakeSteps[1].RunCallCount()).To(Equal(1))
+				Expect(fakeSteps[2].RunCallCount()).To(Equal(0))
+				Expect(fakeSteps[3].RunCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("without fail fast", func() {
+			It("runs the remaining combinations", func() {
+				for _, fakeStep := range fakeSteps {
+					Expect(fakeStep.RunCallCount()).To(Equal(1))
+				}
+			})
+		})
+	})
+})
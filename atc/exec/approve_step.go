@@ -0,0 +1,107 @@
+package exec
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/lager"
+	"code.cloudfoundry.org/lager/lagerctx"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+)
+
+// ApprovalPollInterval is how often an ApproveStep checks whether it has been
+// approved or rejected.
+const ApprovalPollInterval = 5 * time.Second
+
+// ApproveStep pauses the build until a user approves or rejects it. It
+// succeeds if approved and fails if rejected.
+type ApproveStep struct {
+	planID    atc.PlanID
+	plan      atc.ApprovePlan
+	build     db.Build
+	delegate  BuildStepDelegate
+	clock     clock.Clock
+	succeeded bool
+}
+
+func NewApproveStep(
+	planID atc.PlanID,
+	plan atc.ApprovePlan,
+	build db.Build,
+	delegate BuildStepDelegate,
+	clock clock.Clock,
+) Step {
+	return &ApproveStep{
+		planID:   planID,
+		plan:     plan,
+		build:    build,
+		delegate: delegate,
+		clock:    clock,
+	}
+}
+
+// Run requests approval for the build and waits for a user to decide. If the
+// context is canceled while waiting, the request is withdrawn and the
+// context's error is returned.
+func (step *ApproveStep) Run(ctx context.Context, state RunState) error {
+	logger := lagerctx.FromContext(ctx)
+	logger = logger.Session("approve-step", lager.Data{
+		"step-name": step.plan.Name,
+		"build-id":  step.build.ID(),
+	})
+
+	step.delegate.Initializing(logger)
+
+	err := step.build.RequestApproval(step.planID, step.plan.Name, step.plan.Role)
+	if err != nil {
+		return err
+	}
+
+	step.delegate.Starting(logger)
+
+	stdout := step.delegate.Stdout()
+
+	role := step.plan.Role
+	if role == "" {
+		role = "member"
+	}
+
+	fmt.Fprintf(stdout, "waiting for approval from a team %s...\n", role)
+	fmt.Fprintf(stdout, "  approve: fly -t <target> approve-build -b %d -s %s\n", step.build.ID(), step.plan.Name)
+	fmt.Fprintf(stdout, "  reject:  fly -t <target> approve-build -b %d -s %s --reject\n", step.build.ID(), step.plan.Name)
+
+	ticker := step.clock.NewTicker(ApprovalPollInterval)
+	defer ticker.Stop()
+
+	for {
+		approval, found, err := step.build.Approval(step.planID)
+		if err != nil {
+			return err
+		}
+
+		if found && approval.Decided {
+			step.succeeded = approval.Approved
+			step.delegate.Finished(logger, step.succeeded)
+
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			err := step.build.WithdrawApproval(step.planID)
+			if err != nil {
+				logger.Error("failed-to-withdraw-approval", err)
+			}
+
+			return ctx.Err()
+		case <-ticker.C():
+		}
+	}
+}
+
+func (step *ApproveStep) Succeeded() bool {
+	return step.succeeded
+}
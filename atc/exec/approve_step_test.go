@@ -0,0 +1,163 @@
+package exec_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/clock/fakeclock"
+	"code.cloudfoundry.org/lager/lagerctx"
+	"code.cloudfoundry.org/lager/lagertest"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	"github.com/concourse/concourse/atc/exec"
+	"github.com/concourse/concourse/atc/exec/execfakes"
+)
+
+var _ = Describe("ApproveStep", func() {
+	var (
+		ctx    context.Context
+		cancel func()
+
+		fakeBuild    *dbfakes.FakeBuild
+		fakeDelegate *execfakes.FakeBuildStepDelegate
+		fakeClock    *fakeclock.FakeClock
+		state        *execfakes.FakeRunState
+
+		approvePlan atc.ApprovePlan
+		stdout      *gbytes.Buffer
+
+		step    exec.Step
+		stepErr chan error
+	)
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(context.Background())
+		ctx = lagerctx.NewContext(ctx, lagertest.NewTestLogger("approve-step-test"))
+
+		fakeBuild = new(dbfakes.FakeBuild)
+		fakeBuild.IDReturns(42)
+
+		stdout = gbytes.NewBuffer()
+		fakeDelegate = new(execfakes.FakeBuildStepDelegate)
+		fakeDelegate.StdoutReturns(stdout)
+
+		fakeClock = fakeclock.NewFakeClock(time.Unix(123456789, 0))
+		state = new(execfakes.FakeRunState)
+
+		approvePlan = atc.ApprovePlan{
+			Name: "ship-it",
+			Role: "owner",
+		}
+	})
+
+	JustBeforeEach(func() {
+		step = exec.NewApproveStep("some-plan-id", approvePlan, fakeBuild, fakeDelegate, fakeClock)
+
+		stepErr = make(chan error, 1)
+		go func() {
+			stepErr <- step.Run(ctx, state)
+		}()
+	})
+
+	AfterEach(func() {
+		cancel()
+	})
+
+	It("requests approval for the step with the configured role", func() {
+		Eventually(fakeBuild.RequestApprovalCallCount).Should(Equal(1))
+		planID, stepName, role := fakeBuild.RequestApprovalArgsForCall(0)
+		Expect(planID).To(Equal(atc.PlanID("some-plan-id")))
+		Expect(stepName).To(Equal("ship-it"))
+		Expect(role).To(Equal("owner"))
+	})
+
+	It("tells the user how to approve the build", func() {
+		Eventually(stdout).Should(gbytes.Say("waiting for approval from a team owner"))
+		Eventually(stdout).Should(gbytes.Say("approve-build -b 42 -s ship-it"))
+		Eventually(stdout).Should(gbytes.Say("approve-build -b 42 -s ship-it --reject"))
+	})
+
+	Context("when requesting approval fails", func() {
+		disaster := errors.New("nope")
+
+		BeforeEach(func() {
+			fakeBuild.RequestApprovalReturns(disaster)
+		})
+
+		It("returns the error", func() {
+			Eventually(stepErr).Should(Receive(Equal(disaster)))
+			Expect(fakeDelegate.StartingCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when the approval is decided while waiting", func() {
+		BeforeEach(func() {
+			fakeBuild.ApprovalReturnsOnCall(0, db.BuildApproval{}, true, nil)
+			fakeBuild.ApprovalReturnsOnCall(1, db.BuildApproval{
+				Decided:  true,
+				Approved: true,
+				Approver: "some-user",
+			}, true, nil)
+		})
+
+		It("succeeds once the clock ticks", func() {
+			fakeClock.WaitForWatcherAndIncrement(exec.ApprovalPollInterval)
+
+			Eventually(stepErr).Should(Receive(BeNil()))
+			Expect(step.Succeeded()).To(BeTrue())
+
+			Expect(fakeDelegate.FinishedCallCount()).To(Equal(1))
+			_, succeeded := fakeDelegate.FinishedArgsForCall(0)
+			Expect(succeeded).To(BeTrue())
+		})
+	})
+
+	Context("when the approval is rejected", func() {
+		BeforeEach(func() {
+			fakeBuild.ApprovalReturns(db.BuildApproval{
+				Decided:  true,
+				Approved: false,
+				Approver: "some-user",
+			}, true, nil)
+		})
+
+		It("fails", func() {
+			Eventually(stepErr).Should(Receive(BeNil()))
+			Expect(step.Succeeded()).To(BeFalse())
+
+			_, succeeded := fakeDelegate.FinishedArgsForCall(0)
+			Expect(succeeded).To(BeFalse())
+		})
+	})
+
+	Context("when looking up the approval fails", func() {
+		disaster := errors.New("nope")
+
+		BeforeEach(func() {
+			fakeBuild.ApprovalReturns(db.BuildApproval{}, false, disaster)
+		})
+
+		It("returns the error", func() {
+			Eventually(stepErr).Should(Receive(Equal(disaster)))
+		})
+	})
+
+	Context("when the context is canceled while waiting", func() {
+		It("withdraws the approval request and returns the context's error", func() {
+			Eventually(fakeBuild.ApprovalCallCount).Should(Equal(1))
+
+			cancel()
+
+			Eventually(stepErr).Should(Receive(Equal(context.Canceled)))
+			Expect(fakeBuild.WithdrawApprovalCallCount()).To(Equal(1))
+			Expect(fakeBuild.WithdrawApprovalArgsForCall(0)).To(Equal(atc.PlanID("some-plan-id")))
+			Expect(step.Succeeded()).To(BeFalse())
+		})
+	})
+})
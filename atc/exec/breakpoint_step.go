@@ -0,0 +1,145 @@
+package exec
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/lager"
+	"code.cloudfoundry.org/lager/lagerctx"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+)
+
+// BreakpointPollInterval is how often a BreakpointStep checks whether a
+// paused build has been continued.
+const BreakpointPollInterval = 5 * time.Second
+
+// BreakpointStepDelegate is the output a BreakpointStep tells the user how to
+// intercept and continue a paused build through.
+type BreakpointStepDelegate interface {
+	Stdout() io.Writer
+}
+
+// BreakpointStep pauses the build before or after its nested step when a
+// user has set a breakpoint on it.
+type BreakpointStep struct {
+	step     Step
+	planID   atc.PlanID
+	name     string
+	build    db.Build
+	delegate BreakpointStepDelegate
+	clock    clock.Clock
+}
+
+// Breakpoint constructs a BreakpointStep wrapping the step with the given
+// name.
+func Breakpoint(
+	step Step,
+	planID atc.PlanID,
+	name string,
+	build db.Build,
+	delegate BreakpointStepDelegate,
+	clock clock.Clock,
+) *BreakpointStep {
+	return &BreakpointStep{
+		step:     step,
+		planID:   planID,
+		name:     name,
+		build:    build,
+		delegate: delegate,
+		clock:    clock,
+	}
+}
+
+// Run pauses if a breakpoint is set before the nested step, runs it, and
+// pauses again if a breakpoint is set after it. The build stays paused, along
+// with the containers of any steps which have run, until it is continued.
+//
+// A breakpoint after the step is hit whether or not the step succeeded, so
+// that a failing step can be intercepted. If the context is canceled while
+// paused, the breakpoint is removed and the context's error is returned.
+func (bs *BreakpointStep) Run(ctx context.Context, state RunState) error {
+	logger := lagerctx.FromContext(ctx).Session("breakpoint", lager.Data{
+		"step-name": bs.name,
+		"build-id":  bs.build.ID(),
+	})
+
+	err := bs.pause(ctx, logger, atc.BreakpointBefore)
+	if err != nil {
+		return err
+	}
+
+	runErr := bs.step.Run(ctx, state)
+	if ctx.Err() != nil {
+		return runErr
+	}
+
+	err = bs.pause(ctx, logger, atc.BreakpointAfter)
+	if err != nil {
+		return err
+	}
+
+	return runErr
+}
+
+// Succeeded is true when the nested step succeeded.
+func (bs *BreakpointStep) Succeeded() bool {
+	return bs.step.Succeeded()
+}
+
+func (bs *BreakpointStep) pause(ctx context.Context, logger lager.Logger, position atc.BreakpointPosition) error {
+	hasBreakpoints, err := bs.build.HasBreakpoints()
+	if err != nil {
+		return err
+	}
+
+	if !hasBreakpoints {
+		return nil
+	}
+
+	hit, err := bs.build.HitBreakpoint(bs.planID, bs.name, position)
+	if err != nil {
+		return err
+	}
+
+	if !hit {
+		return nil
+	}
+
+	logger.Info("paused", lager.Data{"position": position})
+
+	stdout := bs.delegate.Stdout()
+
+	fmt.Fprintf(stdout, "paused %s step '%s'\n", position, bs.name)
+	fmt.Fprintf(stdout, "  intercept: fly -t <target> intercept -b %d -s %s\n", bs.build.ID(), bs.name)
+	fmt.Fprintf(stdout, "  continue:  fly -t <target> continue-build -b %d\n", bs.build.ID())
+
+	ticker := bs.clock.NewTicker(BreakpointPollInterval)
+	defer ticker.Stop()
+
+	for {
+		paused, err := bs.build.IsPausedAt(bs.planID)
+		if err != nil {
+			return err
+		}
+
+		if !paused {
+			logger.Info("continued")
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			err := bs.build.RemoveBreakpoint(bs.planID)
+			if err != nil {
+				logger.Error("failed-to-remove-breakpoint", err)
+			}
+
+			return ctx.Err()
+		case <-ticker.C():
+		}
+	}
+}
@@ -0,0 +1,193 @@
+package exec_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/clock/fakeclock"
+	"code.cloudfoundry.org/lager/lagerctx"
+	"code.cloudfoundry.org/lager/lagertest"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	"github.com/concourse/concourse/atc/exec"
+	"github.com/concourse/concourse/atc/exec/execfakes"
+)
+
+var _ = Describe("BreakpointStep", func() {
+	var (
+		ctx    context.Context
+		cancel func()
+
+		fakeStep     *execfakes.FakeStep
+		fakeBuild    *dbfakes.FakeBuild
+		fakeDelegate *execfakes.FakeBuildStepDelegate
+		fakeClock    *fakeclock.FakeClock
+		state        *execfakes.FakeRunState
+
+		stdout *gbytes.Buffer
+
+		step    exec.Step
+		stepErr chan error
+	)
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(context.Background())
+		ctx = lagerctx.NewContext(ctx, lagertest.NewTestLogger("breakpoint-step-test"))
+
+		fakeStep = new(execfakes.FakeStep)
+		fakeStep.SucceededReturns(true)
+
+		fakeBuild = new(dbfakes.FakeBuild)
+		fakeBuild.IDReturns(42)
+		fakeBuild.HasBreakpointsReturns(true, nil)
+
+		stdout = gbytes.NewBuffer()
+		fakeDelegate = new(execfakes.FakeBuildStepDelegate)
+		fakeDelegate.StdoutReturns(stdout)
+
+		fakeClock = fakeclock.NewFakeClock(time.Unix(123456789, 0))
+		state = new(execfakes.FakeRunState)
+	})
+
+	JustBeforeEach(func() {
+		step = exec.Breakpoint(fakeStep, "some-plan-id", "unit", fakeBuild, fakeDelegate, fakeClock)
+
+		stepErr = make(chan error, 1)
+		go func() {
+			stepErr <- step.Run(ctx, state)
+		}()
+	})
+
+	AfterEach(func() {
+		cancel()
+	})
+
+	Context("when the build has no breakpoints", func() {
+		BeforeEach(func() {
+			fakeBuild.HasBreakpointsReturns(false, nil)
+		})
+
+		It("runs the step without looking for a breakpoint to hit", func() {
+			Eventually(stepErr).Should(Receive(BeNil()))
+			Expect(fakeStep.RunCallCount()).To(Equal(1))
+			Expect(fakeBuild.HitBreakpointCallCount()).To(BeZero())
+		})
+	})
+
+	Context("when checking for breakpoints fails", func() {
+		disaster := errors.New("nope")
+
+		BeforeEach(func() {
+			fakeBuild.HasBreakpointsReturns(false, disaster)
+		})
+
+		It("returns the error without running the step", func() {
+			Eventually(stepErr).Should(Receive(Equal(disaster)))
+			Expect(fakeStep.RunCallCount()).To(BeZero())
+		})
+	})
+
+	Context("when no breakpoint is set", func() {
+		It("runs the step without pausing", func() {
+			Eventually(stepErr).Should(Receive(BeNil()))
+			Expect(fakeStep.RunCallCount()).To(Equal(1))
+			Expect(step.Succeeded()).To(BeTrue())
+
+			Expect(fakeBuild.HitBreakpointCallCount()).To(Equal(2))
+
+			planID, name, position := fakeBuild.HitBreakpointArgsForCall(0)
+			Expect(planID).To(Equal(atc.PlanID("some-plan-id")))
+			Expect(name).To(Equal("unit"))
+			Expect(position).To(Equal(atc.BreakpointBefore))
+
+			_, _, position = fakeBuild.HitBreakpointArgsForCall(1)
+			Expect(position).To(Equal(atc.BreakpointAfter))
+
+			Expect(fakeBuild.IsPausedAtCallCount()).To(BeZero())
+		})
+	})
+
+	Context("when a breakpoint is hit before the step", func() {
+		BeforeEach(func() {
+			fakeBuild.HitBreakpointReturnsOnCall(0, true, nil)
+			fakeBuild.IsPausedAtReturnsOnCall(0, true, nil)
+			fakeBuild.IsPausedAtReturnsOnCall(1, false, nil)
+		})
+
+		It("tells the user how to intercept and continue the build", func() {
+			Eventually(stdout).Should(gbytes.Say("paused before step 'unit'"))
+			Eventually(stdout).Should(gbytes.Say("intercept -b 42 -s unit"))
+			Eventually(stdout).Should(gbytes.Say("continue-build -b 42"))
+		})
+
+		It("runs the step once the build is continued", func() {
+			Eventually(fakeBuild.IsPausedAtCallCount).Should(Equal(1))
+			Expect(fakeStep.RunCallCount()).To(BeZero())
+
+			fakeClock.WaitForWatcherAndIncrement(exec.BreakpointPollInterval)
+
+			Eventually(stepErr).Should(Receive(BeNil()))
+			Expect(fakeStep.RunCallCount()).To(Equal(1))
+		})
+	})
+
+	Context("when a breakpoint is hit after the step", func() {
+		BeforeEach(func() {
+			fakeBuild.HitBreakpointReturnsOnCall(1, true, nil)
+			fakeBuild.IsPausedAtReturns(true, nil)
+		})
+
+		It("pauses once the step has run", func() {
+			Eventually(stdout).Should(gbytes.Say("paused after step 'unit'"))
+			Expect(fakeStep.RunCallCount()).To(Equal(1))
+			Consistently(stepErr).ShouldNot(Receive())
+		})
+
+		Context("when the step fails", func() {
+			disaster := errors.New("nope")
+
+			BeforeEach(func() {
+				fakeStep.RunReturns(disaster)
+			})
+
+			It("still pauses, and returns the step's error once continued", func() {
+				Eventually(stdout).Should(gbytes.Say("paused after step 'unit'"))
+
+				fakeBuild.IsPausedAtReturns(false, nil)
+				fakeClock.WaitForWatcherAndIncrement(exec.BreakpointPollInterval)
+
+				Eventually(stepErr).Should(Receive(Equal(disaster)))
+			})
+		})
+
+		Context("when the context is canceled while paused", func() {
+			It("removes the breakpoint and returns the context's error", func() {
+				Eventually(fakeBuild.IsPausedAtCallCount).Should(Equal(1))
+
+				cancel()
+
+				Eventually(stepErr).Should(Receive(Equal(context.Canceled)))
+				Expect(fakeBuild.RemoveBreakpointCallCount()).To(Equal(1))
+				Expect(fakeBuild.RemoveBreakpointArgsForCall(0)).To(Equal(atc.PlanID("some-plan-id")))
+			})
+		})
+	})
+
+	Context("when hitting the breakpoint fails", func() {
+		disaster := errors.New("nope")
+
+		BeforeEach(func() {
+			fakeBuild.HitBreakpointReturns(false, disaster)
+		})
+
+		It("returns the error without running the step", func() {
+			Eventually(stepErr).Should(Receive(Equal(disaster)))
+			Expect(fakeStep.RunCallCount()).To(BeZero())
+		})
+	})
+})
@@ -3,6 +3,7 @@ package exec
 import (
 	"github.com/concourse/concourse/vars"
 	"io"
+	"time"
 
 	"code.cloudfoundry.org/lager"
 	"github.com/concourse/concourse/atc/db"
@@ -21,5 +22,7 @@ type BuildStepDelegate interface {
 	Initializing(lager.Logger)
 	Starting(lager.Logger)
 	Finished(lager.Logger, bool)
+	Skipped(lager.Logger)
+	Retrying(lager.Logger, int, string, time.Duration)
 	Errored(lager.Logger, string)
 }
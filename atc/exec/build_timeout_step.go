@@ -0,0 +1,95 @@
+package exec
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"code.cloudfoundry.org/clock"
+)
+
+// BuildTimeoutError is returned when a build runs for longer than its job's
+// build_timeout.
+type BuildTimeoutError struct {
+	Duration string
+}
+
+func (err BuildTimeoutError) Error() string {
+	return fmt.Sprintf("build timed out after %s", err.Duration)
+}
+
+// BuildTimeoutStep limits how long a whole build may run for.
+type BuildTimeoutStep struct {
+	step      Step
+	duration  string
+	startTime time.Time
+	clock     clock.Clock
+	timedOut  bool
+}
+
+// BuildTimeout constructs a BuildTimeoutStep. The duration is measured from
+// startTime, so that a build resumed after a restart keeps its deadline.
+func BuildTimeout(step Step, duration string, startTime time.Time, clock clock.Clock) *BuildTimeoutStep {
+	return &BuildTimeoutStep{
+		step:      step,
+		duration:  duration,
+		startTime: startTime,
+		clock:     clock,
+	}
+}
+
+// Run parses the timeout duration and invokes the nested step.
+//
+// If the build is still running once the duration has passed, the nested
+// step is interrupted in the same way as when the build is aborted, so that
+// on_abort and ensure hooks run. Once the nested step exits, a
+// BuildTimeoutError is returned, erroring the build.
+func (ts *BuildTimeoutStep) Run(ctx context.Context, state RunState) error {
+	parsedDuration, err := time.ParseDuration(ts.duration)
+	if err != nil {
+		return err
+	}
+
+	startTime := ts.startTime
+	if startTime.IsZero() {
+		startTime = ts.clock.Now()
+	}
+
+	remaining := startTime.Add(parsedDuration).Sub(ts.clock.Now())
+	if remaining < 0 {
+		remaining = 0
+	}
+
+	timeoutCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	timer := ts.clock.NewTimer(remaining)
+	defer timer.Stop()
+
+	go func() {
+		select {
+		case <-timer.C():
+			cancel()
+		case <-timeoutCtx.Done():
+		}
+	}()
+
+	err = ts.step.Run(timeoutCtx, state)
+
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+
+	if timeoutCtx.Err() != nil {
+		ts.timedOut = true
+		return BuildTimeoutError{Duration: ts.duration}
+	}
+
+	return err
+}
+
+// Succeeded is true if the nested step completed successfully
+// and did not time out.
+func (ts *BuildTimeoutStep) Succeeded() bool {
+	return !ts.timedOut && ts.step.Succeeded()
+}
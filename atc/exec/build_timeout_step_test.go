@@ -0,0 +1,132 @@
+package exec_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/clock/fakeclock"
+	. "github.com/concourse/concourse/atc/exec"
+	"github.com/concourse/concourse/atc/exec/execfakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Build Timeout Step", func() {
+	var (
+		ctx    context.Context
+		cancel func()
+
+		fakeStep  *execfakes.FakeStep
+		fakeClock *fakeclock.FakeClock
+		state     *execfakes.FakeRunState
+
+		startTime       time.Time
+		timeoutDuration string
+
+		step    Step
+		stepErr chan error
+	)
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(context.Background())
+
+		fakeStep = new(execfakes.FakeStep)
+		fakeStep.RunStub = func(ctx context.Context, state RunState) error {
+			<-ctx.Done()
+			return ctx.Err()
+		}
+
+		fakeClock = fakeclock.NewFakeClock(time.Unix(123456789, 0))
+		state = new(execfakes.FakeRunState)
+
+		startTime = fakeClock.Now().Add(-10 * time.Minute)
+		timeoutDuration = "1h"
+	})
+
+	JustBeforeEach(func() {
+		step = BuildTimeout(fakeStep, timeoutDuration, startTime, fakeClock)
+
+		stepErr = make(chan error, 1)
+		go func() {
+			stepErr <- step.Run(ctx, state)
+		}()
+	})
+
+	AfterEach(func() {
+		cancel()
+	})
+
+	Context("when the build runs past the timeout", func() {
+		It("interrupts the step as an abort would", func() {
+			fakeClock.WaitForWatcherAndIncrement(50 * time.Minute)
+
+			Eventually(stepErr).Should(Receive(Equal(BuildTimeoutError{Duration: "1h"})))
+
+			runCtx, _ := fakeStep.RunArgsForCall(0)
+			Expect(runCtx.Err()).To(Equal(context.Canceled))
+			Expect(step.Succeeded()).To(BeFalse())
+		})
+
+		It("does not time out before the deadline", func() {
+			fakeClock.WaitForWatcherAndIncrement(49 * time.Minute)
+
+			Consistently(stepErr).ShouldNot(Receive())
+		})
+	})
+
+	Context("when the timeout has already passed", func() {
+		BeforeEach(func() {
+			startTime = fakeClock.Now().Add(-2 * time.Hour)
+		})
+
+		It("times out immediately", func() {
+			Eventually(stepErr).Should(Receive(Equal(BuildTimeoutError{Duration: "1h"})))
+		})
+	})
+
+	Context("when the step finishes in time", func() {
+		BeforeEach(func() {
+			fakeStep.RunReturns(nil)
+			fakeStep.RunStub = nil
+			fakeStep.SucceededReturns(true)
+		})
+
+		It("succeeds", func() {
+			Eventually(stepErr).Should(Receive(BeNil()))
+			Expect(step.Succeeded()).To(BeTrue())
+		})
+	})
+
+	Context("when the step errors", func() {
+		disaster := errors.New("nope")
+
+		BeforeEach(func() {
+			fakeStep.RunStub = nil
+			fakeStep.RunReturns(disaster)
+		})
+
+		It("returns the error", func() {
+			Eventually(stepErr).Should(Receive(Equal(disaster)))
+		})
+	})
+
+	Context("when the build is aborted", func() {
+		It("returns the context's error", func() {
+			cancel()
+
+			Eventually(stepErr).Should(Receive(Equal(context.Canceled)))
+		})
+	})
+
+	Context("when the duration is invalid", func() {
+		BeforeEach(func() {
+			timeoutDuration = "nope"
+		})
+
+		It("errors", func() {
+			Eventually(stepErr).Should(Receive(HaveOccurred()))
+			Expect(fakeStep.RunCallCount()).To(BeZero())
+		})
+	})
+})
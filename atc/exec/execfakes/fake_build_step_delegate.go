@@ -4,6 +4,7 @@ package execfakes
 import (
 	"io"
 	"sync"
+	"time"
 
 	"code.cloudfoundry.org/lager"
 	"github.com/concourse/concourse/atc/db"
@@ -40,6 +41,19 @@ type FakeBuildStepDelegate struct {
 	initializingArgsForCall []struct {
 		arg1 lager.Logger
 	}
+	RetryingStub        func(lager.Logger, int, string, time.Duration)
+	retryingMutex       sync.RWMutex
+	retryingArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 int
+		arg3 string
+		arg4 time.Duration
+	}
+	SkippedStub        func(lager.Logger)
+	skippedMutex       sync.RWMutex
+	skippedArgsForCall []struct {
+		arg1 lager.Logger
+	}
 	StartingStub        func(lager.Logger)
 	startingMutex       sync.RWMutex
 	startingArgsForCall []struct {
@@ -234,6 +248,71 @@ func (fake *FakeBuildStepDelegate) InitializingArgsForCall(i int) lager.Logger {
 	return argsForCall.arg1
 }
 
+func (fake *FakeBuildStepDelegate) Retrying(arg1 lager.Logger, arg2 int, arg3 string, arg4 time.Duration) {
+	fake.retryingMutex.Lock()
+	fake.retryingArgsForCall = append(fake.retryingArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 int
+		arg3 string
+		arg4 time.Duration
+	}{arg1, arg2, arg3, arg4})
+	fake.recordInvocation("Retrying", []interface{}{arg1, arg2, arg3, arg4})
+	fake.retryingMutex.Unlock()
+	if fake.RetryingStub != nil {
+		fake.RetryingStub(arg1, arg2, arg3, arg4)
+	}
+}
+
+func (fake *FakeBuildStepDelegate) RetryingCallCount() int {
+	fake.retryingMutex.RLock()
+	defer fake.retryingMutex.RUnlock()
+	return len(fake.retryingArgsForCall)
+}
+
+func (fake *FakeBuildStepDelegate) RetryingCalls(stub func(lager.Logger, int, string, time.Duration)) {
+	fake.retryingMutex.Lock()
+	defer fake.retryingMutex.Unlock()
+	fake.RetryingStub = stub
+}
+
+func (fake *FakeBuildStepDelegate) RetryingArgsForCall(i int) (lager.Logger, int, string, time.Duration) {
+	fake.retryingMutex.RLock()
+	defer fake.retryingMutex.RUnlock()
+	argsForCall := fake.retryingArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeBuildStepDelegate) Skipped(arg1 lager.Logger) {
+	fake.skippedMutex.Lock()
+	fake.skippedArgsForCall = append(fake.skippedArgsForCall, struct {
+		arg1 lager.Logger
+	}{arg1})
+	fake.recordInvocation("Skipped", []interface{}{arg1})
+	fake.skippedMutex.Unlock()
+	if fake.SkippedStub != nil {
+		fake.SkippedStub(arg1)
+	}
+}
+
+func (fake *FakeBuildStepDelegate) SkippedCallCount() int {
+	fake.skippedMutex.RLock()
+	defer fake.skippedMutex.RUnlock()
+	return len(fake.skippedArgsForCall)
+}
+
+func (fake *FakeBuildStepDelegate) SkippedCalls(stub func(lager.Logger)) {
+	fake.skippedMutex.Lock()
+	defer fake.skippedMutex.Unlock()
+	fake.SkippedStub = stub
+}
+
+func (fake *FakeBuildStepDelegate) SkippedArgsForCall(i int) lager.Logger {
+	fake.skippedMutex.RLock()
+	defer fake.skippedMutex.RUnlock()
+	argsForCall := fake.skippedArgsForCall[i]
+	return argsForCall.arg1
+}
+
 func (fake *FakeBuildStepDelegate) Starting(arg1 lager.Logger) {
 	fake.startingMutex.Lock()
 	fake.startingArgsForCall = append(fake.startingArgsForCall, struct {
@@ -432,6 +511,10 @@ func (fake *FakeBuildStepDelegate) Invocations() map[string][][]interface{} {
 	defer fake.imageVersionDeterminedMutex.RUnlock()
 	fake.initializingMutex.RLock()
 	defer fake.initializingMutex.RUnlock()
+	fake.retryingMutex.RLock()
+	defer fake.retryingMutex.RUnlock()
+	fake.skippedMutex.RLock()
+	defer fake.skippedMutex.RUnlock()
 	fake.startingMutex.RLock()
 	defer fake.startingMutex.RUnlock()
 	fake.stderrMutex.RLock()
@@ -4,6 +4,7 @@ package execfakes
 import (
 	"io"
 	"sync"
+	"time"
 
 	"code.cloudfoundry.org/lager"
 	"github.com/concourse/concourse/atc"
@@ -41,6 +42,14 @@ type FakeCheckDelegate struct {
 	initializingArgsForCall []struct {
 		arg1 lager.Logger
 	}
+	RetryingStub        func(lager.Logger, int, string, time.Duration)
+	retryingMutex       sync.RWMutex
+	retryingArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 int
+		arg3 string
+		arg4 time.Duration
+	}
 	SaveVersionsStub        func([]atc.Version) error
 	saveVersionsMutex       sync.RWMutex
 	saveVersionsArgsForCall []struct {
@@ -52,6 +61,11 @@ type FakeCheckDelegate struct {
 	saveVersionsReturnsOnCall map[int]struct {
 		result1 error
 	}
+	SkippedStub        func(lager.Logger)
+	skippedMutex       sync.RWMutex
+	skippedArgsForCall []struct {
+		arg1 lager.Logger
+	}
 	StartingStub        func(lager.Logger)
 	startingMutex       sync.RWMutex
 	startingArgsForCall []struct {
@@ -246,6 +260,40 @@ func (fake *FakeCheckDelegate) InitializingArgsForCall(i int) lager.Logger {
 	return argsForCall.arg1
 }
 
+func (fake *FakeCheckDelegate) Retrying(arg1 lager.Logger, arg2 int, arg3 string, arg4 time.Duration) {
+	fake.retryingMutex.Lock()
+	fake.retryingArgsForCall = append(fake.retryingArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 int
+		arg3 string
+		arg4 time.Duration
+	}{arg1, arg2, arg3, arg4})
+	fake.recordInvocation("Retrying", []interface{}{arg1, arg2, arg3, arg4})
+	fake.retryingMutex.Unlock()
+	if fake.RetryingStub != nil {
+		fake.RetryingStub(arg1, arg2, arg3, arg4)
+	}
+}
+
+func (fake *FakeCheckDelegate) RetryingCallCount() int {
+	fake.retryingMutex.RLock()
+	defer fake.retryingMutex.RUnlock()
+	return len(fake.retryingArgsForCall)
+}
+
+func (fake *FakeCheckDelegate) RetryingCalls(stub func(lager.Logger, int, string, time.Duration)) {
+	fake.retryingMutex.Lock()
+	defer fake.retryingMutex.Unlock()
+	fake.RetryingStub = stub
+}
+
+func (fake *FakeCheckDelegate) RetryingArgsForCall(i int) (lager.Logger, int, string, time.Duration) {
+	fake.retryingMutex.RLock()
+	defer fake.retryingMutex.RUnlock()
+	argsForCall := fake.retryingArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
 func (fake *FakeCheckDelegate) SaveVersions(arg1 []atc.Version) error {
 	var arg1Copy []atc.Version
 	if arg1 != nil {
@@ -311,6 +359,37 @@ func (fake *FakeCheckDelegate) SaveVersionsReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeCheckDelegate) Skipped(arg1 lager.Logger) {
+	fake.skippedMutex.Lock()
+	fake.skippedArgsForCall = append(fake.skippedArgsForCall, struct {
+		arg1 lager.Logger
+	}{arg1})
+	fake.recordInvocation("Skipped", []interface{}{arg1})
+	fake.skippedMutex.Unlock()
+	if fake.SkippedStub != nil {
+		fake.SkippedStub(arg1)
+	}
+}
+
+func (fake *FakeCheckDelegate) SkippedCallCount() int {
+	fake.skippedMutex.RLock()
+	defer fake.skippedMutex.RUnlock()
+	return len(fake.skippedArgsForCall)
+}
+
+func (fake *FakeCheckDelegate) SkippedCalls(stub func(lager.Logger)) {
+	fake.skippedMutex.Lock()
+	defer fake.skippedMutex.Unlock()
+	fake.SkippedStub = stub
+}
+
+func (fake *FakeCheckDelegate) SkippedArgsForCall(i int) lager.Logger {
+	fake.skippedMutex.RLock()
+	defer fake.skippedMutex.RUnlock()
+	argsForCall := fake.skippedArgsForCall[i]
+	return argsForCall.arg1
+}
+
 func (fake *FakeCheckDelegate) Starting(arg1 lager.Logger) {
 	fake.startingMutex.Lock()
 	fake.startingArgsForCall = append(fake.startingArgsForCall, struct {
@@ -509,8 +588,12 @@ func (fake *FakeCheckDelegate) Invocations() map[string][][]interface{} {
 	defer fake.imageVersionDeterminedMutex.RUnlock()
 	fake.initializingMutex.RLock()
 	defer fake.initializingMutex.RUnlock()
+	fake.retryingMutex.RLock()
+	defer fake.retryingMutex.RUnlock()
 	fake.saveVersionsMutex.RLock()
 	defer fake.saveVersionsMutex.RUnlock()
+	fake.skippedMutex.RLock()
+	defer fake.skippedMutex.RUnlock()
 	fake.startingMutex.RLock()
 	defer fake.startingMutex.RUnlock()
 	fake.stderrMutex.RLock()
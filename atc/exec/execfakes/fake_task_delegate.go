@@ -4,6 +4,7 @@ package execfakes
 import (
 	"io"
 	"sync"
+	"time"
 
 	"code.cloudfoundry.org/lager"
 	"github.com/concourse/concourse/atc"
@@ -13,6 +14,13 @@ import (
 )
 
 type FakeTaskDelegate struct {
+	ContainerKeptStub        func(lager.Logger, string, time.Time)
+	containerKeptMutex       sync.RWMutex
+	containerKeptArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 time.Time
+	}
 	ErroredStub        func(lager.Logger, string)
 	erroredMutex       sync.RWMutex
 	erroredArgsForCall []struct {
@@ -41,6 +49,25 @@ type FakeTaskDelegate struct {
 	initializingArgsForCall []struct {
 		arg1 lager.Logger
 	}
+	MemoizedStub        func(lager.Logger, string)
+	memoizedMutex       sync.RWMutex
+	memoizedArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 string
+	}
+	SaveTestResultsStub        func(lager.Logger, string, []atc.TestResult) error
+	saveTestResultsMutex       sync.RWMutex
+	saveTestResultsArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 []atc.TestResult
+	}
+	saveTestResultsReturns struct {
+		result1 error
+	}
+	saveTestResultsReturnsOnCall map[int]struct {
+		result1 error
+	}
 	SetTaskConfigStub        func(atc.TaskConfig)
 	setTaskConfigMutex       sync.RWMutex
 	setTaskConfigArgsForCall []struct {
@@ -85,6 +112,39 @@ type FakeTaskDelegate struct {
 	invocationsMutex sync.RWMutex
 }
 
+func (fake *FakeTaskDelegate) ContainerKept(arg1 lager.Logger, arg2 string, arg3 time.Time) {
+	fake.containerKeptMutex.Lock()
+	fake.containerKeptArgsForCall = append(fake.containerKeptArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 time.Time
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("ContainerKept", []interface{}{arg1, arg2, arg3})
+	fake.containerKeptMutex.Unlock()
+	if fake.ContainerKeptStub != nil {
+		fake.ContainerKeptStub(arg1, arg2, arg3)
+	}
+}
+
+func (fake *FakeTaskDelegate) ContainerKeptCallCount() int {
+	fake.containerKeptMutex.RLock()
+	defer fake.containerKeptMutex.RUnlock()
+	return len(fake.containerKeptArgsForCall)
+}
+
+func (fake *FakeTaskDelegate) ContainerKeptCalls(stub func(lager.Logger, string, time.Time)) {
+	fake.containerKeptMutex.Lock()
+	defer fake.containerKeptMutex.Unlock()
+	fake.ContainerKeptStub = stub
+}
+
+func (fake *FakeTaskDelegate) ContainerKeptArgsForCall(i int) (lager.Logger, string, time.Time) {
+	fake.containerKeptMutex.RLock()
+	defer fake.containerKeptMutex.RUnlock()
+	argsForCall := fake.containerKeptArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
 func (fake *FakeTaskDelegate) Errored(arg1 lager.Logger, arg2 string) {
 	fake.erroredMutex.Lock()
 	fake.erroredArgsForCall = append(fake.erroredArgsForCall, struct {
@@ -240,6 +300,105 @@ func (fake *FakeTaskDelegate) InitializingArgsForCall(i int) lager.Logger {
 	return argsForCall.arg1
 }
 
+func (fake *FakeTaskDelegate) Memoized(arg1 lager.Logger, arg2 string) {
+	fake.memoizedMutex.Lock()
+	fake.memoizedArgsForCall = append(fake.memoizedArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("Memoized", []interface{}{arg1, arg2})
+	fake.memoizedMutex.Unlock()
+	if fake.MemoizedStub != nil {
+		fake.MemoizedStub(arg1, arg2)
+	}
+}
+
+func (fake *FakeTaskDelegate) MemoizedCallCount() int {
+	fake.memoizedMutex.RLock()
+	defer fake.memoizedMutex.RUnlock()
+	return len(fake.memoizedArgsForCall)
+}
+
+func (fake *FakeTaskDelegate) MemoizedCalls(stub func(lager.Logger, string)) {
+	fake.memoizedMutex.Lock()
+	defer fake.memoizedMutex.Unlock()
+	fake.MemoizedStub = stub
+}
+
+func (fake *FakeTaskDelegate) MemoizedArgsForCall(i int) (lager.Logger, string) {
+	fake.memoizedMutex.RLock()
+	defer fake.memoizedMutex.RUnlock()
+	argsForCall := fake.memoizedArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeTaskDelegate) SaveTestResults(arg1 lager.Logger, arg2 string, arg3 []atc.TestResult) error {
+	var arg3Copy []atc.TestResult
+	if arg3 != nil {
+		arg3Copy = make([]atc.TestResult, len(arg3))
+		copy(arg3Copy, arg3)
+	}
+	fake.saveTestResultsMutex.Lock()
+	ret, specificReturn := fake.saveTestResultsReturnsOnCall[len(fake.saveTestResultsArgsForCall)]
+	fake.saveTestResultsArgsForCall = append(fake.saveTestResultsArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 []atc.TestResult
+	}{arg1, arg2, arg3Copy})
+	fake.recordInvocation("SaveTestResults", []interface{}{arg1, arg2, arg3Copy})
+	fake.saveTestResultsMutex.Unlock()
+	if fake.SaveTestResultsStub != nil {
+		return fake.SaveTestResultsStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.saveTestResultsReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeTaskDelegate) SaveTestResultsCallCount() int {
+	fake.saveTestResultsMutex.RLock()
+	defer fake.saveTestResultsMutex.RUnlock()
+	return len(fake.saveTestResultsArgsForCall)
+}
+
+func (fake *FakeTaskDelegate) SaveTestResultsCalls(stub func(lager.Logger, string, []atc.TestResult) error) {
+	fake.saveTestResultsMutex.Lock()
+	defer fake.saveTestResultsMutex.Unlock()
+	fake.SaveTestResultsStub = stub
+}
+
+func (fake *FakeTaskDelegate) SaveTestResultsArgsForCall(i int) (lager.Logger, string, []atc.TestResult) {
+	fake.saveTestResultsMutex.RLock()
+	defer fake.saveTestResultsMutex.RUnlock()
+	argsForCall := fake.saveTestResultsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeTaskDelegate) SaveTestResultsReturns(result1 error) {
+	fake.saveTestResultsMutex.Lock()
+	defer fake.saveTestResultsMutex.Unlock()
+	fake.SaveTestResultsStub = nil
+	fake.saveTestResultsReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeTaskDelegate) SaveTestResultsReturnsOnCall(i int, result1 error) {
+	fake.saveTestResultsMutex.Lock()
+	defer fake.saveTestResultsMutex.Unlock()
+	fake.SaveTestResultsStub = nil
+	if fake.saveTestResultsReturnsOnCall == nil {
+		fake.saveTestResultsReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.saveTestResultsReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeTaskDelegate) SetTaskConfig(arg1 atc.TaskConfig) {
 	fake.setTaskConfigMutex.Lock()
 	fake.setTaskConfigArgsForCall = append(fake.setTaskConfigArgsForCall, struct {
@@ -461,6 +620,8 @@ func (fake *FakeTaskDelegate) VariablesReturnsOnCall(i int, result1 vars.CredVar
 func (fake *FakeTaskDelegate) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.containerKeptMutex.RLock()
+	defer fake.containerKeptMutex.RUnlock()
 	fake.erroredMutex.RLock()
 	defer fake.erroredMutex.RUnlock()
 	fake.finishedMutex.RLock()
@@ -469,6 +630,10 @@ func (fake *FakeTaskDelegate) Invocations() map[string][][]interface{} {
 	defer fake.imageVersionDeterminedMutex.RUnlock()
 	fake.initializingMutex.RLock()
 	defer fake.initializingMutex.RUnlock()
+	fake.memoizedMutex.RLock()
+	defer fake.memoizedMutex.RUnlock()
+	fake.saveTestResultsMutex.RLock()
+	defer fake.saveTestResultsMutex.RUnlock()
 	fake.setTaskConfigMutex.RLock()
 	defer fake.setTaskConfigMutex.RUnlock()
 	fake.startingMutex.RLock()
@@ -0,0 +1,63 @@
+package exec
+
+import (
+	"context"
+
+	"code.cloudfoundry.org/lager"
+	"code.cloudfoundry.org/lager/lagerctx"
+	"github.com/concourse/concourse/atc/condition"
+)
+
+// IfStep runs the nested step only if its condition is true.
+type IfStep struct {
+	step      Step
+	condition string
+	delegate  BuildStepDelegate
+	skipped   bool
+}
+
+// If constructs an IfStep.
+func If(step Step, condition string, delegate BuildStepDelegate) Step {
+	return &IfStep{
+		step:      step,
+		condition: condition,
+		delegate:  delegate,
+	}
+}
+
+// Run evaluates the condition against the build's vars. If it is true the
+// nested step is run and its error returned. Otherwise the step is skipped,
+// which is reported to the delegate rather than treated as a failure.
+func (is *IfStep) Run(ctx context.Context, state RunState) error {
+	logger := lagerctx.FromContext(ctx).Session("if-step", lager.Data{
+		"condition": is.condition,
+	})
+
+	cond, err := condition.Parse(is.condition)
+	if err != nil {
+		return err
+	}
+
+	ok, err := cond.Evaluate(is.delegate.Variables())
+	if err != nil {
+		return err
+	}
+
+	if !ok {
+		is.skipped = true
+		is.delegate.Skipped(logger)
+		return nil
+	}
+
+	return is.step.Run(ctx, state)
+}
+
+// Succeeded is true if the step was skipped, and otherwise returns the nested
+// step's result.
+func (is *IfStep) Succeeded() bool {
+	if is.skipped {
+		return true
+	}
+
+	return is.step.Succeeded()
+}
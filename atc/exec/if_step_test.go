@@ -0,0 +1,155 @@
+package exec_test
+
+import (
+	"context"
+	"errors"
+
+	. "github.com/concourse/concourse/atc/exec"
+	"github.com/concourse/concourse/atc/exec/execfakes"
+	"github.com/concourse/concourse/vars"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("If Step", func() {
+	var (
+		ctx    context.Context
+		cancel func()
+
+		runStep      *execfakes.FakeStep
+		fakeDelegate *execfakes.FakeBuildStepDelegate
+		state        *execfakes.FakeRunState
+
+		credVarsTracker vars.CredVarsTracker
+		condition       string
+
+		step    Step
+		stepErr error
+	)
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(context.Background())
+
+		runStep = new(execfakes.FakeStep)
+		state = new(execfakes.FakeRunState)
+
+		credVarsTracker = vars.NewCredVarsTracker(vars.StaticVariables{
+			"branch": "release",
+		}, true)
+
+		fakeDelegate = new(execfakes.FakeBuildStepDelegate)
+		fakeDelegate.VariablesReturns(credVarsTracker)
+	})
+
+	JustBeforeEach(func() {
+		step = If(runStep, condition, fakeDelegate)
+		stepErr = step.Run(ctx, state)
+	})
+
+	AfterEach(func() {
+		cancel()
+	})
+
+	Context("when the condition is true", func() {
+		BeforeEach(func() {
+			condition = `((branch)) == "release"`
+		})
+
+		It("runs the nested step", func() {
+			Expect(runStep.RunCallCount()).To(Equal(1))
+			runCtx, runState := runStep.RunArgsForCall(0)
+			Expect(runCtx).To(Equal(ctx))
+			Expect(runState).To(Equal(state))
+		})
+
+		It("does not report the step as skipped", func() {
+			Expect(fakeDelegate.SkippedCallCount()).To(BeZero())
+		})
+
+		Context("when the nested step succeeds", func() {
+			BeforeEach(func() {
+				runStep.SucceededReturns(true)
+			})
+
+			It("succeeds", func() {
+				Expect(stepErr).NotTo(HaveOccurred())
+				Expect(step.Succeeded()).To(BeTrue())
+			})
+		})
+
+		Context("when the nested step fails", func() {
+			BeforeEach(func() {
+				runStep.SucceededReturns(false)
+			})
+
+			It("fails", func() {
+				Expect(step.Succeeded()).To(BeFalse())
+			})
+		})
+
+		Context("when the nested step errors", func() {
+			disaster := errors.New("nope")
+
+			BeforeEach(func() {
+				runStep.RunReturns(disaster)
+			})
+
+			It("returns the error", func() {
+				Expect(stepErr).To(Equal(disaster))
+			})
+		})
+	})
+
+	Context("when the condition is false", func() {
+		BeforeEach(func() {
+			condition = `((branch)) != "release"`
+		})
+
+		It("does not run the nested step", func() {
+			Expect(runStep.RunCallCount()).To(BeZero())
+		})
+
+		It("reports the step as skipped", func() {
+			Expect(fakeDelegate.SkippedCallCount()).To(Equal(1))
+		})
+
+		It("succeeds", func() {
+			Expect(stepErr).NotTo(HaveOccurred())
+			Expect(step.Succeeded()).To(BeTrue())
+		})
+	})
+
+	Context("when the condition refers to a local var", func() {
+		BeforeEach(func() {
+			condition = "((.:deploy))"
+			credVarsTracker.AddLocalVar("deploy", "", false)
+		})
+
+		It("evaluates it", func() {
+			Expect(runStep.RunCallCount()).To(BeZero())
+			Expect(fakeDelegate.SkippedCallCount()).To(Equal(1))
+		})
+	})
+
+	Context("when the condition refers to an undefined var", func() {
+		BeforeEach(func() {
+			condition = "((bogus))"
+		})
+
+		It("returns an error without running the nested step", func() {
+			Expect(stepErr).To(HaveOccurred())
+			Expect(runStep.RunCallCount()).To(BeZero())
+			Expect(fakeDelegate.SkippedCallCount()).To(BeZero())
+		})
+	})
+
+	Context("when the condition is malformed", func() {
+		BeforeEach(func() {
+			condition = "((branch)) =="
+		})
+
+		It("returns an error", func() {
+			Expect(stepErr).To(MatchError("unexpected end of expression"))
+		})
+	})
+})
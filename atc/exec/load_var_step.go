@@ -6,10 +6,12 @@ import (
 	"fmt"
 	"io/ioutil"
 	"path/filepath"
+	"strconv"
 	"strings"
 
 	"code.cloudfoundry.org/lager"
 	"code.cloudfoundry.org/lager/lagerctx"
+	"github.com/BurntSushi/toml"
 	"sigs.k8s.io/yaml"
 
 	"github.com/concourse/baggageclaim"
@@ -64,6 +66,15 @@ func (err InvalidLocalVarFile) Error() string {
 	return fmt.Sprintf("failed to parse %s in format %s: %s", err.File, err.Format, err.Err.Error())
 }
 
+type LoadVarFieldNotFoundError struct {
+	File  string
+	Field string
+}
+
+func (err LoadVarFieldNotFoundError) Error() string {
+	return fmt.Sprintf("field '%s' not found in %s", err.Field, err.File)
+}
+
 func (step *LoadVarStep) Run(ctx context.Context, state RunState) error {
 	logger := lagerctx.FromContext(ctx)
 	logger = logger.Session("load-var-step", lager.Data{
@@ -88,6 +99,18 @@ func (step *LoadVarStep) Run(ctx context.Context, state RunState) error {
 	}
 	fmt.Fprintf(stdout, "var %s fetched.\n", step.plan.Name)
 
+	if step.plan.Field != "" {
+		var found bool
+		value, found, err = selectField(value, step.plan.Field)
+		if err != nil {
+			return err
+		}
+
+		if !found {
+			return LoadVarFieldNotFoundError{step.plan.File, step.plan.Field}
+		}
+	}
+
 	step.delegate.Variables().AddLocalVar(step.plan.Name, value, !step.plan.Reveal)
 	fmt.Fprintf(stdout, "added var %s to build.\n", step.plan.Name)
 
@@ -158,6 +181,22 @@ func (step *LoadVarStep) fetchVars(
 		if err != nil {
 			return nil, InvalidLocalVarFile{file, "yaml", err}
 		}
+	case "toml":
+		value = map[string]interface{}{}
+		err = toml.Unmarshal(fileContent, &value)
+		if err != nil {
+			return nil, InvalidLocalVarFile{file, "toml", err}
+		}
+	case "dotenv":
+		value, err = parseDotenv(fileContent)
+		if err != nil {
+			return nil, InvalidLocalVarFile{file, "dotenv", err}
+		}
+	case "properties":
+		value, err = parseProperties(fileContent)
+		if err != nil {
+			return nil, InvalidLocalVarFile{file, "properties", err}
+		}
 	case "raw":
 		value = string(fileContent)
 	default:
@@ -176,6 +215,10 @@ func (step *LoadVarStep) fileFormat(file string) (string, error) {
 
 	fileExt := filepath.Ext(file)
 	format := strings.TrimPrefix(fileExt, ".")
+	if format == "env" {
+		return "dotenv", nil
+	}
+
 	if step.isValidFormat(format) {
 		return format, nil
 	}
@@ -185,8 +228,251 @@ func (step *LoadVarStep) fileFormat(file string) (string, error) {
 
 func (step *LoadVarStep) isValidFormat(format string) bool {
 	switch format {
-	case "raw", "yml", "yaml", "json":
+	case "raw", "yml", "yaml", "json", "toml", "dotenv", "properties":
 		return true
 	}
 	return false
 }
+
+// parseDotenv parses KEY=VALUE lines, as read by most dotenv libraries.
+// Values may be single-quoted (taken literally) or double-quoted (supporting
+// \n, \t, \" and \\ escapes). Unquoted values end at a ' #' comment. Blank
+// lines, '#' comments and a leading 'export ' are ignored.
+func parseDotenv(content []byte) (map[string]interface{}, error) {
+	values := map[string]interface{}{}
+
+	for i, line := range strings.Split(string(content), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		line = strings.TrimPrefix(line, "export ")
+
+		eq := strings.Index(line, "=")
+		if eq == -1 {
+			return nil, fmt.Errorf("line %d: expected KEY=VALUE", i+1)
+		}
+
+		key := strings.TrimSpace(line[:eq])
+		if key == "" {
+			return nil, fmt.Errorf("line %d: missing key", i+1)
+		}
+
+		value := strings.TrimSpace(line[eq+1:])
+
+		switch {
+		case strings.HasPrefix(value, "'"):
+			end := strings.Index(value[1:], "'")
+			if end == -1 {
+				return nil, fmt.Errorf("line %d: unterminated quoted value", i+1)
+			}
+
+			value = value[1 : end+1]
+
+		case strings.HasPrefix(value, `"`):
+			var unquoted strings.Builder
+			terminated := false
+
+			for j := 1; j < len(value); j++ {
+				c := value[j]
+				if c == '"' {
+					terminated = true
+					break
+				}
+
+				if c == '\\' && j+1 < len(value) {
+					j++
+					switch value[j] {
+					case 'n':
+						unquoted.WriteByte('\n')
+					case 't':
+						unquoted.WriteByte('\t')
+					default:
+						unquoted.WriteByte(value[j])
+					}
+					continue
+				}
+
+				unquoted.WriteByte(c)
+			}
+
+			if !terminated {
+				return nil, fmt.Errorf("line %d: unterminated quoted value", i+1)
+			}
+
+			value = unquoted.String()
+
+		default:
+			if comment := strings.Index(value, " #"); comment != -1 {
+				value = strings.TrimSpace(value[:comment])
+			}
+		}
+
+		values[key] = value
+	}
+
+	return values, nil
+}
+
+// parseProperties parses a Java .properties file. Keys and values are
+// separated by '=', ':' or whitespace, lines ending in a backslash continue
+// onto the next line, and '#' and '!' start comments.
+func parseProperties(content []byte) (map[string]interface{}, error) {
+	values := map[string]interface{}{}
+
+	lines := strings.Split(strings.Replace(string(content), "\r\n", "\n", -1), "\n")
+	for i := 0; i < len(lines); i++ {
+		line := strings.TrimLeft(lines[i], " \t\f")
+		if line == "" || line[0] == '#' || line[0] == '!' {
+			continue
+		}
+
+		for continuesOnNextLine(line) && i+1 < len(lines) {
+			i++
+			line = line[:len(line)-1] + strings.TrimLeft(lines[i], " \t\f")
+		}
+
+		key, rest := splitPropertiesKey(line)
+
+		value, err := unescapeProperties(strings.TrimLeft(rest, " \t\f"))
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %s", i+1, err)
+		}
+
+		key, err = unescapeProperties(key)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %s", i+1, err)
+		}
+
+		values[key] = value
+	}
+
+	return values, nil
+}
+
+// continuesOnNextLine returns whether the line ends in an odd number of
+// backslashes, i.e. an unescaped line continuation.
+func continuesOnNextLine(line string) bool {
+	backslashes := 0
+	for i := len(line) - 1; i >= 0 && line[i] == '\\'; i-- {
+		backslashes++
+	}
+
+	return backslashes%2 == 1
+}
+
+func splitPropertiesKey(line string) (string, string) {
+	for i := 0; i < len(line); i++ {
+		switch line[i] {
+		case '\\':
+			i++
+		case '=', ':':
+			return line[:i], line[i+1:]
+		case ' ', '\t', '\f':
+			rest := strings.TrimLeft(line[i:], " \t\f")
+			if rest != "" && (rest[0] == '=' || rest[0] == ':') {
+				rest = rest[1:]
+			}
+
+			return line[:i], rest
+		}
+	}
+
+	return line, ""
+}
+
+func unescapeProperties(s string) (string, error) {
+	if !strings.Contains(s, "\\") {
+		return s, nil
+	}
+
+	var unescaped strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] != '\\' || i+1 == len(s) {
+			unescaped.WriteByte(s[i])
+			continue
+		}
+
+		i++
+		switch s[i] {
+		case 't':
+			unescaped.WriteByte('\t')
+		case 'n':
+			unescaped.WriteByte('\n')
+		case 'r':
+			unescaped.WriteByte('\r')
+		case 'f':
+			unescaped.WriteByte('\f')
+		case 'u':
+			if i+5 > len(s) {
+				return "", fmt.Errorf("invalid unicode escape '\\%s'", s[i:])
+			}
+
+			r, err := strconv.ParseUint(s[i+1:i+5], 16, 16)
+			if err != nil {
+				return "", fmt.Errorf("invalid unicode escape '\\%s'", s[i:i+5])
+			}
+
+			unescaped.WriteRune(rune(r))
+			i += 4
+		default:
+			unescaped.WriteByte(s[i])
+		}
+	}
+
+	return unescaped.String(), nil
+}
+
+// selectField extracts a sub-value from a loaded value given a path such as
+// 'foo.bar[0].baz'. A top-level key matching the whole field is picked first,
+// so that the dotted keys of properties and dotenv files can be selected
+// as-is. It returns false if any part of the path is missing; a field which is
+// present but null is found with a nil value.
+func selectField(value interface{}, field string) (interface{}, bool, error) {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		if literal, found := v[field]; found {
+			return literal, true, nil
+		}
+	case map[interface{}]interface{}:
+		if literal, found := v[field]; found {
+			return literal, true, nil
+		}
+	}
+
+	path, err := atc.ParseFieldPath(field)
+	if err != nil {
+		return nil, false, err
+	}
+
+	for _, seg := range path {
+		var found bool
+		if seg.Key != "" {
+			switch v := value.(type) {
+			case map[string]interface{}:
+				value, found = v[seg.Key]
+			case map[interface{}]interface{}:
+				value, found = v[seg.Key]
+			}
+		} else {
+			switch v := value.(type) {
+			case []interface{}:
+				if seg.Index < len(v) {
+					value, found = v[seg.Index], true
+				}
+			case []map[string]interface{}:
+				// arrays of tables in TOML
+				if seg.Index < len(v) {
+					value, found = v[seg.Index], true
+				}
+			}
+		}
+
+		if !found {
+			return nil, false, nil
+		}
+	}
+
+	return value, true, nil
+}
@@ -31,6 +31,14 @@ const jsonString = `
 }
 `
 
+const dotenvString = `
+# comment
+K1=dv1
+K2="dv 2"
+K3='dv\n3'
+export K4=dv4 # trailing comment
+`
+
 var _ = Describe("LoadVarStep", func() {
 
 	var (
@@ -303,6 +311,212 @@ var _ = Describe("LoadVarStep", func() {
 		})
 	})
 
+	Context("when format is toml", func() {
+		BeforeEach(func() {
+			loadVarPlan = &atc.LoadVarPlan{
+				Name:   "some-var",
+				File:   "some-resource/a.toml",
+				Format: "toml",
+			}
+
+			fakeWorkerClient.StreamFileFromArtifactReturns(&fakeReadCloser{str: "k1 = \"tv1\"\n\n[k2]\nk3 = \"tv3\"\n"}, nil)
+		})
+
+		It("step should not fail", func() {
+			Expect(stepErr).ToNot(HaveOccurred())
+		})
+
+		It("should var parsed correctly", func() {
+			value, err := vars.NewTemplate([]byte("((.:some-var.k1))((.:some-var.k2.k3))")).Evaluate(credVarsTracker, vars.EvaluateOpts{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(value)).To(Equal("tv1tv3\n"))
+		})
+	})
+
+	Context("when format is dotenv", func() {
+		BeforeEach(func() {
+			loadVarPlan = &atc.LoadVarPlan{
+				Name:   "some-var",
+				File:   "some-resource/a.txt",
+				Format: "dotenv",
+			}
+
+			fakeWorkerClient.StreamFileFromArtifactReturns(&fakeReadCloser{str: dotenvString}, nil)
+		})
+
+		It("step should not fail", func() {
+			Expect(stepErr).ToNot(HaveOccurred())
+		})
+
+		It("should var parsed correctly", func() {
+			value, err := vars.NewTemplate([]byte("((.:some-var.K1))|((.:some-var.K2))|((.:some-var.K3))|((.:some-var.K4))")).Evaluate(credVarsTracker, vars.EvaluateOpts{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(value)).To(Equal("dv1|dv 2|dv\\n3|dv4\n"))
+		})
+	})
+
+	Context("when the file extension is .env", func() {
+		BeforeEach(func() {
+			loadVarPlan = &atc.LoadVarPlan{
+				Name: "some-var",
+				File: "some-resource/a.env",
+			}
+
+			fakeWorkerClient.StreamFileFromArtifactReturns(&fakeReadCloser{str: dotenvString}, nil)
+		})
+
+		It("should var parsed as dotenv", func() {
+			value, err := vars.NewTemplate([]byte("((.:some-var.K1))")).Evaluate(credVarsTracker, vars.EvaluateOpts{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(value)).To(Equal("dv1\n"))
+		})
+	})
+
+	Context("when format is properties", func() {
+		BeforeEach(func() {
+			loadVarPlan = &atc.LoadVarPlan{
+				Name: "some-var",
+				File: "some-resource/a.properties",
+			}
+
+			fakeWorkerClient.StreamFileFromArtifactReturns(&fakeReadCloser{str: "# comment\n! another\nk1=pv1\nk2 : pv2\nk3 pv\\\n    3\nk4=\\u0070v4\n"}, nil)
+		})
+
+		It("step should not fail", func() {
+			Expect(stepErr).ToNot(HaveOccurred())
+		})
+
+		It("should var parsed correctly", func() {
+			value, err := vars.NewTemplate([]byte(`((.:some-var.k1))|((.:some-var.k2))|((.:some-var.k3))|((.:some-var.k4))`)).Evaluate(credVarsTracker, vars.EvaluateOpts{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(value)).To(Equal("pv1|pv2|pv3|pv4\n"))
+		})
+
+		Context("when a dotted key is selected as the field", func() {
+			BeforeEach(func() {
+				loadVarPlan.Field = "db.url"
+				fakeWorkerClient.StreamFileFromArtifactReturns(&fakeReadCloser{str: "db.url=postgres://db:5432\ndb.user=admin\n"}, nil)
+			})
+
+			It("step should not fail", func() {
+				Expect(stepErr).ToNot(HaveOccurred())
+			})
+
+			It("should store the value of the key", func() {
+				value, err := vars.NewTemplate([]byte("((.:some-var))")).Evaluate(credVarsTracker, vars.EvaluateOpts{})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(string(value)).To(Equal("postgres://db:5432\n"))
+			})
+		})
+	})
+
+	Context("when a field is specified", func() {
+		BeforeEach(func() {
+			loadVarPlan = &atc.LoadVarPlan{
+				Name:  "some-var",
+				File:  "some-resource/a.json",
+				Field: "items[1].meta.name",
+			}
+
+			fakeWorkerClient.StreamFileFromArtifactReturns(&fakeReadCloser{str: `{"items":[{"meta":{"name":"first"}},{"meta":{"name":"second"}}]}`}, nil)
+		})
+
+		It("step should not fail", func() {
+			Expect(stepErr).ToNot(HaveOccurred())
+		})
+
+		It("should store only the selected field", func() {
+			value, err := vars.NewTemplate([]byte("((.:some-var))")).Evaluate(credVarsTracker, vars.EvaluateOpts{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(value)).To(Equal("second\n"))
+		})
+
+		Context("when the field does not exist", func() {
+			BeforeEach(func() {
+				loadVarPlan.Field = "items[2].meta.name"
+			})
+
+			It("step should fail", func() {
+				Expect(stepErr).To(Equal(exec.LoadVarFieldNotFoundError{
+					File:  "some-resource/a.json",
+					Field: "items[2].meta.name",
+				}))
+			})
+		})
+
+		Context("when the field is null", func() {
+			BeforeEach(func() {
+				loadVarPlan.Field = "items[0].meta.owner"
+				fakeWorkerClient.StreamFileFromArtifactReturns(&fakeReadCloser{str: `{"items":[{"meta":{"name":"first","owner":null}}]}`}, nil)
+			})
+
+			It("step should not fail", func() {
+				Expect(stepErr).ToNot(HaveOccurred())
+			})
+
+			It("should store the null value", func() {
+				value, found, err := credVarsTracker.Get(vars.VariableDefinition{Name: ".:some-var"})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(value).To(BeNil())
+			})
+		})
+
+		Context("when a quoted key is selected", func() {
+			BeforeEach(func() {
+				loadVarPlan.Field = `items[0]["build.number"]`
+				fakeWorkerClient.StreamFileFromArtifactReturns(&fakeReadCloser{str: `{"items":[{"build.number":"v42","build":{"number":"wrong"}}]}`}, nil)
+			})
+
+			It("should store only the selected field", func() {
+				value, err := vars.NewTemplate([]byte("((.:some-var))")).Evaluate(credVarsTracker, vars.EvaluateOpts{})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(string(value)).To(Equal("v42\n"))
+			})
+		})
+
+		Context("when selecting from an array of tables in toml", func() {
+			BeforeEach(func() {
+				loadVarPlan.File = "some-resource/a.toml"
+				loadVarPlan.Field = "items[1].name"
+				fakeWorkerClient.StreamFileFromArtifactReturns(&fakeReadCloser{str: "[[items]]\nname = \"first\"\n\n[[items]]\nname = \"second\"\n"}, nil)
+			})
+
+			It("step should not fail", func() {
+				Expect(stepErr).ToNot(HaveOccurred())
+			})
+
+			It("should store only the selected field", func() {
+				value, err := vars.NewTemplate([]byte("((.:some-var))")).Evaluate(credVarsTracker, vars.EvaluateOpts{})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(string(value)).To(Equal("second\n"))
+			})
+
+			Context("when the index is out of range", func() {
+				BeforeEach(func() {
+					loadVarPlan.Field = "items[2].name"
+				})
+
+				It("step should fail", func() {
+					Expect(stepErr).To(Equal(exec.LoadVarFieldNotFoundError{
+						File:  "some-resource/a.toml",
+						Field: "items[2].name",
+					}))
+				})
+			})
+		})
+
+		Context("when the field is invalid", func() {
+			BeforeEach(func() {
+				loadVarPlan.Field = "items[x]"
+			})
+
+			It("step should fail", func() {
+				Expect(stepErr).To(MatchError(ContainSubstring("invalid field 'items[x]'")))
+			})
+		})
+	})
+
 	Context("when file is bad", func() {
 		Context("when json file is bad", func() {
 			BeforeEach(func() {
@@ -2,6 +2,12 @@ package exec
 
 import (
 	"context"
+	"math"
+	"time"
+
+	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/lager/lagerctx"
+	"github.com/concourse/concourse/atc"
 )
 
 // RetryStep is a step that will run the steps in order until one of them
@@ -9,20 +15,46 @@ import (
 type RetryStep struct {
 	Attempts    []Step
 	LastAttempt Step
+
+	retryOn  string
+	backoff  *atc.RetryBackoff
+	delegate BuildStepDelegate
+	clock    clock.Clock
 }
 
-func Retry(attempts ...Step) Step {
+// Retry constructs a RetryStep. An attempt which errored or failed is only
+// retried if retryOn allows it (an empty retryOn retries both), waiting
+// between attempts as configured by backoff, if any.
+func Retry(
+	attempts []Step,
+	retryOn string,
+	backoff *atc.RetryBackoff,
+	delegate BuildStepDelegate,
+	clock clock.Clock,
+) Step {
 	return &RetryStep{
 		Attempts: attempts,
+		retryOn:  retryOn,
+		backoff:  backoff,
+		delegate: delegate,
+		clock:    clock,
 	}
 }
 
-// Run iterates through each step, stopping once a step succeeds. If all steps
+// Run iterates through each step, stopping once a step succeeds or once a
+// step errors or fails in a way that should not be retried. If all steps
 // fail, the RetryStep will fail.
 func (step *RetryStep) Run(ctx context.Context, state RunState) error {
+	logger := lagerctx.FromContext(ctx)
+
+	delay, err := newRetryDelay(step.backoff)
+	if err != nil {
+		return err
+	}
+
 	var attemptErr error
 
-	for _, attempt := range step.Attempts {
+	for i, attempt := range step.Attempts {
 		step.LastAttempt = attempt
 
 		attemptErr = attempt.Run(ctx, state)
@@ -30,13 +62,32 @@ func (step *RetryStep) Run(ctx context.Context, state RunState) error {
 			return ctx.Err()
 		}
 
+		var reason string
 		if attemptErr != nil {
-			continue
+			reason = atc.RetryOnErrored
+		} else if attempt.Succeeded() {
+			break
+		} else {
+			reason = atc.RetryOnFailed
 		}
 
-		if attempt.Succeeded() {
+		if i == len(step.Attempts)-1 || !step.shouldRetry(reason) {
 			break
 		}
+
+		wait := delay.after(i)
+		step.delegate.Retrying(logger, i+1, reason, wait)
+
+		if wait > 0 {
+			timer := step.clock.NewTimer(wait)
+
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return ctx.Err()
+			case <-timer.C():
+			}
+		}
 	}
 
 	return attemptErr
@@ -46,3 +97,64 @@ func (step *RetryStep) Run(ctx context.Context, state RunState) error {
 func (step *RetryStep) Succeeded() bool {
 	return step.LastAttempt.Succeeded()
 }
+
+func (step *RetryStep) shouldRetry(reason string) bool {
+	switch step.retryOn {
+	case "", atc.RetryOnAny:
+		return true
+	default:
+		return step.retryOn == reason
+	}
+}
+
+type retryDelay struct {
+	initial    time.Duration
+	multiplier float64
+	max        time.Duration
+}
+
+func newRetryDelay(backoff *atc.RetryBackoff) (retryDelay, error) {
+	if backoff == nil {
+		return retryDelay{}, nil
+	}
+
+	delay := retryDelay{
+		multiplier: backoff.Multiplier,
+	}
+
+	if delay.multiplier == 0 {
+		delay.multiplier = 2
+	}
+
+	var err error
+	if backoff.Initial != "" {
+		delay.initial, err = time.ParseDuration(backoff.Initial)
+		if err != nil {
+			return retryDelay{}, err
+		}
+	}
+
+	if backoff.Max != "" {
+		delay.max, err = time.ParseDuration(backoff.Max)
+		if err != nil {
+			return retryDelay{}, err
+		}
+	}
+
+	return delay, nil
+}
+
+// after returns how long to wait after the given (zero-indexed) attempt.
+func (delay retryDelay) after(attempt int) time.Duration {
+	wait := float64(delay.initial) * math.Pow(delay.multiplier, float64(attempt))
+
+	if delay.max > 0 && wait > float64(delay.max) {
+		return delay.max
+	}
+
+	if wait > math.MaxInt64 {
+		return time.Duration(math.MaxInt64)
+	}
+
+	return time.Duration(wait)
+}
@@ -3,7 +3,10 @@ package exec_test
 import (
 	"context"
 	"errors"
+	"time"
 
+	"code.cloudfoundry.org/clock/fakeclock"
+	"github.com/concourse/concourse/atc"
 	. "github.com/concourse/concourse/atc/exec"
 	"github.com/concourse/concourse/atc/exec/build"
 	"github.com/concourse/concourse/atc/exec/execfakes"
@@ -23,6 +26,11 @@ var _ = Describe("Retry Step", func() {
 		repo  *build.Repository
 		state *execfakes.FakeRunState
 
+		retryOn      string
+		backoff      *atc.RetryBackoff
+		fakeDelegate *execfakes.FakeBuildStepDelegate
+		fakeClock    *fakeclock.FakeClock
+
 		step Step
 	)
 
@@ -37,7 +45,14 @@ var _ = Describe("Retry Step", func() {
 		state = new(execfakes.FakeRunState)
 		state.ArtifactRepositoryReturns(repo)
 
-		step = Retry(attempt1, attempt2, attempt3)
+		retryOn = ""
+		backoff = nil
+		fakeDelegate = new(execfakes.FakeBuildStepDelegate)
+		fakeClock = fakeclock.NewFakeClock(time.Unix(123456789, 0))
+	})
+
+	JustBeforeEach(func() {
+		step = Retry([]Step{attempt1, attempt2, attempt3}, retryOn, backoff, fakeDelegate, fakeClock)
 	})
 
 	Context("when attempt 1 succeeds", func() {
@@ -147,6 +162,22 @@ var _ = Describe("Retry Step", func() {
 		})
 	})
 
+	Context("when attempt 1 errors and only failures are retried", func() {
+		disaster := errors.New("nope")
+
+		BeforeEach(func() {
+			attempt1.RunReturns(disaster)
+			retryOn = "failed"
+		})
+
+		It("returns the error without retrying", func() {
+			Expect(step.Run(ctx, state)).To(Equal(disaster))
+
+			Expect(attempt2.RunCallCount()).To(Equal(0))
+			Expect(fakeDelegate.RetryingCallCount()).To(Equal(0))
+		})
+	})
+
 	Context("when attempt 1 errors, and attempt 2 is interrupted", func() {
 		BeforeEach(func() {
 			attempt1.RunReturns(errors.New("nope"))
@@ -249,6 +280,19 @@ var _ = Describe("Retry Step", func() {
 				Expect(attempt3.RunCallCount()).To(Equal(1))
 			})
 
+			It("emits a retry event for each failed attempt", func() {
+				Expect(fakeDelegate.RetryingCallCount()).To(Equal(2))
+
+				_, attempt, reason, delay := fakeDelegate.RetryingArgsForCall(0)
+				Expect(attempt).To(Equal(1))
+				Expect(reason).To(Equal("failed"))
+				Expect(delay).To(BeZero())
+
+				_, attempt, reason, _ = fakeDelegate.RetryingArgsForCall(1)
+				Expect(attempt).To(Equal(2))
+				Expect(reason).To(Equal("failed"))
+			})
+
 			Describe("Succeeded", func() {
 				It("delegates to attempt 3", func() {
 					// internal check for success within retry loop
@@ -262,6 +306,65 @@ var _ = Describe("Retry Step", func() {
 				})
 			})
 		})
+
+		Context("when only retrying errors", func() {
+			BeforeEach(func() {
+				retryOn = "errored"
+			})
+
+			It("fails without retrying", func() {
+				Expect(step.Run(ctx, state)).To(Succeed())
+				Expect(step.Succeeded()).To(BeFalse())
+
+				Expect(attempt1.RunCallCount()).To(Equal(1))
+				Expect(attempt2.RunCallCount()).To(Equal(0))
+				Expect(fakeDelegate.RetryingCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("with a backoff", func() {
+			BeforeEach(func() {
+				backoff = &atc.RetryBackoff{
+					Initial:    "10s",
+					Multiplier: 3,
+					Max:        "20s",
+				}
+			})
+
+			It("waits between attempts, up to the max", func() {
+				stepErr := make(chan error, 1)
+				go func() {
+					stepErr <- step.Run(ctx, state)
+				}()
+
+				fakeClock.WaitForWatcherAndIncrement(10 * time.Second)
+				Eventually(attempt2.RunCallCount).Should(Equal(1))
+				Expect(attempt3.RunCallCount()).To(Equal(0))
+
+				fakeClock.WaitForWatcherAndIncrement(20 * time.Second)
+				Eventually(stepErr).Should(Receive(BeNil()))
+				Expect(attempt3.RunCallCount()).To(Equal(1))
+
+				_, _, _, delay := fakeDelegate.RetryingArgsForCall(0)
+				Expect(delay).To(Equal(10 * time.Second))
+
+				_, _, _, delay = fakeDelegate.RetryingArgsForCall(1)
+				Expect(delay).To(Equal(20 * time.Second))
+			})
+
+			It("stops waiting when interrupted", func() {
+				stepErr := make(chan error, 1)
+				go func() {
+					stepErr <- step.Run(ctx, state)
+				}()
+
+				Eventually(fakeClock.WatcherCount).Should(Equal(1))
+				cancel()
+
+				Eventually(stepErr).Should(Receive(Equal(context.Canceled)))
+				Expect(attempt2.RunCallCount()).To(Equal(0))
+			})
+		})
 	})
 
 	Context("when attempt 1 fails, attempt 2 fails, and attempt 3 errors", func() {
@@ -25,13 +25,34 @@ import (
 // SetPipelineStep sets a pipeline to current team. This step takes pipeline
 // configure file and var files from some resource in the pipeline, like git.
 type SetPipelineStep struct {
-	planID      atc.PlanID
-	plan        atc.SetPipelinePlan
-	metadata    StepMetadata
-	delegate    BuildStepDelegate
-	teamFactory db.TeamFactory
-	client      worker.Client
-	succeeded   bool
+	planID       atc.PlanID
+	plan         atc.SetPipelinePlan
+	metadata     StepMetadata
+	delegate     BuildStepDelegate
+	teamFactory  db.TeamFactory
+	buildFactory db.BuildFactory
+	client       worker.Client
+	teamAuths    []SetPipelineTeamAuthorization
+	succeeded    bool
+}
+
+// SetPipelineTeamAuthorization allows the builds of a team to set pipelines
+// in another team. It is given as a flag in the form BUILD-TEAM:TARGET-TEAM.
+type SetPipelineTeamAuthorization struct {
+	BuildTeam  string
+	TargetTeam string
+}
+
+func (auth *SetPipelineTeamAuthorization) UnmarshalFlag(value string) error {
+	teams := strings.Split(value, ":")
+	if len(teams) != 2 || teams[0] == "" || teams[1] == "" {
+		return fmt.Errorf("invalid team authorization '%s', expected BUILD-TEAM:TARGET-TEAM", value)
+	}
+
+	auth.BuildTeam = teams[0]
+	auth.TargetTeam = teams[1]
+
+	return nil
 }
 
 func NewSetPipelineStep(
@@ -40,15 +61,19 @@ func NewSetPipelineStep(
 	metadata StepMetadata,
 	delegate BuildStepDelegate,
 	teamFactory db.TeamFactory,
+	buildFactory db.BuildFactory,
 	client worker.Client,
+	teamAuths []SetPipelineTeamAuthorization,
 ) Step {
 	return &SetPipelineStep{
-		planID:      planID,
-		plan:        plan,
-		metadata:    metadata,
-		delegate:    delegate,
-		teamFactory: teamFactory,
-		client:      client,
+		planID:       planID,
+		plan:         plan,
+		metadata:     metadata,
+		delegate:     delegate,
+		teamFactory:  teamFactory,
+		buildFactory: buildFactory,
+		client:       client,
+		teamAuths:    teamAuths,
 	}
 }
 
@@ -105,10 +130,18 @@ func (step *SetPipelineStep) Run(ctx context.Context, state RunState) error {
 		return nil
 	}
 
-	team := step.teamFactory.GetByID(step.metadata.TeamID)
+	team, err := step.team()
+	if err != nil {
+		return err
+	}
+
+	pipelineRef := atc.PipelineRef{
+		Name:         step.plan.Name,
+		InstanceVars: step.plan.InstanceVars,
+	}
 
 	fromVersion := db.ConfigVersion(0)
-	pipeline, found, err := team.Pipeline(step.plan.Name)
+	pipeline, found, err := team.Pipeline(pipelineRef)
 	if err != nil {
 		return err
 	}
@@ -124,30 +157,102 @@ func (step *SetPipelineStep) Run(ctx context.Context, state RunState) error {
 		}
 	}
 
+	parentBuild, found, err := step.buildFactory.Build(step.metadata.BuildID)
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		return fmt.Errorf("build %d not found", step.metadata.BuildID)
+	}
+
 	diffExists := existingConfig.Diff(stdout, atcConfig)
 	if !diffExists {
 		logger.Debug("no-diff")
 
+		// the pipeline is still set by this build, even if it is unchanged
+		if pipeline != nil {
+			err = parentBuild.SetPipelineParent(pipeline.ID())
+			if err != nil {
+				return err
+			}
+		}
+
 		fmt.Fprintf(stdout, "no diff found.\n")
 		step.succeeded = true
 		step.delegate.Finished(logger, true)
 		return nil
 	}
 
-	fmt.Fprintf(stdout, "setting pipeline: %s\n", step.plan.Name)
-	pipeline, _, err = team.SavePipeline(step.plan.Name, atcConfig, fromVersion, false)
+	if step.plan.Team != "" {
+		fmt.Fprintf(stdout, "setting pipeline: %s in team %s\n", pipelineRef, team.Name())
+	} else {
+		fmt.Fprintf(stdout, "setting pipeline: %s\n", pipelineRef)
+	}
+
+	pipeline, _, err = parentBuild.SavePipeline(pipelineRef, team.ID(), atcConfig, fromVersion, false)
 	if err != nil {
 		return err
 	}
 
 	fmt.Fprintf(stdout, "done\n")
-	logger.Info("saved-pipeline", lager.Data{"team": team.Name(), "pipeline": pipeline.Name()})
+	logger.Info("saved-pipeline", lager.Data{
+		"team":       team.Name(),
+		"pipeline":   pipeline.Name(),
+		"build-team": step.metadata.TeamName,
+	})
 	step.succeeded = true
 	step.delegate.Finished(logger, true)
 
 	return nil
 }
 
+// team returns the team to set the pipeline in. Only builds of an admin team,
+// or of a team explicitly authorized to, may set pipelines in a team other
+// than their own.
+func (step *SetPipelineStep) team() (db.Team, error) {
+	if step.plan.Team == "" {
+		return step.teamFactory.GetByID(step.metadata.TeamID), nil
+	}
+
+	fmt.Fprintln(step.delegate.Stderr(), "\x1b[1;33mWARNING: specifying the team in a set_pipeline step is experimental and subject to change!\x1b[0m")
+	fmt.Fprintln(step.delegate.Stderr(), "")
+
+	currentTeam, found, err := step.teamFactory.FindTeam(step.metadata.TeamName)
+	if err != nil {
+		return nil, err
+	}
+
+	if !found {
+		return nil, fmt.Errorf("team %s not found", step.metadata.TeamName)
+	}
+
+	targetTeam, found, err := step.teamFactory.FindTeam(step.plan.Team)
+	if err != nil {
+		return nil, err
+	}
+
+	if !found {
+		return nil, fmt.Errorf("team %s not found", step.plan.Team)
+	}
+
+	if targetTeam.ID() != currentTeam.ID() && !currentTeam.Admin() && !step.authorized(currentTeam, targetTeam) {
+		return nil, fmt.Errorf("team %s is not authorized to set a pipeline in team %s", currentTeam.Name(), targetTeam.Name())
+	}
+
+	return targetTeam, nil
+}
+
+func (step *SetPipelineStep) authorized(buildTeam db.Team, targetTeam db.Team) bool {
+	for _, auth := range step.teamAuths {
+		if auth.BuildTeam == buildTeam.Name() && auth.TargetTeam == targetTeam.Name() {
+			return true
+		}
+	}
+
+	return false
+}
+
 func (step *SetPipelineStep) Succeeded() bool {
 	return step.succeeded
 }
@@ -177,6 +282,9 @@ func (s setPipelineSource) FetchPipelineConfig() (atc.Config, error) {
 	}
 
 	staticVars := []vars.Variables{}
+	if len(s.step.plan.InstanceVars) > 0 {
+		staticVars = append(staticVars, vars.StaticVariables(s.step.plan.InstanceVars))
+	}
 	if len(s.step.plan.Vars) > 0 {
 		staticVars = append(staticVars, vars.StaticVariables(s.step.plan.Vars))
 	}
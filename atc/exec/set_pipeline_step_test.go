@@ -16,6 +16,7 @@ import (
 	. "github.com/onsi/gomega"
 
 	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/db/dbfakes"
 	"github.com/concourse/concourse/atc/exec"
 	"github.com/concourse/concourse/atc/exec/execfakes"
@@ -81,7 +82,11 @@ jobs:
 		fakeTeam        *dbfakes.FakeTeam
 		fakePipeline    *dbfakes.FakePipeline
 
+		fakeBuildFactory *dbfakes.FakeBuildFactory
+		fakeBuild        *dbfakes.FakeBuild
+
 		fakeWorkerClient *workerfakes.FakeClient
+		teamAuths        []exec.SetPipelineTeamAuthorization
 
 		spPlan             *atc.SetPipelinePlan
 		artifactRepository *build.Repository
@@ -133,12 +138,20 @@ jobs:
 		fakeTeamFactory = new(dbfakes.FakeTeamFactory)
 		fakeTeam = new(dbfakes.FakeTeam)
 		fakePipeline = new(dbfakes.FakePipeline)
+		fakePipeline.IDReturns(789)
 
+		fakeTeam.IDReturns(123)
 		fakeTeam.NameReturns("some-team")
 		fakePipeline.NameReturns("some-pipeline")
 		fakeTeamFactory.GetByIDReturns(fakeTeam)
 
+		fakeBuildFactory = new(dbfakes.FakeBuildFactory)
+		fakeBuild = new(dbfakes.FakeBuild)
+		fakeBuild.IDReturns(42)
+		fakeBuildFactory.BuildReturns(fakeBuild, true, nil)
+
 		fakeWorkerClient = new(workerfakes.FakeClient)
+		teamAuths = nil
 
 		spPlan = &atc.SetPipelinePlan{
 			Name: "some-pipeline",
@@ -162,7 +175,9 @@ jobs:
 			stepMetadata,
 			fakeDelegate,
 			fakeTeamFactory,
+			fakeBuildFactory,
 			fakeWorkerClient,
+			teamAuths,
 		)
 
 		stepErr = spStep.Run(ctx, state)
@@ -233,25 +248,52 @@ jobs:
 			Context("when specified pipeline not found", func() {
 				BeforeEach(func() {
 					fakeTeam.PipelineReturns(nil, false, nil)
-					fakeTeam.SavePipelineReturns(fakePipeline, true, nil)
+					fakeBuild.SavePipelineReturns(fakePipeline, true, nil)
 				})
 
 				It("should save the pipeline un-paused", func() {
-					Expect(fakeTeam.SavePipelineCallCount()).To(Equal(1))
-					name, _, _, paused := fakeTeam.SavePipelineArgsForCall(0)
-					Expect(name).To(Equal("some-pipeline"))
+					Expect(fakeBuild.SavePipelineCallCount()).To(Equal(1))
+					name, teamID, _, _, paused := fakeBuild.SavePipelineArgsForCall(0)
+					Expect(name).To(Equal(atc.PipelineRef{Name: "some-pipeline"}))
+					Expect(teamID).To(Equal(123))
 					Expect(paused).To(BeFalse())
 				})
 
 				It("should stdout have message", func() {
 					Expect(stdout).To(gbytes.Say("done"))
 				})
+
+				Context("when instance vars are specified", func() {
+					BeforeEach(func() {
+						spPlan.InstanceVars = atc.InstanceVars{"branch": "feature"}
+					})
+
+					It("should look up the instance of the pipeline", func() {
+						Expect(fakeTeam.PipelineArgsForCall(0)).To(Equal(atc.PipelineRef{
+							Name:         "some-pipeline",
+							InstanceVars: atc.InstanceVars{"branch": "feature"},
+						}))
+					})
+
+					It("should save the instance of the pipeline", func() {
+						Expect(fakeBuild.SavePipelineCallCount()).To(Equal(1))
+						ref, _, _, _, _ := fakeBuild.SavePipelineArgsForCall(0)
+						Expect(ref).To(Equal(atc.PipelineRef{
+							Name:         "some-pipeline",
+							InstanceVars: atc.InstanceVars{"branch": "feature"},
+						}))
+					})
+
+					It("should stdout have message", func() {
+						Expect(stdout).To(gbytes.Say("setting pipeline: some-pipeline/branch:feature"))
+					})
+				})
 			})
 
 			Context("when specified pipeline exists already", func() {
 				BeforeEach(func() {
 					fakeTeam.PipelineReturns(fakePipeline, true, nil)
-					fakeTeam.SavePipelineReturns(fakePipeline, false, nil)
+					fakeBuild.SavePipelineReturns(fakePipeline, false, nil)
 				})
 
 				Context("when no diff", func() {
@@ -262,6 +304,25 @@ jobs:
 					It("should log no-diff", func() {
 						Expect(stdout).To(gbytes.Say("no diff found."))
 					})
+
+					It("should not save the pipeline", func() {
+						Expect(fakeBuild.SavePipelineCallCount()).To(Equal(0))
+					})
+
+					It("should still record the build as the pipeline's parent", func() {
+						Expect(fakeBuild.SetPipelineParentCallCount()).To(Equal(1))
+						Expect(fakeBuild.SetPipelineParentArgsForCall(0)).To(Equal(fakePipeline.ID()))
+					})
+
+					Context("when recording the parent fails", func() {
+						BeforeEach(func() {
+							fakeBuild.SetPipelineParentReturns(errors.New("failed to record parent"))
+						})
+
+						It("should return error", func() {
+							Expect(stepErr).To(MatchError("failed to record parent"))
+						})
+					})
 				})
 
 				Context("when there are some diff", func() {
@@ -277,7 +338,7 @@ jobs:
 
 				Context("when SavePipeline fails", func() {
 					BeforeEach(func() {
-						fakeTeam.SavePipelineReturns(nil, false, errors.New("failed to save"))
+						fakeBuild.SavePipelineReturns(nil, false, errors.New("failed to save"))
 					})
 
 					It("should return error", func() {
@@ -286,10 +347,22 @@ jobs:
 					})
 				})
 
+				Context("when the build is not found", func() {
+					BeforeEach(func() {
+						fakeBuildFactory.BuildReturns(nil, false, nil)
+					})
+
+					It("should return error", func() {
+						Expect(stepErr).To(MatchError("build 42 not found"))
+						Expect(fakeBuild.SavePipelineCallCount()).To(Equal(0))
+					})
+				})
+
 				It("should save the pipeline un-paused", func() {
-					Expect(fakeTeam.SavePipelineCallCount()).To(Equal(1))
-					name, _, _, paused := fakeTeam.SavePipelineArgsForCall(0)
-					Expect(name).To(Equal("some-pipeline"))
+					Expect(fakeBuild.SavePipelineCallCount()).To(Equal(1))
+					name, teamID, _, _, paused := fakeBuild.SavePipelineArgsForCall(0)
+					Expect(name).To(Equal(atc.PipelineRef{Name: "some-pipeline"}))
+					Expect(teamID).To(Equal(123))
 					Expect(paused).To(BeFalse())
 				})
 
@@ -304,6 +377,123 @@ jobs:
 					Expect(succeeded).To(BeTrue())
 				})
 			})
+
+			Context("when team is configured", func() {
+				var (
+					fakeUserCurrentTeam *dbfakes.FakeTeam
+					fakeTargetTeam      *dbfakes.FakeTeam
+				)
+
+				BeforeEach(func() {
+					fakeUserCurrentTeam = new(dbfakes.FakeTeam)
+					fakeUserCurrentTeam.IDReturns(123)
+					fakeUserCurrentTeam.NameReturns("some-team")
+
+					fakeTargetTeam = new(dbfakes.FakeTeam)
+					fakeTargetTeam.IDReturns(456)
+					fakeTargetTeam.NameReturns("other-team")
+					fakeTargetTeam.PipelineReturns(nil, false, nil)
+					fakeBuild.SavePipelineReturns(fakePipeline, true, nil)
+
+					fakeTeamFactory.FindTeamStub = func(name string) (db.Team, bool, error) {
+						switch name {
+						case "some-team":
+							return fakeUserCurrentTeam, true, nil
+						case "other-team":
+							return fakeTargetTeam, true, nil
+						default:
+							return nil, false, nil
+						}
+					}
+
+					spPlan.Team = "other-team"
+				})
+
+				It("should warn that it is experimental", func() {
+					Expect(stderr).To(gbytes.Say("WARNING: specifying the team in a set_pipeline step is experimental"))
+				})
+
+				Context("when the build's team is an admin team", func() {
+					BeforeEach(func() {
+						fakeUserCurrentTeam.AdminReturns(true)
+					})
+
+					It("should save the pipeline in the target team", func() {
+						Expect(stepErr).ToNot(HaveOccurred())
+						Expect(fakeBuild.SavePipelineCallCount()).To(Equal(1))
+						_, teamID, _, _, _ := fakeBuild.SavePipelineArgsForCall(0)
+						Expect(teamID).To(Equal(456))
+					})
+
+					It("should stdout have message", func() {
+						Expect(stdout).To(gbytes.Say("setting pipeline: some-pipeline in team other-team"))
+					})
+				})
+
+				Context("when the build's team is not an admin team", func() {
+					BeforeEach(func() {
+						fakeUserCurrentTeam.AdminReturns(false)
+					})
+
+					It("should return an error", func() {
+						Expect(stepErr).To(MatchError("team some-team is not authorized to set a pipeline in team other-team"))
+						Expect(fakeBuild.SavePipelineCallCount()).To(Equal(0))
+					})
+
+					Context("when the build's team is authorized to set pipelines in the target team", func() {
+						BeforeEach(func() {
+							teamAuths = []exec.SetPipelineTeamAuthorization{
+								{BuildTeam: "some-team", TargetTeam: "third-team"},
+								{BuildTeam: "some-team", TargetTeam: "other-team"},
+							}
+						})
+
+						It("should save the pipeline in the target team", func() {
+							Expect(stepErr).ToNot(HaveOccurred())
+							Expect(fakeBuild.SavePipelineCallCount()).To(Equal(1))
+							_, teamID, _, _, _ := fakeBuild.SavePipelineArgsForCall(0)
+							Expect(teamID).To(Equal(456))
+						})
+					})
+
+					Context("when only the target team is authorized to set pipelines in the build's team", func() {
+						BeforeEach(func() {
+							teamAuths = []exec.SetPipelineTeamAuthorization{
+								{BuildTeam: "other-team", TargetTeam: "some-team"},
+							}
+						})
+
+						It("should return an error", func() {
+							Expect(stepErr).To(MatchError("team some-team is not authorized to set a pipeline in team other-team"))
+							Expect(fakeBuild.SavePipelineCallCount()).To(Equal(0))
+						})
+					})
+
+					Context("when the target team is the build's team", func() {
+						BeforeEach(func() {
+							spPlan.Team = "some-team"
+							fakeUserCurrentTeam.PipelineReturns(nil, false, nil)
+						})
+
+						It("should save the pipeline", func() {
+							Expect(stepErr).ToNot(HaveOccurred())
+							Expect(fakeBuild.SavePipelineCallCount()).To(Equal(1))
+							_, teamID, _, _, _ := fakeBuild.SavePipelineArgsForCall(0)
+							Expect(teamID).To(Equal(123))
+						})
+					})
+				})
+
+				Context("when the target team does not exist", func() {
+					BeforeEach(func() {
+						spPlan.Team = "bogus-team"
+					})
+
+					It("should return an error", func() {
+						Expect(stepErr).To(MatchError("team bogus-team not found"))
+					})
+				})
+			})
 		})
 	})
 })
@@ -2,20 +2,28 @@ package exec
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/json"
 	"fmt"
 	"io"
+	"net/url"
 	"path"
 	"path/filepath"
 	"strings"
+	"time"
 
 	"code.cloudfoundry.org/lager"
 	"code.cloudfoundry.org/lager/lagerctx"
+	"github.com/concourse/baggageclaim"
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/creds"
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/db/lock"
+	"github.com/concourse/concourse/atc/exec/artifact"
 	"github.com/concourse/concourse/atc/exec/build"
+	"github.com/concourse/concourse/atc/resource"
 	"github.com/concourse/concourse/atc/runtime"
+	"github.com/concourse/concourse/atc/testreport"
 	"github.com/concourse/concourse/atc/worker"
 	"github.com/concourse/concourse/tracing"
 	"github.com/concourse/concourse/vars"
@@ -42,6 +50,20 @@ func (err MissingTaskImageSourceError) Error() string {
 make sure there's a corresponding 'get' step, or a task that produces it as an output`, err.SourceName)
 }
 
+// MemoizedTaskImageUnavailableError is returned when checking the image of a
+// memoized task finds no versions.
+type MemoizedTaskImageUnavailableError struct {
+	Type string
+}
+
+func (err MemoizedTaskImageUnavailableError) Error() string {
+	return fmt.Sprintf("no versions of the task's %s image are available", err.Type)
+}
+
+// MemoizedTaskImageCheckTimeout is how long a memoized task waits for its
+// image to be checked.
+const MemoizedTaskImageCheckTimeout = time.Hour
+
 type TaskImageSourceParametersError struct {
 	Err error
 }
@@ -64,7 +86,10 @@ type TaskDelegate interface {
 
 	Initializing(lager.Logger)
 	Starting(lager.Logger)
+	Memoized(lager.Logger, string)
+	ContainerKept(lager.Logger, string, time.Time)
 	Finished(lager.Logger, ExitStatus)
+	SaveTestResults(lager.Logger, string, []atc.TestResult) error
 	Errored(lager.Logger, string)
 }
 
@@ -78,8 +103,11 @@ type TaskStep struct {
 	containerMetadata db.ContainerMetadata
 	strategy          worker.ContainerPlacementStrategy
 	workerClient      worker.Client
+	resourceFactory   resource.ResourceFactory
 	delegate          TaskDelegate
 	lockFactory       lock.LockFactory
+	taskCacheFactory  db.TaskCacheFactory
+	volumeRepository  db.VolumeRepository
 	succeeded         bool
 }
 
@@ -91,8 +119,11 @@ func NewTaskStep(
 	containerMetadata db.ContainerMetadata,
 	strategy worker.ContainerPlacementStrategy,
 	workerClient worker.Client,
+	resourceFactory resource.ResourceFactory,
 	delegate TaskDelegate,
 	lockFactory lock.LockFactory,
+	taskCacheFactory db.TaskCacheFactory,
+	volumeRepository db.VolumeRepository,
 ) Step {
 	return &TaskStep{
 		planID:            planID,
@@ -102,8 +133,11 @@ func NewTaskStep(
 		containerMetadata: containerMetadata,
 		strategy:          strategy,
 		workerClient:      workerClient,
+		resourceFactory:   resourceFactory,
 		delegate:          delegate,
 		lockFactory:       lockFactory,
+		taskCacheFactory:  taskCacheFactory,
+		volumeRepository:  volumeRepository,
 	}
 }
 
@@ -122,6 +156,11 @@ func NewTaskStep(
 // are registered with the artifact.Repository. If no outputs are specified, the
 // task's entire working directory is registered as an StreamableArtifactSource under the
 // name of the task.
+//
+// If the plan is memoized, the task's config, image and inputs are hashed, and
+// if an earlier run of the job's task with the same hash succeeded its outputs
+// are registered instead of running the task. Memoization is skipped for
+// one-off builds, and for tasks whose image can't be resolved to a version.
 func (step *TaskStep) Run(ctx context.Context, state RunState) error {
 	ctx, span := tracing.StartSpan(ctx, "task", tracing.Attrs{
 		"team":     step.metadata.TeamName,
@@ -196,9 +235,37 @@ func (step *TaskStep) run(ctx context.Context, state RunState) error {
 	if config.Limits.Memory == nil {
 		config.Limits.Memory = step.defaultLimits.Memory
 	}
+	if config.Limits.Pids == nil {
+		config.Limits.Pids = step.defaultLimits.Pids
+	}
+
+	// not every worker can enforce these, so the operator's defaults for them
+	// are only applied where they can be, lest they fail every task
+	var bestEffortLimits []string
+	if config.Limits.CPUQuota == nil && step.defaultLimits.CPUQuota != nil {
+		config.Limits.CPUQuota = step.defaultLimits.CPUQuota
+		bestEffortLimits = append(bestEffortLimits, worker.CPUQuotaLimit)
+	}
+	if config.Limits.Disk == nil && step.defaultLimits.Disk != nil {
+		config.Limits.Disk = step.defaultLimits.Disk
+		bestEffortLimits = append(bestEffortLimits, worker.DiskLimit)
+	}
 
 	step.delegate.Initializing(logger)
 
+	var memoImage string
+	memoize := step.plan.Memoize && step.metadata.JobID != 0
+	if memoize {
+		config, memoImage, memoize, err = step.resolveMemoImage(ctx, logger, resourceTypes, config)
+		if err != nil {
+			return err
+		}
+
+		if !memoize {
+			fmt.Fprintln(step.delegate.Stderr(), "[WARNING] not memoizing task: its rootfs_uri can't be resolved to a digest")
+		}
+	}
+
 	workerSpec, err := step.workerSpec(logger, resourceTypes, repository, config)
 	if err != nil {
 		return err
@@ -209,6 +276,8 @@ func (step *TaskStep) run(ctx context.Context, state RunState) error {
 		return err
 	}
 
+	containerSpec.BestEffortLimits = bestEffortLimits
+
 	processSpec := runtime.ProcessSpec{
 		Path:         config.Run.Path,
 		Args:         config.Run.Args,
@@ -222,8 +291,43 @@ func (step *TaskStep) run(ctx context.Context, state RunState) error {
 		Delegate:      step.delegate,
 	}
 
+	var memoKey string
+	if memoize {
+		memoKey, err = step.memoKey(ctx, logger, repository, config, memoImage)
+		if err != nil {
+			return err
+		}
+
+		outputs, found, err := step.findMemoizedOutputs(logger, memoKey, config)
+		if err != nil {
+			return err
+		}
+
+		if found {
+			for name, art := range outputs {
+				repository.RegisterArtifact(build.ArtifactName(name), art)
+			}
+
+			err = step.collectReports(ctx, logger, repository)
+			if err != nil {
+				return err
+			}
+
+			step.succeeded = true
+			step.delegate.Memoized(logger, memoKey)
+			step.delegate.Finished(logger, ExitStatus(0))
+
+			return nil
+		}
+	}
+
 	owner := db.NewBuildStepContainerOwner(step.metadata.BuildID, step.planID, step.metadata.TeamID)
 
+	services, err := step.serviceSpecs(config)
+	if err != nil {
+		return err
+	}
+
 	result, err := step.workerClient.RunTaskStep(
 		ctx,
 		logger,
@@ -236,6 +340,7 @@ func (step *TaskStep) run(ctx context.Context, state RunState) error {
 		processSpec,
 		step.delegate,
 		step.lockFactory,
+		services,
 	)
 
 	if err != nil {
@@ -245,17 +350,41 @@ func (step *TaskStep) run(ctx context.Context, state RunState) error {
 		return err
 	}
 
+	step.registerOutputs(logger, repository, config, result.VolumeMounts, step.containerMetadata)
+
+	// reports are collected before the step finishes, as that closes its
+	// output
+	err = step.collectReports(ctx, logger, repository)
+	if err != nil {
+		return err
+	}
+
+	if result.KeptContainerHandle != "" {
+		fmt.Fprintf(step.delegate.Stdout(), "keeping container until %s for debugging\n", result.KeptUntil.Format(time.RFC1123Z))
+		if result.KeptFor < containerSpec.KeepOnFailure {
+			fmt.Fprintf(step.delegate.Stdout(), "  keep_on_failure is capped at %s by the operator\n", result.KeptFor)
+		}
+		fmt.Fprintf(step.delegate.Stdout(), "  intercept: fly -t <target> intercept --handle %s\n", result.KeptContainerHandle)
+
+		step.delegate.ContainerKept(logger, result.KeptContainerHandle, result.KeptUntil)
+	}
+
 	step.succeeded = result.ExitStatus == 0
 	step.delegate.Finished(logger, ExitStatus(result.ExitStatus))
 
-	step.registerOutputs(logger, repository, config, result.VolumeMounts, step.containerMetadata)
-
 	// Do not initialize caches for one-off builds
 	if step.metadata.JobID != 0 {
 		err = step.registerCaches(logger, repository, config, result.VolumeMounts, step.containerMetadata)
 		if err != nil {
 			return err
 		}
+
+		if memoKey != "" && step.succeeded {
+			err = step.memoize(logger, memoKey, config, result.VolumeMounts, step.containerMetadata)
+			if err != nil {
+				return err
+			}
+		}
 	}
 
 	return nil
@@ -360,11 +489,20 @@ func (step *TaskStep) containerSpec(logger lager.Logger, repository *build.Repos
 		User:      config.Run.User,
 		Dir:       metadata.WorkingDirectory,
 		Env:       config.Params.Env(),
+		Secrets:   config.Secrets,
+		Network:   config.Network,
 		Type:      metadata.Type,
 
 		Outputs: worker.OutputPaths{},
 	}
 
+	if step.plan.KeepOnFailure != "" {
+		containerSpec.KeepOnFailure, err = time.ParseDuration(step.plan.KeepOnFailure)
+		if err != nil {
+			return worker.ContainerSpec{}, err
+		}
+	}
+
 	containerSpec.ArtifactByPath, err = step.containerInputs(logger, repository, config, metadata)
 	if err != nil {
 		return worker.ContainerSpec{}, err
@@ -378,6 +516,76 @@ func (step *TaskStep) containerSpec(logger lager.Logger, repository *build.Repos
 	return containerSpec, nil
 }
 
+func (step *TaskStep) serviceSpecs(config atc.TaskConfig) ([]worker.ServiceSpec, error) {
+	var services []worker.ServiceSpec
+
+	for _, service := range config.Services {
+		planID := atc.PlanID(fmt.Sprintf("%s/services/%s", step.planID, service.Name))
+
+		metadata := step.containerMetadata
+		metadata.StepName = fmt.Sprintf("%s/%s", metadata.StepName, service.Name)
+		metadata.WorkingDirectory = ""
+
+		imageSpec := worker.ImageSpec{}
+		if service.ImageResource != nil {
+			imageSpec.ImageResource = &worker.ImageResource{
+				Type:    service.ImageResource.Type,
+				Source:  service.ImageResource.Source,
+				Params:  service.ImageResource.Params,
+				Version: service.ImageResource.Version,
+			}
+		} else {
+			imageSpec.ImageURL = service.RootfsURI
+		}
+
+		spec := worker.ServiceSpec{
+			Name:     service.Name,
+			Owner:    db.NewBuildStepContainerOwner(step.metadata.BuildID, planID, step.metadata.TeamID),
+			Metadata: metadata,
+			ContainerSpec: worker.ContainerSpec{
+				Platform:  config.Platform,
+				Tags:      step.plan.Tags,
+				TeamID:    step.metadata.TeamID,
+				ImageSpec: imageSpec,
+				User:      service.Run.User,
+				Env:       service.Params.Env(),
+				Type:      metadata.Type,
+			},
+			Path: service.Run.Path,
+			Args: service.Run.Args,
+			Dir:  service.Run.Dir,
+		}
+
+		if service.HealthCheck != nil {
+			check := &worker.ServiceHealthCheck{
+				Path: service.HealthCheck.Run.Path,
+				Args: service.HealthCheck.Run.Args,
+			}
+
+			var err error
+			if service.HealthCheck.Interval != "" {
+				check.Interval, err = time.ParseDuration(service.HealthCheck.Interval)
+				if err != nil {
+					return nil, err
+				}
+			}
+
+			if service.HealthCheck.Timeout != "" {
+				check.Timeout, err = time.ParseDuration(service.HealthCheck.Timeout)
+				if err != nil {
+					return nil, err
+				}
+			}
+
+			spec.HealthCheck = check
+		}
+
+		services = append(services, spec)
+	}
+
+	return services, nil
+}
+
 func (step *TaskStep) workerSpec(logger lager.Logger, resourceTypes atc.VersionedResourceTypes, repository *build.Repository, config atc.TaskConfig) (worker.WorkerSpec, error) {
 	workerSpec := worker.WorkerSpec{
 		Platform:      config.Platform,
@@ -445,6 +653,323 @@ func (step *TaskStep) registerCaches(logger lager.Logger, repository *build.Repo
 	return nil
 }
 
+// collectReports parses the test reports written to the task's outputs and
+// attaches their results to the build. Reports which are missing or invalid,
+// e.g. because the task failed before writing them, are noted in the task's
+// output rather than failing the step.
+func (step *TaskStep) collectReports(ctx context.Context, logger lager.Logger, repository *build.Repository) error {
+	var results []atc.TestResult
+
+	for _, report := range step.plan.Reports {
+		reportResults, err := step.readReport(ctx, logger, repository, report)
+		if err != nil {
+			logger.Info("failed-to-read-report", lager.Data{"path": report.Path, "error": err.Error()})
+			fmt.Fprintf(step.delegate.Stderr(), "[WARNING] failed to read %s report '%s': %s\n", report.Type, report.Path, err)
+			continue
+		}
+
+		results = append(results, reportResults...)
+	}
+
+	if len(results) == 0 {
+		return nil
+	}
+
+	return step.delegate.SaveTestResults(logger, step.plan.Name, results)
+}
+
+func (step *TaskStep) readReport(ctx context.Context, logger lager.Logger, repository *build.Repository, report atc.TestReportConfig) ([]atc.TestResult, error) {
+	segs := strings.SplitN(report.Path, "/", 2)
+	if len(segs) != 2 {
+		return nil, UnspecifiedArtifactSourceError{report.Path}
+	}
+
+	artifactName := segs[0]
+	filePath := segs[1]
+
+	art, found := repository.ArtifactFor(build.ArtifactName(artifactName))
+	if !found {
+		return nil, UnknownArtifactSourceError{build.ArtifactName(artifactName), report.Path}
+	}
+
+	stream, err := step.workerClient.StreamFileFromArtifact(ctx, logger, art, filePath)
+	if err != nil {
+		if err == baggageclaim.ErrFileNotFound {
+			return nil, artifact.FileNotFoundError{
+				Name:     artifactName,
+				FilePath: filePath,
+			}
+		}
+
+		return nil, err
+	}
+
+	defer stream.Close()
+
+	return testreport.Parse(report.Type, stream)
+}
+
+// resolveMemoImage determines the image a memoized task runs with, so that the
+// memo changes when the image does. An image_resource without a version is
+// checked and pinned to its latest version, so that the task runs with the
+// image it is memoized with. A docker rootfs_uri is checked with the
+// registry-image resource type and its digest returned. It returns false if
+// the task's rootfs_uri can't be resolved to a digest, in which case the task
+// is not memoized.
+func (step *TaskStep) resolveMemoImage(ctx context.Context, logger lager.Logger, resourceTypes atc.VersionedResourceTypes, config atc.TaskConfig) (atc.TaskConfig, string, bool, error) {
+	if step.plan.ImageArtifactName != "" {
+		// the artifact's contents are hashed along with the inputs
+		return config, "", true, nil
+	}
+
+	if config.ImageResource != nil {
+		if config.ImageResource.Version != nil {
+			return config, "", true, nil
+		}
+
+		version, err := step.checkMemoImage(ctx, logger, resourceTypes, config.ImageResource.Type, config.ImageResource.Source)
+		if err != nil {
+			return atc.TaskConfig{}, "", false, err
+		}
+
+		imageResource := *config.ImageResource
+		imageResource.Version = version
+		config.ImageResource = &imageResource
+
+		return config, "", true, nil
+	}
+
+	if config.RootfsURI == "" {
+		return config, "", true, nil
+	}
+
+	source, digest, ok := dockerRootfsSource(config.RootfsURI)
+	if !ok {
+		return config, "", false, nil
+	}
+
+	if digest != "" {
+		return config, digest, true, nil
+	}
+
+	version, err := step.checkMemoImage(ctx, logger, resourceTypes, "registry-image", source)
+	if err != nil {
+		return atc.TaskConfig{}, "", false, err
+	}
+
+	return config, version["digest"], true, nil
+}
+
+// checkMemoImage returns the latest version of the given image resource.
+func (step *TaskStep) checkMemoImage(ctx context.Context, logger lager.Logger, resourceTypes atc.VersionedResourceTypes, resourceType string, source atc.Source) (atc.Version, error) {
+	planID := atc.PlanID(fmt.Sprintf("%s/image-check", step.planID))
+
+	metadata := step.containerMetadata
+	metadata.Type = db.ContainerTypeCheck
+	metadata.WorkingDirectory = ""
+
+	result, err := step.workerClient.RunCheckStep(
+		ctx,
+		logger.Session("check-image"),
+		db.NewBuildStepContainerOwner(step.metadata.BuildID, planID, step.metadata.TeamID),
+		worker.ContainerSpec{
+			ImageSpec: worker.ImageSpec{
+				ResourceType: resourceType,
+			},
+			BindMounts: []worker.BindMountSource{
+				&worker.CertsVolumeMount{Logger: logger},
+			},
+			Tags:   step.plan.Tags,
+			TeamID: step.metadata.TeamID,
+		},
+		worker.WorkerSpec{
+			ResourceType:  resourceType,
+			Tags:          step.plan.Tags,
+			ResourceTypes: resourceTypes,
+			TeamID:        step.metadata.TeamID,
+		},
+		step.strategy,
+		metadata,
+		resourceTypes,
+		MemoizedTaskImageCheckTimeout,
+		step.resourceFactory.NewResource(source, nil, nil),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(result.Versions) == 0 {
+		return nil, MemoizedTaskImageUnavailableError{resourceType}
+	}
+
+	return result.Versions[len(result.Versions)-1], nil
+}
+
+// dockerRootfsSource returns the registry-image source of a rootfs_uri such
+// as docker:///busybox#1.31 or docker://registry.example.com/busybox, or its
+// digest if it already pins one, e.g. docker:///busybox@sha256:... It returns
+// false for any other kind of rootfs_uri.
+func dockerRootfsSource(uri string) (atc.Source, string, bool) {
+	u, err := url.Parse(uri)
+	if err != nil || u.Scheme != "docker" {
+		return nil, "", false
+	}
+
+	repository := strings.TrimPrefix(u.Path, "/")
+	if repository == "" {
+		return nil, "", false
+	}
+
+	if i := strings.Index(repository, "@"); i != -1 {
+		return nil, repository[i+1:], true
+	}
+
+	if u.Host != "" {
+		repository = u.Host + "/" + repository
+	}
+
+	tag := u.Fragment
+	if tag == "" {
+		tag = "latest"
+	}
+
+	return atc.Source{"repository": repository, "tag": tag}, "", true
+}
+
+// taskMemo is hashed to find an earlier run of the task that can be reused.
+type taskMemo struct {
+	Config     atc.TaskConfig    `json:"config"`
+	Privileged bool              `json:"privileged"`
+	Image      string            `json:"image,omitempty"`
+	Inputs     map[string]string `json:"inputs"`
+}
+
+func (step *TaskStep) memoKey(ctx context.Context, logger lager.Logger, repository *build.Repository, config atc.TaskConfig, image string) (string, error) {
+	memo := taskMemo{
+		Config:     config,
+		Privileged: bool(step.plan.Privileged),
+		Image:      image,
+		Inputs:     map[string]string{},
+	}
+
+	if step.plan.ImageArtifactName != "" {
+		art, found := repository.ArtifactFor(build.ArtifactName(step.plan.ImageArtifactName))
+		if !found {
+			return "", MissingTaskImageSourceError{step.plan.ImageArtifactName}
+		}
+
+		hash, err := step.workerClient.HashArtifact(ctx, logger, art)
+		if err != nil {
+			return "", err
+		}
+
+		memo.Image = hash
+	}
+
+	inputs, err := step.containerInputs(logger, repository, config, step.containerMetadata)
+	if err != nil {
+		return "", err
+	}
+
+	for path, art := range inputs {
+		hash, err := step.workerClient.HashArtifact(ctx, logger, art)
+		if err != nil {
+			return "", err
+		}
+
+		memo.Inputs[path] = hash
+	}
+
+	payload, err := json.Marshal(memo)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%x", sha256.Sum256(payload)), nil
+}
+
+// findMemoizedOutputs returns the outputs of an earlier successful run with
+// the given key, if it has been memoized and all of its outputs are still
+// available on a worker.
+func (step *TaskStep) findMemoizedOutputs(logger lager.Logger, key string, config atc.TaskConfig) (map[string]runtime.Artifact, bool, error) {
+	found, err := step.taskCacheFactory.FindMemo(step.metadata.JobID, step.plan.Name, key)
+	if err != nil {
+		return nil, false, err
+	}
+
+	if !found {
+		return nil, false, nil
+	}
+
+	outputs := map[string]runtime.Artifact{}
+
+	for _, output := range config.Outputs {
+		outputName := output.Name
+		if destinationName, ok := step.plan.OutputMapping[output.Name]; ok {
+			outputName = destinationName
+		}
+
+		taskCache, found, err := step.taskCacheFactory.Find(step.metadata.JobID, step.plan.Name, db.TaskMemoPath(key, output.Name))
+		if err != nil {
+			return nil, false, err
+		}
+
+		if !found {
+			return nil, false, nil
+		}
+
+		volumes, err := step.volumeRepository.FindTaskCacheVolumes(step.metadata.TeamID, taskCache)
+		if err != nil {
+			return nil, false, err
+		}
+
+		for _, volume := range volumes {
+			_, found, err := step.workerClient.FindVolume(logger, step.metadata.TeamID, volume.Handle())
+			if err != nil {
+				return nil, false, err
+			}
+
+			if found {
+				outputs[outputName] = &runtime.TaskArtifact{VolumeHandle: volume.Handle()}
+				break
+			}
+		}
+
+		if _, ok := outputs[outputName]; !ok {
+			logger.Info("memoized-output-missing", lager.Data{"output": output.Name})
+			return nil, false, nil
+		}
+	}
+
+	return outputs, true, nil
+}
+
+// memoize keeps the outputs of a successful run as task caches so that later
+// runs with the same key can reuse them.
+func (step *TaskStep) memoize(logger lager.Logger, key string, config atc.TaskConfig, volumeMounts []worker.VolumeMount, metadata db.ContainerMetadata) error {
+	logger.Debug("memoizing", lager.Data{"key": key})
+
+	for _, output := range config.Outputs {
+		outputPath := artifactsPath(output, metadata.WorkingDirectory)
+
+		for _, mount := range volumeMounts {
+			if filepath.Clean(mount.MountPath) == filepath.Clean(outputPath) {
+				err := mount.Volume.InitializeTaskCache(
+					logger,
+					step.metadata.JobID,
+					step.plan.Name,
+					db.TaskMemoPath(key, output.Name),
+					bool(step.plan.Privileged))
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return step.taskCacheFactory.Memoize(step.metadata.JobID, step.plan.Name, key)
+}
+
 type taskInput struct {
 	config        atc.TaskInputConfig
 	artifact      runtime.Artifact
@@ -3,14 +3,20 @@ package exec_test
 import (
 	"context"
 	"errors"
+	"io/ioutil"
+	"regexp"
+	"strings"
+	"time"
 
 	"code.cloudfoundry.org/lager"
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/db/dbfakes"
 	"github.com/concourse/concourse/atc/db/lock/lockfakes"
 	"github.com/concourse/concourse/atc/exec"
 	"github.com/concourse/concourse/atc/exec/build"
 	"github.com/concourse/concourse/atc/exec/execfakes"
+	"github.com/concourse/concourse/atc/resource/resourcefakes"
 	"github.com/concourse/concourse/atc/runtime"
 	"github.com/concourse/concourse/atc/runtime/runtimefakes"
 	"github.com/concourse/concourse/atc/worker"
@@ -20,6 +26,8 @@ import (
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+
+	"github.com/concourse/baggageclaim"
 )
 
 var _ = Describe("TaskStep", func() {
@@ -33,8 +41,13 @@ var _ = Describe("TaskStep", func() {
 		fakeClient   *workerfakes.FakeClient
 		fakeStrategy *workerfakes.FakeContainerPlacementStrategy
 
+		fakeResourceFactory *resourcefakes.FakeResourceFactory
+
 		fakeLockFactory *lockfakes.FakeLockFactory
 
+		fakeTaskCacheFactory *dbfakes.FakeTaskCacheFactory
+		fakeVolumeRepository *dbfakes.FakeVolumeRepository
+
 		fakeDelegate *execfakes.FakeTaskDelegate
 		taskPlan     *atc.TaskPlan
 
@@ -47,6 +60,7 @@ var _ = Describe("TaskStep", func() {
 		stepErr  error
 
 		credVarsTracker vars.CredVarsTracker
+		defaultLimits   atc.ContainerLimits
 
 		containerMetadata = db.ContainerMetadata{
 			WorkingDirectory: "some-artifact-root",
@@ -72,8 +86,15 @@ var _ = Describe("TaskStep", func() {
 		fakeClient = new(workerfakes.FakeClient)
 		fakeStrategy = new(workerfakes.FakeContainerPlacementStrategy)
 
+		fakeResourceFactory = new(resourcefakes.FakeResourceFactory)
+
 		fakeLockFactory = new(lockfakes.FakeLockFactory)
 
+		fakeTaskCacheFactory = new(dbfakes.FakeTaskCacheFactory)
+		fakeVolumeRepository = new(dbfakes.FakeVolumeRepository)
+
+		defaultLimits = atc.ContainerLimits{}
+
 		credVars := vars.StaticVariables{"source-param": "super-secret-source"}
 		credVarsTracker = vars.NewCredVarsTracker(credVars, true)
 
@@ -128,13 +149,16 @@ var _ = Describe("TaskStep", func() {
 		taskStep = exec.NewTaskStep(
 			plan.ID,
 			*plan.Task,
-			atc.ContainerLimits{},
+			defaultLimits,
 			stepMetadata,
 			containerMetadata,
 			fakeStrategy,
 			fakeClient,
+			fakeResourceFactory,
 			fakeDelegate,
 			fakeLockFactory,
+			fakeTaskCacheFactory,
+			fakeVolumeRepository,
 		)
 
 		stepErr = taskStep.Run(ctx, state)
@@ -195,7 +219,7 @@ var _ = Describe("TaskStep", func() {
 
 				It("correctly sets up the image spec", func() {
 					Expect(fakeClient.RunTaskStepCallCount()).To(Equal(1))
-					_, _, _, containerSpec, _, _, _, _, _, _, _ := fakeClient.RunTaskStepArgsForCall(0)
+					_, _, _, containerSpec, _, _, _, _, _, _, _, _ := fakeClient.RunTaskStepArgsForCall(0)
 
 					Expect(containerSpec).To(Equal(worker.ContainerSpec{
 						Platform: "some-platform",
@@ -224,10 +248,116 @@ var _ = Describe("TaskStep", func() {
 			Expect(mapit.Data["source-param"]).To(Equal("super-secret-source"))
 		})
 
+		Context("when the task has secrets", func() {
+			BeforeEach(func() {
+				credVarsTracker = vars.NewCredVarsTracker(vars.StaticVariables{
+					"source-param": "super-secret-source",
+					"db-password":  "super-secret-password",
+				}, true)
+				fakeDelegate.VariablesReturns(credVarsTracker)
+
+				taskPlan.Config.Secrets = atc.TaskEnv{
+					"db-password": "((db-password))",
+				}
+			})
+
+			It("passes the interpolated secrets to the container", func() {
+				Expect(fakeClient.RunTaskStepCallCount()).To(Equal(1))
+
+				_, _, _, containerSpec, _, _, _, _, _, _, _, _ := fakeClient.RunTaskStepArgsForCall(0)
+				Expect(containerSpec.Secrets).To(Equal(map[string]string{
+					"db-password": "super-secret-password",
+				}))
+				Expect(containerSpec.Env).ToNot(ContainElement(ContainSubstring("super-secret-password")))
+			})
+
+			It("tracks the secrets for redaction", func() {
+				mapit := vars.NewMapCredVarsTrackerIterator()
+				credVarsTracker.IterateInterpolatedCreds(mapit)
+				Expect(mapit.Data["db-password"]).To(Equal("super-secret-password"))
+			})
+		})
+
+		Context("when default limits are configured", func() {
+			BeforeEach(func() {
+				defaultCPU := uint64(512)
+				defaultQuota := uint64(100)
+				defaultPids := uint64(1000)
+				defaultDisk := uint64(1024)
+				defaultLimits = atc.ContainerLimits{
+					CPU:      &defaultCPU,
+					CPUQuota: &defaultQuota,
+					Pids:     &defaultPids,
+					Disk:     &defaultDisk,
+				}
+
+				pids := uint64(50)
+				taskPlan.Config.Limits.Pids = &pids
+			})
+
+			It("uses them for the limits the task does not set", func() {
+				Expect(fakeClient.RunTaskStepCallCount()).To(Equal(1))
+
+				_, _, _, containerSpec, _, _, _, _, _, _, _, _ := fakeClient.RunTaskStepArgsForCall(0)
+				Expect(*containerSpec.Limits.CPU).To(Equal(uint64(1024)))
+				Expect(*containerSpec.Limits.Memory).To(Equal(uint64(1024)))
+				Expect(*containerSpec.Limits.CPUQuota).To(Equal(uint64(100)))
+				Expect(*containerSpec.Limits.Pids).To(Equal(uint64(50)))
+				Expect(*containerSpec.Limits.Disk).To(Equal(uint64(1024)))
+			})
+
+			It("only applies the cpu quota and disk limits where they are supported", func() {
+				_, _, _, containerSpec, _, _, _, _, _, _, _, _ := fakeClient.RunTaskStepArgsForCall(0)
+				Expect(containerSpec.BestEffortLimits).To(Equal([]string{"cpu_quota", "disk"}))
+			})
+
+			Context("when the task sets the cpu quota and disk limits itself", func() {
+				BeforeEach(func() {
+					quota := uint64(50)
+					disk := uint64(2048)
+					taskPlan.Config.Limits.CPUQuota = &quota
+					taskPlan.Config.Limits.Disk = &disk
+				})
+
+				It("requires them to be applied", func() {
+					_, _, _, containerSpec, _, _, _, _, _, _, _, _ := fakeClient.RunTaskStepArgsForCall(0)
+					Expect(*containerSpec.Limits.CPUQuota).To(Equal(uint64(50)))
+					Expect(*containerSpec.Limits.Disk).To(Equal(uint64(2048)))
+					Expect(containerSpec.BestEffortLimits).To(BeEmpty())
+				})
+			})
+		})
+
+		Context("when the task has no network", func() {
+			BeforeEach(func() {
+				taskPlan.Config.Network = "none"
+			})
+
+			It("creates the container without a network", func() {
+				Expect(fakeClient.RunTaskStepCallCount()).To(Equal(1))
+
+				_, _, _, containerSpec, _, _, _, _, _, _, _, _ := fakeClient.RunTaskStepArgsForCall(0)
+				Expect(containerSpec.Network).To(Equal("none"))
+			})
+		})
+
+		Context("when the task keeps its container on failure", func() {
+			BeforeEach(func() {
+				taskPlan.KeepOnFailure = "2h"
+			})
+
+			It("creates the container to be kept for that long", func() {
+				Expect(fakeClient.RunTaskStepCallCount()).To(Equal(1))
+
+				_, _, _, containerSpec, _, _, _, _, _, _, _, _ := fakeClient.RunTaskStepArgsForCall(0)
+				Expect(containerSpec.KeepOnFailure).To(Equal(2 * time.Hour))
+			})
+		})
+
 		It("creates a containerSpec with the correct parameters", func() {
 			Expect(fakeClient.RunTaskStepCallCount()).To(Equal(1))
 
-			_, _, _, containerSpec, _, _, _, _, _, _, _ := fakeClient.RunTaskStepArgsForCall(0)
+			_, _, _, containerSpec, _, _, _, _, _, _, _, _ := fakeClient.RunTaskStepArgsForCall(0)
 
 			Expect(containerSpec.Dir).To(Equal("some-artifact-root"))
 			Expect(containerSpec.User).To(BeEmpty())
@@ -236,7 +366,7 @@ var _ = Describe("TaskStep", func() {
 		It("creates the task process spec with the correct parameters", func() {
 			Expect(fakeClient.RunTaskStepCallCount()).To(Equal(1))
 
-			_, _, _, _, _, _, _, _, taskProcessSpec, _, _ := fakeClient.RunTaskStepArgsForCall(0)
+			_, _, _, _, _, _, _, _, taskProcessSpec, _, _, _ := fakeClient.RunTaskStepArgsForCall(0)
 			Expect(taskProcessSpec.StdoutWriter).To(Equal(stdoutBuf))
 			Expect(taskProcessSpec.StderrWriter).To(Equal(stderrBuf))
 			Expect(taskProcessSpec.Path).To(Equal("ls"))
@@ -256,7 +386,7 @@ var _ = Describe("TaskStep", func() {
 
 			It("marks the container's image spec as privileged", func() {
 				Expect(fakeClient.RunTaskStepCallCount()).To(Equal(1))
-				_, _, _, containerSpec, _, _, _, _, _, _, _ := fakeClient.RunTaskStepArgsForCall(0)
+				_, _, _, containerSpec, _, _, _, _, _, _, _, _ := fakeClient.RunTaskStepArgsForCall(0)
 				Expect(containerSpec.ImageSpec.Privileged).To(BeTrue())
 			})
 		})
@@ -292,7 +422,7 @@ var _ = Describe("TaskStep", func() {
 
 				It("configures the inputs for the containerSpec correctly", func() {
 					Expect(fakeClient.RunTaskStepCallCount()).To(Equal(1))
-					_, _, _, actualContainerSpec, _, _, _, _, _, _, _ := fakeClient.RunTaskStepArgsForCall(0)
+					_, _, _, actualContainerSpec, _, _, _, _, _, _, _, _ := fakeClient.RunTaskStepArgsForCall(0)
 					Expect(actualContainerSpec.ArtifactByPath).To(HaveLen(2))
 					Expect(actualContainerSpec.ArtifactByPath["some-artifact-root/some-input-configured-path"]).To(Equal(inputArtifact))
 					Expect(actualContainerSpec.ArtifactByPath["some-artifact-root/some-other-input"]).To(Equal(otherInputArtifact))
@@ -336,7 +466,7 @@ var _ = Describe("TaskStep", func() {
 
 				It("uses remapped input", func() {
 					Expect(fakeClient.RunTaskStepCallCount()).To(Equal(1))
-					_, _, _, actualContainerSpec, _, _, _, _, _, _, _ := fakeClient.RunTaskStepArgsForCall(0)
+					_, _, _, actualContainerSpec, _, _, _, _, _, _, _, _ := fakeClient.RunTaskStepArgsForCall(0)
 					Expect(actualContainerSpec.ArtifactByPath).To(HaveLen(1))
 					Expect(actualContainerSpec.ArtifactByPath["some-artifact-root/remapped-input"]).To(Equal(remappedInputArtifact))
 					Expect(stepErr).ToNot(HaveOccurred())
@@ -382,7 +512,7 @@ var _ = Describe("TaskStep", func() {
 				It("runs successfully without the optional input", func() {
 					Expect(stepErr).ToNot(HaveOccurred())
 					Expect(fakeClient.RunTaskStepCallCount()).To(Equal(1))
-					_, _, _, actualContainerSpec, _, _, _, _, _, _, _ := fakeClient.RunTaskStepArgsForCall(0)
+					_, _, _, actualContainerSpec, _, _, _, _, _, _, _, _ := fakeClient.RunTaskStepArgsForCall(0)
 					Expect(actualContainerSpec.ArtifactByPath).To(HaveLen(2))
 					Expect(actualContainerSpec.ArtifactByPath["some-artifact-root/required-input"]).To(Equal(optionalInputArtifact))
 					Expect(actualContainerSpec.ArtifactByPath["some-artifact-root/optional-input-2"]).To(Equal(optionalInput2Artifact))
@@ -440,7 +570,7 @@ var _ = Describe("TaskStep", func() {
 			})
 
 			It("creates the containerSpec with the caches in the inputs", func() {
-				_, _, _, containerSpec, _, _, _, _, _, _, _ := fakeClient.RunTaskStepArgsForCall(0)
+				_, _, _, containerSpec, _, _, _, _, _, _, _, _ := fakeClient.RunTaskStepArgsForCall(0)
 				Expect(containerSpec.ArtifactByPath).To(HaveLen(2))
 				Expect(containerSpec.ArtifactByPath["some-artifact-root/some-path-1"]).ToNot(BeNil())
 				Expect(containerSpec.ArtifactByPath["some-artifact-root/some-path-2"]).ToNot(BeNil())
@@ -502,7 +632,7 @@ var _ = Describe("TaskStep", func() {
 			})
 
 			It("configures them appropriately in the container spec", func() {
-				_, _, _, containerSpec, _, _, _, _, _, _, _ := fakeClient.RunTaskStepArgsForCall(0)
+				_, _, _, containerSpec, _, _, _, _, _, _, _, _ := fakeClient.RunTaskStepArgsForCall(0)
 				Expect(containerSpec.Outputs).To(Equal(worker.OutputPaths{
 					"some-output":                "some-artifact-root/some-output-configured-path/",
 					"some-other-output":          "some-artifact-root/some-other-output/",
@@ -555,7 +685,7 @@ var _ = Describe("TaskStep", func() {
 				})
 
 				It("configures it in the containerSpec's ImageSpec", func() {
-					_, _, _, containerSpec, workerSpec, _, _, _, _, _, _ := fakeClient.RunTaskStepArgsForCall(0)
+					_, _, _, containerSpec, workerSpec, _, _, _, _, _, _, _ := fakeClient.RunTaskStepArgsForCall(0)
 					Expect(containerSpec.ImageSpec).To(Equal(worker.ImageSpec{
 						ImageArtifact: imageArtifact,
 					}))
@@ -584,7 +714,7 @@ var _ = Describe("TaskStep", func() {
 							})
 
 							It("still uses the image artifact", func() {
-								_, _, _, containerSpec, workerSpec, _, _, _, _, _, _ := fakeClient.RunTaskStepArgsForCall(0)
+								_, _, _, containerSpec, workerSpec, _, _, _, _, _, _, _ := fakeClient.RunTaskStepArgsForCall(0)
 								Expect(containerSpec.ImageSpec).To(Equal(worker.ImageSpec{
 									ImageArtifact: imageArtifact,
 								}))
@@ -612,7 +742,7 @@ var _ = Describe("TaskStep", func() {
 							})
 
 							It("still uses the image artifact", func() {
-								_, _, _, containerSpec, workerSpec, _, _, _, _, _, _ := fakeClient.RunTaskStepArgsForCall(0)
+								_, _, _, containerSpec, workerSpec, _, _, _, _, _, _, _ := fakeClient.RunTaskStepArgsForCall(0)
 								Expect(containerSpec.ImageSpec).To(Equal(worker.ImageSpec{
 									ImageArtifact: imageArtifact,
 								}))
@@ -641,7 +771,7 @@ var _ = Describe("TaskStep", func() {
 							})
 
 							It("still uses the image artifact", func() {
-								_, _, _, containerSpec, workerSpec, _, _, _, _, _, _ := fakeClient.RunTaskStepArgsForCall(0)
+								_, _, _, containerSpec, workerSpec, _, _, _, _, _, _, _ := fakeClient.RunTaskStepArgsForCall(0)
 								Expect(containerSpec.ImageSpec).To(Equal(worker.ImageSpec{
 									ImageArtifact: imageArtifact,
 								}))
@@ -683,7 +813,7 @@ var _ = Describe("TaskStep", func() {
 			})
 
 			It("creates the specs with the image resource", func() {
-				_, _, _, containerSpec, workerSpec, _, _, _, _, _, _ := fakeClient.RunTaskStepArgsForCall(0)
+				_, _, _, containerSpec, workerSpec, _, _, _, _, _, _, _ := fakeClient.RunTaskStepArgsForCall(0)
 				Expect(containerSpec.ImageSpec.ImageResource).To(Equal(&worker.ImageResource{
 					Type:    "docker",
 					Source:  atc.Source{"some": "super-secret-source"},
@@ -715,7 +845,7 @@ var _ = Describe("TaskStep", func() {
 			})
 
 			It("creates the specs with the image resource", func() {
-				_, _, _, containerSpec, workerSpec, _, _, _, _, _, _ := fakeClient.RunTaskStepArgsForCall(0)
+				_, _, _, containerSpec, workerSpec, _, _, _, _, _, _, _ := fakeClient.RunTaskStepArgsForCall(0)
 				Expect(containerSpec.ImageSpec.ImageURL).To(Equal("some-image"))
 
 				Expect(workerSpec).To(Equal(worker.WorkerSpec{
@@ -735,7 +865,7 @@ var _ = Describe("TaskStep", func() {
 			})
 
 			It("specifies it in the process  spec", func() {
-				_, _, _, _, _, _, _, _, processSpec, _, _ := fakeClient.RunTaskStepArgsForCall(0)
+				_, _, _, _, _, _, _, _, processSpec, _, _, _ := fakeClient.RunTaskStepArgsForCall(0)
 				Expect(processSpec.Dir).To(Equal(dir))
 			})
 		})
@@ -746,16 +876,68 @@ var _ = Describe("TaskStep", func() {
 			})
 
 			It("adds the user to the container spec", func() {
-				_, _, _, containerSpec, _, _, _, _, _, _, _ := fakeClient.RunTaskStepArgsForCall(0)
+				_, _, _, containerSpec, _, _, _, _, _, _, _, _ := fakeClient.RunTaskStepArgsForCall(0)
 				Expect(containerSpec.User).To(Equal("some-user"))
 			})
 
 			It("doesn't bother adding the user to the run spec", func() {
-				_, _, _, _, _, _, _, _, processSpec, _, _ := fakeClient.RunTaskStepArgsForCall(0)
+				_, _, _, _, _, _, _, _, processSpec, _, _, _ := fakeClient.RunTaskStepArgsForCall(0)
 				Expect(processSpec.User).To(BeEmpty())
 			})
 		})
 
+		Context("when services are configured", func() {
+			BeforeEach(func() {
+				taskPlan.Config.Services = []atc.TaskServiceConfig{
+					{
+						Name:      "postgres",
+						RootfsURI: "docker:///postgres",
+						Params:    atc.TaskEnv{"POSTGRES_PASSWORD": "secret"},
+						Run: atc.TaskRunConfig{
+							Path: "docker-entrypoint.sh",
+							Args: []string{"postgres"},
+						},
+						HealthCheck: &atc.TaskServiceHealthCheck{
+							Run:      atc.TaskRunConfig{Path: "pg_isready"},
+							Interval: "2s",
+							Timeout:  "30s",
+						},
+					},
+				}
+			})
+
+			It("passes them to the worker client to start alongside the task", func() {
+				_, _, _, _, _, _, _, _, _, _, _, services := fakeClient.RunTaskStepArgsForCall(0)
+				Expect(services).To(Equal([]worker.ServiceSpec{
+					{
+						Name:  "postgres",
+						Owner: db.NewBuildStepContainerOwner(stepMetadata.BuildID, planID+"/services/postgres", stepMetadata.TeamID),
+						Metadata: db.ContainerMetadata{
+							Type:     db.ContainerTypeTask,
+							StepName: "some-step/postgres",
+						},
+						ContainerSpec: worker.ContainerSpec{
+							Platform: "some-platform",
+							Tags:     []string{"step", "tags"},
+							TeamID:   stepMetadata.TeamID,
+							ImageSpec: worker.ImageSpec{
+								ImageURL: "docker:///postgres",
+							},
+							Env:  []string{"POSTGRES_PASSWORD=secret"},
+							Type: db.ContainerTypeTask,
+						},
+						Path: "docker-entrypoint.sh",
+						Args: []string{"postgres"},
+						HealthCheck: &worker.ServiceHealthCheck{
+							Path:     "pg_isready",
+							Interval: 2 * time.Second,
+							Timeout:  30 * time.Second,
+						},
+					},
+				}))
+			})
+		})
+
 		Context("when running the task succeeds", func() {
 			var taskStepStatus int
 			BeforeEach(func() {
@@ -862,7 +1044,7 @@ var _ = Describe("TaskStep", func() {
 					})
 
 					It("passes existing output volumes to the resource", func() {
-						_, _, _, containerSpec, _, _, _, _, _, _, _ := fakeClient.RunTaskStepArgsForCall(0)
+						_, _, _, containerSpec, _, _, _, _, _, _, _, _ := fakeClient.RunTaskStepArgsForCall(0)
 						Expect(containerSpec.Outputs).To(Equal(worker.OutputPaths{
 							"some-output":                "some-artifact-root/some-output-configured-path/",
 							"some-other-output":          "some-artifact-root/some-other-output/",
@@ -887,6 +1069,57 @@ var _ = Describe("TaskStep", func() {
 				It("returns successfully", func() {
 					Expect(stepErr).ToNot(HaveOccurred())
 				})
+
+				It("does not report a kept container", func() {
+					Expect(fakeDelegate.ContainerKeptCallCount()).To(BeZero())
+				})
+
+				Context("when the container is kept", func() {
+					var keptUntil time.Time
+
+					BeforeEach(func() {
+						keptUntil = time.Unix(1600000000, 0)
+						fakeClient.RunTaskStepReturns(worker.TaskResult{
+							ExitStatus:          taskStepStatus,
+							VolumeMounts:        []worker.VolumeMount{},
+							KeptContainerHandle: "some-handle",
+							KeptUntil:           keptUntil,
+						}, nil)
+					})
+
+					It("tells the user how to intercept it", func() {
+						Expect(stdoutBuf).To(gbytes.Say("keeping container until .* for debugging"))
+						Expect(stdoutBuf).To(gbytes.Say("fly -t <target> intercept --handle some-handle"))
+					})
+
+					It("reports the kept container via the delegate", func() {
+						Expect(fakeDelegate.ContainerKeptCallCount()).To(Equal(1))
+						_, handle, expiresAt := fakeDelegate.ContainerKeptArgsForCall(0)
+						Expect(handle).To(Equal("some-handle"))
+						Expect(expiresAt).To(Equal(keptUntil))
+					})
+
+					Context("when it is kept for less than asked", func() {
+						BeforeEach(func() {
+							taskPlan.KeepOnFailure = "10000h"
+							fakeClient.RunTaskStepReturns(worker.TaskResult{
+								ExitStatus:          taskStepStatus,
+								VolumeMounts:        []worker.VolumeMount{},
+								KeptContainerHandle: "some-handle",
+								KeptFor:             24 * time.Hour,
+								KeptUntil:           keptUntil,
+							}, nil)
+						})
+
+						It("shows the capped expiry", func() {
+							Expect(stdoutBuf).To(gbytes.Say(regexp.QuoteMeta("keeping container until " + keptUntil.Format(time.RFC1123Z) + " for debugging")))
+							Expect(stdoutBuf).To(gbytes.Say("keep_on_failure is capped at 24h0m0s by the operator"))
+
+							_, _, expiresAt := fakeDelegate.ContainerKeptArgsForCall(0)
+							Expect(expiresAt).To(Equal(keptUntil))
+						})
+					})
+				})
 			})
 		})
 
@@ -935,6 +1168,446 @@ var _ = Describe("TaskStep", func() {
 			})
 		})
 
+		Context("when reports are configured", func() {
+			BeforeEach(func() {
+				taskPlan.Config.Outputs = []atc.TaskOutputConfig{{Name: "output"}}
+				taskPlan.Reports = []atc.TestReportConfig{
+					{Type: "junit", Path: "output/report.xml"},
+				}
+
+				fakeOutputVolume := new(workerfakes.FakeVolume)
+				fakeOutputVolume.HandleReturns("some-output-handle")
+
+				fakeClient.RunTaskStepReturns(worker.TaskResult{
+					ExitStatus: 1,
+					VolumeMounts: []worker.VolumeMount{
+						{
+							Volume:    fakeOutputVolume,
+							MountPath: "some-artifact-root/output/",
+						},
+					},
+				}, nil)
+
+				fakeClient.StreamFileFromArtifactReturns(ioutil.NopCloser(strings.NewReader(`<testsuite name="math">
+  <testcase name="adds"/>
+  <testcase name="subtracts"><failure message="expected 1, got 2"/></testcase>
+</testsuite>`)), nil)
+			})
+
+			It("reads the report from the output", func() {
+				Expect(fakeClient.StreamFileFromArtifactCallCount()).To(Equal(1))
+				_, _, art, path := fakeClient.StreamFileFromArtifactArgsForCall(0)
+				Expect(art.ID()).To(Equal("some-output-handle"))
+				Expect(path).To(Equal("report.xml"))
+			})
+
+			It("saves the results, even though the task failed", func() {
+				Expect(stepErr).ToNot(HaveOccurred())
+				Expect(fakeDelegate.SaveTestResultsCallCount()).To(Equal(1))
+				_, stepName, results := fakeDelegate.SaveTestResultsArgsForCall(0)
+				Expect(stepName).To(Equal("some-task"))
+				Expect(results).To(Equal([]atc.TestResult{
+					{Suite: "math", Name: "adds", Status: atc.TestPassed},
+					{Suite: "math", Name: "subtracts", Status: atc.TestFailed, Message: "expected 1, got 2"},
+				}))
+			})
+
+			Context("when the report does not exist", func() {
+				BeforeEach(func() {
+					fakeClient.StreamFileFromArtifactReturns(nil, baggageclaim.ErrFileNotFound)
+				})
+
+				It("warns without failing the step", func() {
+					Expect(stepErr).ToNot(HaveOccurred())
+					Expect(fakeDelegate.SaveTestResultsCallCount()).To(Equal(0))
+					Expect(stderrBuf).To(gbytes.Say(`failed to read junit report 'output/report.xml'`))
+				})
+			})
+
+			Context("when saving the results fails", func() {
+				disaster := errors.New("nope")
+
+				BeforeEach(func() {
+					fakeDelegate.SaveTestResultsReturns(disaster)
+				})
+
+				It("returns the error", func() {
+					Expect(stepErr).To(Equal(disaster))
+				})
+			})
+		})
+
+		Context("when memoize is set", func() {
+			var (
+				fakeInput        *runtimefakes.FakeArtifact
+				fakeOutputVolume *workerfakes.FakeVolume
+			)
+
+			BeforeEach(func() {
+				stepMetadata.JobID = 12345
+				taskPlan.Memoize = true
+				taskPlan.Config.Inputs = []atc.TaskInputConfig{{Name: "some-input"}}
+				taskPlan.Config.Outputs = []atc.TaskOutputConfig{{Name: "some-output"}}
+
+				fakeInput = new(runtimefakes.FakeArtifact)
+				fakeInput.IDReturns("some-input-handle")
+				repo.RegisterArtifact("some-input", fakeInput)
+
+				fakeOutputVolume = new(workerfakes.FakeVolume)
+				fakeOutputVolume.HandleReturns("some-output-handle")
+
+				fakeClient.HashArtifactReturns("some-input-hash", nil)
+				fakeClient.RunTaskStepReturns(worker.TaskResult{
+					ExitStatus: 0,
+					VolumeMounts: []worker.VolumeMount{
+						{
+							Volume:    fakeOutputVolume,
+							MountPath: "some-artifact-root/some-output/",
+						},
+					},
+				}, nil)
+			})
+
+			It("hashes the inputs", func() {
+				Expect(fakeClient.HashArtifactCallCount()).To(Equal(1))
+				_, _, art := fakeClient.HashArtifactArgsForCall(0)
+				Expect(art).To(Equal(fakeInput))
+			})
+
+			It("looks up the memo for the job's step", func() {
+				Expect(fakeTaskCacheFactory.FindMemoCallCount()).To(Equal(1))
+				jobID, stepName, key := fakeTaskCacheFactory.FindMemoArgsForCall(0)
+				Expect(jobID).To(Equal(stepMetadata.JobID))
+				Expect(stepName).To(Equal("some-task"))
+				Expect(key).ToNot(BeEmpty())
+			})
+
+			It("uses a different key when the inputs differ", func() {
+				fakeClient.HashArtifactReturns("some-other-input-hash", nil)
+
+				err := exec.NewTaskStep(
+					planID,
+					*taskPlan,
+					atc.ContainerLimits{},
+					stepMetadata,
+					containerMetadata,
+					fakeStrategy,
+					fakeClient,
+					fakeResourceFactory,
+					fakeDelegate,
+					fakeLockFactory,
+					fakeTaskCacheFactory,
+					fakeVolumeRepository,
+				).Run(ctx, state)
+				Expect(err).ToNot(HaveOccurred())
+
+				_, _, key := fakeTaskCacheFactory.FindMemoArgsForCall(0)
+				_, _, otherKey := fakeTaskCacheFactory.FindMemoArgsForCall(1)
+				Expect(otherKey).ToNot(Equal(key))
+			})
+
+			Context("when no earlier run was memoized", func() {
+				It("runs the task", func() {
+					Expect(stepErr).ToNot(HaveOccurred())
+					Expect(fakeClient.RunTaskStepCallCount()).To(Equal(1))
+					Expect(fakeDelegate.MemoizedCallCount()).To(Equal(0))
+				})
+
+				It("keeps the outputs as task caches under the key", func() {
+					_, _, key := fakeTaskCacheFactory.FindMemoArgsForCall(0)
+
+					Expect(fakeOutputVolume.InitializeTaskCacheCallCount()).To(Equal(1))
+					_, jobID, stepName, path, _ := fakeOutputVolume.InitializeTaskCacheArgsForCall(0)
+					Expect(jobID).To(Equal(stepMetadata.JobID))
+					Expect(stepName).To(Equal("some-task"))
+					Expect(path).To(Equal(db.TaskMemoPath(key, "some-output")))
+				})
+
+				It("memoizes the run", func() {
+					_, _, key := fakeTaskCacheFactory.FindMemoArgsForCall(0)
+
+					Expect(fakeTaskCacheFactory.MemoizeCallCount()).To(Equal(1))
+					jobID, stepName, memoizedKey := fakeTaskCacheFactory.MemoizeArgsForCall(0)
+					Expect(jobID).To(Equal(stepMetadata.JobID))
+					Expect(stepName).To(Equal("some-task"))
+					Expect(memoizedKey).To(Equal(key))
+				})
+
+				Context("when the task fails", func() {
+					BeforeEach(func() {
+						fakeClient.RunTaskStepReturns(worker.TaskResult{ExitStatus: 1}, nil)
+					})
+
+					It("does not memoize the run", func() {
+						Expect(fakeTaskCacheFactory.MemoizeCallCount()).To(Equal(0))
+					})
+				})
+			})
+
+			Context("when an earlier run was memoized", func() {
+				var fakeMemoVolume *dbfakes.FakeCreatedVolume
+
+				BeforeEach(func() {
+					fakeTaskCacheFactory.FindMemoReturns(true, nil)
+					fakeTaskCacheFactory.FindReturns(nil, true, nil)
+
+					fakeMemoVolume = new(dbfakes.FakeCreatedVolume)
+					fakeMemoVolume.HandleReturns("some-memoized-handle")
+					fakeVolumeRepository.FindTaskCacheVolumesReturns([]db.CreatedVolume{fakeMemoVolume}, nil)
+
+					fakeClient.FindVolumeReturns(new(workerfakes.FakeVolume), true, nil)
+				})
+
+				It("does not run the task", func() {
+					Expect(stepErr).ToNot(HaveOccurred())
+					Expect(fakeClient.RunTaskStepCallCount()).To(Equal(0))
+				})
+
+				It("looks up the memoized output", func() {
+					_, _, key := fakeTaskCacheFactory.FindMemoArgsForCall(0)
+
+					jobID, stepName, path := fakeTaskCacheFactory.FindArgsForCall(0)
+					Expect(jobID).To(Equal(stepMetadata.JobID))
+					Expect(stepName).To(Equal("some-task"))
+					Expect(path).To(Equal(db.TaskMemoPath(key, "some-output")))
+
+					_, teamID, handle := fakeClient.FindVolumeArgsForCall(0)
+					Expect(teamID).To(Equal(stepMetadata.TeamID))
+					Expect(handle).To(Equal("some-memoized-handle"))
+				})
+
+				It("registers the memoized outputs", func() {
+					art, found := repo.ArtifactFor("some-output")
+					Expect(found).To(BeTrue())
+					Expect(art).To(Equal(&runtime.TaskArtifact{VolumeHandle: "some-memoized-handle"}))
+				})
+
+				It("succeeds and says the run was memoized", func() {
+					Expect(taskStep.Succeeded()).To(BeTrue())
+
+					Expect(fakeDelegate.MemoizedCallCount()).To(Equal(1))
+					_, key := fakeDelegate.MemoizedArgsForCall(0)
+					_, _, memoKey := fakeTaskCacheFactory.FindMemoArgsForCall(0)
+					Expect(key).To(Equal(memoKey))
+
+					Expect(fakeDelegate.FinishedCallCount()).To(Equal(1))
+					_, status := fakeDelegate.FinishedArgsForCall(0)
+					Expect(status).To(Equal(exec.ExitStatus(0)))
+				})
+
+				Context("when the memoized output is no longer on a worker", func() {
+					BeforeEach(func() {
+						fakeClient.FindVolumeReturns(nil, false, nil)
+					})
+
+					It("runs the task", func() {
+						Expect(fakeClient.RunTaskStepCallCount()).To(Equal(1))
+						Expect(fakeDelegate.MemoizedCallCount()).To(Equal(0))
+					})
+				})
+			})
+
+			Context("when hashing an input fails", func() {
+				disaster := errors.New("nope")
+
+				BeforeEach(func() {
+					fakeClient.HashArtifactReturns("", disaster)
+				})
+
+				It("returns the error without running the task", func() {
+					Expect(stepErr).To(Equal(disaster))
+					Expect(fakeClient.RunTaskStepCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when the task has an image_resource without a version", func() {
+				var fakeImageResource *resourcefakes.FakeResource
+
+				BeforeEach(func() {
+					taskPlan.Config.ImageResource = &atc.ImageResource{
+						Type:   "docker",
+						Source: atc.Source{"repository": "busybox"},
+					}
+
+					fakeImageResource = new(resourcefakes.FakeResource)
+					fakeResourceFactory.NewResourceReturns(fakeImageResource)
+
+					fakeClient.RunCheckStepReturns(worker.CheckResult{
+						Versions: []atc.Version{{"digest": "older"}, {"digest": "latest"}},
+					}, nil)
+				})
+
+				It("checks the image for its latest version", func() {
+					Expect(fakeClient.RunCheckStepCallCount()).To(Equal(1))
+					_, _, owner, containerSpec, workerSpec, _, metadata, _, _, checkable := fakeClient.RunCheckStepArgsForCall(0)
+					Expect(owner).To(Equal(db.NewBuildStepContainerOwner(stepMetadata.BuildID, planID+"/image-check", stepMetadata.TeamID)))
+					Expect(containerSpec.ImageSpec.ResourceType).To(Equal("docker"))
+					Expect(workerSpec.ResourceType).To(Equal("docker"))
+					Expect(metadata.Type).To(Equal(db.ContainerTypeCheck))
+					Expect(checkable).To(Equal(fakeImageResource))
+
+					source, params, version := fakeResourceFactory.NewResourceArgsForCall(0)
+					Expect(source).To(Equal(atc.Source{"repository": "busybox"}))
+					Expect(params).To(BeNil())
+					Expect(version).To(BeNil())
+				})
+
+				It("runs the task with the version it was memoized with", func() {
+					_, _, _, containerSpec, _, _, _, _, _, _, _, _ := fakeClient.RunTaskStepArgsForCall(0)
+					Expect(containerSpec.ImageSpec.ImageResource.Version).To(Equal(atc.Version{"digest": "latest"}))
+				})
+
+				It("uses a different key when the image changes", func() {
+					fakeClient.RunCheckStepReturns(worker.CheckResult{
+						Versions: []atc.Version{{"digest": "newer"}},
+					}, nil)
+
+					err := exec.NewTaskStep(
+						planID,
+						*taskPlan,
+						atc.ContainerLimits{},
+						stepMetadata,
+						containerMetadata,
+						fakeStrategy,
+						fakeClient,
+						fakeResourceFactory,
+						fakeDelegate,
+						fakeLockFactory,
+						fakeTaskCacheFactory,
+						fakeVolumeRepository,
+					).Run(ctx, state)
+					Expect(err).ToNot(HaveOccurred())
+
+					_, _, key := fakeTaskCacheFactory.FindMemoArgsForCall(0)
+					_, _, otherKey := fakeTaskCacheFactory.FindMemoArgsForCall(1)
+					Expect(otherKey).ToNot(Equal(key))
+				})
+
+				Context("when the image has no versions", func() {
+					BeforeEach(func() {
+						fakeClient.RunCheckStepReturns(worker.CheckResult{}, nil)
+					})
+
+					It("returns an error without running the task", func() {
+						Expect(stepErr).To(Equal(exec.MemoizedTaskImageUnavailableError{Type: "docker"}))
+						Expect(fakeClient.RunTaskStepCallCount()).To(Equal(0))
+					})
+				})
+
+				Context("when the version is pinned", func() {
+					BeforeEach(func() {
+						taskPlan.Config.ImageResource.Version = atc.Version{"digest": "pinned"}
+					})
+
+					It("does not check the image", func() {
+						Expect(fakeClient.RunCheckStepCallCount()).To(Equal(0))
+
+						_, _, _, containerSpec, _, _, _, _, _, _, _, _ := fakeClient.RunTaskStepArgsForCall(0)
+						Expect(containerSpec.ImageSpec.ImageResource.Version).To(Equal(atc.Version{"digest": "pinned"}))
+					})
+				})
+			})
+
+			Context("when the task has a docker rootfs_uri", func() {
+				BeforeEach(func() {
+					taskPlan.Config.ImageResource = nil
+					taskPlan.Config.RootfsURI = "docker:///busybox#1.31"
+
+					fakeClient.RunCheckStepReturns(worker.CheckResult{
+						Versions: []atc.Version{{"digest": "sha256:some-digest"}},
+					}, nil)
+				})
+
+				It("checks the digest of the image's tag", func() {
+					Expect(fakeClient.RunCheckStepCallCount()).To(Equal(1))
+					_, _, _, containerSpec, _, _, _, _, _, _ := fakeClient.RunCheckStepArgsForCall(0)
+					Expect(containerSpec.ImageSpec.ResourceType).To(Equal("registry-image"))
+
+					source, _, _ := fakeResourceFactory.NewResourceArgsForCall(0)
+					Expect(source).To(Equal(atc.Source{"repository": "busybox", "tag": "1.31"}))
+				})
+
+				It("uses a different key when the digest changes", func() {
+					fakeClient.RunCheckStepReturns(worker.CheckResult{
+						Versions: []atc.Version{{"digest": "sha256:other-digest"}},
+					}, nil)
+
+					err := exec.NewTaskStep(
+						planID,
+						*taskPlan,
+						atc.ContainerLimits{},
+						stepMetadata,
+						containerMetadata,
+						fakeStrategy,
+						fakeClient,
+						fakeResourceFactory,
+						fakeDelegate,
+						fakeLockFactory,
+						fakeTaskCacheFactory,
+						fakeVolumeRepository,
+					).Run(ctx, state)
+					Expect(err).ToNot(HaveOccurred())
+
+					_, _, key := fakeTaskCacheFactory.FindMemoArgsForCall(0)
+					_, _, otherKey := fakeTaskCacheFactory.FindMemoArgsForCall(1)
+					Expect(otherKey).ToNot(Equal(key))
+				})
+
+				Context("when the rootfs_uri is on another registry", func() {
+					BeforeEach(func() {
+						taskPlan.Config.RootfsURI = "docker://registry.example.com/some/image"
+					})
+
+					It("checks the latest tag on that registry", func() {
+						source, _, _ := fakeResourceFactory.NewResourceArgsForCall(0)
+						Expect(source).To(Equal(atc.Source{"repository": "registry.example.com/some/image", "tag": "latest"}))
+					})
+				})
+
+				Context("when the rootfs_uri pins a digest", func() {
+					BeforeEach(func() {
+						taskPlan.Config.RootfsURI = "docker:///busybox@sha256:some-digest"
+					})
+
+					It("does not check the image", func() {
+						Expect(stepErr).ToNot(HaveOccurred())
+						Expect(fakeClient.RunCheckStepCallCount()).To(Equal(0))
+						Expect(fakeTaskCacheFactory.FindMemoCallCount()).To(Equal(1))
+					})
+				})
+			})
+
+			Context("when the task has a rootfs_uri that can't be resolved", func() {
+				BeforeEach(func() {
+					taskPlan.Config.ImageResource = nil
+					taskPlan.Config.RootfsURI = "raw:///some/rootfs"
+				})
+
+				It("runs the task without memoizing it", func() {
+					Expect(stepErr).ToNot(HaveOccurred())
+					Expect(fakeTaskCacheFactory.FindMemoCallCount()).To(Equal(0))
+					Expect(fakeClient.RunTaskStepCallCount()).To(Equal(1))
+					Expect(fakeTaskCacheFactory.MemoizeCallCount()).To(Equal(0))
+				})
+
+				It("warns that the task was not memoized", func() {
+					Expect(stderrBuf).To(gbytes.Say("not memoizing task"))
+				})
+			})
+
+			Context("when the build is a one-off", func() {
+				BeforeEach(func() {
+					stepMetadata.JobID = 0
+				})
+
+				It("runs the task without memoizing it", func() {
+					Expect(fakeClient.HashArtifactCallCount()).To(Equal(0))
+					Expect(fakeClient.RunTaskStepCallCount()).To(Equal(1))
+					Expect(fakeTaskCacheFactory.MemoizeCallCount()).To(Equal(0))
+				})
+			})
+		})
+
 		Context("when RunTaskStep returns volume mounts", func() {
 			var (
 				fakeMountPath1 string = "some-artifact-root/some-output-configured-path/"
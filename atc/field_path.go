@@ -0,0 +1,77 @@
+package atc
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// FieldPathSegment is either a map key or, if Key is empty, a list index.
+type FieldPathSegment struct {
+	Key   string
+	Index int
+}
+
+// ParseFieldPath parses a dot-separated path with optional list indices,
+// e.g. 'foo.bar[0].baz'. Keys containing dots or brackets can be quoted in
+// brackets, e.g. 'foo["db.url"]'.
+func ParseFieldPath(path string) ([]FieldPathSegment, error) {
+	var segments []FieldPathSegment
+
+	rest := path
+	for rest != "" {
+		if strings.HasPrefix(rest, "[\"") {
+			quoted, err := strconv.QuotedPrefix(rest[1:])
+			if err != nil || !strings.HasPrefix(rest[1+len(quoted):], "]") {
+				return nil, fmt.Errorf("invalid field '%s': unterminated quoted key", path)
+			}
+
+			key, err := strconv.Unquote(quoted)
+			if err != nil || key == "" {
+				return nil, fmt.Errorf("invalid field '%s': invalid key %s", path, quoted)
+			}
+
+			segments = append(segments, FieldPathSegment{Key: key})
+			rest = rest[1+len(quoted)+1:]
+		} else if strings.HasPrefix(rest, "[") {
+			end := strings.Index(rest, "]")
+			if end == -1 {
+				return nil, fmt.Errorf("invalid field '%s': missing ']'", path)
+			}
+
+			index, err := strconv.Atoi(rest[1:end])
+			if err != nil || index < 0 {
+				return nil, fmt.Errorf("invalid field '%s': invalid index '%s'", path, rest[1:end])
+			}
+
+			segments = append(segments, FieldPathSegment{Index: index})
+			rest = rest[end+1:]
+		} else {
+			if len(segments) > 0 {
+				if !strings.HasPrefix(rest, ".") {
+					return nil, fmt.Errorf("invalid field '%s': expected '.' or '['", path)
+				}
+
+				rest = rest[1:]
+			}
+
+			end := strings.IndexAny(rest, ".[")
+			if end == -1 {
+				end = len(rest)
+			}
+
+			if end == 0 {
+				return nil, fmt.Errorf("invalid field '%s': empty key", path)
+			}
+
+			segments = append(segments, FieldPathSegment{Key: rest[:end]})
+			rest = rest[end:]
+		}
+	}
+
+	if len(segments) == 0 {
+		return nil, fmt.Errorf("invalid field '%s': empty path", path)
+	}
+
+	return segments, nil
+}
@@ -0,0 +1,50 @@
+package atc_test
+
+import (
+	"github.com/concourse/concourse/atc"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ParseFieldPath", func() {
+	It("parses keys and indices", func() {
+		path, err := atc.ParseFieldPath("foo.bar[1][0].baz")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(path).To(Equal([]atc.FieldPathSegment{
+			{Key: "foo"},
+			{Key: "bar"},
+			{Index: 1},
+			{Index: 0},
+			{Key: "baz"},
+		}))
+	})
+
+	It("allows the path to start with an index", func() {
+		path, err := atc.ParseFieldPath("[2].name")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(path).To(Equal([]atc.FieldPathSegment{
+			{Index: 2},
+			{Key: "name"},
+		}))
+	})
+
+	It("parses quoted keys", func() {
+		path, err := atc.ParseFieldPath(`["db.url"].host[0]["a]\"b"]`)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(path).To(Equal([]atc.FieldPathSegment{
+			{Key: "db.url"},
+			{Key: "host"},
+			{Index: 0},
+			{Key: `a]"b`},
+		}))
+	})
+
+	for _, invalid := range []string{"", "foo..bar", "foo.", ".foo", "foo[", "foo[-1]", "foo[x]", "foo[0]bar", `foo["bar`, `foo["bar"`, `foo[""]`} {
+		invalid := invalid
+
+		It("rejects '"+invalid+"'", func() {
+			_, err := atc.ParseFieldPath(invalid)
+			Expect(err).To(HaveOccurred())
+		})
+	}
+})
@@ -116,6 +116,10 @@ func (c *containerCollector) cleanupOrphanedContainers(logger lager.Logger) erro
 
 	for _, createdContainer := range createdContainers {
 
+		if time.Now().Before(createdContainer.KeepUntil()) {
+			continue
+		}
+
 		if time.Since(createdContainer.LastHijack()) > c.hijackContainerGracePeriod {
 			_, err := createdContainer.Destroying()
 			if err != nil {
@@ -159,6 +159,27 @@ var _ = Describe("ContainerCollector", func() {
 				})
 			})
 
+			Context("when there are created containers kept for debugging", func() {
+				BeforeEach(func() {
+					createdContainer.KeepUntilReturns(time.Now().Add(time.Hour))
+				})
+
+				It("does not destroy them", func() {
+					Expect(err).ToNot(HaveOccurred())
+					Expect(createdContainer.DestroyingCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when there are created containers whose keep has expired", func() {
+				BeforeEach(func() {
+					createdContainer.KeepUntilReturns(time.Now().Add(-time.Minute))
+				})
+
+				It("marks the container as destroying", func() {
+					Expect(createdContainer.DestroyingCallCount()).To(Equal(1))
+				})
+			})
+
 			It("marks all found containers (created and destroying only, no creating) as destroying", func() {
 				Expect(fakeContainerRepository.FindOrphanedContainersCallCount()).To(Equal(1))
 
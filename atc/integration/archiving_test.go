@@ -40,7 +40,7 @@ var _ = Describe("ATC Integration Test", func() {
 		givenAPipeline(client, "pipeline")
 		whenIArchiveIt(client, "pipeline")
 
-		_, err := client.Team("main").UnpausePipeline("pipeline")
+		_, err := client.Team("main").UnpausePipeline(atc.PipelineRef{Name: "pipeline"})
 
 		Expect(err).To(HaveOccurred())
 		Expect(err.Error()).To(ContainSubstring("archived pipelines cannot be unpaused"))
@@ -50,8 +50,8 @@ var _ = Describe("ATC Integration Test", func() {
 		givenAPipeline(client, "pipeline")
 		whenIArchiveIt(client, "pipeline")
 
-		_, version, _, _ := client.Team("main").PipelineConfig("pipeline")
-		client.Team("main").CreateOrUpdatePipelineConfig("pipeline", version, basicPipelineConfig, false)
+		_, version, _, _ := client.Team("main").PipelineConfig(atc.PipelineRef{Name: "pipeline"})
+		client.Team("main").CreateOrUpdatePipelineConfig(atc.PipelineRef{Name: "pipeline"}, version, basicPipelineConfig, false)
 
 		pipeline := getPipeline(client, "pipeline")
 		Expect(pipeline.Archived).To(BeFalse(), "pipeline is still archived")
@@ -85,7 +85,7 @@ var _ = Describe("ATC Integration Test", func() {
 		It("returns an error", func() {
 			givenAPipeline(client, "pipeline")
 
-			_, err := client.Team("main").ArchivePipeline("pipeline")
+			_, err := client.Team("main").ArchivePipeline(atc.PipelineRef{Name: "pipeline"})
 
 			Expect(err).To(HaveOccurred())
 			Expect(err.Error()).To(ContainSubstring("forbidden"))
@@ -94,28 +94,28 @@ var _ = Describe("ATC Integration Test", func() {
 })
 
 func givenAPipeline(client concourse.Client, pipelineName string) {
-	_, _, _, err := client.Team("main").CreateOrUpdatePipelineConfig(pipelineName, "0", basicPipelineConfig, false)
+	_, _, _, err := client.Team("main").CreateOrUpdatePipelineConfig(atc.PipelineRef{Name: pipelineName}, "0", basicPipelineConfig, false)
 	Expect(err).NotTo(HaveOccurred())
 }
 
 func whenIUnpauseIt(client concourse.Client, pipelineName string) {
-	_, err := client.Team("main").UnpausePipeline(pipelineName)
+	_, err := client.Team("main").UnpausePipeline(atc.PipelineRef{Name: pipelineName})
 	Expect(err).ToNot(HaveOccurred())
 }
 
 func whenIArchiveIt(client concourse.Client, pipelineName string) {
-	_, err := client.Team("main").ArchivePipeline(pipelineName)
+	_, err := client.Team("main").ArchivePipeline(atc.PipelineRef{Name: pipelineName})
 	Expect(err).ToNot(HaveOccurred())
 }
 
 func getPipeline(client concourse.Client, pipelineName string) atc.Pipeline {
-	pipeline, _, err := client.Team("main").Pipeline(pipelineName)
+	pipeline, _, err := client.Team("main").Pipeline(atc.PipelineRef{Name: pipelineName})
 	Expect(err).ToNot(HaveOccurred())
 	return pipeline
 }
 
 func getPipelineConfig(client concourse.Client, pipelineName string) (atc.Config, bool) {
-	config, _, ok, err := client.Team("main").PipelineConfig(pipelineName)
+	config, _, ok, err := client.Team("main").PipelineConfig(atc.PipelineRef{Name: pipelineName})
 	Expect(err).ToNot(HaveOccurred())
 	return config, ok
 }
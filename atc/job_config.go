@@ -1,5 +1,11 @@
 package atc
 
+import (
+	"time"
+
+	"github.com/concourse/concourse/atc/cron"
+)
+
 type JobConfig struct {
 	Name    string `json:"name"`
 	OldName string `json:"old_name,omitempty"`
@@ -14,6 +20,12 @@ type JobConfig struct {
 
 	BuildLogRetention *BuildLogRetention `json:"build_log_retention,omitempty"`
 
+	Schedule *ScheduleConfig `json:"schedule,omitempty"`
+
+	BuildTimeout string `json:"build_timeout,omitempty"`
+
+	Artifacts []string `json:"artifacts,omitempty"`
+
 	Abort   *PlanConfig `json:"on_abort,omitempty"`
 	Error   *PlanConfig `json:"on_error,omitempty"`
 	Failure *PlanConfig `json:"on_failure,omitempty"`
@@ -29,6 +41,31 @@ type BuildLogRetention struct {
 	Days                   int `json:"days,omitempty"`
 }
 
+// ScheduleConfig configures a job to be triggered periodically, without the
+// need for a time resource.
+type ScheduleConfig struct {
+	Cron     string `json:"cron"`
+	Location string `json:"location,omitempty"`
+}
+
+// Parse returns the cron schedule, evaluated in the configured location (UTC
+// by default).
+func (config ScheduleConfig) Parse() (*cron.Schedule, error) {
+	schedule, err := cron.Parse(config.Cron)
+	if err != nil {
+		return nil, err
+	}
+
+	if config.Location != "" {
+		schedule.Location, err = time.LoadLocation(config.Location)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return schedule, nil
+}
+
 func (config JobConfig) Hooks() Hooks {
 	return Hooks{
 		Abort:   config.Abort,
@@ -481,6 +481,48 @@ func (event BuildFinished) Emit(logger lager.Logger) {
 	)
 }
 
+type TestsReported struct {
+	PipelineName string
+	JobName      string
+	BuildName    string
+	BuildID      int
+	TeamName     string
+	StepName     string
+	Passed       int
+	Failed       int
+	Skipped      int
+}
+
+func (event TestsReported) Emit(logger lager.Logger) {
+	counts := []struct {
+		status string
+		count  int
+	}{
+		{"passed", event.Passed},
+		{"failed", event.Failed},
+		{"skipped", event.Skipped},
+	}
+
+	for _, c := range counts {
+		emit(
+			logger.Session("tests-reported"),
+			Event{
+				Name:  "tests reported",
+				Value: float64(c.count),
+				Attributes: map[string]string{
+					"pipeline":   event.PipelineName,
+					"job":        event.JobName,
+					"build_name": event.BuildName,
+					"build_id":   strconv.Itoa(event.BuildID),
+					"team_name":  event.TeamName,
+					"step_name":  event.StepName,
+					"status":     c.status,
+				},
+			},
+		)
+	}
+}
+
 func ms(duration time.Duration) float64 {
 	return float64(duration) / 1000000
 }
@@ -42,6 +42,45 @@ var _ = Describe("Metrics", func() {
 			Expect(event.Value).To(Equal(float64(1)))
 		})
 	})
+
+	Describe("tests reported metric", func() {
+		var emitter *smartFakeEmitter
+
+		BeforeEach(func() {
+			emitter = registerFakeEmitterInUnsafeGlobalMap()
+		})
+
+		AfterEach(func() {
+			metric.Deinitialize(testLogger)
+		})
+
+		It("emits the number of tests with each status", func() {
+			metric.TestsReported{
+				PipelineName: "some-pipeline",
+				JobName:      "some-job",
+				StepName:     "unit",
+				Passed:       3,
+				Failed:       2,
+				Skipped:      1,
+			}.Emit(testLogger)
+
+			Eventually(emitter.EmitCallCount).Should(Equal(3))
+
+			counts := map[string]float64{}
+			for i := 0; i < emitter.EmitCallCount(); i++ {
+				_, event := emitter.EmitArgsForCall(i)
+				Expect(event.Name).To(Equal("tests reported"))
+				Expect(event.Attributes["step_name"]).To(Equal("unit"))
+				counts[event.Attributes["status"]] = event.Value
+			}
+
+			Expect(counts).To(Equal(map[string]float64{
+				"passed":  3,
+				"failed":  2,
+				"skipped": 1,
+			}))
+		})
+	})
 })
 
 type smartFakeEmitter struct {
@@ -1,16 +1,126 @@
 package atc
 
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+
+	"sigs.k8s.io/yaml"
+)
+
 type Pipeline struct {
-	ID          int          `json:"id"`
-	Name        string       `json:"name"`
-	Paused      bool         `json:"paused"`
-	Public      bool         `json:"public"`
-	Archived    bool         `json:"archived"`
-	Groups      GroupConfigs `json:"groups,omitempty"`
-	TeamName    string       `json:"team_name"`
-	LastUpdated int64        `json:"last_updated,omitempty"`
+	ID             int          `json:"id"`
+	Name           string       `json:"name"`
+	InstanceVars   InstanceVars `json:"instance_vars,omitempty"`
+	Paused         bool         `json:"paused"`
+	Public         bool         `json:"public"`
+	Archived       bool         `json:"archived"`
+	Groups         GroupConfigs `json:"groups,omitempty"`
+	TeamName       string       `json:"team_name"`
+	LastUpdated    int64        `json:"last_updated,omitempty"`
+	ParentJobID    int          `json:"parent_job_id,omitempty"`
+	ParentBuildID  int          `json:"parent_build_id,omitempty"`
+	ParentTeamName string       `json:"parent_team_name,omitempty"`
+}
+
+func (p Pipeline) Ref() PipelineRef {
+	return PipelineRef{
+		Name:         p.Name,
+		InstanceVars: p.InstanceVars,
+	}
 }
 
 type RenameRequest struct {
 	NewName string `json:"name"`
 }
+
+// InstanceVars are the vars which, along with the pipeline name, identify an
+// instance of a pipeline. They are interpolated into the pipeline's config.
+type InstanceVars map[string]interface{}
+
+// String returns the instance vars as comma-separated key:value pairs, sorted
+// by key. Values are JSON-encoded, except for strings which read back as
+// themselves, so that the pairs can be parsed again.
+func (iv InstanceVars) String() string {
+	keys := make([]string, 0, len(iv))
+	for key := range iv {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	pairs := make([]string, len(keys))
+	for i, key := range keys {
+		value, ok := iv[key].(string)
+		if !ok || !plainInstanceVar(value) {
+			payload, _ := json.Marshal(iv[key])
+			value = string(payload)
+		}
+
+		pairs[i] = key + ":" + value
+	}
+
+	return strings.Join(pairs, ",")
+}
+
+// plainInstanceVar is true when a string instance var can be written as is,
+// as it contains none of the separators of a pipeline ref and doesn't read as
+// another type, e.g. "true" or "2".
+func plainInstanceVar(value string) bool {
+	if value == "" || strings.ContainsAny(value, `/,:"[]{}`) {
+		return false
+	}
+
+	var parsed interface{}
+	err := yaml.Unmarshal([]byte(value), &parsed)
+
+	return err == nil && parsed == value
+}
+
+const InstanceVarsQueryParam = "instance_vars"
+
+// InstanceVarsFromQueryParams parses the JSON-encoded instance vars from the
+// given query params, if any.
+func InstanceVarsFromQueryParams(params url.Values) (InstanceVars, error) {
+	payload := params.Get(InstanceVarsQueryParam)
+	if payload == "" {
+		return nil, nil
+	}
+
+	var instanceVars InstanceVars
+	err := json.Unmarshal([]byte(payload), &instanceVars)
+	if err != nil {
+		return nil, fmt.Errorf("malformed instance vars: %s", err)
+	}
+
+	return instanceVars, nil
+}
+
+// PipelineRef identifies a pipeline within a team by its name and, for
+// instanced pipelines, its instance vars.
+type PipelineRef struct {
+	Name         string       `json:"name"`
+	InstanceVars InstanceVars `json:"instance_vars,omitempty"`
+}
+
+func (ref PipelineRef) String() string {
+	if len(ref.InstanceVars) == 0 {
+		return ref.Name
+	}
+
+	return ref.Name + "/" + ref.InstanceVars.String()
+}
+
+// QueryParams returns the query params which identify the instance of the
+// pipeline in API requests.
+func (ref PipelineRef) QueryParams() url.Values {
+	if len(ref.InstanceVars) == 0 {
+		return nil
+	}
+
+	payload, _ := json.Marshal(ref.InstanceVars)
+
+	return url.Values{InstanceVarsQueryParam: []string{string(payload)}}
+}
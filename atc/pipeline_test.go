@@ -0,0 +1,78 @@
+package atc_test
+
+import (
+	"net/url"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/concourse/concourse/atc"
+)
+
+var _ = Describe("PipelineRef", func() {
+	Describe("String", func() {
+		It("returns the name if there are no instance vars", func() {
+			ref := atc.PipelineRef{Name: "some-pipeline"}
+			Expect(ref.String()).To(Equal("some-pipeline"))
+		})
+
+		It("appends the instance vars sorted by key", func() {
+			ref := atc.PipelineRef{
+				Name: "some-pipeline",
+				InstanceVars: atc.InstanceVars{
+					"branch":  "feature",
+					"version": 2,
+					"debug":   true,
+				},
+			}
+			Expect(ref.String()).To(Equal("some-pipeline/branch:feature,debug:true,version:2"))
+		})
+
+		It("quotes strings which wouldn't read back as themselves", func() {
+			ref := atc.PipelineRef{
+				Name: "some-pipeline",
+				InstanceVars: atc.InstanceVars{
+					"branch":  "feature/a,b",
+					"version": "2",
+				},
+			}
+			Expect(ref.String()).To(Equal(`some-pipeline/branch:"feature/a,b",version:"2"`))
+		})
+	})
+
+	Describe("QueryParams", func() {
+		It("returns no params if there are no instance vars", func() {
+			ref := atc.PipelineRef{Name: "some-pipeline"}
+			Expect(ref.QueryParams()).To(BeNil())
+		})
+
+		It("round-trips the instance vars", func() {
+			ref := atc.PipelineRef{
+				Name: "some-pipeline",
+				InstanceVars: atc.InstanceVars{
+					"branch": "feature",
+					"nested": map[string]interface{}{"key": "value"},
+				},
+			}
+
+			instanceVars, err := atc.InstanceVarsFromQueryParams(ref.QueryParams())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(instanceVars).To(Equal(ref.InstanceVars))
+		})
+	})
+})
+
+var _ = Describe("InstanceVarsFromQueryParams", func() {
+	It("returns nil if the param is not present", func() {
+		instanceVars, err := atc.InstanceVarsFromQueryParams(url.Values{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(instanceVars).To(BeNil())
+	})
+
+	It("errors if the param is not valid JSON", func() {
+		_, err := atc.InstanceVarsFromQueryParams(url.Values{
+			atc.InstanceVarsQueryParam: []string{"{"},
+		})
+		Expect(err).To(HaveOccurred())
+	})
+})
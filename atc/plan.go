@@ -6,6 +6,7 @@ type Plan struct {
 
 	Aggregate   *AggregatePlan   `json:"aggregate,omitempty"`
 	InParallel  *InParallelPlan  `json:"in_parallel,omitempty"`
+	Across      *AcrossPlan      `json:"across,omitempty"`
 	Do          *DoPlan          `json:"do,omitempty"`
 	Get         *GetPlan         `json:"get,omitempty"`
 	Put         *PutPlan         `json:"put,omitempty"`
@@ -13,6 +14,7 @@ type Plan struct {
 	Task        *TaskPlan        `json:"task,omitempty"`
 	SetPipeline *SetPipelinePlan `json:"set_pipeline,omitempty"`
 	LoadVar     *LoadVarPlan     `json:"load_var,omitempty"`
+	Approve     *ApprovePlan     `json:"approve,omitempty"`
 	OnAbort     *OnAbortPlan     `json:"on_abort,omitempty"`
 	OnError     *OnErrorPlan     `json:"on_error,omitempty"`
 	Ensure      *EnsurePlan      `json:"ensure,omitempty"`
@@ -20,8 +22,16 @@ type Plan struct {
 	OnFailure   *OnFailurePlan   `json:"on_failure,omitempty"`
 	Try         *TryPlan         `json:"try,omitempty"`
 	Timeout     *TimeoutPlan     `json:"timeout,omitempty"`
+	If          *IfPlan          `json:"if,omitempty"`
 	Retry       *RetryPlan       `json:"retry,omitempty"`
 
+	// used with 'retry'
+	RetryOn      string        `json:"retry_on,omitempty"`
+	RetryBackoff *RetryBackoff `json:"retry_backoff,omitempty"`
+
+	// used for a job's 'build_timeout'
+	BuildTimeout *BuildTimeoutPlan `json:"build_timeout,omitempty"`
+
 	// used for 'fly execute'
 	ArtifactInput  *ArtifactInputPlan  `json:"artifact_input,omitempty"`
 	ArtifactOutput *ArtifactOutputPlan `json:"artifact_output,omitempty"`
@@ -71,6 +81,18 @@ type TimeoutPlan struct {
 	Duration string `json:"duration"`
 }
 
+// BuildTimeoutPlan aborts the build as errored if it is still running after
+// Duration, measured from when the build started.
+type BuildTimeoutPlan struct {
+	Step     Plan   `json:"step"`
+	Duration string `json:"duration"`
+}
+
+type IfPlan struct {
+	Step      Plan   `json:"step"`
+	Condition string `json:"condition"`
+}
+
 type TryPlan struct {
 	Step Plan `json:"step"`
 }
@@ -83,6 +105,23 @@ type InParallelPlan struct {
 	FailFast bool   `json:"fail_fast,omitempty"`
 }
 
+type AcrossPlan struct {
+	Vars     []AcrossVar     `json:"vars"`
+	Steps    []VarScopedPlan `json:"steps"`
+	FailFast bool            `json:"fail_fast,omitempty"`
+}
+
+type AcrossVar struct {
+	Var         string        `json:"name"`
+	Values      []interface{} `json:"values"`
+	MaxInFlight int           `json:"max_in_flight,omitempty"`
+}
+
+type VarScopedPlan struct {
+	Step   Plan          `json:"step"`
+	Values []interface{} `json:"values"`
+}
+
 type DoPlan []Plan
 
 type GetPlan struct {
@@ -125,8 +164,13 @@ type TaskPlan struct {
 	Name string `json:"name,omitempty"`
 
 	Privileged bool `json:"privileged"`
+	Memoize    bool `json:"memoize,omitempty"`
 	Tags       Tags `json:"tags,omitempty"`
 
+	Reports []TestReportConfig `json:"reports,omitempty"`
+
+	KeepOnFailure string `json:"keep_on_failure,omitempty"`
+
 	ConfigPath string      `json:"config_path,omitempty"`
 	Config     *TaskConfig `json:"config,omitempty"`
 	Vars       Params      `json:"vars,omitempty"`
@@ -140,19 +184,27 @@ type TaskPlan struct {
 }
 
 type SetPipelinePlan struct {
-	Name     string                 `json:"name"`
-	File     string                 `json:"file"`
-	Vars     map[string]interface{} `json:"vars,omitempty"`
-	VarFiles []string               `json:"var_files,omitempty"`
+	Name         string                 `json:"name"`
+	File         string                 `json:"file"`
+	Vars         map[string]interface{} `json:"vars,omitempty"`
+	VarFiles     []string               `json:"var_files,omitempty"`
+	InstanceVars InstanceVars           `json:"instance_vars,omitempty"`
+	Team         string                 `json:"team,omitempty"`
 }
 
 type LoadVarPlan struct {
 	Name   string `json:"name"`
 	File   string `json:"file"`
 	Format string `json:"format,omitempty"`
+	Field  string `json:"field,omitempty"`
 	Reveal bool   `json:"reveal,omitempty"`
 }
 
+type ApprovePlan struct {
+	Name string `json:"name"`
+	Role string `json:"role,omitempty"`
+}
+
 type RetryPlan []Plan
 
 type DependentGetPlan struct {
@@ -29,6 +29,8 @@ func (factory PlanFactory) NewPlan(step Step) Plan {
 		plan.Aggregate = &t
 	case InParallelPlan:
 		plan.InParallel = &t
+	case AcrossPlan:
+		plan.Across = &t
 	case DoPlan:
 		plan.Do = &t
 	case GetPlan:
@@ -41,6 +43,8 @@ func (factory PlanFactory) NewPlan(step Step) Plan {
 		plan.SetPipeline = &t
 	case LoadVarPlan:
 		plan.LoadVar = &t
+	case ApprovePlan:
+		plan.Approve = &t
 	case CheckPlan:
 		plan.Check = &t
 	case OnAbortPlan:
@@ -57,6 +61,10 @@ func (factory PlanFactory) NewPlan(step Step) Plan {
 		plan.Try = &t
 	case TimeoutPlan:
 		plan.Timeout = &t
+	case IfPlan:
+		plan.If = &t
+	case BuildTimeoutPlan:
+		plan.BuildTimeout = &t
 	case RetryPlan:
 		plan.Retry = &t
 	case ArtifactInputPlan:
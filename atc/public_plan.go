@@ -8,6 +8,7 @@ func (plan Plan) Public() *json.RawMessage {
 
 		Aggregate      *json.RawMessage `json:"aggregate,omitempty"`
 		InParallel     *json.RawMessage `json:"in_parallel,omitempty"`
+		Across         *json.RawMessage `json:"across,omitempty"`
 		Do             *json.RawMessage `json:"do,omitempty"`
 		Get            *json.RawMessage `json:"get,omitempty"`
 		Put            *json.RawMessage `json:"put,omitempty"`
@@ -15,6 +16,7 @@ func (plan Plan) Public() *json.RawMessage {
 		Task           *json.RawMessage `json:"task,omitempty"`
 		SetPipeline    *json.RawMessage `json:"set_pipeline,omitempty"`
 		LoadVar        *json.RawMessage `json:"load_var,omitempty"`
+		Approve        *json.RawMessage `json:"approve,omitempty"`
 		OnAbort        *json.RawMessage `json:"on_abort,omitempty"`
 		OnError        *json.RawMessage `json:"on_error,omitempty"`
 		Ensure         *json.RawMessage `json:"ensure,omitempty"`
@@ -23,6 +25,8 @@ func (plan Plan) Public() *json.RawMessage {
 		Try            *json.RawMessage `json:"try,omitempty"`
 		DependentGet   *json.RawMessage `json:"dependent_get,omitempty"`
 		Timeout        *json.RawMessage `json:"timeout,omitempty"`
+		If             *json.RawMessage `json:"if,omitempty"`
+		BuildTimeout   *json.RawMessage `json:"build_timeout,omitempty"`
 		Retry          *json.RawMessage `json:"retry,omitempty"`
 		ArtifactInput  *json.RawMessage `json:"artifact_input,omitempty"`
 		ArtifactOutput *json.RawMessage `json:"artifact_output,omitempty"`
@@ -38,6 +42,10 @@ func (plan Plan) Public() *json.RawMessage {
 		public.InParallel = plan.InParallel.Public()
 	}
 
+	if plan.Across != nil {
+		public.Across = plan.Across.Public()
+	}
+
 	if plan.Do != nil {
 		public.Do = plan.Do.Public()
 	}
@@ -66,6 +74,10 @@ func (plan Plan) Public() *json.RawMessage {
 		public.LoadVar = plan.LoadVar.Public()
 	}
 
+	if plan.Approve != nil {
+		public.Approve = plan.Approve.Public()
+	}
+
 	if plan.OnAbort != nil {
 		public.OnAbort = plan.OnAbort.Public()
 	}
@@ -94,6 +106,14 @@ func (plan Plan) Public() *json.RawMessage {
 		public.Timeout = plan.Timeout.Public()
 	}
 
+	if plan.If != nil {
+		public.If = plan.If.Public()
+	}
+
+	if plan.BuildTimeout != nil {
+		public.BuildTimeout = plan.BuildTimeout.Public()
+	}
+
 	if plan.Retry != nil {
 		public.Retry = plan.Retry.Public()
 	}
@@ -141,6 +161,38 @@ func (plan InParallelPlan) Public() *json.RawMessage {
 	})
 }
 
+func (plan AcrossPlan) Public() *json.RawMessage {
+	type scopedStep struct {
+		Step   *json.RawMessage `json:"step"`
+		Values []interface{}    `json:"values"`
+	}
+
+	steps := make([]scopedStep, len(plan.Steps))
+
+	for i := 0; i < len(plan.Steps); i++ {
+		steps[i] = scopedStep{
+			Step:   plan.Steps[i].Step.Public(),
+			Values: plan.Steps[i].Values,
+		}
+	}
+
+	vars := make([]string, len(plan.Vars))
+
+	for i := 0; i < len(plan.Vars); i++ {
+		vars[i] = plan.Vars[i].Var
+	}
+
+	return enc(struct {
+		Vars     []string     `json:"vars"`
+		Steps    []scopedStep `json:"steps"`
+		FailFast bool         `json:"fail_fast,omitempty"`
+	}{
+		Vars:     vars,
+		Steps:    steps,
+		FailFast: plan.FailFast,
+	})
+}
+
 func (plan DoPlan) Public() *json.RawMessage {
 	public := make([]*json.RawMessage, len(plan))
 
@@ -260,6 +312,18 @@ func (plan TaskPlan) Public() *json.RawMessage {
 }
 
 func (plan SetPipelinePlan) Public() *json.RawMessage {
+	return enc(struct {
+		Name         string       `json:"name"`
+		InstanceVars InstanceVars `json:"instance_vars,omitempty"`
+		Team         string       `json:"team,omitempty"`
+	}{
+		Name:         plan.Name,
+		InstanceVars: plan.InstanceVars,
+		Team:         plan.Team,
+	})
+}
+
+func (plan LoadVarPlan) Public() *json.RawMessage {
 	return enc(struct {
 		Name string `json:"name"`
 	}{
@@ -267,11 +331,13 @@ func (plan SetPipelinePlan) Public() *json.RawMessage {
 	})
 }
 
-func (plan LoadVarPlan) Public() *json.RawMessage {
+func (plan ApprovePlan) Public() *json.RawMessage {
 	return enc(struct {
 		Name string `json:"name"`
+		Role string `json:"role,omitempty"`
 	}{
 		Name: plan.Name,
+		Role: plan.Role,
 	})
 }
 
@@ -285,6 +351,26 @@ func (plan TimeoutPlan) Public() *json.RawMessage {
 	})
 }
 
+func (plan BuildTimeoutPlan) Public() *json.RawMessage {
+	return enc(struct {
+		Step     *json.RawMessage `json:"step"`
+		Duration string           `json:"duration"`
+	}{
+		Step:     plan.Step.Public(),
+		Duration: plan.Duration,
+	})
+}
+
+func (plan IfPlan) Public() *json.RawMessage {
+	return enc(struct {
+		Step      *json.RawMessage `json:"step"`
+		Condition string           `json:"condition"`
+	}{
+		Step:      plan.Step.Public(),
+		Condition: plan.Condition,
+	})
+}
+
 func (plan TryPlan) Public() *json.RawMessage {
 	return enc(struct {
 		Step *json.RawMessage `json:"step"`
@@ -359,6 +359,48 @@ var _ = Describe("Plan", func() {
 							Vars:     map[string]interface{}{"k1": "v1"},
 						},
 					},
+
+					atc.Plan{
+						ID: "38",
+						Approve: &atc.ApprovePlan{
+							Name: "ship-it",
+							Role: "owner",
+						},
+					},
+
+					atc.Plan{
+						ID: "39",
+						If: &atc.IfPlan{
+							Step: atc.Plan{
+								ID: "40",
+								Task: &atc.TaskPlan{
+									Name:       "name",
+									ConfigPath: "some/config/path.yml",
+									Config: &atc.TaskConfig{
+										Params: atc.TaskEnv{"some": "secret"},
+									},
+								},
+							},
+							Condition: `((branch)) == "release"`,
+						},
+					},
+
+					atc.Plan{
+						ID: "41",
+						BuildTimeout: &atc.BuildTimeoutPlan{
+							Step: atc.Plan{
+								ID: "42",
+								Task: &atc.TaskPlan{
+									Name:       "name",
+									ConfigPath: "some/config/path.yml",
+									Config: &atc.TaskConfig{
+										Params: atc.TaskEnv{"some": "secret"},
+									},
+								},
+							},
+							Duration: "2h",
+						},
+					},
 				},
 			}
 
@@ -623,6 +665,39 @@ var _ = Describe("Plan", func() {
 	  "set_pipeline": {
 		"name": "some-pipeline"
 	  }
+	},
+	{
+	  "id": "38",
+	  "approve": {
+		"name": "ship-it",
+		"role": "owner"
+	  }
+	},
+	{
+	  "id": "39",
+	  "if": {
+		"step": {
+		  "id": "40",
+		  "task": {
+			"name": "name",
+			"privileged": false
+		  }
+		},
+		"condition": "((branch)) == \"release\""
+	  }
+	},
+	{
+	  "id": "41",
+	  "build_timeout": {
+		"step": {
+		  "id": "42",
+		  "task": {
+			"name": "name",
+			"privileged": false
+		  }
+		},
+		"duration": "2h"
+	  }
 	}
   ]
 }
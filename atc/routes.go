@@ -13,7 +13,11 @@ const (
 	BuildEvents         = "BuildEvents"
 	BuildResources      = "BuildResources"
 	AbortBuild          = "AbortBuild"
+	ApproveBuild        = "ApproveBuild"
+	SetBuildBreakpoint  = "SetBuildBreakpoint"
+	ContinueBuild       = "ContinueBuild"
 	GetBuildPreparation = "GetBuildPreparation"
+	GetBuildTests       = "GetBuildTests"
 
 	GetCheck = "GetCheck"
 
@@ -86,6 +90,7 @@ const (
 	GetInfoCreds = "GetInfoCreds"
 
 	ListContainers           = "ListContainers"
+	ListKeptContainers       = "ListKeptContainers"
 	GetContainer             = "GetContainer"
 	HijackContainer          = "HijackContainer"
 	ListDestroyingContainers = "ListDestroyingContainers"
@@ -131,8 +136,12 @@ var Routes = rata.Routes([]rata.Route{
 	{Path: "/api/v1/builds/:build_id/events", Method: "GET", Name: BuildEvents},
 	{Path: "/api/v1/builds/:build_id/resources", Method: "GET", Name: BuildResources},
 	{Path: "/api/v1/builds/:build_id/abort", Method: "PUT", Name: AbortBuild},
+	{Path: "/api/v1/builds/:build_id/approval", Method: "PUT", Name: ApproveBuild},
+	{Path: "/api/v1/builds/:build_id/breakpoints", Method: "PUT", Name: SetBuildBreakpoint},
+	{Path: "/api/v1/builds/:build_id/continue", Method: "PUT", Name: ContinueBuild},
 	{Path: "/api/v1/builds/:build_id/preparation", Method: "GET", Name: GetBuildPreparation},
 	{Path: "/api/v1/builds/:build_id/artifacts", Method: "GET", Name: ListBuildArtifacts},
+	{Path: "/api/v1/builds/:build_id/tests", Method: "GET", Name: GetBuildTests},
 
 	{Path: "/api/v1/checks/:check_id", Method: "GET", Name: GetCheck},
 
@@ -210,6 +219,7 @@ var Routes = rata.Routes([]rata.Route{
 	{Path: "/api/v1/containers/destroying", Method: "GET", Name: ListDestroyingContainers},
 	{Path: "/api/v1/containers/report", Method: "PUT", Name: ReportWorkerContainers},
 	{Path: "/api/v1/teams/:team_name/containers", Method: "GET", Name: ListContainers},
+	{Path: "/api/v1/teams/:team_name/kept-containers", Method: "GET", Name: ListKeptContainers},
 	{Path: "/api/v1/teams/:team_name/containers/:id", Method: "GET", Name: GetContainer},
 	{Path: "/api/v1/teams/:team_name/containers/:id/hijack", Method: "GET", Name: HijackContainer},
 
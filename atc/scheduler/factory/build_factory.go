@@ -45,13 +45,50 @@ func (factory *buildFactory) Create(
 		return atc.Plan{}, err
 	}
 
-	return factory.applyHooks(job, constructionParams{
+	plan, err = factory.applyHooks(job, constructionParams{
 		plan:          plan,
 		hooks:         job.Hooks(),
 		resources:     resources,
 		resourceTypes: resourceTypes,
 		inputs:        inputs,
 	})
+	if err != nil {
+		return atc.Plan{}, err
+	}
+
+	if len(job.Artifacts) > 0 {
+		plan = factory.keepArtifacts(plan, job.Artifacts)
+	}
+
+	if job.BuildTimeout != "" {
+		plan = factory.planFactory.NewPlan(atc.BuildTimeoutPlan{
+			Duration: job.BuildTimeout,
+			Step:     plan,
+		})
+	}
+
+	return plan, nil
+}
+
+// keepArtifacts persists the given outputs as worker artifacts once the plan
+// has run, regardless of its outcome. Outputs which were never produced, e.g.
+// because the build failed early, are skipped.
+func (factory *buildFactory) keepArtifacts(plan atc.Plan, artifacts []string) atc.Plan {
+	var steps []atc.Plan
+	for _, name := range artifacts {
+		steps = append(steps, factory.planFactory.NewPlan(atc.TryPlan{
+			Step: factory.planFactory.NewPlan(atc.ArtifactOutputPlan{
+				Name: name,
+			}),
+		}))
+	}
+
+	return factory.planFactory.NewPlan(atc.EnsurePlan{
+		Step: plan,
+		Next: factory.planFactory.NewPlan(atc.InParallelPlan{
+			Steps: steps,
+		}),
+	})
 }
 
 func (factory *buildFactory) constructPlanFromJob(
@@ -110,6 +147,10 @@ func (factory *buildFactory) constructPlanFromConfig(
 	resourceTypes atc.VersionedResourceTypes,
 	inputs []db.BuildInput,
 ) (atc.Plan, error) {
+	if len(planConfig.Across) > 0 {
+		return factory.across(job, planConfig, resources, resourceTypes, inputs)
+	}
+
 	var plan atc.Plan
 	var err error
 
@@ -131,15 +172,96 @@ func (factory *buildFactory) constructPlanFromConfig(
 		}
 
 		plan = factory.planFactory.NewPlan(retryStep)
+		plan.RetryOn = planConfig.RetryOn
+		plan.RetryBackoff = planConfig.Backoff
 	}
 
-	return factory.applyHooks(job, constructionParams{
+	plan, err = factory.applyHooks(job, constructionParams{
 		plan:          plan,
 		hooks:         planConfig.Hooks(),
 		resources:     resources,
 		resourceTypes: resourceTypes,
 		inputs:        inputs,
 	})
+	if err != nil {
+		return atc.Plan{}, err
+	}
+
+	if planConfig.If != "" {
+		plan = factory.planFactory.NewPlan(atc.IfPlan{
+			Condition: planConfig.If,
+			Step:      plan,
+		})
+	}
+
+	return plan, nil
+}
+
+func (factory *buildFactory) across(
+	job atc.JobConfig,
+	planConfig atc.PlanConfig,
+	resources atc.ResourceConfigs,
+	resourceTypes atc.VersionedResourceTypes,
+	inputs []db.BuildInput,
+) (atc.Plan, error) {
+	acrossPlan := atc.AcrossPlan{
+		FailFast: planConfig.FailFast,
+	}
+
+	for _, acrossVar := range planConfig.Across {
+		acrossPlan.Vars = append(acrossPlan.Vars, atc.AcrossVar{
+			Var:         acrossVar.Var,
+			Values:      acrossVar.Values,
+			MaxInFlight: acrossVar.MaxInFlight,
+		})
+	}
+
+	stepConfig := planConfig
+	stepConfig.Across = nil
+	stepConfig.FailFast = false
+
+	for _, values := range acrossCombinations(planConfig.Across) {
+		step, err := factory.constructPlanFromConfig(
+			job,
+			stepConfig,
+			resources,
+			resourceTypes,
+			inputs,
+		)
+		if err != nil {
+			return atc.Plan{}, err
+		}
+
+		acrossPlan.Steps = append(acrossPlan.Steps, atc.VarScopedPlan{
+			Step:   step,
+			Values: values,
+		})
+	}
+
+	return factory.planFactory.NewPlan(acrossPlan), nil
+}
+
+// acrossCombinations returns every combination of the given vars' values,
+// ordered such that the first var changes the slowest.
+func acrossCombinations(acrossVars []atc.AcrossVarConfig) [][]interface{} {
+	combinations := [][]interface{}{{}}
+
+	for _, acrossVar := range acrossVars {
+		var next [][]interface{}
+
+		for _, combination := range combinations {
+			for _, value := range acrossVar.Values {
+				values := make([]interface{}, len(combination), len(combination)+1)
+				copy(values, combination)
+
+				next = append(next, append(values, value))
+			}
+		}
+
+		combinations = next
+	}
+
+	return combinations
 }
 
 func (factory *buildFactory) constructUnhookedPlan(
@@ -192,6 +314,11 @@ func (factory *buildFactory) constructUnhookedPlan(
 
 		putPlan := factory.planFactory.NewPlan(atcPutPlan)
 
+		if planConfig.NoGet {
+			plan = putPlan
+			break
+		}
+
 		dependentGetPlan := factory.planFactory.NewPlan(atc.GetPlan{
 			Type:        resource.Type,
 			Name:        logicalName,
@@ -250,6 +377,9 @@ func (factory *buildFactory) constructUnhookedPlan(
 		plan = factory.planFactory.NewPlan(atc.TaskPlan{
 			Name:              planConfig.Task,
 			Privileged:        planConfig.Privileged,
+			Memoize:           planConfig.Memoize,
+			Reports:           planConfig.Reports,
+			KeepOnFailure:     planConfig.KeepOnFailure,
 			Config:            planConfig.TaskConfig,
 			ConfigPath:        planConfig.File,
 			Vars:              planConfig.Vars,
@@ -269,6 +399,9 @@ func (factory *buildFactory) constructUnhookedPlan(
 			File:     planConfig.File,
 			Vars:     planConfig.Vars,
 			VarFiles: planConfig.VarFiles,
+
+			InstanceVars: planConfig.InstanceVars,
+			Team:         planConfig.Team,
 		})
 
 	case planConfig.LoadVar != "":
@@ -277,9 +410,16 @@ func (factory *buildFactory) constructUnhookedPlan(
 			Name:   name,
 			File:   planConfig.File,
 			Format: planConfig.Format,
+			Field:  planConfig.Field,
 			Reveal: planConfig.Reveal,
 		})
 
+	case planConfig.Approve != "":
+		plan = factory.planFactory.NewPlan(atc.ApprovePlan{
+			Name: planConfig.Approve,
+			Role: planConfig.Role,
+		})
+
 	case planConfig.Try != nil:
 		nextStep, err := factory.constructPlanFromConfig(
 			job,
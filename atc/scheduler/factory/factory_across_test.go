@@ -0,0 +1,150 @@
+package factory_test
+
+import (
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/scheduler/factory"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Factory Across", func() {
+	var (
+		buildFactory factory.BuildFactory
+
+		resources           atc.ResourceConfigs
+		resourceTypes       atc.VersionedResourceTypes
+		actualPlanFactory   atc.PlanFactory
+		expectedPlanFactory atc.PlanFactory
+	)
+
+	BeforeEach(func() {
+		actualPlanFactory = atc.NewPlanFactory(123)
+		expectedPlanFactory = atc.NewPlanFactory(123)
+
+		buildFactory = factory.NewBuildFactory(actualPlanFactory)
+
+		resources = atc.ResourceConfigs{
+			{
+				Name:   "some-resource",
+				Type:   "git",
+				Source: atc.Source{"uri": "git://some-resource"},
+			},
+		}
+
+		resourceTypes = atc.VersionedResourceTypes{
+			{
+				ResourceType: atc.ResourceType{
+					Name:   "some-custom-resource",
+					Type:   "registry-image",
+					Source: atc.Source{"some": "custom-source"},
+				},
+				Version: atc.Version{"some": "version"},
+			},
+		}
+	})
+
+	Context("when I have a step with across", func() {
+		It("returns a plan for every combination of values", func() {
+			actual, err := buildFactory.Create(atc.JobConfig{
+				Plan: atc.PlanSequence{
+					{
+						Task: "some-task",
+						Across: []atc.AcrossVarConfig{
+							{
+								Var:         "go",
+								Values:      []interface{}{"1.13", "1.14"},
+								MaxInFlight: 1,
+							},
+							{
+								Var:    "db",
+								Values: []interface{}{"postgres", "mysql"},
+							},
+						},
+						FailFast: true,
+					},
+				},
+			}, resources, resourceTypes, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			taskPlan := func() atc.Plan {
+				return expectedPlanFactory.NewPlan(atc.TaskPlan{
+					Name:                   "some-task",
+					VersionedResourceTypes: resourceTypes,
+				})
+			}
+
+			expected := expectedPlanFactory.NewPlan(atc.AcrossPlan{
+				Vars: []atc.AcrossVar{
+					{
+						Var:         "go",
+						Values:      []interface{}{"1.13", "1.14"},
+						MaxInFlight: 1,
+					},
+					{
+						Var:    "db",
+						Values: []interface{}{"postgres", "mysql"},
+					},
+				},
+				Steps: []atc.VarScopedPlan{
+					{Step: taskPlan(), Values: []interface{}{"1.13", "postgres"}},
+					{Step: taskPlan(), Values: []interface{}{"1.13", "mysql"}},
+					{Step: taskPlan(), Values: []interface{}{"1.14", "postgres"}},
+					{Step: taskPlan(), Values: []interface{}{"1.14", "mysql"}},
+				},
+				FailFast: true,
+			})
+			Expect(actual).To(Equal(expected))
+		})
+
+		It("applies hooks to every combination", func() {
+			actual, err := buildFactory.Create(atc.JobConfig{
+				Plan: atc.PlanSequence{
+					{
+						Task: "some-task",
+						Across: []atc.AcrossVarConfig{
+							{
+								Var:    "go",
+								Values: []interface{}{"1.13", "1.14"},
+							},
+						},
+						Failure: &atc.PlanConfig{
+							Task: "some-failure-task",
+						},
+					},
+				},
+			}, resources, resourceTypes, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			hookedPlan := func() atc.Plan {
+				step := expectedPlanFactory.NewPlan(atc.TaskPlan{
+					Name:                   "some-task",
+					VersionedResourceTypes: resourceTypes,
+				})
+
+				next := expectedPlanFactory.NewPlan(atc.TaskPlan{
+					Name:                   "some-failure-task",
+					VersionedResourceTypes: resourceTypes,
+				})
+
+				return expectedPlanFactory.NewPlan(atc.OnFailurePlan{
+					Step: step,
+					Next: next,
+				})
+			}
+
+			expected := expectedPlanFactory.NewPlan(atc.AcrossPlan{
+				Vars: []atc.AcrossVar{
+					{
+						Var:    "go",
+						Values: []interface{}{"1.13", "1.14"},
+					},
+				},
+				Steps: []atc.VarScopedPlan{
+					{Step: hookedPlan(), Values: []interface{}{"1.13"}},
+					{Step: hookedPlan(), Values: []interface{}{"1.14"}},
+				},
+			})
+			Expect(actual).To(Equal(expected))
+		})
+	})
+})
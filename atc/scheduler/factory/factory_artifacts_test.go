@@ -0,0 +1,107 @@
+package factory_test
+
+import (
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/scheduler/factory"
+	"github.com/concourse/concourse/atc/testhelpers"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Factory Artifacts", func() {
+	var (
+		buildFactory        factory.BuildFactory
+		actualPlanFactory   atc.PlanFactory
+		expectedPlanFactory atc.PlanFactory
+	)
+
+	BeforeEach(func() {
+		actualPlanFactory = atc.NewPlanFactory(321)
+		expectedPlanFactory = atc.NewPlanFactory(321)
+		buildFactory = factory.NewBuildFactory(actualPlanFactory)
+	})
+
+	Context("when the job keeps artifacts", func() {
+		It("outputs them after the plan and its hooks have run", func() {
+			actual, err := buildFactory.Create(atc.JobConfig{
+				Artifacts: []string{"binary", "reports"},
+				Plan: atc.PlanSequence{
+					{
+						Task: "build",
+					},
+				},
+				Failure: &atc.PlanConfig{
+					Task: "notify",
+				},
+			}, nil, nil, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			taskPlan := expectedPlanFactory.NewPlan(atc.TaskPlan{
+				Name: "build",
+			})
+
+			failurePlan := expectedPlanFactory.NewPlan(atc.OnFailurePlan{
+				Step: taskPlan,
+				Next: expectedPlanFactory.NewPlan(atc.TaskPlan{
+					Name: "notify",
+				}),
+			})
+
+			expected := expectedPlanFactory.NewPlan(atc.EnsurePlan{
+				Step: failurePlan,
+				Next: expectedPlanFactory.NewPlan(atc.InParallelPlan{
+					Steps: []atc.Plan{
+						expectedPlanFactory.NewPlan(atc.TryPlan{
+							Step: expectedPlanFactory.NewPlan(atc.ArtifactOutputPlan{
+								Name: "binary",
+							}),
+						}),
+						expectedPlanFactory.NewPlan(atc.TryPlan{
+							Step: expectedPlanFactory.NewPlan(atc.ArtifactOutputPlan{
+								Name: "reports",
+							}),
+						}),
+					},
+				}),
+			})
+
+			Expect(actual).To(testhelpers.MatchPlan(expected))
+		})
+	})
+
+	Context("when the job also has a build_timeout", func() {
+		It("outputs the artifacts within the timeout", func() {
+			actual, err := buildFactory.Create(atc.JobConfig{
+				Artifacts:    []string{"binary"},
+				BuildTimeout: "1h",
+				Plan: atc.PlanSequence{
+					{
+						Task: "build",
+					},
+				},
+			}, nil, nil, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			expected := expectedPlanFactory.NewPlan(atc.BuildTimeoutPlan{
+				Duration: "1h",
+				Step: expectedPlanFactory.NewPlan(atc.EnsurePlan{
+					Step: expectedPlanFactory.NewPlan(atc.TaskPlan{
+						Name: "build",
+					}),
+					Next: expectedPlanFactory.NewPlan(atc.InParallelPlan{
+						Steps: []atc.Plan{
+							expectedPlanFactory.NewPlan(atc.TryPlan{
+								Step: expectedPlanFactory.NewPlan(atc.ArtifactOutputPlan{
+									Name: "binary",
+								}),
+							}),
+						},
+					}),
+				}),
+			})
+
+			Expect(actual).To(testhelpers.MatchPlan(expected))
+		})
+	})
+})
@@ -0,0 +1,124 @@
+package factory_test
+
+import (
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/scheduler/factory"
+	"github.com/concourse/concourse/atc/testhelpers"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Factory If Step", func() {
+	var (
+		buildFactory        factory.BuildFactory
+		actualPlanFactory   atc.PlanFactory
+		expectedPlanFactory atc.PlanFactory
+		input               atc.JobConfig
+	)
+
+	BeforeEach(func() {
+		actualPlanFactory = atc.NewPlanFactory(123)
+		expectedPlanFactory = atc.NewPlanFactory(123)
+		buildFactory = factory.NewBuildFactory(actualPlanFactory)
+	})
+
+	Context("when a step has a condition", func() {
+		BeforeEach(func() {
+			input = atc.JobConfig{
+				Plan: atc.PlanSequence{
+					{
+						Task: "some-task",
+						If:   `((branch)) == "release"`,
+					},
+				},
+			}
+		})
+
+		It("wraps the step in an if", func() {
+			actual, err := buildFactory.Create(input, nil, nil, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			expected := expectedPlanFactory.NewPlan(atc.IfPlan{
+				Condition: `((branch)) == "release"`,
+				Step: expectedPlanFactory.NewPlan(atc.TaskPlan{
+					Name: "some-task",
+				}),
+			})
+
+			Expect(actual).To(testhelpers.MatchPlan(expected))
+		})
+	})
+
+	Context("when a step with a condition has hooks and a timeout", func() {
+		BeforeEach(func() {
+			input = atc.JobConfig{
+				Plan: atc.PlanSequence{
+					{
+						Task:    "some-task",
+						Timeout: "1h",
+						If:      "((deploy))",
+						Success: &atc.PlanConfig{
+							Task: "some-success-task",
+						},
+					},
+				},
+			}
+		})
+
+		It("skips the hooks and the timeout along with the step", func() {
+			actual, err := buildFactory.Create(input, nil, nil, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			expected := expectedPlanFactory.NewPlan(atc.IfPlan{
+				Condition: "((deploy))",
+				Step: expectedPlanFactory.NewPlan(atc.OnSuccessPlan{
+					Step: expectedPlanFactory.NewPlan(atc.TimeoutPlan{
+						Duration: "1h",
+						Step: expectedPlanFactory.NewPlan(atc.TaskPlan{
+							Name: "some-task",
+						}),
+					}),
+					Next: expectedPlanFactory.NewPlan(atc.TaskPlan{
+						Name: "some-success-task",
+					}),
+				}),
+			})
+
+			Expect(actual).To(testhelpers.MatchPlan(expected))
+		})
+	})
+
+	Context("when a step with a condition runs across vars", func() {
+		BeforeEach(func() {
+			input = atc.JobConfig{
+				Plan: atc.PlanSequence{
+					{
+						Task: "some-task",
+						If:   `((.:go)) != "1.13"`,
+						Across: []atc.AcrossVarConfig{
+							{
+								Var:    "go",
+								Values: []interface{}{"1.13", "1.14"},
+							},
+						},
+					},
+				},
+			}
+		})
+
+		It("evaluates the condition for every combination", func() {
+			actual, err := buildFactory.Create(input, nil, nil, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(actual.Across).NotTo(BeNil())
+			Expect(actual.Across.Steps).To(HaveLen(2))
+
+			for _, step := range actual.Across.Steps {
+				Expect(step.Step.If).NotTo(BeNil())
+				Expect(step.Step.If.Condition).To(Equal(`((.:go)) != "1.13"`))
+				Expect(step.Step.If.Step.Task).NotTo(BeNil())
+			}
+		})
+	})
+})
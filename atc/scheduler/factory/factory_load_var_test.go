@@ -43,6 +43,8 @@ var _ = Describe("Factory LoadVar Step", func() {
 					{
 						LoadVar: "some-var",
 						File:    "some-file",
+						Format:  "dotenv",
+						Field:   "some-field",
 						Reveal:  false,
 					},
 				},
@@ -55,6 +57,8 @@ var _ = Describe("Factory LoadVar Step", func() {
 			expected := expectedPlanFactory.NewPlan(atc.LoadVarPlan{
 				Name:   "some-var",
 				File:   "some-file",
+				Format: "dotenv",
+				Field:  "some-field",
 				Reveal: false,
 			})
 
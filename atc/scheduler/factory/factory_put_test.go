@@ -88,6 +88,36 @@ var _ = Describe("Factory Put", func() {
 			})
 		})
 
+		Context("with a put that skips the get", func() {
+			BeforeEach(func() {
+				input = atc.JobConfig{
+					Plan: atc.PlanSequence{
+						{
+							Put:      "some-put",
+							Resource: "some-resource",
+							NoGet:    true,
+						},
+					},
+				}
+			})
+
+			It("returns only the put plan", func() {
+				actual, err := buildFactory.Create(input, resources, resourceTypes, nil)
+				Expect(err).NotTo(HaveOccurred())
+
+				expected := expectedPlanFactory.NewPlan(atc.PutPlan{
+					Type:     "git",
+					Name:     "some-put",
+					Resource: "some-resource",
+					Source: atc.Source{
+						"uri": "git://some-resource",
+					},
+					VersionedResourceTypes: resourceTypes,
+				})
+				Expect(actual).To(testhelpers.MatchPlan(expected))
+			})
+		})
+
 		Context("with a put for a non-existent resource", func() {
 			BeforeEach(func() {
 				input = atc.JobConfig{
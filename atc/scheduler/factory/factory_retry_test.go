@@ -66,6 +66,45 @@ var _ = Describe("Factory Retry Step", func() {
 		})
 	})
 
+	Context("when there is a task annotated with 'attempts', 'retry_on' and 'backoff'", func() {
+		It("builds correctly", func() {
+			actual, err := buildFactory.Create(atc.JobConfig{
+				Plan: atc.PlanSequence{
+					{
+						Task:     "second task",
+						Attempts: 2,
+						RetryOn:  "errored",
+						Backoff: &atc.RetryBackoff{
+							Initial:    "10s",
+							Multiplier: 3,
+							Max:        "1m",
+						},
+					},
+				},
+			}, nil, resourceTypes, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			expected := expectedPlanFactory.NewPlan(atc.RetryPlan{
+				expectedPlanFactory.NewPlan(atc.TaskPlan{
+					Name:                   "second task",
+					VersionedResourceTypes: resourceTypes,
+				}),
+				expectedPlanFactory.NewPlan(atc.TaskPlan{
+					Name:                   "second task",
+					VersionedResourceTypes: resourceTypes,
+				}),
+			})
+			expected.RetryOn = "errored"
+			expected.RetryBackoff = &atc.RetryBackoff{
+				Initial:    "10s",
+				Multiplier: 3,
+				Max:        "1m",
+			}
+
+			Expect(actual).To(testhelpers.MatchPlan(expected))
+		})
+	})
+
 	Context("when there is a task annotated with 'attempts' and 'on_success'", func() {
 		It("builds correctly", func() {
 			actual, err := buildFactory.Create(atc.JobConfig{
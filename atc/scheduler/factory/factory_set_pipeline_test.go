@@ -63,4 +63,32 @@ var _ = Describe("Factory SetPipeline Step", func() {
 			Expect(actual).To(testhelpers.MatchPlan(expected))
 		})
 	})
+
+	Context("when set a pipeline instance in another team", func() {
+		BeforeEach(func() {
+			input = atc.JobConfig{
+				Plan: atc.PlanSequence{
+					{
+						SetPipeline:  "some-pipeline",
+						File:         "some-file",
+						InstanceVars: atc.InstanceVars{"branch": "feature"},
+						Team:         "some-team",
+					},
+				},
+			}
+		})
+		It("builds correctly", func() {
+			actual, err := buildFactory.Create(input, nil, resourceTypes, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			expected := expectedPlanFactory.NewPlan(atc.SetPipelinePlan{
+				Name:         "some-pipeline",
+				File:         "some-file",
+				InstanceVars: atc.InstanceVars{"branch": "feature"},
+				Team:         "some-team",
+			})
+
+			Expect(actual).To(testhelpers.MatchPlan(expected))
+		})
+	})
 })
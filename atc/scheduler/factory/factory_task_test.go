@@ -76,6 +76,60 @@ var _ = Describe("Factory Task", func() {
 			})
 		})
 
+		Context("when memoize is set", func() {
+			BeforeEach(func() {
+				input = atc.JobConfig{
+					Plan: atc.PlanSequence{
+						{
+							Task:    "some-task",
+							Memoize: true,
+						},
+					},
+				}
+			})
+
+			It("memoizes the task", func() {
+				actual, err := buildFactory.Create(input, resources, resourceTypes, nil)
+				Expect(err).NotTo(HaveOccurred())
+
+				expected := expectedPlanFactory.NewPlan(atc.TaskPlan{
+					Name:                   "some-task",
+					Memoize:                true,
+					VersionedResourceTypes: resourceTypes,
+				})
+				Expect(actual).To(testhelpers.MatchPlan(expected))
+			})
+		})
+
+		Context("when reports are specified", func() {
+			BeforeEach(func() {
+				input = atc.JobConfig{
+					Plan: atc.PlanSequence{
+						{
+							Task: "some-task",
+							Reports: []atc.TestReportConfig{
+								{Type: "junit", Path: "output/report.xml"},
+							},
+						},
+					},
+				}
+			})
+
+			It("collects the reports", func() {
+				actual, err := buildFactory.Create(input, resources, resourceTypes, nil)
+				Expect(err).NotTo(HaveOccurred())
+
+				expected := expectedPlanFactory.NewPlan(atc.TaskPlan{
+					Name: "some-task",
+					Reports: []atc.TestReportConfig{
+						{Type: "junit", Path: "output/report.xml"},
+					},
+					VersionedResourceTypes: resourceTypes,
+				})
+				Expect(actual).To(testhelpers.MatchPlan(expected))
+			})
+		})
+
 		Context("when input mapping is specified", func() {
 			BeforeEach(func() {
 				input = atc.JobConfig{
@@ -3,6 +3,7 @@ package factory_test
 import (
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/scheduler/factory"
+	"github.com/concourse/concourse/atc/testhelpers"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -57,4 +58,41 @@ var _ = Describe("Factory Timeout Step", func() {
 			Expect(actual).To(Equal(expected))
 		})
 	})
+
+	Context("When the job has a build_timeout", func() {
+		It("wraps the whole plan, including the job's hooks", func() {
+			actual, err := buildFactory.Create(atc.JobConfig{
+				BuildTimeout: "2h",
+				Plan: atc.PlanSequence{
+					{
+						Task: "first task",
+					},
+				},
+				Ensure: &atc.PlanConfig{
+					Task: "cleanup",
+				},
+			}, nil, resourceTypes, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			taskPlan := expectedPlanFactory.NewPlan(atc.TaskPlan{
+				Name:                   "first task",
+				VersionedResourceTypes: resourceTypes,
+			})
+
+			ensurePlan := expectedPlanFactory.NewPlan(atc.EnsurePlan{
+				Step: taskPlan,
+				Next: expectedPlanFactory.NewPlan(atc.TaskPlan{
+					Name:                   "cleanup",
+					VersionedResourceTypes: resourceTypes,
+				}),
+			})
+
+			expected := expectedPlanFactory.NewPlan(atc.BuildTimeoutPlan{
+				Duration: "2h",
+				Step:     ensurePlan,
+			})
+
+			Expect(actual).To(testhelpers.MatchPlan(expected))
+		})
+	})
 })
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"code.cloudfoundry.org/clock"
 	"code.cloudfoundry.org/lager"
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/db"
@@ -25,6 +26,7 @@ type Algorithm interface {
 type Scheduler struct {
 	Algorithm    Algorithm
 	BuildStarter BuildStarter
+	Clock        clock.Clock
 }
 
 func (s *Scheduler) Schedule(
@@ -62,6 +64,11 @@ func (s *Scheduler) Schedule(
 		return false, err
 	}
 
+	err = s.ensureScheduledBuildExists(logger, job)
+	if err != nil {
+		return false, err
+	}
+
 	return s.BuildStarter.TryStartPendingBuildsForJob(logger, pipeline, job, jobInputs, resources, relatedJobs)
 }
 
@@ -112,3 +119,46 @@ func (s *Scheduler) ensurePendingBuildExists(
 
 	return nil
 }
+
+func (s *Scheduler) ensureScheduledBuildExists(logger lager.Logger, job db.Job) error {
+	scheduleConfig := job.Schedule()
+	if scheduleConfig == nil {
+		return nil
+	}
+
+	schedule, err := scheduleConfig.Parse()
+	if err != nil {
+		return fmt.Errorf("parse schedule: %w", err)
+	}
+
+	now := s.Clock.Now()
+
+	next := schedule.Next(now)
+	if next.IsZero() {
+		logger.Info("schedule-never-triggers", lager.Data{"cron": scheduleConfig.Cron})
+		return nil
+	}
+
+	nextTrigger := job.NextScheduledTrigger()
+	if nextTrigger.IsZero() {
+		err = job.UpdateNextScheduledTrigger(next)
+		if err != nil {
+			return fmt.Errorf("update next scheduled trigger: %w", err)
+		}
+
+		return nil
+	}
+
+	if nextTrigger.After(now) {
+		return nil
+	}
+
+	logger.Debug("schedule-triggered", lager.Data{"trigger": nextTrigger, "next": next})
+
+	err = job.EnsureScheduledBuildExists(next)
+	if err != nil {
+		return fmt.Errorf("ensure scheduled build exists: %w", err)
+	}
+
+	return nil
+}
@@ -4,7 +4,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
+	"code.cloudfoundry.org/clock/fakeclock"
 	"code.cloudfoundry.org/lager/lagertest"
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/db"
@@ -20,6 +22,7 @@ var _ = Describe("Scheduler", func() {
 	var (
 		fakeAlgorithm    *schedulerfakes.FakeAlgorithm
 		fakeBuildStarter *schedulerfakes.FakeBuildStarter
+		fakeClock        *fakeclock.FakeClock
 
 		scheduler *Scheduler
 
@@ -29,10 +32,12 @@ var _ = Describe("Scheduler", func() {
 	BeforeEach(func() {
 		fakeAlgorithm = new(schedulerfakes.FakeAlgorithm)
 		fakeBuildStarter = new(schedulerfakes.FakeBuildStarter)
+		fakeClock = fakeclock.NewFakeClock(time.Date(2020, 3, 31, 10, 15, 0, 0, time.UTC))
 
 		scheduler = &Scheduler{
 			Algorithm:    fakeAlgorithm,
 			BuildStarter: fakeBuildStarter,
+			Clock:        fakeClock,
 		}
 
 		disaster = errors.New("bad thing")
@@ -416,6 +421,66 @@ var _ = Describe("Scheduler", func() {
 			})
 		})
 
+		Context("when the job has a schedule", func() {
+			BeforeEach(func() {
+				fakeJob.NameReturns("some-job")
+				fakeJob.ScheduleReturns(&atc.ScheduleConfig{
+					Cron:     "0 2 * * *",
+					Location: "America/New_York",
+				})
+
+				fakeBuildStarter.TryStartPendingBuildsForJobReturns(false, nil)
+			})
+
+			Context("when the next trigger has not been computed", func() {
+				It("saves the next trigger without creating a build", func() {
+					Expect(scheduleErr).NotTo(HaveOccurred())
+					Expect(fakeJob.UpdateNextScheduledTriggerCallCount()).To(Equal(1))
+					Expect(fakeJob.UpdateNextScheduledTriggerArgsForCall(0)).To(BeTemporally("==", time.Date(2020, 4, 1, 6, 0, 0, 0, time.UTC)))
+					Expect(fakeJob.EnsureScheduledBuildExistsCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when the next trigger is in the future", func() {
+				BeforeEach(func() {
+					fakeJob.NextScheduledTriggerReturns(fakeClock.Now().Add(time.Hour))
+				})
+
+				It("does not create a build", func() {
+					Expect(scheduleErr).NotTo(HaveOccurred())
+					Expect(fakeJob.UpdateNextScheduledTriggerCallCount()).To(Equal(0))
+					Expect(fakeJob.EnsureScheduledBuildExistsCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when the next trigger is due", func() {
+				BeforeEach(func() {
+					fakeJob.NextScheduledTriggerReturns(fakeClock.Now().Add(-time.Minute))
+				})
+
+				It("creates a scheduled build and saves the following trigger", func() {
+					Expect(scheduleErr).NotTo(HaveOccurred())
+					Expect(fakeJob.EnsureScheduledBuildExistsCallCount()).To(Equal(1))
+					Expect(fakeJob.EnsureScheduledBuildExistsArgsForCall(0)).To(BeTemporally("==", time.Date(2020, 4, 1, 6, 0, 0, 0, time.UTC)))
+				})
+
+				It("starts the pending builds", func() {
+					Expect(fakeBuildStarter.TryStartPendingBuildsForJobCallCount()).To(Equal(1))
+				})
+
+				Context("when creating the scheduled build fails", func() {
+					BeforeEach(func() {
+						fakeJob.EnsureScheduledBuildExistsReturns(disaster)
+					})
+
+					It("returns the error", func() {
+						Expect(scheduleErr).To(Equal(fmt.Errorf("ensure scheduled build exists: %w", disaster)))
+						Expect(fakeBuildStarter.TryStartPendingBuildsForJobCallCount()).To(Equal(0))
+					})
+				})
+			})
+		})
+
 		Context("when the job inputs fail to fetch", func() {
 			BeforeEach(func() {
 				fakeJob.InputsReturns(nil, disaster)
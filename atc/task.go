@@ -4,7 +4,10 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+	"regexp"
+	"sort"
 	"strings"
+	"time"
 
 	"sigs.k8s.io/yaml"
 )
@@ -25,6 +28,10 @@ type TaskConfig struct {
 	// Parameters to pass to the task via environment variables.
 	Params TaskEnv `json:"params,omitempty"`
 
+	// Values to write to files in a tmpfs mounted at /run/secrets, keyed by
+	// file name, so that they are not exposed via environment variables.
+	Secrets TaskEnv `json:"secrets,omitempty"`
+
 	// Script to execute.
 	Run TaskRunConfig `json:"run,omitempty"`
 
@@ -36,11 +43,32 @@ type TaskConfig struct {
 
 	// Path to cached directory that will be shared between builds for the same task.
 	Caches []TaskCacheConfig `json:"caches,omitempty"`
+
+	// Containers to run alongside the task on the same worker, e.g. databases
+	// needed by integration tests.
+	Services []TaskServiceConfig `json:"services,omitempty"`
+
+	// The network to run the task in. If set to 'none', the task's container
+	// only has a loopback interface.
+	Network string `json:"network,omitempty"`
 }
 
+const TaskNetworkNone = "none"
+
 type ContainerLimits struct {
 	CPU    *uint64 `json:"cpu,omitempty"`
 	Memory *uint64 `json:"memory,omitempty"`
+
+	// Hard limit on CPU time, as a percentage of one CPU (e.g. 150 for one and
+	// a half CPUs).
+	CPUQuota *uint64 `json:"cpu_quota,omitempty"`
+
+	// Maximum number of processes and threads.
+	Pids *uint64 `json:"pids,omitempty"`
+
+	// Maximum number of bytes written to the container's root filesystem, not
+	// counting its inputs, outputs and caches.
+	Disk *uint64 `json:"disk,omitempty"`
 }
 
 type ImageResource struct {
@@ -79,6 +107,9 @@ func (config TaskConfig) Validate() error {
 
 	messages = append(messages, config.validateInputContainsNames()...)
 	messages = append(messages, config.validateOutputContainsNames()...)
+	messages = append(messages, config.validateSecrets()...)
+	messages = append(messages, config.validateServices()...)
+	messages = append(messages, config.validateNetwork()...)
 
 	if len(messages) > 0 {
 		return fmt.Errorf("invalid task configuration:\n%s", strings.Join(messages, "\n"))
@@ -111,6 +142,109 @@ func (config TaskConfig) validateInputContainsNames() []string {
 	return messages
 }
 
+func (config TaskConfig) validateSecrets() []string {
+	var messages []string
+
+	for name := range config.Secrets {
+		if name == "" || name == "." || name == ".." || strings.Contains(name, "/") {
+			messages = append(messages, fmt.Sprintf("  secret '%s' has an invalid name (must be a file name)", name))
+		}
+	}
+
+	sort.Strings(messages)
+
+	return messages
+}
+
+func (config TaskConfig) validateNetwork() []string {
+	var messages []string
+
+	switch config.Network {
+	case "":
+	case TaskNetworkNone:
+		if len(config.Services) > 0 {
+			messages = append(messages, fmt.Sprintf("  services cannot be reached with network '%s'", TaskNetworkNone))
+		}
+	default:
+		messages = append(messages, fmt.Sprintf("  invalid network '%s' (must be '%s')", config.Network, TaskNetworkNone))
+	}
+
+	return messages
+}
+
+func (config TaskConfig) validateServices() []string {
+	var messages []string
+
+	names := map[string]bool{}
+	for i, service := range config.Services {
+		identifier := fmt.Sprintf("service '%s'", service.Name)
+
+		if service.Name == "" {
+			identifier = fmt.Sprintf("service in position %d", i)
+			messages = append(messages, fmt.Sprintf("  %s is missing a name", identifier))
+		} else if !serviceNameRegexp.MatchString(service.Name) {
+			messages = append(messages, fmt.Sprintf("  %s has an invalid name (must be lowercase letters, numbers, '-' and '_', starting with a letter)", identifier))
+		} else if names[service.Name] {
+			messages = append(messages, fmt.Sprintf("  %s is defined more than once", identifier))
+		}
+		names[service.Name] = true
+
+		if service.ImageResource == nil && service.RootfsURI == "" {
+			messages = append(messages, fmt.Sprintf("  %s is missing an image_resource or rootfs_uri", identifier))
+		}
+
+		if service.Run.Path == "" {
+			messages = append(messages, fmt.Sprintf("  %s is missing path to executable to run", identifier))
+		}
+
+		if service.HealthCheck != nil {
+			if service.HealthCheck.Run.Path == "" {
+				messages = append(messages, fmt.Sprintf("  %s health_check is missing path to executable to run", identifier))
+			}
+
+			if _, err := time.ParseDuration(service.HealthCheck.Interval); service.HealthCheck.Interval != "" && err != nil {
+				messages = append(messages, fmt.Sprintf("  %s health_check has an invalid interval '%s'", identifier, service.HealthCheck.Interval))
+			}
+
+			if _, err := time.ParseDuration(service.HealthCheck.Timeout); service.HealthCheck.Timeout != "" && err != nil {
+				messages = append(messages, fmt.Sprintf("  %s health_check has an invalid timeout '%s'", identifier, service.HealthCheck.Timeout))
+			}
+		}
+	}
+
+	return messages
+}
+
+var serviceNameRegexp = regexp.MustCompile(`^[a-z][a-z0-9_-]*$`)
+
+// TaskServiceConfig configures a container which is started on the same
+// worker as the task before it runs, and destroyed once it finishes. The task
+// can reach the service at the address in the <NAME>_HOST env var, e.g.
+// POSTGRES_HOST for a service named 'postgres'.
+type TaskServiceConfig struct {
+	Name string `json:"name"`
+
+	RootfsURI     string         `json:"rootfs_uri,omitempty"`
+	ImageResource *ImageResource `json:"image_resource,omitempty"`
+
+	// Parameters to pass to the service via environment variables.
+	Params TaskEnv `json:"params,omitempty"`
+
+	// Command which runs the service.
+	Run TaskRunConfig `json:"run"`
+
+	// Command run in the service's container to check that it is ready.
+	HealthCheck *TaskServiceHealthCheck `json:"health_check,omitempty"`
+}
+
+// TaskServiceHealthCheck is run every Interval (default 1s) until it exits 0,
+// failing the task if the service is not healthy within Timeout (default 1m).
+type TaskServiceHealthCheck struct {
+	Run      TaskRunConfig `json:"run"`
+	Interval string        `json:"interval,omitempty"`
+	Timeout  string        `json:"timeout,omitempty"`
+}
+
 type TaskRunConfig struct {
 	Path string   `json:"path"`
 	Args []string `json:"args,omitempty"`
@@ -296,6 +296,32 @@ run: {path: a/file}
 
 			})
 
+			Context("when invalid pids limit value is provided", func() {
+				It("throws an error and does not continue", func() {
+					data := []byte(`
+platform: beos
+container_limits: { pids: lots }
+
+run: {path: a/file}
+`)
+					_, err := NewTaskConfig(data)
+					Expect(err).To(MatchError(ContainSubstring("pids limit must be an integer")))
+				})
+			})
+
+			Context("when invalid disk limit value is provided", func() {
+				It("throws an error and does not continue", func() {
+					data := []byte(`
+platform: beos
+container_limits: { disk: abc1000kb }
+
+run: {path: a/file}
+`)
+					_, err := NewTaskConfig(data)
+					Expect(err).To(MatchError(ContainSubstring("could not parse container disk limit")))
+				})
+			})
+
 			Context("when invalid cpu limit value is provided", func() {
 				It("throws an error and does not continue", func() {
 					data := []byte(`
@@ -386,6 +412,116 @@ run: {path: a/file}
 			})
 		})
 
+		Context("when the task has secrets", func() {
+			BeforeEach(func() {
+				validConfig.Secrets = TaskEnv{"id_rsa": "some-key", "db.password": "some-password"}
+			})
+
+			It("is valid", func() {
+				Expect(validConfig.Validate()).ToNot(HaveOccurred())
+			})
+
+			Context("when a secret name is not a file name", func() {
+				BeforeEach(func() {
+					invalidConfig.Secrets = TaskEnv{"ssh/id_rsa": "some-key", "..": "some-value"}
+				})
+
+				It("returns an error", func() {
+					err := invalidConfig.Validate()
+
+					Expect(err).To(MatchError(ContainSubstring("  secret 'ssh/id_rsa' has an invalid name (must be a file name)")))
+					Expect(err).To(MatchError(ContainSubstring("  secret '..' has an invalid name (must be a file name)")))
+				})
+			})
+		})
+
+		Context("when the task has services", func() {
+			var service TaskServiceConfig
+
+			BeforeEach(func() {
+				service = TaskServiceConfig{
+					Name:      "postgres",
+					RootfsURI: "docker:///postgres",
+					Run:       TaskRunConfig{Path: "docker-entrypoint.sh", Args: []string{"postgres"}},
+					HealthCheck: &TaskServiceHealthCheck{
+						Run:      TaskRunConfig{Path: "pg_isready"},
+						Interval: "2s",
+						Timeout:  "30s",
+					},
+				}
+			})
+
+			It("is valid", func() {
+				validConfig.Services = []TaskServiceConfig{service}
+				Expect(validConfig.Validate()).ToNot(HaveOccurred())
+			})
+
+			Context("when a service is invalid", func() {
+				BeforeEach(func() {
+					unnamed := service
+					unnamed.Name = ""
+
+					badName := service
+					badName.Name = "Postgres"
+
+					noImage := service
+					noImage.Name = "redis"
+					noImage.RootfsURI = ""
+					noImage.Run.Path = ""
+					noImage.HealthCheck = &TaskServiceHealthCheck{Interval: "often"}
+
+					invalidConfig.Services = []TaskServiceConfig{service, service, unnamed, badName, noImage}
+				})
+
+				It("returns an error", func() {
+					err := invalidConfig.Validate()
+
+					Expect(err).To(MatchError(ContainSubstring("  service 'postgres' is defined more than once")))
+					Expect(err).To(MatchError(ContainSubstring("  service in position 2 is missing a name")))
+					Expect(err).To(MatchError(ContainSubstring("  service 'Postgres' has an invalid name")))
+					Expect(err).To(MatchError(ContainSubstring("  service 'redis' is missing an image_resource or rootfs_uri")))
+					Expect(err).To(MatchError(ContainSubstring("  service 'redis' is missing path to executable to run")))
+					Expect(err).To(MatchError(ContainSubstring("  service 'redis' health_check is missing path to executable to run")))
+					Expect(err).To(MatchError(ContainSubstring("  service 'redis' health_check has an invalid interval 'often'")))
+				})
+			})
+		})
+
+		Context("when the task has a network", func() {
+			BeforeEach(func() {
+				validConfig.Network = "none"
+			})
+
+			It("is valid", func() {
+				Expect(validConfig.Validate()).ToNot(HaveOccurred())
+			})
+
+			Context("when the network is unknown", func() {
+				BeforeEach(func() {
+					invalidConfig.Network = "host"
+				})
+
+				It("returns an error", func() {
+					Expect(invalidConfig.Validate()).To(MatchError(ContainSubstring("  invalid network 'host' (must be 'none')")))
+				})
+			})
+
+			Context("when the task also has services", func() {
+				BeforeEach(func() {
+					invalidConfig.Network = "none"
+					invalidConfig.Services = []TaskServiceConfig{{
+						Name:      "postgres",
+						RootfsURI: "docker:///postgres",
+						Run:       TaskRunConfig{Path: "docker-entrypoint.sh"},
+					}}
+				})
+
+				It("returns an error", func() {
+					Expect(invalidConfig.Validate()).To(MatchError(ContainSubstring("  services cannot be reached with network 'none'")))
+				})
+			})
+		})
+
 		Context("when run is missing", func() {
 			BeforeEach(func() {
 				invalidConfig.Run.Path = ""
@@ -0,0 +1,58 @@
+package atc
+
+const (
+	TestReportTypeJUnit = "junit"
+	TestReportTypeTAP   = "tap"
+)
+
+// TestReportConfig points a task step at a file of test results in one of its
+// outputs, e.g. output/report.xml.
+type TestReportConfig struct {
+	Type string `json:"type"`
+	Path string `json:"path"`
+}
+
+type TestStatus string
+
+const (
+	TestPassed  TestStatus = "passed"
+	TestFailed  TestStatus = "failed"
+	TestSkipped TestStatus = "skipped"
+)
+
+// TestResult is the outcome of a single test reported by a build.
+type TestResult struct {
+	Step     string     `json:"step"`
+	Suite    string     `json:"suite,omitempty"`
+	Name     string     `json:"name"`
+	Status   TestStatus `json:"status"`
+	Duration float64    `json:"duration,omitempty"`
+	Message  string     `json:"message,omitempty"`
+}
+
+// TestReport summarizes the test results reported by a build.
+type TestReport struct {
+	Passed  int          `json:"passed"`
+	Failed  int          `json:"failed"`
+	Skipped int          `json:"skipped"`
+	Tests   []TestResult `json:"tests"`
+}
+
+func NewTestReport(results []TestResult) TestReport {
+	report := TestReport{Tests: []TestResult{}}
+
+	for _, result := range results {
+		switch result.Status {
+		case TestPassed:
+			report.Passed++
+		case TestFailed:
+			report.Failed++
+		case TestSkipped:
+			report.Skipped++
+		}
+
+		report.Tests = append(report.Tests, result)
+	}
+
+	return report
+}
@@ -99,6 +99,13 @@ func stripIDs(plan atc.Plan) (atc.Plan, []string) {
 		}
 	}
 
+	if plan.Across != nil {
+		for i, p := range plan.Across.Steps {
+			plan.Across.Steps[i].Step, subIDs = stripIDs(p.Step)
+			ids = append(ids, subIDs...)
+		}
+	}
+
 	if plan.Do != nil {
 		for i, p := range *plan.Do {
 			(*plan.Do)[i], subIDs = stripIDs(p)
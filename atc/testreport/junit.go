@@ -0,0 +1,104 @@
+package testreport
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/concourse/concourse/atc"
+)
+
+// junitSuite is a <testsuite>, or the <testsuites> wrapping them. Suites may
+// be nested.
+type junitSuite struct {
+	XMLName xml.Name
+	Name    string       `xml:"name,attr"`
+	Suites  []junitSuite `xml:"testsuite"`
+	Cases   []junitCase  `xml:"testcase"`
+}
+
+type junitCase struct {
+	Name      string        `xml:"name,attr"`
+	ClassName string        `xml:"classname,attr"`
+	Time      string        `xml:"time,attr"`
+	Failure   *junitProblem `xml:"failure"`
+	Error     *junitProblem `xml:"error"`
+	Skipped   *junitProblem `xml:"skipped"`
+}
+
+type junitProblem struct {
+	Message  string `xml:"message,attr"`
+	Contents string `xml:",chardata"`
+}
+
+func (problem junitProblem) String() string {
+	contents := strings.TrimSpace(problem.Contents)
+
+	switch {
+	case problem.Message == "":
+		return contents
+	case contents == "":
+		return problem.Message
+	default:
+		return problem.Message + "\n" + contents
+	}
+}
+
+func parseJUnit(r io.Reader) ([]atc.TestResult, error) {
+	var root junitSuite
+	err := xml.NewDecoder(r).Decode(&root)
+	if err != nil {
+		return nil, fmt.Errorf("invalid junit report: %s", err)
+	}
+
+	switch root.XMLName.Local {
+	case "testsuites", "testsuite":
+	default:
+		return nil, fmt.Errorf("invalid junit report: unexpected <%s> element", root.XMLName.Local)
+	}
+
+	return junitResults(root, nil), nil
+}
+
+func junitResults(suite junitSuite, results []atc.TestResult) []atc.TestResult {
+	for _, testCase := range suite.Cases {
+		result := atc.TestResult{
+			Suite:  suite.Name,
+			Name:   testCase.Name,
+			Status: atc.TestPassed,
+		}
+
+		if result.Suite == "" {
+			result.Suite = testCase.ClassName
+		}
+
+		if testCase.Time != "" {
+			duration, err := strconv.ParseFloat(testCase.Time, 64)
+			if err == nil {
+				result.Duration = duration
+			}
+		}
+
+		switch {
+		case testCase.Failure != nil:
+			result.Status = atc.TestFailed
+			result.Message = testCase.Failure.String()
+		case testCase.Error != nil:
+			result.Status = atc.TestFailed
+			result.Message = testCase.Error.String()
+		case testCase.Skipped != nil:
+			result.Status = atc.TestSkipped
+			result.Message = testCase.Skipped.String()
+		}
+
+		results = append(results, result)
+	}
+
+	for _, child := range suite.Suites {
+		results = junitResults(child, results)
+	}
+
+	return results
+}
@@ -0,0 +1,119 @@
+package testreport
+
+import (
+	"bufio"
+	"io"
+	"regexp"
+	"strings"
+
+	"github.com/concourse/concourse/atc"
+)
+
+var (
+	tapTestLine  = regexp.MustCompile(`^(not )?ok\b\s*(\d+)?\s*(?:- )?(.*)$`)
+	tapDirective = regexp.MustCompile(`(?i)^(skip|todo)\S*\s*(.*)$`)
+)
+
+// parseTAP reads a Test Anything Protocol stream. Tests marked SKIP or TODO
+// are reported as skipped, and the YAML diagnostics following a test are
+// used as its message. Indented subtests are ignored in favor of the line
+// summarizing them.
+func parseTAP(r io.Reader) ([]atc.TestResult, error) {
+	var (
+		results     []atc.TestResult
+		diagnostics []string
+		inYAML      bool
+		afterTest   bool
+	)
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+
+	for scanner.Scan() {
+		line := scanner.Text()
+		trimmed := strings.TrimSpace(line)
+
+		if inYAML {
+			if trimmed == "..." {
+				inYAML = false
+				results[len(results)-1].Message = strings.Join(diagnostics, "\n")
+				diagnostics = nil
+			} else {
+				diagnostics = append(diagnostics, strings.TrimSpace(line))
+			}
+
+			continue
+		}
+
+		// diagnostics must immediately follow the test they describe
+		if trimmed == "---" && afterTest && line != trimmed {
+			inYAML = true
+			continue
+		}
+
+		afterTest = false
+
+		if strings.HasPrefix(line, "Bail out!") {
+			results = append(results, atc.TestResult{
+				Name:    "Bail out!",
+				Status:  atc.TestFailed,
+				Message: strings.TrimSpace(strings.TrimPrefix(line, "Bail out!")),
+			})
+			break
+		}
+
+		match := tapTestLine.FindStringSubmatch(line)
+		if match == nil {
+			continue
+		}
+
+		result := atc.TestResult{
+			Status: atc.TestPassed,
+		}
+
+		if match[1] != "" {
+			result.Status = atc.TestFailed
+		}
+
+		description, directive := splitTAPDirective(match[3])
+
+		result.Name = description
+		if result.Name == "" {
+			result.Name = "test " + match[2]
+		}
+
+		if directive := tapDirective.FindStringSubmatch(directive); directive != nil {
+			result.Status = atc.TestSkipped
+			result.Message = directive[2]
+		}
+
+		results = append(results, result)
+		afterTest = true
+	}
+
+	err := scanner.Err()
+	if err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
+// splitTAPDirective splits a test's description from its directive, which
+// follows the first unescaped '#'.
+func splitTAPDirective(text string) (string, string) {
+	for i := 0; i < len(text); i++ {
+		switch text[i] {
+		case '\\':
+			i++
+		case '#':
+			return unescapeTAP(strings.TrimSpace(text[:i])), strings.TrimSpace(text[i+1:])
+		}
+	}
+
+	return unescapeTAP(strings.TrimSpace(text)), ""
+}
+
+func unescapeTAP(text string) string {
+	return strings.NewReplacer(`\#`, `#`, `\\`, `\`).Replace(text)
+}
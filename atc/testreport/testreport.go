@@ -0,0 +1,23 @@
+// Package testreport parses files of test results written by test runners,
+// so that they can be attached to the build which ran them.
+package testreport
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/concourse/concourse/atc"
+)
+
+// Parse reads the results of each test from a report of the given type, i.e.
+// 'junit' or 'tap'.
+func Parse(reportType string, r io.Reader) ([]atc.TestResult, error) {
+	switch reportType {
+	case atc.TestReportTypeJUnit:
+		return parseJUnit(r)
+	case atc.TestReportTypeTAP:
+		return parseTAP(r)
+	default:
+		return nil, fmt.Errorf("unknown report type '%s'", reportType)
+	}
+}
@@ -0,0 +1,149 @@
+package testreport_test
+
+import (
+	"strings"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/testreport"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Parse", func() {
+	var (
+		reportType string
+		report     string
+
+		results []atc.TestResult
+		err     error
+	)
+
+	JustBeforeEach(func() {
+		results, err = testreport.Parse(reportType, strings.NewReader(report))
+	})
+
+	Context("with a junit report", func() {
+		BeforeEach(func() {
+			reportType = "junit"
+			report = `<?xml version="1.0" encoding="UTF-8"?>
+<testsuites>
+  <testsuite name="math" tests="4">
+    <testcase name="adds" classname="math.Add" time="0.25"/>
+    <testcase name="subtracts" classname="math.Sub" time="1.5">
+      <failure message="expected 1, got 2">math_test.go:12</failure>
+    </testcase>
+    <testcase name="divides">
+      <error message="panic: division by zero"/>
+    </testcase>
+    <testcase name="multiplies">
+      <skipped message="not implemented"/>
+    </testcase>
+    <testsuite name="nested">
+      <testcase name="inner"/>
+    </testsuite>
+  </testsuite>
+</testsuites>`
+		})
+
+		It("returns each test's result", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(results).To(Equal([]atc.TestResult{
+				{Suite: "math", Name: "adds", Status: atc.TestPassed, Duration: 0.25},
+				{Suite: "math", Name: "subtracts", Status: atc.TestFailed, Duration: 1.5, Message: "expected 1, got 2\nmath_test.go:12"},
+				{Suite: "math", Name: "divides", Status: atc.TestFailed, Message: "panic: division by zero"},
+				{Suite: "math", Name: "multiplies", Status: atc.TestSkipped, Message: "not implemented"},
+				{Suite: "nested", Name: "inner", Status: atc.TestPassed},
+			}))
+		})
+
+		Context("when the root is a single suite without a name", func() {
+			BeforeEach(func() {
+				report = `<testsuite><testcase name="adds" classname="math.Add"/></testsuite>`
+			})
+
+			It("uses the test's class name as its suite", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(results).To(Equal([]atc.TestResult{
+					{Suite: "math.Add", Name: "adds", Status: atc.TestPassed},
+				}))
+			})
+		})
+
+		Context("when the report is not junit", func() {
+			BeforeEach(func() {
+				report = `<html></html>`
+			})
+
+			It("returns an error", func() {
+				Expect(err).To(MatchError("invalid junit report: unexpected <html> element"))
+			})
+		})
+
+		Context("when the report is not xml", func() {
+			BeforeEach(func() {
+				report = `ok 1`
+			})
+
+			It("returns an error", func() {
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
+
+	Context("with a tap report", func() {
+		BeforeEach(func() {
+			reportType = "tap"
+			report = `TAP version 13
+1..6
+ok 1 - adds
+not ok 2 - subtracts
+  ---
+  message: expected 1, got 2
+  ...
+ok 3 - escapes \# hashes
+not ok 4 - multiplies # TODO not implemented
+ok 5 # skip no network
+    ok 1 - subtest
+ok 6 - has subtests
+# a comment
+`
+		})
+
+		It("returns each test's result", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(results).To(Equal([]atc.TestResult{
+				{Name: "adds", Status: atc.TestPassed},
+				{Name: "subtracts", Status: atc.TestFailed, Message: "message: expected 1, got 2"},
+				{Name: "escapes # hashes", Status: atc.TestPassed},
+				{Name: "multiplies", Status: atc.TestSkipped, Message: "not implemented"},
+				{Name: "test 5", Status: atc.TestSkipped, Message: "no network"},
+				{Name: "has subtests", Status: atc.TestPassed},
+			}))
+		})
+
+		Context("when the run bails out", func() {
+			BeforeEach(func() {
+				report = "ok 1 - adds\nBail out! database is down\nok 2 - never run\n"
+			})
+
+			It("reports a failure and stops", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(results).To(Equal([]atc.TestResult{
+					{Name: "adds", Status: atc.TestPassed},
+					{Name: "Bail out!", Status: atc.TestFailed, Message: "database is down"},
+				}))
+			})
+		})
+	})
+
+	Context("with an unknown report type", func() {
+		BeforeEach(func() {
+			reportType = "nunit"
+		})
+
+		It("returns an error", func() {
+			Expect(err).To(MatchError("unknown report type 'nunit'"))
+		})
+	})
+})
@@ -3,7 +3,10 @@ package worker
 import (
 	"archive/tar"
 	"context"
+	"crypto/sha256"
+	"fmt"
 	"io"
+	"path"
 
 	"code.cloudfoundry.org/lager"
 	"github.com/concourse/concourse/atc/compression"
@@ -34,6 +37,10 @@ type StreamableArtifactSource interface {
 	// StreamFile returns the contents of a single file in the artifact source.
 	// This is used for loading a task's configuration at runtime.
 	StreamFile(context.Context, lager.Logger, string) (io.ReadCloser, error)
+
+	// Hash returns a digest of the files in the artifact source. Like
+	// StreamTo, this transfers the whole artifact through the ATC.
+	Hash(context.Context, lager.Logger) (string, error)
 }
 
 type artifactSource struct {
@@ -105,6 +112,56 @@ func (source *artifactSource) StreamFile(
 	}, nil
 }
 
+// Hash digests the path, mode, link target and contents of every file in the
+// volume. Timestamps and ownership are left out so that artifacts with
+// identical files have the same digest regardless of when they were made.
+func (source *artifactSource) Hash(
+	ctx context.Context,
+	logger lager.Logger,
+) (string, error) {
+	out, err := source.volume.StreamOut(ctx, ".", source.compression.Encoding())
+	if err != nil {
+		return "", err
+	}
+
+	defer out.Close()
+
+	compressionReader, err := source.compression.NewReader(out)
+	if err != nil {
+		return "", err
+	}
+
+	defer compressionReader.Close()
+
+	hash := sha256.New()
+	tarReader := tar.NewReader(compressionReader)
+
+	for {
+		header, err := tarReader.Next()
+		if err == io.EOF {
+			break
+		}
+
+		if err != nil {
+			return "", err
+		}
+
+		fmt.Fprintf(hash, "%s\x00%s\x00%s\x00%d\x00",
+			path.Clean(header.Name),
+			header.FileInfo().Mode(),
+			header.Linkname,
+			header.Size,
+		)
+
+		_, err = io.Copy(hash, tarReader)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	return fmt.Sprintf("%x", hash.Sum(nil)), nil
+}
+
 func (source *artifactSource) ExistsOn(logger lager.Logger, worker Worker) (Volume, bool, error) {
 	return worker.LookupVolume(logger, source.artifact.ID())
 }
@@ -7,6 +7,7 @@ import (
 	"errors"
 	"io"
 	"io/ioutil"
+	"time"
 
 	"code.cloudfoundry.org/lager"
 	"github.com/concourse/baggageclaim"
@@ -173,6 +174,104 @@ var _ = Describe("StreamableArtifactSource", func() {
 		})
 	})
 
+	Context("Hash", func() {
+		type file struct {
+			name    string
+			content string
+			modTime time.Time
+		}
+
+		var (
+			files []file
+
+			hash    string
+			hashErr error
+		)
+
+		tgz := func(files []file) *gbytes.Buffer {
+			tgzBuffer := gbytes.NewBuffer()
+			gzipWriter := gzip.NewWriter(tgzBuffer)
+			tarWriter := tar.NewWriter(gzipWriter)
+
+			for _, f := range files {
+				err := tarWriter.WriteHeader(&tar.Header{
+					Name:    f.name,
+					Mode:    0644,
+					Size:    int64(len(f.content)),
+					ModTime: f.modTime,
+				})
+				Expect(err).NotTo(HaveOccurred())
+
+				_, err = tarWriter.Write([]byte(f.content))
+				Expect(err).NotTo(HaveOccurred())
+			}
+
+			Expect(tarWriter.Close()).To(Succeed())
+			Expect(gzipWriter.Close()).To(Succeed())
+
+			return tgzBuffer
+		}
+
+		hashOf := func(files []file) string {
+			fakeVolume.StreamOutReturns(tgz(files), nil)
+
+			hash, err := artifactSource.Hash(context.TODO(), testLogger)
+			Expect(err).NotTo(HaveOccurred())
+
+			return hash
+		}
+
+		BeforeEach(func() {
+			files = []file{
+				{name: "some-file", content: "some-content", modTime: time.Unix(1, 0)},
+				{name: "some-other-file", content: "some-other-content", modTime: time.Unix(2, 0)},
+			}
+
+			fakeVolume.StreamOutReturns(tgz(files), nil)
+		})
+
+		JustBeforeEach(func() {
+			hash, hashErr = artifactSource.Hash(context.TODO(), testLogger)
+		})
+
+		It("streams out the whole volume", func() {
+			Expect(hashErr).NotTo(HaveOccurred())
+
+			_, path, encoding := fakeVolume.StreamOutArgsForCall(0)
+			Expect(path).To(Equal("."))
+			Expect(encoding).To(Equal(baggageclaim.GzipEncoding))
+		})
+
+		It("returns the same hash for the same files made at another time", func() {
+			files[0].modTime = time.Unix(3, 0)
+			files[1].modTime = time.Unix(4, 0)
+
+			Expect(hashOf(files)).To(Equal(hash))
+		})
+
+		It("returns a different hash when a file's contents differ", func() {
+			files[1].content = "some-changed-content"
+
+			Expect(hashOf(files)).ToNot(Equal(hash))
+		})
+
+		It("returns a different hash when a file is renamed", func() {
+			files[1].name = "some-renamed-file"
+
+			Expect(hashOf(files)).ToNot(Equal(hash))
+		})
+
+		Context("when streaming out of source fails", func() {
+			BeforeEach(func() {
+				fakeVolume.StreamOutReturns(nil, disaster)
+			})
+
+			It("returns the error", func() {
+				Expect(hashErr).To(Equal(disaster))
+			})
+		})
+	})
+
 	Context("ExistsOn", func() {
 		var (
 			fakeWorker   *workerfakes.FakeWorker
@@ -9,6 +9,7 @@ import (
 	"strconv"
 	"time"
 
+	"code.cloudfoundry.org/clock"
 	"code.cloudfoundry.org/garden"
 	"code.cloudfoundry.org/lager"
 	"github.com/concourse/baggageclaim"
@@ -36,6 +37,11 @@ type Client interface {
 		artifact runtime.Artifact,
 		filePath string,
 	) (io.ReadCloser, error)
+	HashArtifact(
+		ctx context.Context,
+		logger lager.Logger,
+		artifact runtime.Artifact,
+	) (string, error)
 
 	RunCheckStep(
 		ctx context.Context,
@@ -62,6 +68,7 @@ type Client interface {
 		runtime.ProcessSpec,
 		runtime.StartingEventDelegate,
 		lock.LockFactory,
+		[]ServiceSpec,
 	) (TaskResult, error)
 
 	RunPutStep(
@@ -94,11 +101,13 @@ type Client interface {
 	) (GetResult, error)
 }
 
-func NewClient(pool Pool, provider WorkerProvider, compression compression.Compression) *client {
+func NewClient(pool Pool, provider WorkerProvider, compression compression.Compression, clock clock.Clock, maxKeepOnFailure time.Duration) *client {
 	return &client{
-		pool:        pool,
-		provider:    provider,
-		compression: compression,
+		pool:             pool,
+		provider:         provider,
+		compression:      compression,
+		clock:            clock,
+		maxKeepOnFailure: maxKeepOnFailure,
 	}
 }
 
@@ -106,11 +115,22 @@ type client struct {
 	pool        Pool
 	provider    WorkerProvider
 	compression compression.Compression
+	clock       clock.Clock
+
+	// caps how long a failed task's container is kept, 0 meaning no cap
+	maxKeepOnFailure time.Duration
 }
 
 type TaskResult struct {
 	ExitStatus   int
 	VolumeMounts []VolumeMount
+
+	// Set when the task failed and its container is being kept for
+	// debugging, per the container spec's KeepOnFailure capped at the
+	// client's maximum.
+	KeptContainerHandle string
+	KeptFor             time.Duration
+	KeptUntil           time.Time
 }
 
 type CheckResult struct {
@@ -244,6 +264,7 @@ func (client *client) RunTaskStep(
 	processSpec runtime.ProcessSpec,
 	eventDelegate runtime.StartingEventDelegate,
 	lockFactory lock.LockFactory,
+	services []ServiceSpec,
 ) (TaskResult, error) {
 	err := client.wireInputsAndCaches(logger, &containerSpec)
 	if err != nil {
@@ -275,6 +296,18 @@ func (client *client) RunTaskStep(
 		defer decreaseActiveTasks(logger.Session("decrease-active-tasks"), chosenWorker)
 	}
 
+	serviceEnv, destroyServices, err := client.startServices(ctx, logger, chosenWorker, services, imageFetcherSpec)
+	defer destroyServices()
+	if err != nil {
+		return TaskResult{}, err
+	}
+
+	for _, env := range serviceEnv {
+		if !hasEnv(containerSpec.Env, env) {
+			containerSpec.Env = append(containerSpec.Env, env)
+		}
+	}
+
 	container, err := chosenWorker.FindOrCreateContainer(
 		ctx,
 		logger,
@@ -378,10 +411,29 @@ func (client *client) RunTaskStep(
 				ExitStatus: status.processStatus,
 			}, err
 		}
-		return TaskResult{
+
+		result := TaskResult{
 			ExitStatus:   status.processStatus,
 			VolumeMounts: container.VolumeMounts(),
-		}, err
+		}
+
+		if status.processStatus != 0 && containerSpec.KeepOnFailure > 0 {
+			keepFor := containerSpec.KeepOnFailure
+			if client.maxKeepOnFailure > 0 && keepFor > client.maxKeepOnFailure {
+				keepFor = client.maxKeepOnFailure
+			}
+
+			keptUntil, err := container.Keep(keepFor)
+			if err != nil {
+				logger.Error("failed-to-keep-container", err)
+			} else {
+				result.KeptContainerHandle = container.Handle()
+				result.KeptFor = keepFor
+				result.KeptUntil = keptUntil
+			}
+		}
+
+		return result, nil
 	}
 }
 
@@ -709,6 +761,29 @@ func (client *client) StreamFileFromArtifact(
 	return source.StreamFile(ctx, logger, filePath)
 }
 
+// HashArtifact returns a digest of the files in the artifact, which is the
+// same for any artifact with identical files.
+func (client *client) HashArtifact(
+	ctx context.Context,
+	logger lager.Logger,
+	artifact runtime.Artifact,
+) (string, error) {
+	artifactVolume, found, err := client.FindVolume(logger, 0, artifact.ID())
+	if err != nil {
+		return "", err
+	}
+	if !found {
+		return "", baggageclaim.ErrVolumeNotFound
+	}
+
+	source := artifactSource{
+		artifact:    artifact,
+		volume:      artifactVolume,
+		compression: client.compression,
+	}
+	return source.Hash(ctx, logger)
+}
+
 func lockName(resourceJSON []byte, workerName string) string {
 	jsonRes := append(resourceJSON, []byte(workerName)...)
 	return fmt.Sprintf("%x", sha256.Sum256(jsonRes))
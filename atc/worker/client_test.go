@@ -7,6 +7,7 @@ import (
 	"path"
 	"time"
 
+	"code.cloudfoundry.org/clock/fakeclock"
 	"code.cloudfoundry.org/garden"
 	"code.cloudfoundry.org/garden/gardenfakes"
 	"github.com/concourse/concourse/atc"
@@ -38,6 +39,7 @@ var _ = Describe("Client", func() {
 		fakeLock        *lockfakes.FakeLock
 		fakeLockFactory *lockfakes.FakeLockFactory
 		fakeCompression *compressionfakes.FakeCompression
+		fakeClock       *fakeclock.FakeClock
 	)
 
 	BeforeEach(func() {
@@ -46,7 +48,9 @@ var _ = Describe("Client", func() {
 		fakeProvider = new(workerfakes.FakeWorkerProvider)
 		fakeCompression = new(compressionfakes.FakeCompression)
 
-		client = worker.NewClient(fakePool, fakeProvider, fakeCompression)
+		fakeClock = fakeclock.NewFakeClock(time.Unix(123, 456))
+
+		client = worker.NewClient(fakePool, fakeProvider, fakeCompression, fakeClock, 24*time.Hour)
 	})
 
 	Describe("FindContainer", func() {
@@ -515,6 +519,7 @@ var _ = Describe("Client", func() {
 			fakeTaskProcessSpec  runtime.ProcessSpec
 			fakeContainer        *workerfakes.FakeContainer
 			fakeEventDelegate    *runtimefakes.FakeStartingEventDelegate
+			services             []worker.ServiceSpec
 
 			ctx    context.Context
 			cancel func()
@@ -591,6 +596,7 @@ var _ = Describe("Client", func() {
 			}
 
 			fakeEventDelegate = new(runtimefakes.FakeStartingEventDelegate)
+			services = nil
 
 			fakeLockFactory = new(lockfakes.FakeLockFactory)
 			fakeLock = new(lockfakes.FakeLock)
@@ -613,6 +619,7 @@ var _ = Describe("Client", func() {
 				fakeTaskProcessSpec,
 				fakeEventDelegate,
 				fakeLockFactory,
+				services,
 			)
 			status = taskResult.ExitStatus
 			volumeMounts = taskResult.VolumeMounts
@@ -705,6 +712,164 @@ var _ = Describe("Client", func() {
 			}))
 		})
 
+		Context("when services are configured", func() {
+			var (
+				fakeServiceContainer *workerfakes.FakeContainer
+				fakeHealthProcess    *gardenfakes.FakeProcess
+				serviceOwner         db.ContainerOwner
+			)
+
+			BeforeEach(func() {
+				serviceOwner = db.NewBuildStepContainerOwner(1234, atc.PlanID("42/services/postgres"), 123)
+
+				services = []worker.ServiceSpec{
+					{
+						Name:     "postgres",
+						Owner:    serviceOwner,
+						Metadata: db.ContainerMetadata{Type: db.ContainerTypeTask, StepName: "some-step/postgres"},
+						ContainerSpec: worker.ContainerSpec{
+							TeamID:    123,
+							ImageSpec: worker.ImageSpec{ImageURL: "docker:///postgres"},
+						},
+						Path: "docker-entrypoint.sh",
+						Args: []string{"postgres"},
+						HealthCheck: &worker.ServiceHealthCheck{
+							Path:     "pg_isready",
+							Interval: time.Second,
+							Timeout:  3 * time.Second,
+						},
+					},
+				}
+
+				fakeHealthProcess = new(gardenfakes.FakeProcess)
+				fakeHealthProcess.WaitReturns(0, nil)
+
+				fakeServiceContainer = new(workerfakes.FakeContainer)
+				fakeServiceContainer.AttachReturns(nil, errors.New("no such process"))
+				fakeServiceContainer.RunReturns(fakeHealthProcess, nil)
+				fakeServiceContainer.InfoReturns(garden.ContainerInfo{ContainerIP: "10.0.0.2"}, nil)
+
+				fakeWorker.FindOrCreateContainerReturnsOnCall(0, fakeServiceContainer, nil)
+				fakeWorker.FindOrCreateContainerReturnsOnCall(1, fakeContainer, nil)
+			})
+
+			It("creates the service container on the chosen worker", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(fakeWorker.FindOrCreateContainerCallCount()).To(Equal(2))
+
+				_, _, delegate, owner, metadata, containerSpec, _ := fakeWorker.FindOrCreateContainerArgsForCall(0)
+				Expect(delegate).To(Equal(fakeDelegate))
+				Expect(owner).To(Equal(serviceOwner))
+				Expect(metadata.StepName).To(Equal("some-step/postgres"))
+				Expect(containerSpec.ImageSpec.ImageURL).To(Equal("docker:///postgres"))
+			})
+
+			It("runs the service process", func() {
+				Expect(fakeServiceContainer.RunCallCount()).To(BeNumerically(">=", 1))
+				_, spec, _ := fakeServiceContainer.RunArgsForCall(0)
+				Expect(spec.ID).To(Equal("service"))
+				Expect(spec.Path).To(Equal("docker-entrypoint.sh"))
+				Expect(spec.Args).To(Equal([]string{"postgres"}))
+			})
+
+			It("runs the health check in the service container", func() {
+				Expect(fakeServiceContainer.RunCallCount()).To(Equal(2))
+				_, spec, _ := fakeServiceContainer.RunArgsForCall(1)
+				Expect(spec.Path).To(Equal("pg_isready"))
+			})
+
+			It("tells the task where to reach the service", func() {
+				_, _, _, _, _, containerSpec, _ := fakeWorker.FindOrCreateContainerArgsForCall(1)
+				Expect(containerSpec.Env).To(ContainElement("POSTGRES_HOST=10.0.0.2"))
+			})
+
+			It("marks the service as destroying once the task finishes", func() {
+				Expect(fakeServiceContainer.MarkDestroyingCallCount()).To(Equal(1))
+				Expect(fakeServiceContainer.DestroyCallCount()).To(BeZero())
+			})
+
+			Context("when the task's params already set the host", func() {
+				BeforeEach(func() {
+					fakeContainerSpec.Env = append(fakeContainerSpec.Env, "POSTGRES_HOST=elsewhere")
+				})
+
+				It("does not override it", func() {
+					_, _, _, _, _, containerSpec, _ := fakeWorker.FindOrCreateContainerArgsForCall(1)
+					Expect(containerSpec.Env).To(ContainElement("POSTGRES_HOST=elsewhere"))
+					Expect(containerSpec.Env).ToNot(ContainElement("POSTGRES_HOST=10.0.0.2"))
+				})
+			})
+
+			Context("when the service process is already running", func() {
+				BeforeEach(func() {
+					fakeServiceContainer.AttachReturns(new(gardenfakes.FakeProcess), nil)
+				})
+
+				It("does not run it again", func() {
+					Expect(fakeServiceContainer.RunCallCount()).To(Equal(1))
+					_, spec, _ := fakeServiceContainer.RunArgsForCall(0)
+					Expect(spec.Path).To(Equal("pg_isready"))
+				})
+			})
+
+			Context("when the health check fails at first", func() {
+				BeforeEach(func() {
+					fakeHealthProcess.WaitReturnsOnCall(0, 1, nil)
+					fakeHealthProcess.WaitReturnsOnCall(1, 0, nil)
+
+					go fakeClock.WaitForWatcherAndIncrement(time.Second)
+				})
+
+				It("retries it after the interval until it passes", func() {
+					Expect(err).ToNot(HaveOccurred())
+					Expect(fakeHealthProcess.WaitCallCount()).To(Equal(2))
+				})
+			})
+
+			Context("when the service never becomes healthy", func() {
+				BeforeEach(func() {
+					fakeHealthProcess.WaitReturns(1, nil)
+
+					go func() {
+						defer GinkgoRecover()
+
+						for i := 0; i < 3; i++ {
+							fakeClock.WaitForWatcherAndIncrement(time.Second)
+						}
+					}()
+				})
+
+				It("returns an error without running the task", func() {
+					Expect(err).To(Equal(worker.ServiceUnhealthyError{
+						Name:    "postgres",
+						Timeout: 3 * time.Second,
+					}))
+					Expect(fakeWorker.FindOrCreateContainerCallCount()).To(Equal(1))
+				})
+
+				It("stops checking once the timeout has elapsed", func() {
+					Expect(fakeHealthProcess.WaitCallCount()).To(Equal(4))
+				})
+
+				It("marks the service as destroying", func() {
+					Expect(fakeServiceContainer.MarkDestroyingCallCount()).To(Equal(1))
+				})
+			})
+
+			Context("when creating the service container fails", func() {
+				disaster := errors.New("nope")
+
+				BeforeEach(func() {
+					fakeWorker.FindOrCreateContainerReturnsOnCall(0, nil, disaster)
+				})
+
+				It("returns the error", func() {
+					Expect(err).To(Equal(disaster))
+					Expect(fakeWorker.FindOrCreateContainerCallCount()).To(Equal(1))
+				})
+			})
+		})
+
 		Context("found a container that has already exited", func() {
 			BeforeEach(func() {
 				fakeContainer.PropertiesReturns(garden.Properties{"concourse:exit-status": "8"}, nil)
@@ -1230,6 +1395,74 @@ var _ = Describe("Client", func() {
 							Expect(fakeWorker.ActiveTasks()).To(Equal(0))
 						})
 					})
+
+					It("does not keep the container", func() {
+						Expect(fakeContainer.KeepCallCount()).To(BeZero())
+						Expect(taskResult.KeptUntil).To(BeZero())
+					})
+
+					Context("when the container should be kept on failure", func() {
+						var keptUntil time.Time
+
+						BeforeEach(func() {
+							fakeContainerSpec.KeepOnFailure = time.Hour
+
+							keptUntil = time.Now().Add(time.Hour)
+							fakeContainer.HandleReturns("some-handle")
+							fakeContainer.KeepReturns(keptUntil, nil)
+						})
+
+						It("keeps the container", func() {
+							Expect(fakeContainer.KeepCallCount()).To(Equal(1))
+							Expect(fakeContainer.KeepArgsForCall(0)).To(Equal(time.Hour))
+						})
+
+						It("returns which container is kept until when", func() {
+							Expect(err).ToNot(HaveOccurred())
+							Expect(taskResult.KeptContainerHandle).To(Equal("some-handle"))
+							Expect(taskResult.KeptFor).To(Equal(time.Hour))
+							Expect(taskResult.KeptUntil).To(Equal(keptUntil))
+						})
+
+						Context("when keeping the container fails", func() {
+							BeforeEach(func() {
+								fakeContainer.KeepReturns(time.Time{}, errors.New("nope"))
+							})
+
+							It("still returns the result", func() {
+								Expect(err).ToNot(HaveOccurred())
+								Expect(status).To(Equal(fakeProcessExitCode))
+								Expect(taskResult.KeptContainerHandle).To(BeEmpty())
+							})
+						})
+
+						Context("when it should be kept for longer than the maximum", func() {
+							BeforeEach(func() {
+								fakeContainerSpec.KeepOnFailure = 10000 * time.Hour
+
+								keptUntil = time.Now().Add(24 * time.Hour)
+								fakeContainer.KeepReturns(keptUntil, nil)
+							})
+
+							It("keeps the container for the maximum", func() {
+								Expect(fakeContainer.KeepCallCount()).To(Equal(1))
+								Expect(fakeContainer.KeepArgsForCall(0)).To(Equal(24 * time.Hour))
+								Expect(taskResult.KeptFor).To(Equal(24 * time.Hour))
+								Expect(taskResult.KeptUntil).To(Equal(keptUntil))
+							})
+						})
+
+						Context("when there is no maximum", func() {
+							BeforeEach(func() {
+								fakeContainerSpec.KeepOnFailure = 10000 * time.Hour
+								client = worker.NewClient(fakePool, fakeProvider, fakeCompression, fakeClock, 0)
+							})
+
+							It("keeps the container for as long as asked", func() {
+								Expect(fakeContainer.KeepArgsForCall(0)).To(Equal(10000 * time.Hour))
+							})
+						})
+					})
 				})
 
 				Context("when running the container fails with an error", func() {
@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"time"
 
 	"code.cloudfoundry.org/garden"
 	"code.cloudfoundry.org/lager"
@@ -32,6 +33,11 @@ type Container interface {
 	WorkerName() string
 
 	UpdateLastHijack() error
+	Keep(time.Duration) (time.Time, error)
+
+	// MarkDestroying marks the container as destroying so that it is reaped by
+	// container GC.
+	MarkDestroying() error
 }
 
 type gardenWorkerContainer struct {
@@ -104,6 +110,15 @@ func (container *gardenWorkerContainer) UpdateLastHijack() error {
 	return container.dbContainer.UpdateLastHijack()
 }
 
+func (container *gardenWorkerContainer) Keep(duration time.Duration) (time.Time, error) {
+	return container.dbContainer.Keep(duration)
+}
+
+func (container *gardenWorkerContainer) MarkDestroying() error {
+	_, err := container.dbContainer.Destroying()
+	return err
+}
+
 func (container *gardenWorkerContainer) Run(ctx context.Context, spec garden.ProcessSpec, io garden.ProcessIO) (garden.Process, error) {
 	spec.User = container.user
 	return container.Container.Run(ctx, spec, io)
@@ -3,6 +3,7 @@ package worker
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"code.cloudfoundry.org/garden"
 	"github.com/concourse/concourse/atc"
@@ -44,11 +45,24 @@ type ContainerSpec struct {
 	// Resource limits to be set on the container when creating in garden.
 	Limits ContainerLimits
 
+	// Limits, named as in container_limits, which are only to be applied by
+	// workers that support them, rather than failing the container.
+	BestEffortLimits []string
+
 	// Local volumes to bind mount directly to the container when creating in garden.
 	BindMounts []BindMountSource
 
 	// Optional user to run processes as. Overwrites the one specified in the docker image.
 	User string
+
+	// Files to write to a tmpfs mounted at SecretsPath, keyed by file name.
+	Secrets map[string]string
+
+	// Set to "none" to give the container only a loopback interface.
+	Network string
+
+	// How long to keep the container around for debugging if its task fails.
+	KeepOnFailure time.Duration
 }
 
 //go:generate counterfeiter . InputSource
@@ -84,8 +98,11 @@ type ImageResource struct {
 }
 
 type ContainerLimits struct {
-	CPU    *uint64
-	Memory *uint64
+	CPU      *uint64
+	Memory   *uint64
+	CPUQuota *uint64
+	Pids     *uint64
+	Disk     *uint64
 }
 
 type inputSource struct {
@@ -115,6 +132,12 @@ func (cl ContainerLimits) ToGardenLimits() garden.Limits {
 	} else {
 		gardenLimits.Memory = garden.MemoryLimits{LimitInBytes: *cl.Memory}
 	}
+	if cl.Pids != nil {
+		gardenLimits.Pid = garden.PidLimits{Max: *cl.Pids}
+	}
+	if cl.Disk != nil {
+		gardenLimits.Disk = garden.DiskLimits{ByteHard: *cl.Disk, Scope: garden.DiskLimitScopeExclusive}
+	}
 	return gardenLimits
 }
 
@@ -0,0 +1,205 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/garden"
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc/db"
+)
+
+const serviceProcessID = "service"
+
+const (
+	DefaultServiceHealthCheckInterval = time.Second
+	DefaultServiceHealthCheckTimeout  = time.Minute
+)
+
+// ServiceSpec describes a container to run on the same worker as a task, e.g.
+// a database used by the task's tests.
+type ServiceSpec struct {
+	Name string
+
+	Owner         db.ContainerOwner
+	Metadata      db.ContainerMetadata
+	ContainerSpec ContainerSpec
+
+	Path string
+	Args []string
+	Dir  string
+
+	HealthCheck *ServiceHealthCheck
+}
+
+// ServiceHealthCheck is a command run in a service's container until it exits
+// 0, indicating that the service is ready.
+type ServiceHealthCheck struct {
+	Path     string
+	Args     []string
+	Interval time.Duration
+	Timeout  time.Duration
+}
+
+type ServiceUnhealthyError struct {
+	Name    string
+	Timeout time.Duration
+}
+
+func (err ServiceUnhealthyError) Error() string {
+	return fmt.Sprintf("service '%s' did not become healthy within %s", err.Name, err.Timeout)
+}
+
+var invalidEnvChars = regexp.MustCompile(`[^A-Z0-9_]`)
+
+// ServiceHostEnv returns the name of the env var through which a task is told
+// the address of the given service, e.g. POSTGRES_HOST for 'postgres'.
+func ServiceHostEnv(name string) string {
+	return invalidEnvChars.ReplaceAllString(strings.ToUpper(name), "_") + "_HOST"
+}
+
+// startServices starts each service on the given worker and waits for them
+// to become healthy. It returns the env vars telling the task where to reach
+// them, along with a function to mark them for destruction by container GC,
+// which must be called even if an error is returned.
+func (client *client) startServices(
+	ctx context.Context,
+	logger lager.Logger,
+	chosenWorker Worker,
+	services []ServiceSpec,
+	imageFetcherSpec ImageFetcherSpec,
+) ([]string, func(), error) {
+	var (
+		containers []Container
+		env        []string
+	)
+
+	destroy := func() {
+		for _, container := range containers {
+			err := container.MarkDestroying()
+			if err != nil {
+				logger.Error("failed-to-mark-service-as-destroying", err, lager.Data{"handle": container.Handle()})
+			}
+		}
+	}
+
+	for _, service := range services {
+		logger := logger.Session("service", lager.Data{"service": service.Name})
+
+		container, err := chosenWorker.FindOrCreateContainer(
+			ctx,
+			logger,
+			imageFetcherSpec.Delegate,
+			service.Owner,
+			service.Metadata,
+			service.ContainerSpec,
+			imageFetcherSpec.ResourceTypes,
+		)
+		if err != nil {
+			return nil, destroy, err
+		}
+
+		containers = append(containers, container)
+
+		_, err = container.Attach(ctx, serviceProcessID, garden.ProcessIO{})
+		if err != nil {
+			logger.Info("spawning")
+
+			_, err = container.Run(ctx, garden.ProcessSpec{
+				ID:   serviceProcessID,
+				Path: service.Path,
+				Args: service.Args,
+				Dir:  service.Dir,
+			}, garden.ProcessIO{})
+			if err != nil {
+				return nil, destroy, err
+			}
+		}
+
+		info, err := container.Info()
+		if err != nil {
+			return nil, destroy, err
+		}
+
+		env = append(env, ServiceHostEnv(service.Name)+"="+info.ContainerIP)
+
+		if service.HealthCheck != nil {
+			err = client.waitForHealthyService(ctx, logger, container, service.Name, *service.HealthCheck)
+			if err != nil {
+				return nil, destroy, err
+			}
+		}
+
+		logger.Info("started")
+	}
+
+	return env, destroy, nil
+}
+
+func (client *client) waitForHealthyService(
+	ctx context.Context,
+	logger lager.Logger,
+	container Container,
+	name string,
+	check ServiceHealthCheck,
+) error {
+	interval := check.Interval
+	if interval == 0 {
+		interval = DefaultServiceHealthCheckInterval
+	}
+
+	timeout := check.Timeout
+	if timeout == 0 {
+		timeout = DefaultServiceHealthCheckTimeout
+	}
+
+	deadline := client.clock.Now().Add(timeout)
+
+	for {
+		process, err := container.Run(ctx, garden.ProcessSpec{
+			Path: check.Path,
+			Args: check.Args,
+		}, garden.ProcessIO{})
+		if err != nil {
+			return err
+		}
+
+		status, err := process.Wait()
+		if err != nil {
+			return err
+		}
+
+		if status == 0 {
+			return nil
+		}
+
+		logger.Debug("unhealthy", lager.Data{"status": status})
+
+		if client.clock.Now().Add(interval).After(deadline) {
+			return ServiceUnhealthyError{Name: name, Timeout: timeout}
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-client.clock.After(interval):
+		}
+	}
+}
+
+// hasEnv returns whether the given env var is already set, so that params
+// configured by the user take precedence.
+func hasEnv(env []string, envVar string) bool {
+	name := strings.SplitN(envVar, "=", 2)[0]
+
+	for _, e := range env {
+		if strings.SplitN(e, "=", 2)[0] == name {
+			return true
+		}
+	}
+
+	return false
+}
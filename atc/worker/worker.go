@@ -28,8 +28,56 @@ import (
 
 const userPropertyName = "user"
 
+// tmpfsMountsPropertyName asks the worker to mount a tmpfs at each of the
+// comma-separated paths. Guardian ignores it, in which case the paths are
+// regular directories in the container.
+const tmpfsMountsPropertyName = "concourse:tmpfs-mounts"
+
+// appliedPropertiesPropertyName lists the properties that the worker applied
+// when creating the container. Guardian never sets it.
+const appliedPropertiesPropertyName = "concourse:applied-properties"
+
+// networkPropertyName keeps the container out of the worker's network when set
+// to "none". Guardian ignores it.
+const networkPropertyName = "concourse:network"
+
+// cpuQuotaPropertyName sets a hard limit on the container's CPU time, as a
+// percentage of one CPU, which garden.Limits has no field for. Guardian ignores
+// it.
+const cpuQuotaPropertyName = "concourse:cpu-quota"
+
+// bestEffortLimitsPropertyName lists the limits that the worker is to apply
+// only if it can, leaving out the ones it does not enforce.
+const bestEffortLimitsPropertyName = "concourse:best-effort-limits"
+
+// Names of the limits that not every worker enforces.
+const (
+	CPUQuotaLimit = "cpu_quota"
+	DiskLimit     = "disk"
+)
+
+// SecretsPath is the directory in which a container's secrets are written.
+const SecretsPath = "/run/secrets"
+
 var ResourceConfigCheckSessionExpiredError = errors.New("no db container was found for owner")
 
+// requiredProperties maps the properties which a container must not run
+// without, should the worker ignore them, to the feature that sets them.
+var requiredProperties = map[string]string{
+	tmpfsMountsPropertyName: "secrets",
+	networkPropertyName:     "network: none",
+	cpuQuotaPropertyName:    CPUQuotaLimit,
+}
+
+type UnsupportedContainerFeatureError struct {
+	Worker  string
+	Feature string
+}
+
+func (err UnsupportedContainerFeatureError) Error() string {
+	return fmt.Sprintf("worker '%s' does not support %s", err.Worker, err.Feature)
+}
+
 //go:generate counterfeiter . Worker
 
 type Worker interface {
@@ -1,8 +1,13 @@
 package worker
 
 import (
+	"archive/tar"
+	"bytes"
 	"fmt"
 	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
 
 	"code.cloudfoundry.org/garden"
 	"code.cloudfoundry.org/lager"
@@ -33,6 +38,24 @@ func (w workerHelper) createGardenContainer(
 		gardenProperties[userPropertyName] = fetchedImage.Metadata.User
 	}
 
+	if len(containerSpec.Secrets) > 0 {
+		gardenProperties[tmpfsMountsPropertyName] = SecretsPath
+	}
+
+	if containerSpec.Limits.CPUQuota != nil && *containerSpec.Limits.CPUQuota > 0 {
+		gardenProperties[cpuQuotaPropertyName] = strconv.FormatUint(*containerSpec.Limits.CPUQuota, 10)
+	}
+
+	if containerSpec.Network != "" {
+		gardenProperties[networkPropertyName] = containerSpec.Network
+	}
+
+	if len(containerSpec.BestEffortLimits) > 0 {
+		bestEffortLimits := append([]string{}, containerSpec.BestEffortLimits...)
+		sort.Strings(bestEffortLimits)
+		gardenProperties[bestEffortLimitsPropertyName] = strings.Join(bestEffortLimits, ",")
+	}
+
 	env := append(fetchedImage.Metadata.Env, containerSpec.Env...)
 
 	if w.dbWorker.HTTPProxyURL() != "" {
@@ -47,7 +70,7 @@ func (w workerHelper) createGardenContainer(
 		env = append(env, fmt.Sprintf("no_proxy=%s", w.dbWorker.NoProxy()))
 	}
 
-	return w.gardenClient.Create(
+	container, err := w.gardenClient.Create(
 		garden.ContainerSpec{
 			Handle:     handleToCreate,
 			RootFSPath: fetchedImage.URL,
@@ -57,6 +80,110 @@ func (w workerHelper) createGardenContainer(
 			Env:        env,
 			Properties: gardenProperties,
 		})
+	if err != nil {
+		return nil, err
+	}
+
+	err = w.checkAppliedProperties(container, gardenProperties)
+	if err != nil {
+		_ = w.gardenClient.Destroy(handleToCreate)
+		return nil, err
+	}
+
+	if len(containerSpec.Secrets) > 0 {
+		err = w.writeSecrets(container, gardenProperties[userPropertyName], containerSpec.Secrets)
+		if err != nil {
+			_ = w.gardenClient.Destroy(handleToCreate)
+			return nil, err
+		}
+	}
+
+	return container, nil
+}
+
+// checkAppliedProperties makes sure that the worker applied every required
+// property that the container was created with, rather than ignoring it,
+// unless it only sets a best-effort limit.
+func (w workerHelper) checkAppliedProperties(container gclient.Container, properties garden.Properties) error {
+	bestEffort := map[string]bool{}
+	for _, limit := range strings.Split(properties[bestEffortLimitsPropertyName], ",") {
+		bestEffort[limit] = true
+	}
+
+	var required []string
+	for name := range properties {
+		if feature, ok := requiredProperties[name]; ok && !bestEffort[feature] {
+			required = append(required, name)
+		}
+	}
+
+	if len(required) == 0 {
+		return nil
+	}
+
+	sort.Strings(required)
+
+	actualProperties, err := container.Properties()
+	if err != nil {
+		return err
+	}
+
+	applied := map[string]bool{}
+	for _, name := range strings.Split(actualProperties[appliedPropertiesPropertyName], ",") {
+		applied[name] = true
+	}
+
+	for _, name := range required {
+		if !applied[name] {
+			return UnsupportedContainerFeatureError{
+				Worker:  w.dbWorker.Name(),
+				Feature: requiredProperties[name],
+			}
+		}
+	}
+
+	return nil
+}
+
+// writeSecrets streams the secrets into the container as files readable only
+// by the user that its processes run as.
+func (w workerHelper) writeSecrets(container gclient.Container, user string, secrets map[string]string) error {
+	names := make([]string, 0, len(secrets))
+	for name := range secrets {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	buf := new(bytes.Buffer)
+	tarWriter := tar.NewWriter(buf)
+
+	for _, name := range names {
+		err := tarWriter.WriteHeader(&tar.Header{
+			Name: name,
+			Mode: 0400,
+			Size: int64(len(secrets[name])),
+		})
+		if err != nil {
+			return err
+		}
+
+		_, err = tarWriter.Write([]byte(secrets[name]))
+		if err != nil {
+			return err
+		}
+	}
+
+	err := tarWriter.Close()
+	if err != nil {
+		return err
+	}
+
+	return container.StreamIn(garden.StreamInSpec{
+		Path:      SecretsPath,
+		User:      user,
+		TarStream: buf,
+	})
 }
 
 func (w workerHelper) constructGardenWorkerContainer(
@@ -1,10 +1,12 @@
 package worker_test
 
 import (
+	"archive/tar"
 	"bytes"
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"io/ioutil"
 
 	"code.cloudfoundry.org/garden"
@@ -1412,6 +1414,201 @@ var _ = Describe("Worker", func() {
 
 				})
 
+				Context("when the container has secrets", func() {
+					BeforeEach(func() {
+						containerSpec.Secrets = map[string]string{
+							"id_rsa":      "some-key",
+							"db-password": "some-password",
+						}
+
+						fakeGardenContainer.PropertiesReturns(garden.Properties{
+							"concourse:applied-properties": "concourse:tmpfs-mounts",
+						}, nil)
+					})
+
+					It("asks for a tmpfs at the secrets path", func() {
+						actualSpec := fakeGardenClient.CreateArgsForCall(0)
+						Expect(actualSpec.Properties).To(Equal(garden.Properties{
+							"user":                   "some-user",
+							"concourse:tmpfs-mounts": "/run/secrets",
+						}))
+					})
+
+					It("streams the secrets into the container as the container's user", func() {
+						Expect(fakeGardenContainer.StreamInCallCount()).To(Equal(1))
+
+						spec := fakeGardenContainer.StreamInArgsForCall(0)
+						Expect(spec.Path).To(Equal("/run/secrets"))
+						Expect(spec.User).To(Equal("some-user"))
+
+						tarReader := tar.NewReader(spec.TarStream)
+
+						header, err := tarReader.Next()
+						Expect(err).ToNot(HaveOccurred())
+						Expect(header.Name).To(Equal("db-password"))
+						Expect(header.Mode).To(Equal(int64(0400)))
+						Expect(ioutil.ReadAll(tarReader)).To(Equal([]byte("some-password")))
+
+						header, err = tarReader.Next()
+						Expect(err).ToNot(HaveOccurred())
+						Expect(header.Name).To(Equal("id_rsa"))
+						Expect(ioutil.ReadAll(tarReader)).To(Equal([]byte("some-key")))
+
+						_, err = tarReader.Next()
+						Expect(err).To(Equal(io.EOF))
+					})
+
+					Context("when streaming in the secrets fails", func() {
+						BeforeEach(func() {
+							fakeGardenContainer.StreamInReturns(disasterErr)
+						})
+
+						It("returns an error", func() {
+							Expect(findOrCreateErr).To(Equal(disasterErr))
+						})
+
+						It("destroys the container and marks it as failed", func() {
+							Expect(fakeGardenClient.DestroyCallCount()).To(Equal(1))
+							Expect(fakeGardenClient.DestroyArgsForCall(0)).To(Equal(fakeGardenClient.CreateArgsForCall(0).Handle))
+							Expect(fakeCreatingContainer.FailedCallCount()).To(Equal(1))
+						})
+					})
+
+					Context("when the worker does not apply the tmpfs", func() {
+						BeforeEach(func() {
+							fakeGardenContainer.PropertiesReturns(garden.Properties{
+								"user":                   "some-user",
+								"concourse:tmpfs-mounts": "/run/secrets",
+							}, nil)
+						})
+
+						It("returns an error", func() {
+							Expect(findOrCreateErr).To(Equal(UnsupportedContainerFeatureError{
+								Worker:  workerName,
+								Feature: "secrets",
+							}))
+						})
+
+						It("does not stream in the secrets", func() {
+							Expect(fakeGardenContainer.StreamInCallCount()).To(BeZero())
+						})
+
+						It("destroys the container and marks it as failed", func() {
+							Expect(fakeGardenClient.DestroyCallCount()).To(Equal(1))
+							Expect(fakeCreatingContainer.FailedCallCount()).To(Equal(1))
+						})
+					})
+				})
+
+				Context("when the container has extended limits", func() {
+					BeforeEach(func() {
+						quota := uint64(150)
+						pids := uint64(1000)
+						disk := uint64(2048)
+						containerSpec.Limits.CPUQuota = &quota
+						containerSpec.Limits.Pids = &pids
+						containerSpec.Limits.Disk = &disk
+
+						fakeGardenContainer.PropertiesReturns(garden.Properties{
+							"concourse:applied-properties": "concourse:cpu-quota",
+						}, nil)
+					})
+
+					It("passes them to garden", func() {
+						actualSpec := fakeGardenClient.CreateArgsForCall(0)
+						Expect(actualSpec.Limits).To(Equal(garden.Limits{
+							CPU:    garden.CPULimits{LimitInShares: 1024},
+							Memory: garden.MemoryLimits{LimitInBytes: 1024},
+							Pid:    garden.PidLimits{Max: 1000},
+							Disk:   garden.DiskLimits{ByteHard: 2048, Scope: garden.DiskLimitScopeExclusive},
+						}))
+						Expect(actualSpec.Properties).To(HaveKeyWithValue("concourse:cpu-quota", "150"))
+					})
+
+					It("succeeds", func() {
+						Expect(findOrCreateErr).ToNot(HaveOccurred())
+					})
+
+					Context("when the worker does not apply the cpu quota", func() {
+						BeforeEach(func() {
+							fakeGardenContainer.PropertiesReturns(garden.Properties{
+								"concourse:cpu-quota": "150",
+							}, nil)
+						})
+
+						It("returns an error", func() {
+							Expect(findOrCreateErr).To(Equal(UnsupportedContainerFeatureError{
+								Worker:  workerName,
+								Feature: "cpu_quota",
+							}))
+						})
+
+						It("destroys the container and marks it as failed", func() {
+							Expect(fakeGardenClient.DestroyCallCount()).To(Equal(1))
+							Expect(fakeCreatingContainer.FailedCallCount()).To(Equal(1))
+						})
+
+						Context("when the limits are only best-effort", func() {
+							BeforeEach(func() {
+								containerSpec.BestEffortLimits = []string{"disk", "cpu_quota"}
+							})
+
+							It("marks them as best-effort", func() {
+								actualSpec := fakeGardenClient.CreateArgsForCall(0)
+								Expect(actualSpec.Properties).To(HaveKeyWithValue("concourse:best-effort-limits", "cpu_quota,disk"))
+							})
+
+							It("succeeds", func() {
+								Expect(findOrCreateErr).ToNot(HaveOccurred())
+								Expect(fakeGardenClient.DestroyCallCount()).To(BeZero())
+							})
+						})
+					})
+				})
+
+				Context("when the container has no network", func() {
+					BeforeEach(func() {
+						containerSpec.Network = "none"
+
+						fakeGardenContainer.PropertiesReturns(garden.Properties{
+							"concourse:applied-properties": "concourse:network",
+						}, nil)
+					})
+
+					It("asks for the container to be kept out of the network", func() {
+						actualSpec := fakeGardenClient.CreateArgsForCall(0)
+						Expect(actualSpec.Properties).To(Equal(garden.Properties{
+							"user":              "some-user",
+							"concourse:network": "none",
+						}))
+					})
+
+					It("succeeds", func() {
+						Expect(findOrCreateErr).ToNot(HaveOccurred())
+					})
+
+					Context("when the worker leaves the container in the network", func() {
+						BeforeEach(func() {
+							fakeGardenContainer.PropertiesReturns(garden.Properties{
+								"user":              "some-user",
+								"concourse:network": "none",
+							}, nil)
+						})
+
+						It("returns an error", func() {
+							Expect(findOrCreateErr).To(Equal(UnsupportedContainerFeatureError{
+								Worker:  workerName,
+								Feature: "network: none",
+							}))
+						})
+
+						It("destroys the container and marks it as failed", func() {
+							Expect(fakeGardenClient.DestroyCallCount()).To(Equal(1))
+							Expect(fakeCreatingContainer.FailedCallCount()).To(Equal(1))
+						})
+					})
+				})
+
 				Context("when an input has the path set to the workdir itself", func() {
 					BeforeEach(func() {
 						fakeLocalInput.DestinationPathReturns("/some/work-dir")
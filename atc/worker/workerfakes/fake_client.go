@@ -67,6 +67,21 @@ type FakeClient struct {
 		result2 bool
 		result3 error
 	}
+	HashArtifactStub        func(context.Context, lager.Logger, runtime.Artifact) (string, error)
+	hashArtifactMutex       sync.RWMutex
+	hashArtifactArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 runtime.Artifact
+	}
+	hashArtifactReturns struct {
+		result1 string
+		result2 error
+	}
+	hashArtifactReturnsOnCall map[int]struct {
+		result1 string
+		result2 error
+	}
 	RunCheckStepStub        func(context.Context, lager.Logger, db.ContainerOwner, worker.ContainerSpec, worker.WorkerSpec, worker.ContainerPlacementStrategy, db.ContainerMetadata, atc.VersionedResourceTypes, time.Duration, resource.Resource) (worker.CheckResult, error)
 	runCheckStepMutex       sync.RWMutex
 	runCheckStepArgsForCall []struct {
@@ -136,7 +151,7 @@ type FakeClient struct {
 		result1 worker.PutResult
 		result2 error
 	}
-	RunTaskStepStub        func(context.Context, lager.Logger, db.ContainerOwner, worker.ContainerSpec, worker.WorkerSpec, worker.ContainerPlacementStrategy, db.ContainerMetadata, worker.ImageFetcherSpec, runtime.ProcessSpec, runtime.StartingEventDelegate, lock.LockFactory) (worker.TaskResult, error)
+	RunTaskStepStub        func(context.Context, lager.Logger, db.ContainerOwner, worker.ContainerSpec, worker.WorkerSpec, worker.ContainerPlacementStrategy, db.ContainerMetadata, worker.ImageFetcherSpec, runtime.ProcessSpec, runtime.StartingEventDelegate, lock.LockFactory, []worker.ServiceSpec) (worker.TaskResult, error)
 	runTaskStepMutex       sync.RWMutex
 	runTaskStepArgsForCall []struct {
 		arg1  context.Context
@@ -150,6 +165,7 @@ type FakeClient struct {
 		arg9  runtime.ProcessSpec
 		arg10 runtime.StartingEventDelegate
 		arg11 lock.LockFactory
+		arg12 []worker.ServiceSpec
 	}
 	runTaskStepReturns struct {
 		result1 worker.TaskResult
@@ -381,6 +397,71 @@ func (fake *FakeClient) FindVolumeReturnsOnCall(i int, result1 worker.Volume, re
 	}{result1, result2, result3}
 }
 
+func (fake *FakeClient) HashArtifact(arg1 context.Context, arg2 lager.Logger, arg3 runtime.Artifact) (string, error) {
+	fake.hashArtifactMutex.Lock()
+	ret, specificReturn := fake.hashArtifactReturnsOnCall[len(fake.hashArtifactArgsForCall)]
+	fake.hashArtifactArgsForCall = append(fake.hashArtifactArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 runtime.Artifact
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("HashArtifact", []interface{}{arg1, arg2, arg3})
+	fake.hashArtifactMutex.Unlock()
+	if fake.HashArtifactStub != nil {
+		return fake.HashArtifactStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.hashArtifactReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) HashArtifactCallCount() int {
+	fake.hashArtifactMutex.RLock()
+	defer fake.hashArtifactMutex.RUnlock()
+	return len(fake.hashArtifactArgsForCall)
+}
+
+func (fake *FakeClient) HashArtifactCalls(stub func(context.Context, lager.Logger, runtime.Artifact) (string, error)) {
+	fake.hashArtifactMutex.Lock()
+	defer fake.hashArtifactMutex.Unlock()
+	fake.HashArtifactStub = stub
+}
+
+func (fake *FakeClient) HashArtifactArgsForCall(i int) (context.Context, lager.Logger, runtime.Artifact) {
+	fake.hashArtifactMutex.RLock()
+	defer fake.hashArtifactMutex.RUnlock()
+	argsForCall := fake.hashArtifactArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeClient) HashArtifactReturns(result1 string, result2 error) {
+	fake.hashArtifactMutex.Lock()
+	defer fake.hashArtifactMutex.Unlock()
+	fake.HashArtifactStub = nil
+	fake.hashArtifactReturns = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) HashArtifactReturnsOnCall(i int, result1 string, result2 error) {
+	fake.hashArtifactMutex.Lock()
+	defer fake.hashArtifactMutex.Unlock()
+	fake.HashArtifactStub = nil
+	if fake.hashArtifactReturnsOnCall == nil {
+		fake.hashArtifactReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 error
+		})
+	}
+	fake.hashArtifactReturnsOnCall[i] = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeClient) RunCheckStep(arg1 context.Context, arg2 lager.Logger, arg3 db.ContainerOwner, arg4 worker.ContainerSpec, arg5 worker.WorkerSpec, arg6 worker.ContainerPlacementStrategy, arg7 db.ContainerMetadata, arg8 atc.VersionedResourceTypes, arg9 time.Duration, arg10 resource.Resource) (worker.CheckResult, error) {
 	fake.runCheckStepMutex.Lock()
 	ret, specificReturn := fake.runCheckStepReturnsOnCall[len(fake.runCheckStepArgsForCall)]
@@ -600,7 +681,12 @@ func (fake *FakeClient) RunPutStepReturnsOnCall(i int, result1 worker.PutResult,
 	}{result1, result2}
 }
 
-func (fake *FakeClient) RunTaskStep(arg1 context.Context, arg2 lager.Logger, arg3 db.ContainerOwner, arg4 worker.ContainerSpec, arg5 worker.WorkerSpec, arg6 worker.ContainerPlacementStrategy, arg7 db.ContainerMetadata, arg8 worker.ImageFetcherSpec, arg9 runtime.ProcessSpec, arg10 runtime.StartingEventDelegate, arg11 lock.LockFactory) (worker.TaskResult, error) {
+func (fake *FakeClient) RunTaskStep(arg1 context.Context, arg2 lager.Logger, arg3 db.ContainerOwner, arg4 worker.ContainerSpec, arg5 worker.WorkerSpec, arg6 worker.ContainerPlacementStrategy, arg7 db.ContainerMetadata, arg8 worker.ImageFetcherSpec, arg9 runtime.ProcessSpec, arg10 runtime.StartingEventDelegate, arg11 lock.LockFactory, arg12 []worker.ServiceSpec) (worker.TaskResult, error) {
+	var arg12Copy []worker.ServiceSpec
+	if arg12 != nil {
+		arg12Copy = make([]worker.ServiceSpec, len(arg12))
+		copy(arg12Copy, arg12)
+	}
 	fake.runTaskStepMutex.Lock()
 	ret, specificReturn := fake.runTaskStepReturnsOnCall[len(fake.runTaskStepArgsForCall)]
 	fake.runTaskStepArgsForCall = append(fake.runTaskStepArgsForCall, struct {
@@ -615,11 +701,12 @@ func (fake *FakeClient) RunTaskStep(arg1 context.Context, arg2 lager.Logger, arg
 		arg9  runtime.ProcessSpec
 		arg10 runtime.StartingEventDelegate
 		arg11 lock.LockFactory
-	}{arg1, arg2, arg3, arg4, arg5, arg6, arg7, arg8, arg9, arg10, arg11})
-	fake.recordInvocation("RunTaskStep", []interface{}{arg1, arg2, arg3, arg4, arg5, arg6, arg7, arg8, arg9, arg10, arg11})
+		arg12 []worker.ServiceSpec
+	}{arg1, arg2, arg3, arg4, arg5, arg6, arg7, arg8, arg9, arg10, arg11, arg12Copy})
+	fake.recordInvocation("RunTaskStep", []interface{}{arg1, arg2, arg3, arg4, arg5, arg6, arg7, arg8, arg9, arg10, arg11, arg12Copy})
 	fake.runTaskStepMutex.Unlock()
 	if fake.RunTaskStepStub != nil {
-		return fake.RunTaskStepStub(arg1, arg2, arg3, arg4, arg5, arg6, arg7, arg8, arg9, arg10, arg11)
+		return fake.RunTaskStepStub(arg1, arg2, arg3, arg4, arg5, arg6, arg7, arg8, arg9, arg10, arg11, arg12)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -634,17 +721,17 @@ func (fake *FakeClient) RunTaskStepCallCount() int {
 	return len(fake.runTaskStepArgsForCall)
 }
 
-func (fake *FakeClient) RunTaskStepCalls(stub func(context.Context, lager.Logger, db.ContainerOwner, worker.ContainerSpec, worker.WorkerSpec, worker.ContainerPlacementStrategy, db.ContainerMetadata, worker.ImageFetcherSpec, runtime.ProcessSpec, runtime.StartingEventDelegate, lock.LockFactory) (worker.TaskResult, error)) {
+func (fake *FakeClient) RunTaskStepCalls(stub func(context.Context, lager.Logger, db.ContainerOwner, worker.ContainerSpec, worker.WorkerSpec, worker.ContainerPlacementStrategy, db.ContainerMetadata, worker.ImageFetcherSpec, runtime.ProcessSpec, runtime.StartingEventDelegate, lock.LockFactory, []worker.ServiceSpec) (worker.TaskResult, error)) {
 	fake.runTaskStepMutex.Lock()
 	defer fake.runTaskStepMutex.Unlock()
 	fake.RunTaskStepStub = stub
 }
 
-func (fake *FakeClient) RunTaskStepArgsForCall(i int) (context.Context, lager.Logger, db.ContainerOwner, worker.ContainerSpec, worker.WorkerSpec, worker.ContainerPlacementStrategy, db.ContainerMetadata, worker.ImageFetcherSpec, runtime.ProcessSpec, runtime.StartingEventDelegate, lock.LockFactory) {
+func (fake *FakeClient) RunTaskStepArgsForCall(i int) (context.Context, lager.Logger, db.ContainerOwner, worker.ContainerSpec, worker.WorkerSpec, worker.ContainerPlacementStrategy, db.ContainerMetadata, worker.ImageFetcherSpec, runtime.ProcessSpec, runtime.StartingEventDelegate, lock.LockFactory, []worker.ServiceSpec) {
 	fake.runTaskStepMutex.RLock()
 	defer fake.runTaskStepMutex.RUnlock()
 	argsForCall := fake.runTaskStepArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5, argsForCall.arg6, argsForCall.arg7, argsForCall.arg8, argsForCall.arg9, argsForCall.arg10, argsForCall.arg11
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5, argsForCall.arg6, argsForCall.arg7, argsForCall.arg8, argsForCall.arg9, argsForCall.arg10, argsForCall.arg11, argsForCall.arg12
 }
 
 func (fake *FakeClient) RunTaskStepReturns(result1 worker.TaskResult, result2 error) {
@@ -748,6 +835,8 @@ func (fake *FakeClient) Invocations() map[string][][]interface{} {
 	defer fake.findContainerMutex.RUnlock()
 	fake.findVolumeMutex.RLock()
 	defer fake.findVolumeMutex.RUnlock()
+	fake.hashArtifactMutex.RLock()
+	defer fake.hashArtifactMutex.RUnlock()
 	fake.runCheckStepMutex.RLock()
 	defer fake.runCheckStepMutex.RUnlock()
 	fake.runGetStepMutex.RLock()
@@ -118,6 +118,29 @@ type FakeContainer struct {
 		result1 garden.ContainerInfo
 		result2 error
 	}
+	KeepStub        func(time.Duration) (time.Time, error)
+	keepMutex       sync.RWMutex
+	keepArgsForCall []struct {
+		arg1 time.Duration
+	}
+	keepReturns struct {
+		result1 time.Time
+		result2 error
+	}
+	keepReturnsOnCall map[int]struct {
+		result1 time.Time
+		result2 error
+	}
+	MarkDestroyingStub        func() error
+	markDestroyingMutex       sync.RWMutex
+	markDestroyingArgsForCall []struct {
+	}
+	markDestroyingReturns struct {
+		result1 error
+	}
+	markDestroyingReturnsOnCall map[int]struct {
+		result1 error
+	}
 	MetricsStub        func() (garden.Metrics, error)
 	metricsMutex       sync.RWMutex
 	metricsArgsForCall []struct {
@@ -826,6 +849,121 @@ func (fake *FakeContainer) InfoReturnsOnCall(i int, result1 garden.ContainerInfo
 	}{result1, result2}
 }
 
+func (fake *FakeContainer) Keep(arg1 time.Duration) (time.Time, error) {
+	fake.keepMutex.Lock()
+	ret, specificReturn := fake.keepReturnsOnCall[len(fake.keepArgsForCall)]
+	fake.keepArgsForCall = append(fake.keepArgsForCall, struct {
+		arg1 time.Duration
+	}{arg1})
+	fake.recordInvocation("Keep", []interface{}{arg1})
+	fake.keepMutex.Unlock()
+	if fake.KeepStub != nil {
+		return fake.KeepStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.keepReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeContainer) KeepCallCount() int {
+	fake.keepMutex.RLock()
+	defer fake.keepMutex.RUnlock()
+	return len(fake.keepArgsForCall)
+}
+
+func (fake *FakeContainer) KeepCalls(stub func(time.Duration) (time.Time, error)) {
+	fake.keepMutex.Lock()
+	defer fake.keepMutex.Unlock()
+	fake.KeepStub = stub
+}
+
+func (fake *FakeContainer) KeepArgsForCall(i int) time.Duration {
+	fake.keepMutex.RLock()
+	defer fake.keepMutex.RUnlock()
+	argsForCall := fake.keepArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeContainer) KeepReturns(result1 time.Time, result2 error) {
+	fake.keepMutex.Lock()
+	defer fake.keepMutex.Unlock()
+	fake.KeepStub = nil
+	fake.keepReturns = struct {
+		result1 time.Time
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeContainer) KeepReturnsOnCall(i int, result1 time.Time, result2 error) {
+	fake.keepMutex.Lock()
+	defer fake.keepMutex.Unlock()
+	fake.KeepStub = nil
+	if fake.keepReturnsOnCall == nil {
+		fake.keepReturnsOnCall = make(map[int]struct {
+			result1 time.Time
+			result2 error
+		})
+	}
+	fake.keepReturnsOnCall[i] = struct {
+		result1 time.Time
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeContainer) MarkDestroying() error {
+	fake.markDestroyingMutex.Lock()
+	ret, specificReturn := fake.markDestroyingReturnsOnCall[len(fake.markDestroyingArgsForCall)]
+	fake.markDestroyingArgsForCall = append(fake.markDestroyingArgsForCall, struct {
+	}{})
+	fake.recordInvocation("MarkDestroying", []interface{}{})
+	fake.markDestroyingMutex.Unlock()
+	if fake.MarkDestroyingStub != nil {
+		return fake.MarkDestroyingStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.markDestroyingReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeContainer) MarkDestroyingCallCount() int {
+	fake.markDestroyingMutex.RLock()
+	defer fake.markDestroyingMutex.RUnlock()
+	return len(fake.markDestroyingArgsForCall)
+}
+
+func (fake *FakeContainer) MarkDestroyingCalls(stub func() error) {
+	fake.markDestroyingMutex.Lock()
+	defer fake.markDestroyingMutex.Unlock()
+	fake.MarkDestroyingStub = stub
+}
+
+func (fake *FakeContainer) MarkDestroyingReturns(result1 error) {
+	fake.markDestroyingMutex.Lock()
+	defer fake.markDestroyingMutex.Unlock()
+	fake.MarkDestroyingStub = nil
+	fake.markDestroyingReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeContainer) MarkDestroyingReturnsOnCall(i int, result1 error) {
+	fake.markDestroyingMutex.Lock()
+	defer fake.markDestroyingMutex.Unlock()
+	fake.MarkDestroyingStub = nil
+	if fake.markDestroyingReturnsOnCall == nil {
+		fake.markDestroyingReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.markDestroyingReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeContainer) Metrics() (garden.Metrics, error) {
 	fake.metricsMutex.Lock()
 	ret, specificReturn := fake.metricsReturnsOnCall[len(fake.metricsArgsForCall)]
@@ -1808,6 +1946,10 @@ func (fake *FakeContainer) Invocations() map[string][][]interface{} {
 	defer fake.handleMutex.RUnlock()
 	fake.infoMutex.RLock()
 	defer fake.infoMutex.RUnlock()
+	fake.keepMutex.RLock()
+	defer fake.keepMutex.RUnlock()
+	fake.markDestroyingMutex.RLock()
+	defer fake.markDestroyingMutex.RUnlock()
 	fake.metricsMutex.RLock()
 	defer fake.metricsMutex.RUnlock()
 	fake.netInMutex.RLock()
@@ -27,6 +27,20 @@ type FakeStreamableArtifactSource struct {
 		result2 bool
 		result3 error
 	}
+	HashStub        func(context.Context, lager.Logger) (string, error)
+	hashMutex       sync.RWMutex
+	hashArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+	}
+	hashReturns struct {
+		result1 string
+		result2 error
+	}
+	hashReturnsOnCall map[int]struct {
+		result1 string
+		result2 error
+	}
 	StreamFileStub        func(context.Context, lager.Logger, string) (io.ReadCloser, error)
 	streamFileMutex       sync.RWMutex
 	streamFileArgsForCall []struct {
@@ -126,6 +140,70 @@ func (fake *FakeStreamableArtifactSource) ExistsOnReturnsOnCall(i int, result1 w
 	}{result1, result2, result3}
 }
 
+func (fake *FakeStreamableArtifactSource) Hash(arg1 context.Context, arg2 lager.Logger) (string, error) {
+	fake.hashMutex.Lock()
+	ret, specificReturn := fake.hashReturnsOnCall[len(fake.hashArgsForCall)]
+	fake.hashArgsForCall = append(fake.hashArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+	}{arg1, arg2})
+	fake.recordInvocation("Hash", []interface{}{arg1, arg2})
+	fake.hashMutex.Unlock()
+	if fake.HashStub != nil {
+		return fake.HashStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.hashReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStreamableArtifactSource) HashCallCount() int {
+	fake.hashMutex.RLock()
+	defer fake.hashMutex.RUnlock()
+	return len(fake.hashArgsForCall)
+}
+
+func (fake *FakeStreamableArtifactSource) HashCalls(stub func(context.Context, lager.Logger) (string, error)) {
+	fake.hashMutex.Lock()
+	defer fake.hashMutex.Unlock()
+	fake.HashStub = stub
+}
+
+func (fake *FakeStreamableArtifactSource) HashArgsForCall(i int) (context.Context, lager.Logger) {
+	fake.hashMutex.RLock()
+	defer fake.hashMutex.RUnlock()
+	argsForCall := fake.hashArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeStreamableArtifactSource) HashReturns(result1 string, result2 error) {
+	fake.hashMutex.Lock()
+	defer fake.hashMutex.Unlock()
+	fake.HashStub = nil
+	fake.hashReturns = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStreamableArtifactSource) HashReturnsOnCall(i int, result1 string, result2 error) {
+	fake.hashMutex.Lock()
+	defer fake.hashMutex.Unlock()
+	fake.HashStub = nil
+	if fake.hashReturnsOnCall == nil {
+		fake.hashReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 error
+		})
+	}
+	fake.hashReturnsOnCall[i] = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeStreamableArtifactSource) StreamFile(arg1 context.Context, arg2 lager.Logger, arg3 string) (io.ReadCloser, error) {
 	fake.streamFileMutex.Lock()
 	ret, specificReturn := fake.streamFileReturnsOnCall[len(fake.streamFileArgsForCall)]
@@ -258,6 +336,8 @@ func (fake *FakeStreamableArtifactSource) Invocations() map[string][][]interface
 	defer fake.invocationsMutex.RUnlock()
 	fake.existsOnMutex.RLock()
 	defer fake.existsOnMutex.RUnlock()
+	fake.hashMutex.RLock()
+	defer fake.hashMutex.RUnlock()
 	fake.streamFileMutex.RLock()
 	defer fake.streamFileMutex.RUnlock()
 	fake.streamToMutex.RLock()
@@ -45,11 +45,15 @@ func (wrappa *APIAuthWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
 		case atc.GetBuildPreparation,
 			atc.BuildEvents,
 			atc.GetBuildPlan,
-			atc.ListBuildArtifacts:
+			atc.ListBuildArtifacts,
+			atc.GetBuildTests:
 			newHandler = wrappa.checkBuildReadAccessHandlerFactory.CheckIfPrivateJobHandler(handler, rejector)
 
 			// resource belongs to authorized team
-		case atc.AbortBuild:
+		case atc.AbortBuild,
+			atc.ApproveBuild,
+			atc.SetBuildBreakpoint,
+			atc.ContinueBuild:
 			newHandler = wrappa.checkBuildWriteAccessHandlerFactory.HandlerFor(handler, rejector)
 
 		// requester is system, admin team, or worker owning team
@@ -86,6 +90,7 @@ func (wrappa *APIAuthWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
 			atc.GetContainer,
 			atc.HijackContainer,
 			atc.ListContainers,
+			atc.ListKeptContainers,
 			atc.ListWorkers,
 			atc.RegisterWorker,
 			atc.HeartbeatWorker,
@@ -125,9 +125,13 @@ var _ = Describe("APIAuthWrappa", func() {
 				atc.ListBuildArtifacts:  checksIfPrivateJob(inputHandlers[atc.ListBuildArtifacts]),
 				atc.GetBuildPreparation: checksIfPrivateJob(inputHandlers[atc.GetBuildPreparation]),
 				atc.GetBuildPlan:        checksIfPrivateJob(inputHandlers[atc.GetBuildPlan]),
+				atc.GetBuildTests:       checksIfPrivateJob(inputHandlers[atc.GetBuildTests]),
 
 				// resource belongs to authorized team
-				atc.AbortBuild: checkWritePermissionForBuild(inputHandlers[atc.AbortBuild]),
+				atc.AbortBuild:         checkWritePermissionForBuild(inputHandlers[atc.AbortBuild]),
+				atc.ApproveBuild:       checkWritePermissionForBuild(inputHandlers[atc.ApproveBuild]),
+				atc.SetBuildBreakpoint: checkWritePermissionForBuild(inputHandlers[atc.SetBuildBreakpoint]),
+				atc.ContinueBuild:      checkWritePermissionForBuild(inputHandlers[atc.ContinueBuild]),
 
 				// resource belongs to authorized team
 				atc.PruneWorker:              checkTeamAccessForWorker(inputHandlers[atc.PruneWorker]),
@@ -157,21 +161,22 @@ var _ = Describe("APIAuthWrappa", func() {
 				atc.GetResourceVersion:            openForPublicPipelineOrAuthorized(inputHandlers[atc.GetResourceVersion]),
 
 				// authenticated
-				atc.CreateBuild:     authenticated(inputHandlers[atc.CreateBuild]),
-				atc.GetContainer:    authenticated(inputHandlers[atc.GetContainer]),
-				atc.HijackContainer: authenticated(inputHandlers[atc.HijackContainer]),
-				atc.ListContainers:  authenticated(inputHandlers[atc.ListContainers]),
-				atc.ListVolumes:     authenticated(inputHandlers[atc.ListVolumes]),
-				atc.ListTeamBuilds:  authenticated(inputHandlers[atc.ListTeamBuilds]),
-				atc.ListWorkers:     authenticated(inputHandlers[atc.ListWorkers]),
-				atc.RegisterWorker:  authenticated(inputHandlers[atc.RegisterWorker]),
-				atc.HeartbeatWorker: authenticated(inputHandlers[atc.HeartbeatWorker]),
-				atc.DeleteWorker:    authenticated(inputHandlers[atc.DeleteWorker]),
-				atc.GetTeam:         authenticated(inputHandlers[atc.GetTeam]),
-				atc.SetTeam:         authenticated(inputHandlers[atc.SetTeam]),
-				atc.RenameTeam:      authenticated(inputHandlers[atc.RenameTeam]),
-				atc.DestroyTeam:     authenticated(inputHandlers[atc.DestroyTeam]),
-				atc.GetUser:         authenticated(inputHandlers[atc.GetUser]),
+				atc.CreateBuild:        authenticated(inputHandlers[atc.CreateBuild]),
+				atc.GetContainer:       authenticated(inputHandlers[atc.GetContainer]),
+				atc.HijackContainer:    authenticated(inputHandlers[atc.HijackContainer]),
+				atc.ListContainers:     authenticated(inputHandlers[atc.ListContainers]),
+				atc.ListKeptContainers: authenticated(inputHandlers[atc.ListKeptContainers]),
+				atc.ListVolumes:        authenticated(inputHandlers[atc.ListVolumes]),
+				atc.ListTeamBuilds:     authenticated(inputHandlers[atc.ListTeamBuilds]),
+				atc.ListWorkers:        authenticated(inputHandlers[atc.ListWorkers]),
+				atc.RegisterWorker:     authenticated(inputHandlers[atc.RegisterWorker]),
+				atc.HeartbeatWorker:    authenticated(inputHandlers[atc.HeartbeatWorker]),
+				atc.DeleteWorker:       authenticated(inputHandlers[atc.DeleteWorker]),
+				atc.GetTeam:            authenticated(inputHandlers[atc.GetTeam]),
+				atc.SetTeam:            authenticated(inputHandlers[atc.SetTeam]),
+				atc.RenameTeam:         authenticated(inputHandlers[atc.RenameTeam]),
+				atc.DestroyTeam:        authenticated(inputHandlers[atc.DestroyTeam]),
+				atc.GetUser:            authenticated(inputHandlers[atc.GetUser]),
 
 				//authenticateIfTokenProvided / delegating to handler
 				atc.GetInfo:              authenticateIfTokenProvided(inputHandlers[atc.GetInfo]),
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"os"
 
+	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
 	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
 	"github.com/concourse/concourse/fly/rc"
@@ -46,9 +47,9 @@ func (command *ArchivePipelineCommand) Execute(args []string) error {
 		return err
 	}
 
-	var pipelineNames []string
+	var pipelineRefs []atc.PipelineRef
 	if string(command.Pipeline) != "" {
-		pipelineNames = []string{string(command.Pipeline)}
+		pipelineRefs = []atc.PipelineRef{command.Pipeline.Ref()}
 	}
 
 	if command.All {
@@ -59,39 +60,39 @@ func (command *ArchivePipelineCommand) Execute(args []string) error {
 
 		for _, pipeline := range pipelines {
 			if !pipeline.Archived {
-				pipelineNames = append(pipelineNames, pipeline.Name)
+				pipelineRefs = append(pipelineRefs, pipeline.Ref())
 			}
 		}
 	}
 
-	if len(pipelineNames) == 0 {
+	if len(pipelineRefs) == 0 {
 		fmt.Println("there are no unarchived pipelines")
 		fmt.Println("bailing out")
 		return nil
 	}
 
-	if !command.confirmArchive(pipelineNames) {
+	if !command.confirmArchive(pipelineRefs) {
 		fmt.Println("bailing out")
 		return nil
 	}
 
-	for _, pipelineName := range pipelineNames {
-		found, err := target.Team().ArchivePipeline(pipelineName)
+	for _, pipelineRef := range pipelineRefs {
+		found, err := target.Team().ArchivePipeline(pipelineRef)
 		if err != nil {
 			return err
 		}
 
 		if found {
-			fmt.Printf("archived '%s'\n", pipelineName)
+			fmt.Printf("archived '%s'\n", pipelineRef)
 		} else {
-			displayhelpers.Failf("pipeline '%s' not found\n", pipelineName)
+			displayhelpers.Failf("pipeline '%s' not found\n", pipelineRef)
 		}
 	}
 
 	return nil
 }
 
-func (command ArchivePipelineCommand) confirmArchive(pipelines []string) bool {
+func (command ArchivePipelineCommand) confirmArchive(pipelines []atc.PipelineRef) bool {
 	if command.SkipInteractive {
 		return true
 	}
@@ -111,16 +112,16 @@ func (command ArchivePipelineCommand) confirmArchive(pipelines []string) bool {
 	return confirm
 }
 
-func (ArchivePipelineCommand) printPipelinesTable(pipelines []string) {
+func (ArchivePipelineCommand) printPipelinesTable(pipelines []atc.PipelineRef) {
 	table := ui.Table{Headers: ui.TableRow{{Contents: "pipelines", Color: color.New(color.Bold)}}}
 	for _, pipeline := range pipelines {
-		table.Data = append(table.Data, ui.TableRow{{Contents: pipeline}})
+		table.Data = append(table.Data, ui.TableRow{{Contents: pipeline.String()}})
 	}
 	table.Render(os.Stdout, true)
 	fmt.Println()
 }
 
-func (ArchivePipelineCommand) archivePrompt(pipelines []string) string {
+func (ArchivePipelineCommand) archivePrompt(pipelines []atc.PipelineRef) string {
 	if len(pipelines) == 1 {
 		return fmt.Sprintf("archive pipeline '%s'?", pipelines[0])
 	}
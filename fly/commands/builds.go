@@ -29,6 +29,7 @@ type BuildsCommand struct {
 	Teams       []string                 `short:"n"  long:"team" description:"Show builds for these teams"`
 	Since       string                   `long:"since" description:"Start of the range to filter builds"`
 	Until       string                   `long:"until" description:"End of the range to filter builds"`
+	Tests       bool                     `long:"tests" description:"Show how many of each build's reported tests passed, failed and were skipped"`
 }
 
 func (command *BuildsCommand) Execute([]string) error {
@@ -67,7 +68,7 @@ func (command *BuildsCommand) Execute([]string) error {
 		return err
 	}
 
-	return command.displayBuilds(builds)
+	return command.displayBuilds(builds, client)
 }
 
 func (command *BuildsCommand) getBuilds(builds []atc.Build, currentTeam concourse.Team, page concourse.Page, client concourse.Client, teams []concourse.Team) ([]atc.Build, error) {
@@ -136,7 +137,7 @@ func (command *BuildsCommand) validateJobBuilds(builds []atc.Build, currentTeam
 	)
 
 	builds, _, found, err = currentTeam.JobBuilds(
-		command.Job.PipelineName,
+		command.Job.PipelineRef,
 		command.Job.JobName,
 		page,
 	)
@@ -157,7 +158,7 @@ func (command *BuildsCommand) validatePipelineBuilds(builds []atc.Build, current
 
 	var found bool
 	builds, _, found, err = currentTeam.PipelineBuilds(
-		string(command.Pipeline),
+		command.Pipeline.Ref(),
 		page,
 	)
 
@@ -172,7 +173,7 @@ func (command *BuildsCommand) validatePipelineBuilds(builds []atc.Build, current
 	return builds, err
 }
 
-func (command *BuildsCommand) displayBuilds(builds []atc.Build) error {
+func (command *BuildsCommand) displayBuilds(builds []atc.Build, client concourse.Client) error {
 	var err error
 	if command.Json {
 		err = displayhelpers.JsonPrint(builds)
@@ -195,6 +196,10 @@ func (command *BuildsCommand) displayBuilds(builds []atc.Build) error {
 		},
 	}
 
+	if command.Tests {
+		table.Headers = append(table.Headers, ui.TableCell{Contents: "tests", Color: color.New(color.Bold)})
+	}
+
 	buildCap := command.buildCap(builds)
 	for _, b := range builds[:buildCap] {
 		startTimeCell, endTimeCell, durationCell := populateTimeCells(time.Unix(b.StartTime, 0), time.Unix(b.EndTime, 0))
@@ -210,8 +215,11 @@ func (command *BuildsCommand) displayBuilds(builds []atc.Build) error {
 
 		var statusCell ui.TableCell
 		statusCell.Contents = b.Status
+		if b.Paused {
+			statusCell.Contents = "paused"
+		}
 
-		switch b.Status {
+		switch statusCell.Contents {
 		case "pending":
 			statusCell.Color = ui.PendingColor
 		case "started":
@@ -228,7 +236,7 @@ func (command *BuildsCommand) displayBuilds(builds []atc.Build) error {
 			statusCell.Color = ui.PausedColor
 		}
 
-		table.Data = append(table.Data, []ui.TableCell{
+		row := ui.TableRow{
 			{Contents: strconv.Itoa(b.ID)},
 			pipelineJobCell,
 			buildCell,
@@ -237,12 +245,46 @@ func (command *BuildsCommand) displayBuilds(builds []atc.Build) error {
 			endTimeCell,
 			durationCell,
 			{Contents: b.TeamName},
-		})
+		}
+
+		if command.Tests {
+			testsCell, err := buildTestsCell(client, b.ID)
+			if err != nil {
+				return err
+			}
+
+			row = append(row, testsCell)
+		}
+
+		table.Data = append(table.Data, row)
 	}
 
 	return table.Render(os.Stdout, Fly.PrintTableHeaders)
 }
 
+func buildTestsCell(client concourse.Client, buildID int) (ui.TableCell, error) {
+	report, found, err := client.BuildTests(buildID)
+	if err != nil {
+		return ui.TableCell{}, err
+	}
+
+	if !found || len(report.Tests) == 0 {
+		return ui.TableCell{Contents: "n/a", Color: color.New(color.Faint)}, nil
+	}
+
+	cell := ui.TableCell{
+		Contents: fmt.Sprintf("%d passed, %d failed, %d skipped", report.Passed, report.Failed, report.Skipped),
+	}
+
+	if report.Failed > 0 {
+		cell.Color = ui.FailedColor
+	} else {
+		cell.Color = ui.SucceededColor
+	}
+
+	return cell, nil
+}
+
 func (command *BuildsCommand) validateBuildArguments(timeSince time.Time, page concourse.Page, timeUntil time.Time) (concourse.Page, error) {
 	var err error
 	if command.Since != "" {
@@ -309,7 +351,7 @@ func roundSecondsOffDuration(d time.Duration) time.Duration {
 }
 
 func (command *BuildsCommand) jobFlag() bool {
-	return command.Job.PipelineName != "" && command.Job.JobName != ""
+	return command.Job.PipelineRef.Name != "" && command.Job.JobName != ""
 }
 
 func (command *BuildsCommand) pipelineFlag() bool {
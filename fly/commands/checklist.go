@@ -33,14 +33,14 @@ func (command *ChecklistCommand) Execute([]string) error {
 		return err
 	}
 
-	pipelineName := string(command.Pipeline)
+	pipelineRef := command.Pipeline.Ref()
 
-	config, _, _, err := target.Team().PipelineConfig(pipelineName)
+	config, _, _, err := target.Team().PipelineConfig(pipelineRef)
 	if err != nil {
 		return err
 	}
 
-	printCheckfile(target.Team().Name(), pipelineName, config, target.Client().URL())
+	printCheckfile(target.Team().Name(), pipelineRef.Name, config, target.Client().URL())
 
 	return nil
 }
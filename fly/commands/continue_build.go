@@ -0,0 +1,55 @@
+package commands
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
+	"github.com/concourse/concourse/fly/rc"
+)
+
+type ContinueBuildCommand struct {
+	Job   flaghelpers.JobFlag `short:"j" long:"job" value-name:"PIPELINE/JOB"   description:"Name of a job to continue"`
+	Build string              `short:"b" long:"build" required:"true" description:"If job is specified: build number to continue. If job not specified: build id"`
+}
+
+func (command *ContinueBuildCommand) Execute([]string) error {
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	var build atc.Build
+	var exists bool
+	if command.Job.PipelineRef.Name == "" && command.Job.JobName == "" {
+		build, exists, err = target.Client().Build(command.Build)
+	} else {
+		build, exists, err = target.Team().JobBuild(command.Job.PipelineRef, command.Job.JobName, command.Build)
+	}
+	if err != nil {
+		return err
+	}
+
+	if !exists {
+		return fmt.Errorf("build does not exist")
+	}
+
+	continued, err := target.Client().ContinueBuild(strconv.Itoa(build.ID))
+	if err != nil {
+		return err
+	}
+
+	if !continued {
+		return fmt.Errorf("build is not paused at a breakpoint")
+	}
+
+	fmt.Println("build successfully continued")
+
+	return nil
+}
@@ -34,8 +34,8 @@ func (command *DestroyPipelineCommand) Execute(args []string) error {
 		return err
 	}
 
-	pipelineName := string(command.Pipeline)
-	fmt.Printf("!!! this will remove all data for pipeline `%s`\n\n", pipelineName)
+	pipelineRef := command.Pipeline.Ref()
+	fmt.Printf("!!! this will remove all data for pipeline `%s`\n\n", pipelineRef)
 
 	confirm := command.SkipInteractive
 	if !confirm {
@@ -46,15 +46,15 @@ func (command *DestroyPipelineCommand) Execute(args []string) error {
 		}
 	}
 
-	found, err := target.Team().DeletePipeline(pipelineName)
+	found, err := target.Team().DeletePipeline(pipelineRef)
 	if err != nil {
 		return err
 	}
 
 	if !found {
-		fmt.Printf("`%s` does not exist\n", pipelineName)
+		fmt.Printf("`%s` does not exist\n", pipelineRef)
 	} else {
-		fmt.Printf("`%s` deleted\n", pipelineName)
+		fmt.Printf("`%s` deleted\n", pipelineRef)
 	}
 
 	return nil
@@ -0,0 +1,95 @@
+package commands
+
+import (
+	"fmt"
+	"path/filepath"
+	"strconv"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/commands/internal/executehelpers"
+	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/fly/ui/progress"
+	"github.com/vbauerster/mpb/v4"
+)
+
+type DownloadArtifactCommand struct {
+	Job       flaghelpers.JobFlag `short:"j" long:"job" value-name:"PIPELINE/JOB" description:"Name of a job to download artifacts from"`
+	Build     string              `short:"b" long:"build" required:"true" description:"If job is specified: build number to download artifacts from. If job not specified: build id"`
+	Artifacts []string            `short:"a" long:"artifact" value-name:"NAME" description:"Only download the named artifact. Can be specified multiple times"`
+	OutputDir string              `short:"o" long:"output-dir" default:"." description:"Directory in which to place each artifact's contents, under a directory named after the artifact"`
+}
+
+func (command *DownloadArtifactCommand) Execute([]string) error {
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	var build atc.Build
+	var exists bool
+	if command.Job.PipelineRef.Name == "" && command.Job.JobName == "" {
+		build, exists, err = target.Client().Build(command.Build)
+	} else {
+		build, exists, err = target.Team().JobBuild(command.Job.PipelineRef, command.Job.JobName, command.Build)
+	}
+	if err != nil {
+		return err
+	}
+
+	if !exists {
+		return fmt.Errorf("build does not exist")
+	}
+
+	artifactList, err := target.Client().ListBuildArtifacts(strconv.Itoa(build.ID))
+	if err != nil {
+		return err
+	}
+
+	artifacts := map[string]atc.WorkerArtifact{}
+	for _, artifact := range artifactList {
+		artifacts[artifact.Name] = artifact
+	}
+
+	names := command.Artifacts
+	if len(names) == 0 {
+		for _, artifact := range artifactList {
+			names = append(names, artifact.Name)
+		}
+	}
+
+	if len(names) == 0 {
+		return fmt.Errorf("build has no artifacts")
+	}
+
+	for _, name := range names {
+		if _, found := artifacts[name]; !found {
+			return fmt.Errorf("build has no artifact named '%s'", name)
+		}
+	}
+
+	team := target.Client().Team(build.TeamName)
+
+	prog := progress.New()
+
+	for _, name := range names {
+		artifact := artifacts[name]
+		path := filepath.Join(command.OutputDir, name)
+
+		prog.Go("downloading "+name, func(bar *mpb.Bar) error {
+			return executehelpers.Download(bar, team, artifact.ID, path)
+		})
+	}
+
+	err = prog.Wait()
+	if err != nil {
+		return fmt.Errorf("downloading failed: %s", err)
+	}
+
+	return nil
+}
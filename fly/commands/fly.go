@@ -71,9 +71,13 @@ type FlyCommand struct {
 
 	ClearTaskCache ClearTaskCacheCommand `command:"clear-task-cache" alias:"ctc" description:"Clears cache from a task container"`
 
-	Builds     BuildsCommand     `command:"builds"      alias:"bs" description:"List builds data"`
-	AbortBuild AbortBuildCommand `command:"abort-build" alias:"ab" description:"Abort a build"`
-	RerunBuild RerunBuildCommand `command:"rerun-build" alias:"rb" description:"Rerun a build"`
+	Builds           BuildsCommand           `command:"builds"            alias:"bs"  description:"List builds data"`
+	AbortBuild       AbortBuildCommand       `command:"abort-build"       alias:"ab"  description:"Abort a build"`
+	ApproveBuild     ApproveBuildCommand     `command:"approve-build"     alias:"apb" description:"Approve or reject the step a build is waiting on"`
+	SetBreakpoint    SetBreakpointCommand    `command:"set-breakpoint"    alias:"sbp" description:"Pause a running build before or after a step"`
+	ContinueBuild    ContinueBuildCommand    `command:"continue-build"    alias:"cb"  description:"Continue a build paused at a breakpoint"`
+	RerunBuild       RerunBuildCommand       `command:"rerun-build"       alias:"rb"  description:"Rerun a build"`
+	DownloadArtifact DownloadArtifactCommand `command:"download-artifact" alias:"da"  description:"Download the artifacts kept by a build"`
 
 	TriggerJob TriggerJobCommand `command:"trigger-job" alias:"tj" description:"Start a job in a pipeline"`
 
@@ -24,7 +24,7 @@ var _ = Describe("Helper Functions", func() {
 		expectedBuildID := "123"
 		expectedBuildName := "5"
 		expectedJobName := "myjob"
-		expectedPipelineName := "mypipeline"
+		expectedPipelineRef := atc.PipelineRef{Name: "mypipeline", InstanceVars: atc.InstanceVars{"branch": "master"}}
 		expectedBuild := atc.Build{
 			ID:      123,
 			Name:    expectedBuildName,
@@ -46,7 +46,7 @@ var _ = Describe("Helper Functions", func() {
 					})
 
 					It("returns the build", func() {
-						build, err := GetBuild(client, nil, "", expectedBuildID, "")
+						build, err := GetBuild(client, nil, "", expectedBuildID, atc.PipelineRef{})
 						Expect(err).NotTo(HaveOccurred())
 						Expect(build).To(Equal(expectedBuild))
 						Expect(client.BuildCallCount()).To(Equal(1))
@@ -60,7 +60,7 @@ var _ = Describe("Helper Functions", func() {
 					})
 
 					It("returns an error", func() {
-						_, err := GetBuild(client, nil, "", expectedBuildID, "")
+						_, err := GetBuild(client, nil, "", expectedBuildID, atc.PipelineRef{})
 						Expect(err).To(HaveOccurred())
 						Expect(err).To(MatchError("build not found"))
 					})
@@ -73,7 +73,7 @@ var _ = Describe("Helper Functions", func() {
 				})
 
 				It("return an error", func() {
-					_, err := GetBuild(client, nil, "", expectedBuildID, "")
+					_, err := GetBuild(client, nil, "", expectedBuildID, atc.PipelineRef{})
 					Expect(err).To(MatchError("failed to get build some-error"))
 				})
 			})
@@ -92,12 +92,12 @@ var _ = Describe("Helper Functions", func() {
 						})
 
 						It("returns the next build for that job", func() {
-							build, err := GetBuild(client, team, expectedJobName, "", expectedPipelineName)
+							build, err := GetBuild(client, team, expectedJobName, "", expectedPipelineRef)
 							Expect(err).NotTo(HaveOccurred())
 							Expect(build).To(Equal(expectedBuild))
 							Expect(team.JobCallCount()).To(Equal(1))
-							pipelineName, jobName := team.JobArgsForCall(0)
-							Expect(pipelineName).To(Equal(expectedPipelineName))
+							pipelineRef, jobName := team.JobArgsForCall(0)
+							Expect(pipelineRef).To(Equal(expectedPipelineRef))
 							Expect(jobName).To(Equal(expectedJobName))
 						})
 					})
@@ -112,12 +112,12 @@ var _ = Describe("Helper Functions", func() {
 						})
 
 						It("returns the finished build for that job", func() {
-							build, err := GetBuild(client, team, expectedJobName, "", expectedPipelineName)
+							build, err := GetBuild(client, team, expectedJobName, "", expectedPipelineRef)
 							Expect(err).NotTo(HaveOccurred())
 							Expect(build).To(Equal(expectedBuild))
 							Expect(team.JobCallCount()).To(Equal(1))
-							pipelineName, jobName := team.JobArgsForCall(0)
-							Expect(pipelineName).To(Equal(expectedPipelineName))
+							pipelineRef, jobName := team.JobArgsForCall(0)
+							Expect(pipelineRef).To(Equal(expectedPipelineRef))
 							Expect(jobName).To(Equal(expectedJobName))
 						})
 					})
@@ -131,7 +131,7 @@ var _ = Describe("Helper Functions", func() {
 						})
 
 						It("returns an error", func() {
-							_, err := GetBuild(client, team, expectedJobName, "", expectedPipelineName)
+							_, err := GetBuild(client, team, expectedJobName, "", expectedPipelineRef)
 							Expect(err).To(HaveOccurred())
 						})
 					})
@@ -143,7 +143,7 @@ var _ = Describe("Helper Functions", func() {
 					})
 
 					It("returns an error", func() {
-						_, err := GetBuild(client, team, expectedJobName, "", expectedPipelineName)
+						_, err := GetBuild(client, team, expectedJobName, "", expectedPipelineRef)
 						Expect(err).To(MatchError("job not found"))
 					})
 				})
@@ -155,7 +155,7 @@ var _ = Describe("Helper Functions", func() {
 				})
 
 				It("should return an error", func() {
-					_, err := GetBuild(client, team, expectedJobName, "", "")
+					_, err := GetBuild(client, team, expectedJobName, "", atc.PipelineRef{})
 					Expect(err).To(HaveOccurred())
 					Expect(err).To(MatchError("failed to get job some-error"))
 				})
@@ -170,12 +170,12 @@ var _ = Describe("Helper Functions", func() {
 				})
 
 				It("returns the build", func() {
-					build, err := GetBuild(client, team, expectedJobName, expectedBuildName, expectedPipelineName)
+					build, err := GetBuild(client, team, expectedJobName, expectedBuildName, expectedPipelineRef)
 					Expect(err).NotTo(HaveOccurred())
 					Expect(build).To(Equal(expectedBuild))
 					Expect(team.JobBuildCallCount()).To(Equal(1))
-					pipelineName, jobName, buildName := team.JobBuildArgsForCall(0)
-					Expect(pipelineName).To(Equal(expectedPipelineName))
+					pipelineRef, jobName, buildName := team.JobBuildArgsForCall(0)
+					Expect(pipelineRef).To(Equal(expectedPipelineRef))
 					Expect(buildName).To(Equal(expectedBuildName))
 					Expect(jobName).To(Equal(expectedJobName))
 				})
@@ -187,7 +187,7 @@ var _ = Describe("Helper Functions", func() {
 				})
 
 				It("returns an error", func() {
-					_, err := GetBuild(client, team, expectedJobName, expectedBuildName, expectedPipelineName)
+					_, err := GetBuild(client, team, expectedJobName, expectedBuildName, expectedPipelineRef)
 					Expect(err).To(MatchError("build not found"))
 				})
 			})
@@ -242,7 +242,7 @@ var _ = Describe("Helper Functions", func() {
 					})
 
 					It("returns latest one off build", func() {
-						build, err := GetBuild(client, nil, "", "", "")
+						build, err := GetBuild(client, nil, "", "", atc.PipelineRef{})
 						Expect(err).NotTo(HaveOccurred())
 						Expect(build).To(Equal(expectedOneOffBuild))
 						Expect(client.BuildsCallCount()).To(Equal(2))
@@ -255,7 +255,7 @@ var _ = Describe("Helper Functions", func() {
 					})
 
 					It("returns an error", func() {
-						_, err := GetBuild(client, nil, "", "", "")
+						_, err := GetBuild(client, nil, "", "", atc.PipelineRef{})
 						Expect(err).To(HaveOccurred())
 						Expect(err).To(MatchError("no builds match job"))
 					})
@@ -268,7 +268,7 @@ var _ = Describe("Helper Functions", func() {
 				})
 
 				It("should return an error", func() {
-					_, err := GetBuild(client, nil, "", "", "")
+					_, err := GetBuild(client, nil, "", "", atc.PipelineRef{})
 					Expect(err).To(HaveOccurred())
 					Expect(err).To(MatchError("failed to get builds some-error"))
 				})
@@ -280,7 +280,7 @@ var _ = Describe("Helper Functions", func() {
 		var resourceVersions []atc.ResourceVersion
 
 		resource := flaghelpers.ResourceFlag{
-			PipelineName: "mypipeline",
+			PipelineRef:  atc.PipelineRef{Name: "mypipeline"},
 			ResourceName: "myresource",
 		}
 
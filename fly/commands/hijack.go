@@ -259,8 +259,13 @@ func (command *HijackCommand) getContainerFingerprintFromUrl(target rc.Target, u
 		return nil, err
 	}
 
+	instanceVars, err := atc.InstanceVarsFromQueryParams(u.Query())
+	if err != nil {
+		return nil, err
+	}
+
 	fingerprint := &containerFingerprint{
-		pipelineName:  urlMap["pipelines"],
+		pipelineRef:   atc.PipelineRef{Name: urlMap["pipelines"], InstanceVars: instanceVars},
 		jobName:       urlMap["jobs"],
 		buildNameOrID: urlMap["builds"],
 		checkName:     urlMap["resources"],
@@ -280,16 +285,19 @@ func (command *HijackCommand) getContainerFingerprint(target rc.Target, team con
 		}
 	}
 
-	pipelineName := command.Check.PipelineName
-	if command.Job.PipelineName != "" {
-		pipelineName = command.Job.PipelineName
+	pipelineRef := command.Check.PipelineRef
+	if command.Job.PipelineRef.Name != "" {
+		pipelineRef = command.Job.PipelineRef
+	}
+
+	if pipelineRef.Name != "" {
+		fingerprint.pipelineRef = pipelineRef
 	}
 
 	for _, field := range []struct {
 		fp  *string
 		cmd string
 	}{
-		{fp: &fingerprint.pipelineName, cmd: pipelineName},
 		{fp: &fingerprint.buildNameOrID, cmd: command.Build},
 		{fp: &fingerprint.stepName, cmd: command.StepName},
 		{fp: &fingerprint.stepType, cmd: command.StepType},
@@ -361,7 +369,7 @@ func (locator stepContainerLocator) locate(fingerprint *containerFingerprint) (m
 	}
 
 	if fingerprint.jobName != "" {
-		reqValues["pipeline_name"] = fingerprint.pipelineName
+		addPipelineRef(reqValues, fingerprint.pipelineRef)
 		reqValues["job_name"] = fingerprint.jobName
 		if fingerprint.buildNameOrID != "" {
 			reqValues["build_name"] = fingerprint.buildNameOrID
@@ -369,7 +377,7 @@ func (locator stepContainerLocator) locate(fingerprint *containerFingerprint) (m
 	} else if fingerprint.buildNameOrID != "" {
 		reqValues["build_id"] = fingerprint.buildNameOrID
 	} else {
-		build, err := GetBuild(locator.client, nil, "", "", "")
+		build, err := GetBuild(locator.client, nil, "", "", atc.PipelineRef{})
 		if err != nil {
 			return reqValues, err
 		}
@@ -388,15 +396,22 @@ func (locator checkContainerLocator) locate(fingerprint *containerFingerprint) (
 	if fingerprint.checkName != "" {
 		reqValues["resource_name"] = fingerprint.checkName
 	}
-	if fingerprint.pipelineName != "" {
-		reqValues["pipeline_name"] = fingerprint.pipelineName
+	if fingerprint.pipelineRef.Name != "" {
+		addPipelineRef(reqValues, fingerprint.pipelineRef)
 	}
 
 	return reqValues, nil
 }
 
+func addPipelineRef(reqValues map[string]string, pipelineRef atc.PipelineRef) {
+	reqValues["pipeline_name"] = pipelineRef.Name
+	for key, values := range pipelineRef.QueryParams() {
+		reqValues[key] = values[0]
+	}
+}
+
 type containerFingerprint struct {
-	pipelineName  string
+	pipelineRef   atc.PipelineRef
 	jobName       string
 	buildNameOrID string
 
@@ -44,7 +44,7 @@ func DetermineInputs(
 		return nil, nil, nil, err
 	}
 
-	if len(localInputMappings) == 0 && inputsFrom.PipelineName == "" && inputsFrom.JobName == "" {
+	if len(localInputMappings) == 0 && inputsFrom.PipelineRef.Name == "" && inputsFrom.JobName == "" {
 		wd, err := os.Getwd()
 		if err != nil {
 			return nil, nil, nil, err
@@ -187,26 +187,26 @@ func GenerateLocalInputs(
 func FetchInputsFromJob(fact atc.PlanFactory, team concourse.Team, inputsFrom flaghelpers.JobFlag, imageName string) (map[string]Input, *atc.ImageResource, error) {
 	kvMap := map[string]Input{}
 
-	if inputsFrom.PipelineName == "" && inputsFrom.JobName == "" {
+	if inputsFrom.PipelineRef.Name == "" && inputsFrom.JobName == "" {
 		return kvMap, nil, nil
 	}
 
-	buildInputs, found, err := team.BuildInputsForJob(inputsFrom.PipelineName, inputsFrom.JobName)
+	buildInputs, found, err := team.BuildInputsForJob(inputsFrom.PipelineRef, inputsFrom.JobName)
 	if err != nil {
 		return nil, nil, err
 	}
 
 	if !found {
-		return nil, nil, fmt.Errorf("build inputs for %s/%s not found", inputsFrom.PipelineName, inputsFrom.JobName)
+		return nil, nil, fmt.Errorf("build inputs for %s/%s not found", inputsFrom.PipelineRef, inputsFrom.JobName)
 	}
 
-	versionedResourceTypes, found, err := team.VersionedResourceTypes(inputsFrom.PipelineName)
+	versionedResourceTypes, found, err := team.VersionedResourceTypes(inputsFrom.PipelineRef)
 	if err != nil {
 		return nil, nil, err
 	}
 
 	if !found {
-		return nil, nil, fmt.Errorf("versioned resource types of %s not found", inputsFrom.PipelineName)
+		return nil, nil, fmt.Errorf("versioned resource types of %s not found", inputsFrom.PipelineRef)
 	}
 
 	var imageResource *atc.ImageResource
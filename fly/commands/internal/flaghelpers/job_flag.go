@@ -2,28 +2,36 @@ package flaghelpers
 
 import (
 	"errors"
-	"fmt"
 	"strings"
 
 	"github.com/jessevdk/go-flags"
 
+	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/fly/rc"
 )
 
+// JobFlag identifies a job by the pipeline it is in, optionally followed by
+// the pipeline's instance vars, and its name, e.g.
+// some-pipeline/branch:feature/some-job.
 type JobFlag struct {
-	PipelineName string
-	JobName      string
+	PipelineRef atc.PipelineRef
+	JobName     string
 }
 
 func (job *JobFlag) UnmarshalFlag(value string) error {
-	vs := strings.SplitN(value, "/", -1)
+	vs := splitUnquoted(value, '/')
 
-	if len(vs) != 2 {
-		return errors.New("argument format should be <pipeline>/<job>")
+	if len(vs) != 2 && (len(vs) != 3 || !strings.Contains(vs[1], ":")) {
+		return errors.New("argument format should be <pipeline>[/<instance vars>]/<job>")
 	}
 
-	job.PipelineName = vs[0]
-	job.JobName = vs[1]
+	ref, err := parsePipelineRef(vs[0], vs[1:len(vs)-1]...)
+	if err != nil {
+		return err
+	}
+
+	job.PipelineRef = ref
+	job.JobName = vs[len(vs)-1]
 
 	return nil
 }
@@ -43,29 +51,43 @@ func (flag *JobFlag) Complete(match string) []flags.Completion {
 
 	team := target.Team()
 	comps := []flags.Completion{}
-	vs := strings.SplitN(match, "/", 2)
+	vs := splitUnquoted(match, '/')
 
-	if len(vs) == 1 {
+	// still completing the pipeline, including its instance vars
+	if len(vs) == 1 || len(vs) == 2 && strings.Contains(vs[1], ":") {
 		pipelines, err := team.ListPipelines()
 		if err != nil {
 			return comps
 		}
 
 		for _, pipeline := range pipelines {
-			if strings.HasPrefix(pipeline.Name, vs[0]) {
-				comps = append(comps, flags.Completion{Item: pipeline.Name + "/"})
+			prefix := pipeline.Ref().String() + "/"
+			if strings.HasPrefix(prefix, match) {
+				comps = append(comps, flags.Completion{Item: prefix})
 			}
 		}
-	} else if len(vs) == 2 {
-		jobs, err := team.ListJobs(vs[0])
-		if err != nil {
-			return comps
-		}
 
-		for _, job := range jobs {
-			if strings.HasPrefix(job.Name, vs[1]) {
-				comps = append(comps, flags.Completion{Item: fmt.Sprintf("%s/%s", vs[0], job.Name)})
-			}
+		return comps
+	}
+
+	if len(vs) > 3 {
+		return comps
+	}
+
+	pipelineRef, err := parsePipelineRef(vs[0], vs[1:len(vs)-1]...)
+	if err != nil {
+		return comps
+	}
+
+	jobs, err := team.ListJobs(pipelineRef)
+	if err != nil {
+		return comps
+	}
+
+	prefix := strings.Join(vs[:len(vs)-1], "/") + "/"
+	for _, job := range jobs {
+		if strings.HasPrefix(job.Name, vs[len(vs)-1]) {
+			comps = append(comps, flags.Completion{Item: prefix + job.Name})
 		}
 	}
 
@@ -1,6 +1,7 @@
 package flaghelpers_test
 
 import (
+	"github.com/concourse/concourse/atc"
 	. "github.com/concourse/concourse/fly/commands/internal/flaghelpers"
 
 	. "github.com/onsi/ginkgo"
@@ -13,7 +14,46 @@ var _ = Describe("JobFlag", func() {
 			jobFlag := &JobFlag{}
 
 			err := jobFlag.UnmarshalFlag("pipeline")
-			Expect(err).To(MatchError("argument format should be <pipeline>/<job>"))
+			Expect(err).To(MatchError("argument format should be <pipeline>[/<instance vars>]/<job>"))
+		})
+	})
+
+	Context("when a pipeline and job are specified", func() {
+		It("refers to the job of the pipeline", func() {
+			jobFlag := &JobFlag{}
+
+			err := jobFlag.UnmarshalFlag("pipeline/job")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(jobFlag.PipelineRef).To(Equal(atc.PipelineRef{Name: "pipeline"}))
+			Expect(jobFlag.JobName).To(Equal("job"))
+		})
+	})
+
+	Context("when instance vars are specified", func() {
+		It("refers to the job of the instance of the pipeline", func() {
+			jobFlag := &JobFlag{}
+
+			err := jobFlag.UnmarshalFlag(`pipeline/branch:"feature/a",version:2/job`)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(jobFlag.PipelineRef).To(Equal(atc.PipelineRef{
+				Name:         "pipeline",
+				InstanceVars: atc.InstanceVars{"branch": "feature/a", "version": float64(2)},
+			}))
+			Expect(jobFlag.JobName).To(Equal("job"))
+		})
+
+		It("displays an error message when they are malformed", func() {
+			jobFlag := &JobFlag{}
+
+			err := jobFlag.UnmarshalFlag("pipeline/branch:feature,version/job")
+			Expect(err).To(MatchError("malformed instance var 'version': expected <key>:<value>"))
+		})
+
+		It("displays the format when they are missing their values", func() {
+			jobFlag := &JobFlag{}
+
+			err := jobFlag.UnmarshalFlag("pipeline/branch/job")
+			Expect(err).To(MatchError("argument format should be <pipeline>[/<instance vars>]/<job>"))
 		})
 	})
 })
@@ -2,20 +2,114 @@ package flaghelpers
 
 import (
 	"errors"
+	"fmt"
 	"strings"
 
 	"github.com/jessevdk/go-flags"
+	"sigs.k8s.io/yaml"
 
+	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/fly/rc"
 )
 
+// PipelineFlag identifies a pipeline by its name, optionally followed by the
+// instance vars of an instanced pipeline, e.g. some-pipeline/branch:feature.
 type PipelineFlag string
 
 func (flag *PipelineFlag) Validate() error {
-	if strings.Contains(string(*flag), "/") {
-		return errors.New("pipeline name cannot contain '/'")
+	_, err := flag.parse()
+	return err
+}
+
+// Ref returns the pipeline ref identified by the flag. The flag should be
+// validated first, as an invalid flag results in an empty ref.
+func (flag PipelineFlag) Ref() atc.PipelineRef {
+	ref, _ := flag.parse()
+	return ref
+}
+
+func (flag PipelineFlag) parse() (atc.PipelineRef, error) {
+	if flag == "" {
+		return atc.PipelineRef{}, nil
+	}
+
+	parts := splitUnquoted(string(flag), '/')
+	if len(parts) > 2 {
+		return atc.PipelineRef{}, errors.New("argument format should be <pipeline>[/<instance vars>]")
+	}
+
+	if len(parts) == 2 && !strings.Contains(parts[1], ":") {
+		return atc.PipelineRef{}, errors.New("pipeline name cannot contain '/'")
+	}
+
+	return parsePipelineRef(parts[0], parts[1:]...)
+}
+
+// parsePipelineRef builds the ref of the pipeline with the given name and,
+// if any, comma-separated key:value instance vars.
+func parsePipelineRef(name string, instanceVars ...string) (atc.PipelineRef, error) {
+	if name == "" {
+		return atc.PipelineRef{}, errors.New("pipeline name cannot be empty")
+	}
+
+	ref := atc.PipelineRef{Name: name}
+	if len(instanceVars) == 0 {
+		return ref, nil
 	}
-	return nil
+
+	ref.InstanceVars = atc.InstanceVars{}
+	for _, pair := range splitUnquoted(instanceVars[0], ',') {
+		kv := strings.SplitN(pair, ":", 2)
+		if len(kv) != 2 || kv[0] == "" {
+			return atc.PipelineRef{}, fmt.Errorf("malformed instance var '%s': expected <key>:<value>", pair)
+		}
+
+		var value interface{} = kv[1]
+		if kv[1] != "" {
+			err := yaml.Unmarshal([]byte(kv[1]), &value)
+			if err != nil {
+				value = kv[1]
+			}
+		}
+
+		ref.InstanceVars[kv[0]] = value
+	}
+
+	return ref, nil
+}
+
+// splitUnquoted splits s around each sep that isn't within quotes, brackets or
+// braces, so that instance var values can contain it when given as JSON, e.g.
+// branch:"feature/a,b".
+func splitUnquoted(s string, sep rune) []string {
+	var (
+		parts   []string
+		depth   int
+		quoted  bool
+		escaped bool
+		start   int
+	)
+
+	for i, c := range s {
+		switch {
+		case escaped:
+			escaped = false
+		case quoted && c == '\\':
+			escaped = true
+		case c == '"':
+			quoted = !quoted
+		case quoted:
+		case c == '[' || c == '{':
+			depth++
+		case c == ']' || c == '}':
+			depth--
+		case c == sep && depth == 0:
+			parts = append(parts, s[start:i])
+			start = i + 1
+		}
+	}
+
+	return append(parts, s[start:])
 }
 
 func (flag *PipelineFlag) Complete(match string) []flags.Completion {
@@ -38,8 +132,9 @@ func (flag *PipelineFlag) Complete(match string) []flags.Completion {
 
 	comps := []flags.Completion{}
 	for _, pipeline := range pipelines {
-		if strings.HasPrefix(pipeline.Name, match) {
-			comps = append(comps, flags.Completion{Item: pipeline.Name})
+		ref := pipeline.Ref().String()
+		if strings.HasPrefix(ref, match) {
+			comps = append(comps, flags.Completion{Item: ref})
 		}
 	}
 
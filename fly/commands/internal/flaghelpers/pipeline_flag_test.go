@@ -0,0 +1,86 @@
+package flaghelpers_test
+
+import (
+	"github.com/concourse/concourse/atc"
+	. "github.com/concourse/concourse/fly/commands/internal/flaghelpers"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("PipelineFlag", func() {
+	Context("when only a name is specified", func() {
+		It("refers to the pipeline by name", func() {
+			flag := PipelineFlag("some-pipeline")
+			Expect(flag.Validate()).To(Succeed())
+			Expect(flag.Ref()).To(Equal(atc.PipelineRef{Name: "some-pipeline"}))
+		})
+	})
+
+	Context("when instance vars are specified", func() {
+		It("refers to the instance of the pipeline", func() {
+			flag := PipelineFlag("some-pipeline/branch:feature,version:2")
+			Expect(flag.Validate()).To(Succeed())
+			Expect(flag.Ref()).To(Equal(atc.PipelineRef{
+				Name: "some-pipeline",
+				InstanceVars: atc.InstanceVars{
+					"branch":  "feature",
+					"version": float64(2),
+				},
+			}))
+		})
+	})
+
+	Context("when instance var values contain separators", func() {
+		It("splits only outside of quotes and brackets", func() {
+			flag := PipelineFlag(`some-pipeline/branch:"feature/a,b",list:[1,2]`)
+			Expect(flag.Validate()).To(Succeed())
+			Expect(flag.Ref()).To(Equal(atc.PipelineRef{
+				Name: "some-pipeline",
+				InstanceVars: atc.InstanceVars{
+					"branch": "feature/a,b",
+					"list":   []interface{}{float64(1), float64(2)},
+				},
+			}))
+		})
+
+		It("parses what the ref is written as", func() {
+			ref := atc.PipelineRef{
+				Name:         "some-pipeline",
+				InstanceVars: atc.InstanceVars{"branch": "feature/a,b", "version": "2"},
+			}
+
+			flag := PipelineFlag(ref.String())
+			Expect(flag.Validate()).To(Succeed())
+			Expect(flag.Ref()).To(Equal(ref))
+		})
+	})
+
+	Context("when the instance vars are malformed", func() {
+		It("displays an error message", func() {
+			flag := PipelineFlag("some-pipeline/branch:feature,version")
+			Expect(flag.Validate()).To(MatchError("malformed instance var 'version': expected <key>:<value>"))
+		})
+	})
+
+	Context("when the name contains a '/'", func() {
+		It("displays an error message", func() {
+			flag := PipelineFlag("some/pipeline")
+			Expect(flag.Validate()).To(MatchError("pipeline name cannot contain '/'"))
+		})
+	})
+
+	Context("when the flag is empty", func() {
+		It("is valid, so that commands can make it optional", func() {
+			flag := PipelineFlag("")
+			Expect(flag.Validate()).To(Succeed())
+		})
+	})
+
+	Context("when there are too many parts", func() {
+		It("displays an error message", func() {
+			flag := PipelineFlag("some-pipeline/branch:feature/other")
+			Expect(flag.Validate()).To(MatchError("argument format should be <pipeline>[/<instance vars>]"))
+		})
+	})
+})
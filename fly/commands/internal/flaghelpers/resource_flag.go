@@ -3,22 +3,32 @@ package flaghelpers
 import (
 	"errors"
 	"strings"
+
+	"github.com/concourse/concourse/atc"
 )
 
+// ResourceFlag identifies a resource by the pipeline it is in, optionally
+// followed by the pipeline's instance vars, and its name, e.g.
+// some-pipeline/branch:feature/some-resource.
 type ResourceFlag struct {
-	PipelineName string
+	PipelineRef  atc.PipelineRef
 	ResourceName string
 }
 
 func (resource *ResourceFlag) UnmarshalFlag(value string) error {
-	vs := strings.SplitN(value, "/", 2)
+	vs := splitUnquoted(value, '/')
+
+	if len(vs) != 2 && (len(vs) != 3 || !strings.Contains(vs[1], ":")) {
+		return errors.New("argument format should be <pipeline>[/<instance vars>]/<resource>")
+	}
 
-	if len(vs) != 2 {
-		return errors.New("argument format should be <pipeline>/<resource>")
+	ref, err := parsePipelineRef(vs[0], vs[1:len(vs)-1]...)
+	if err != nil {
+		return err
 	}
 
-	resource.PipelineName = vs[0]
-	resource.ResourceName = vs[1]
+	resource.PipelineRef = ref
+	resource.ResourceName = vs[len(vs)-1]
 
 	return nil
 }
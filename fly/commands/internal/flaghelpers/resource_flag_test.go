@@ -1,6 +1,7 @@
 package flaghelpers_test
 
 import (
+	"github.com/concourse/concourse/atc"
 	. "github.com/concourse/concourse/fly/commands/internal/flaghelpers"
 
 	. "github.com/onsi/ginkgo"
@@ -13,7 +14,21 @@ var _ = Describe("ResourceFlag", func() {
 			resourceFlag := &ResourceFlag{}
 
 			err := resourceFlag.UnmarshalFlag("pipeline")
-			Expect(err).To(MatchError("argument format should be <pipeline>/<resource>"))
+			Expect(err).To(MatchError("argument format should be <pipeline>[/<instance vars>]/<resource>"))
+		})
+	})
+
+	Context("when instance vars are specified", func() {
+		It("refers to the resource of the instance of the pipeline", func() {
+			resourceFlag := &ResourceFlag{}
+
+			err := resourceFlag.UnmarshalFlag("pipeline/branch:feature/resource")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resourceFlag.PipelineRef).To(Equal(atc.PipelineRef{
+				Name:         "pipeline",
+				InstanceVars: atc.InstanceVars{"branch": "feature"},
+			}))
+			Expect(resourceFlag.ResourceName).To(Equal("resource"))
 		})
 	})
 })
@@ -17,7 +17,7 @@ import (
 )
 
 type ATCConfig struct {
-	PipelineName     string
+	PipelineRef      atc.PipelineRef
 	Team             concourse.Team
 	TargetName       rc.TargetName
 	Target           string
@@ -45,7 +45,7 @@ func (atcConfig ATCConfig) Set(yamlTemplateWithParams templatehelpers.YamlTempla
 		return err
 	}
 
-	existingConfig, existingConfigVersion, _, err := atcConfig.Team.PipelineConfig(atcConfig.PipelineName)
+	existingConfig, existingConfigVersion, _, err := atcConfig.Team.PipelineConfig(atcConfig.PipelineRef)
 	if err != nil {
 		return err
 	}
@@ -69,7 +69,7 @@ func (atcConfig ATCConfig) Set(yamlTemplateWithParams templatehelpers.YamlTempla
 	}
 
 	created, updated, warnings, err := atcConfig.Team.CreateOrUpdatePipelineConfig(
-		atcConfig.PipelineName,
+		atcConfig.PipelineRef,
 		existingConfigVersion,
 		evaluatedTemplate,
 		atcConfig.CheckCredentials,
@@ -87,7 +87,7 @@ func (atcConfig ATCConfig) Set(yamlTemplateWithParams templatehelpers.YamlTempla
 }
 
 func (atcConfig ATCConfig) UnpausePipelineCommand() string {
-	return fmt.Sprintf("%s -t %s unpause-pipeline -p %s", os.Args[0], atcConfig.TargetName, atcConfig.PipelineName)
+	return fmt.Sprintf("%s -t %s unpause-pipeline -p %s", os.Args[0], atcConfig.TargetName, atcConfig.PipelineRef)
 }
 
 func (atcConfig ATCConfig) showPipelineUpdateResult(created bool, updated bool) {
@@ -100,11 +100,13 @@ func (atcConfig ATCConfig) showPipelineUpdateResult(created bool, updated bool)
 			fmt.Println("Could not parse targetURL")
 		}
 
-		pipelineURL, err := url.Parse("/teams/" + atcConfig.Team.Name() + "/pipelines/" + atcConfig.PipelineName)
+		pipelineURL, err := url.Parse("/teams/" + atcConfig.Team.Name() + "/pipelines/" + atcConfig.PipelineRef.Name)
 		if err != nil {
 			fmt.Println("Could not parse pipelineURL")
 		}
 
+		pipelineURL.RawQuery = atcConfig.PipelineRef.QueryParams().Encode()
+
 		fmt.Println("pipeline created!")
 		fmt.Printf("you can view your pipeline here: %s\n", targetURL.ResolveReference(pipelineURL))
 		fmt.Println("")
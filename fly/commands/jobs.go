@@ -5,6 +5,7 @@ import (
 
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
+	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
 	"github.com/concourse/concourse/fly/rc"
 	"github.com/concourse/concourse/fly/ui"
 	"github.com/concourse/concourse/go-concourse/concourse"
@@ -12,9 +13,9 @@ import (
 )
 
 type JobsCommand struct {
-	Pipeline string `short:"p" long:"pipeline" required:"true" description:"Get jobs in this pipeline"`
-	Json     bool   `long:"json" description:"Print command result as JSON"`
-	Team     string `long:"team" description:"Name of the team to which the pipeline belongs, if different from the target default"`
+	Pipeline flaghelpers.PipelineFlag `short:"p" long:"pipeline" required:"true" description:"Get jobs in this pipeline"`
+	Json     bool                     `long:"json" description:"Print command result as JSON"`
+	Team     string                   `long:"team" description:"Name of the team to which the pipeline belongs, if different from the target default"`
 }
 
 func (command *JobsCommand) Execute([]string) error {
@@ -22,7 +23,12 @@ func (command *JobsCommand) Execute([]string) error {
 		headers []string
 		team    concourse.Team
 	)
-	pipelineName := command.Pipeline
+	err := command.Pipeline.Validate()
+	if err != nil {
+		return err
+	}
+
+	pipelineRef := command.Pipeline.Ref()
 
 	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
 	if err != nil {
@@ -44,7 +50,7 @@ func (command *JobsCommand) Execute([]string) error {
 	}
 
 	var jobs []atc.Job
-	jobs, err = team.ListJobs(pipelineName)
+	jobs, err = team.ListJobs(pipelineRef)
 	if err != nil {
 		return err
 	}
@@ -14,7 +14,7 @@ type PauseJobCommand struct {
 }
 
 func (command *PauseJobCommand) Execute(args []string) error {
-	pipelineName, jobName := command.Job.PipelineName, command.Job.JobName
+	pipelineName, jobName := command.Job.PipelineRef, command.Job.JobName
 	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
 	if err != nil {
 		return err
@@ -3,6 +3,7 @@ package commands
 import (
 	"fmt"
 
+	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
 	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
 	"github.com/concourse/concourse/fly/rc"
@@ -41,9 +42,9 @@ func (command *PausePipelineCommand) Execute(args []string) error {
 		return err
 	}
 
-	var pipelineNames []string
+	var pipelineRefs []atc.PipelineRef
 	if string(command.Pipeline) != "" {
-		pipelineNames = []string{string(command.Pipeline)}
+		pipelineRefs = []atc.PipelineRef{command.Pipeline.Ref()}
 	}
 
 	if command.All {
@@ -53,20 +54,20 @@ func (command *PausePipelineCommand) Execute(args []string) error {
 		}
 
 		for _, pipeline := range pipelines {
-			pipelineNames = append(pipelineNames, pipeline.Name)
+			pipelineRefs = append(pipelineRefs, pipeline.Ref())
 		}
 	}
 
-	for _, pipelineName := range pipelineNames {
-		found, err := target.Team().PausePipeline(pipelineName)
+	for _, pipelineRef := range pipelineRefs {
+		found, err := target.Team().PausePipeline(pipelineRef)
 		if err != nil {
 			return err
 		}
 
 		if found {
-			fmt.Printf("paused '%s'\n", pipelineName)
+			fmt.Printf("paused '%s'\n", pipelineRef)
 		} else {
-			displayhelpers.Failf("pipeline '%s' not found\n", pipelineName)
+			displayhelpers.Failf("pipeline '%s' not found\n", pipelineRef)
 		}
 	}
 
@@ -1,6 +1,7 @@
 package commands
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
@@ -19,7 +20,16 @@ func (command *RenamePipelineCommand) Validate() error {
 		return err
 	}
 
-	return command.Name.Validate()
+	err = command.Name.Validate()
+	if err != nil {
+		return err
+	}
+
+	if len(command.Name.Ref().InstanceVars) != 0 {
+		return errors.New("new pipeline name cannot contain instance vars")
+	}
+
+	return nil
 }
 
 func (command *RenamePipelineCommand) Execute([]string) error {
@@ -38,16 +48,16 @@ func (command *RenamePipelineCommand) Execute([]string) error {
 		return err
 	}
 
-	oldName := string(command.Pipeline)
-	newName := string(command.Name)
+	pipelineRef := command.Pipeline.Ref()
+	newName := command.Name.Ref().Name
 
-	found, err := target.Team().RenamePipeline(oldName, newName)
+	found, err := target.Team().RenamePipeline(pipelineRef, newName)
 	if err != nil {
 		return err
 	}
 
 	if !found {
-		displayhelpers.Failf("pipeline '%s' not found\n", oldName)
+		displayhelpers.Failf("pipeline '%s' not found\n", pipelineRef)
 		return nil
 	}
 
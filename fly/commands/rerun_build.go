@@ -19,7 +19,7 @@ type RerunBuildCommand struct {
 }
 
 func (command *RerunBuildCommand) Execute(args []string) error {
-	pipelineName, jobName, buildName := command.Job.PipelineName, command.Job.JobName, command.Build
+	pipelineName, jobName, buildName := command.Job.PipelineRef, command.Job.JobName, command.Build
 
 	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
 	if err != nil {
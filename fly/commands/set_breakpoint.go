@@ -0,0 +1,62 @@
+package commands
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
+	"github.com/concourse/concourse/fly/rc"
+)
+
+type SetBreakpointCommand struct {
+	Job   flaghelpers.JobFlag `short:"j" long:"job" value-name:"PIPELINE/JOB"   description:"Name of a job to pause"`
+	Build string              `short:"b" long:"build" required:"true" description:"If job is specified: build number to pause. If job not specified: build id"`
+	Step  string              `short:"s" long:"step" required:"true" description:"Name of the step to pause at"`
+	After bool                `long:"after" description:"Pause after the step has run instead of before it"`
+}
+
+func (command *SetBreakpointCommand) Execute([]string) error {
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	var build atc.Build
+	var exists bool
+	if command.Job.PipelineRef.Name == "" && command.Job.JobName == "" {
+		build, exists, err = target.Client().Build(command.Build)
+	} else {
+		build, exists, err = target.Team().JobBuild(command.Job.PipelineRef, command.Job.JobName, command.Build)
+	}
+	if err != nil {
+		return err
+	}
+
+	if !exists {
+		return fmt.Errorf("build does not exist")
+	}
+
+	position := atc.BreakpointBefore
+	if command.After {
+		position = atc.BreakpointAfter
+	}
+
+	set, err := target.Client().SetBuildBreakpoint(strconv.Itoa(build.ID), command.Step, position)
+	if err != nil {
+		return err
+	}
+
+	if !set {
+		return fmt.Errorf("build has already completed")
+	}
+
+	fmt.Printf("build will pause %s step '%s'\n", position, command.Step)
+
+	return nil
+}
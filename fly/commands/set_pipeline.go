@@ -35,7 +35,7 @@ func (command *SetPipelineCommand) Execute(args []string) error {
 	}
 	configPath := command.Config
 	templateVariablesFiles := command.VarsFrom
-	pipelineName := string(command.Pipeline)
+	pipelineRef := command.Pipeline.Ref()
 
 	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
 	if err != nil {
@@ -51,13 +51,22 @@ func (command *SetPipelineCommand) Execute(args []string) error {
 
 	atcConfig := setpipelinehelpers.ATCConfig{
 		Team:             target.Team(),
-		PipelineName:     pipelineName,
+		PipelineRef:      pipelineRef,
 		TargetName:       Fly.Target,
 		Target:           target.Client().URL(),
 		SkipInteraction:  command.SkipInteractive,
 		CheckCredentials: command.CheckCredentials,
 	}
 
-	yamlTemplateWithParams := templatehelpers.NewYamlTemplateWithParams(configPath, templateVariablesFiles, command.Var, command.YAMLVar)
+	// instance vars are interpolated into the config like any other var
+	yamlVars := command.YAMLVar
+	for name, value := range pipelineRef.InstanceVars {
+		yamlVars = append(yamlVars, flaghelpers.YAMLVariablePairFlag{
+			Name:  name,
+			Value: value,
+		})
+	}
+
+	yamlTemplateWithParams := templatehelpers.NewYamlTemplateWithParams(configPath, templateVariablesFiles, command.Var, yamlVars)
 	return atcConfig.Set(yamlTemplateWithParams)
 }
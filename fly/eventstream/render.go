@@ -48,9 +48,37 @@ func Render(dst io.Writer, src eventstream.EventStream, options RenderOptions) i
 			dstImpl.SetTimestamp(e.Time)
 			fmt.Fprintf(dstImpl, "\x1b[1mrunning %s\x1b[0m\n", argv)
 
+		case event.MemoizedTask:
+			dstImpl.SetTimestamp(e.Time)
+			fmt.Fprintf(dstImpl, "\x1b[1musing memoized result\x1b[0m\n")
+
+		case event.Skipped:
+			dstImpl.SetTimestamp(e.Time)
+			fmt.Fprintf(dstImpl, "\x1b[1mskipped\x1b[0m\n")
+
 		case event.FinishTask:
 			exitStatus = e.ExitStatus
 
+		case event.Retry:
+			dstImpl.SetTimestamp(e.Time)
+			if e.Delay != "" {
+				fmt.Fprintf(dstImpl, "\x1b[1mattempt %d %s, retrying in %s\x1b[0m\n", e.Attempt, e.Reason, e.Delay)
+			} else {
+				fmt.Fprintf(dstImpl, "\x1b[1mattempt %d %s, retrying\x1b[0m\n", e.Attempt, e.Reason)
+			}
+
+		case event.Approval:
+			dstImpl.SetTimestamp(e.Time)
+			if e.Approved {
+				fmt.Fprintf(dstImpl, "\x1b[1mapproved by %s\x1b[0m\n", e.Approver)
+			} else {
+				fmt.Fprintf(dstImpl, "\x1b[1mrejected by %s\x1b[0m\n", e.Approver)
+			}
+
+		case event.Continue:
+			dstImpl.SetTimestamp(e.Time)
+			fmt.Fprintf(dstImpl, "\x1b[1mcontinued by %s\x1b[0m\n", e.Continuer)
+
 		case event.Error:
 			errCol := ui.ErroredColor.SprintFunc()
 			dstImpl.SetTimestamp(0)
@@ -119,6 +119,101 @@ var _ = Describe("V1.0 Renderer", func() {
 		})
 	})
 
+	Context("when a MemoizedTask event is received", func() {
+		BeforeEach(func() {
+			receivedEvents <- event.MemoizedTask{
+				Time: time.Now().Unix(),
+				Key:  "some-key",
+			}
+		})
+
+		It("prints that the task's result was reused", func() {
+			Expect(out.Contents()).To(ContainSubstring("\x1b[1musing memoized result\x1b[0m\n"))
+		})
+	})
+
+	Context("when a Skipped event is received", func() {
+		BeforeEach(func() {
+			receivedEvents <- event.Skipped{
+				Time: time.Now().Unix(),
+			}
+		})
+
+		It("prints that the step was skipped", func() {
+			Expect(out.Contents()).To(ContainSubstring("\x1b[1mskipped\x1b[0m\n"))
+		})
+	})
+
+	Context("when a Retry event is received", func() {
+		BeforeEach(func() {
+			receivedEvents <- event.Retry{
+				Time:    time.Now().Unix(),
+				Attempt: 1,
+				Reason:  "errored",
+			}
+		})
+
+		It("prints why the step is being retried", func() {
+			Expect(out.Contents()).To(ContainSubstring("\x1b[1mattempt 1 errored, retrying\x1b[0m\n"))
+		})
+
+		Context("with a delay", func() {
+			BeforeEach(func() {
+				receivedEvents <- event.Retry{
+					Time:    time.Now().Unix(),
+					Attempt: 2,
+					Reason:  "failed",
+					Delay:   "20s",
+				}
+			})
+
+			It("prints how long until the next attempt", func() {
+				Expect(out.Contents()).To(ContainSubstring("\x1b[1mattempt 2 failed, retrying in 20s\x1b[0m\n"))
+			})
+		})
+	})
+
+	Context("when an Approval event is received", func() {
+		BeforeEach(func() {
+			receivedEvents <- event.Approval{
+				Time:     time.Now().Unix(),
+				Approved: true,
+				Approver: "some-user",
+			}
+		})
+
+		It("prints who approved the build", func() {
+			Expect(out.Contents()).To(ContainSubstring("\x1b[1mapproved by some-user\x1b[0m\n"))
+		})
+
+		Context("when the build is rejected", func() {
+			BeforeEach(func() {
+				receivedEvents <- event.Approval{
+					Time:     time.Now().Unix(),
+					Approved: false,
+					Approver: "some-other-user",
+				}
+			})
+
+			It("prints who rejected the build", func() {
+				Expect(out.Contents()).To(ContainSubstring("\x1b[1mrejected by some-other-user\x1b[0m\n"))
+			})
+		})
+	})
+
+	Context("when a Continue event is received", func() {
+		BeforeEach(func() {
+			receivedEvents <- event.Continue{
+				Time:      time.Now().Unix(),
+				Continuer: "some-user",
+			}
+		})
+
+		It("prints who continued the build", func() {
+			Expect(out.Contents()).To(ContainSubstring("\x1b[1mcontinued by some-user\x1b[0m\n"))
+		})
+	})
+
 	Context("and a StartTask event is received", func() {
 		BeforeEach(func() {
 			receivedEvents <- event.StartTask{
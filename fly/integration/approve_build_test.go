@@ -0,0 +1,192 @@
+package integration_test
+
+import (
+	"net/http"
+	"os/exec"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+
+	"github.com/concourse/concourse/atc"
+)
+
+var _ = Describe("ApproveBuild", func() {
+	var expectedApprovalURL = "/api/v1/builds/23/approval"
+
+	var expectedBuild = atc.Build{
+		ID:      23,
+		Name:    "42",
+		Status:  "started",
+		JobName: "myjob",
+		APIURL:  "api/v1/builds/23",
+	}
+
+	Context("when the job name is not specified", func() {
+		var approvalStatus int
+
+		BeforeEach(func() {
+			approvalStatus = http.StatusNoContent
+		})
+
+		JustBeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/builds/23"),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, expectedBuild),
+				),
+			)
+		})
+
+		Context("and the build is approved", func() {
+			BeforeEach(func() {
+				atcServer.RouteToHandler("PUT", expectedApprovalURL, ghttp.CombineHandlers(
+					ghttp.VerifyJSON(`{"approved":true}`),
+					func(w http.ResponseWriter, r *http.Request) {
+						w.WriteHeader(approvalStatus)
+					},
+				))
+			})
+
+			It("approves the build", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "approve-build", "-b", "23")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(0))
+
+				Expect(sess.Out).To(gbytes.Say("build successfully approved"))
+			})
+
+			Context("when the build is not waiting for approval", func() {
+				BeforeEach(func() {
+					approvalStatus = http.StatusConflict
+				})
+
+				It("returns a helpful error message", func() {
+					flyCmd := exec.Command(flyPath, "-t", targetName, "approve-build", "-b", "23")
+
+					sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+					Expect(err).NotTo(HaveOccurred())
+
+					Eventually(sess).Should(gexec.Exit(1))
+
+					Expect(sess.Err).To(gbytes.Say("error: build is not waiting for approval"))
+				})
+			})
+
+			Context("when the user may not approve the build", func() {
+				BeforeEach(func() {
+					approvalStatus = http.StatusForbidden
+				})
+
+				It("fails", func() {
+					flyCmd := exec.Command(flyPath, "-t", targetName, "approve-build", "-b", "23")
+
+					sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+					Expect(err).NotTo(HaveOccurred())
+
+					Eventually(sess).Should(gexec.Exit(1))
+
+					Expect(sess.Err).To(gbytes.Say("forbidden"))
+				})
+			})
+		})
+
+		Context("and the build is rejected", func() {
+			BeforeEach(func() {
+				atcServer.RouteToHandler("PUT", expectedApprovalURL, ghttp.CombineHandlers(
+					ghttp.VerifyJSON(`{"approved":false}`),
+					ghttp.RespondWith(http.StatusNoContent, ""),
+				))
+			})
+
+			It("rejects the build", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "approve-build", "-b", "23", "--reject")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(0))
+
+				Expect(sess.Out).To(gbytes.Say("build successfully rejected"))
+			})
+		})
+
+		Context("and a step is specified", func() {
+			BeforeEach(func() {
+				atcServer.RouteToHandler("PUT", expectedApprovalURL, ghttp.CombineHandlers(
+					ghttp.VerifyJSON(`{"approved":true,"step":"ship-it"}`),
+					ghttp.RespondWith(http.StatusNoContent, ""),
+				))
+			})
+
+			It("approves the step", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "approve-build", "-b", "23", "-s", "ship-it")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(0))
+
+				Expect(sess.Out).To(gbytes.Say("build successfully approved"))
+			})
+		})
+	})
+
+	Context("when the job name is specified", func() {
+		BeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines/my-pipeline/jobs/my-job/builds/42"),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, expectedBuild),
+				),
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("PUT", expectedApprovalURL),
+					ghttp.RespondWith(http.StatusNoContent, ""),
+				),
+			)
+		})
+
+		It("approves the build", func() {
+			Expect(func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "approve-build", "-j", "my-pipeline/my-job", "-b", "42")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(0))
+
+				Expect(sess.Out).To(gbytes.Say("build successfully approved"))
+			}).To(Change(func() int {
+				return len(atcServer.ReceivedRequests())
+			}).By(3))
+		})
+	})
+
+	Context("when the build does not exist", func() {
+		BeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/builds/42"),
+					ghttp.RespondWith(http.StatusNotFound, ""),
+				),
+			)
+		})
+
+		It("returns a helpful error message", func() {
+			flyCmd := exec.Command(flyPath, "-t", targetName, "approve-build", "-b", "42")
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gexec.Exit(1))
+
+			Expect(sess.Err).To(gbytes.Say("error: build does not exist"))
+		})
+	})
+})
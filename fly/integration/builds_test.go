@@ -85,6 +85,109 @@ var _ = Describe("Fly CLI", func() {
 			Expect(err).ToNot(HaveOccurred())
 		})
 
+		Context("when --tests is given", func() {
+			BeforeEach(func() {
+				cmdArgs = append(cmdArgs, "--tests")
+
+				expectedURL = "/api/v1/builds"
+				queryParams = "limit=50"
+
+				returnedStatusCode = http.StatusOK
+				returnedBuilds = []atc.Build{
+					{
+						ID:           3,
+						PipelineName: "some-pipeline",
+						JobName:      "some-job",
+						Name:         "63",
+						Status:       "failed",
+						StartTime:    succeededBuildStartTime.Unix(),
+						EndTime:      succeededBuildEndTime.Unix(),
+						TeamName:     "team1",
+					},
+					{
+						ID:           2,
+						PipelineName: "some-pipeline",
+						JobName:      "some-job",
+						Name:         "62",
+						Status:       "succeeded",
+						StartTime:    succeededBuildStartTime.Unix(),
+						EndTime:      succeededBuildEndTime.Unix(),
+						TeamName:     "team1",
+					},
+				}
+
+				expectedHeaders = append(expectedHeaders, ui.TableCell{Contents: "tests", Color: color.New(color.Bold)})
+
+				atcServer.RouteToHandler("GET", "/api/v1/builds/3/tests", ghttp.RespondWithJSONEncoded(http.StatusOK, atc.TestReport{
+					Passed:  10,
+					Failed:  2,
+					Skipped: 1,
+					Tests:   make([]atc.TestResult, 13),
+				}))
+				atcServer.RouteToHandler("GET", "/api/v1/builds/2/tests", ghttp.RespondWithJSONEncoded(http.StatusOK, atc.TestReport{
+					Tests: []atc.TestResult{},
+				}))
+			})
+
+			It("shows how many tests passed, failed and were skipped", func() {
+				Eventually(session).Should(gexec.Exit(0))
+
+				Expect(session.Out).To(PrintTable(ui.Table{
+					Headers: expectedHeaders,
+					Data: []ui.TableRow{
+						{
+							{Contents: "3"},
+							{Contents: "some-pipeline/some-job"},
+							{Contents: "63"},
+							{Contents: "failed"},
+							{Contents: succeededBuildStartTime.Local().Format(timeDateLayout)},
+							{Contents: succeededBuildEndTime.Local().Format(timeDateLayout)},
+							{Contents: "1h15m0s"},
+							{Contents: "team1"},
+							{Contents: "10 passed, 2 failed, 1 skipped"},
+						},
+						{
+							{Contents: "2"},
+							{Contents: "some-pipeline/some-job"},
+							{Contents: "62"},
+							{Contents: "succeeded"},
+							{Contents: succeededBuildStartTime.Local().Format(timeDateLayout)},
+							{Contents: succeededBuildEndTime.Local().Format(timeDateLayout)},
+							{Contents: "1h15m0s"},
+							{Contents: "team1"},
+							{Contents: "n/a"},
+						},
+					},
+				}))
+			})
+		})
+
+		Context("when a build is paused at a breakpoint", func() {
+			BeforeEach(func() {
+				expectedURL = "/api/v1/builds"
+				queryParams = "limit=50"
+
+				returnedStatusCode = http.StatusOK
+				returnedBuilds = []atc.Build{
+					{
+						ID:           2,
+						PipelineName: "some-pipeline",
+						JobName:      "some-job",
+						Name:         "62",
+						Status:       "started",
+						Paused:       true,
+						StartTime:    succeededBuildStartTime.Unix(),
+						TeamName:     "team1",
+					},
+				}
+			})
+
+			It("shows the build as paused", func() {
+				Eventually(session).Should(gexec.Exit(0))
+				Expect(session.Out).To(gbytes.Say(`2\s+some-pipeline/some-job\s+62\s+paused`))
+			})
+		})
+
 		Context("with no arguments", func() {
 			BeforeEach(func() {
 				expectedURL = "/api/v1/builds"
@@ -0,0 +1,99 @@
+package integration_test
+
+import (
+	"net/http"
+	"os/exec"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+
+	"github.com/concourse/concourse/atc"
+)
+
+var _ = Describe("ContinueBuild", func() {
+	var expectedContinueURL = "/api/v1/builds/23/continue"
+
+	var expectedBuild = atc.Build{
+		ID:      23,
+		Name:    "42",
+		Status:  "started",
+		JobName: "myjob",
+		APIURL:  "api/v1/builds/23",
+		Paused:  true,
+	}
+
+	Context("when the build exists", func() {
+		var continueStatus int
+
+		BeforeEach(func() {
+			continueStatus = http.StatusNoContent
+		})
+
+		JustBeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/builds/23"),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, expectedBuild),
+				),
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("PUT", expectedContinueURL),
+					ghttp.RespondWith(continueStatus, ""),
+				),
+			)
+		})
+
+		It("continues the build", func() {
+			flyCmd := exec.Command(flyPath, "-t", targetName, "continue-build", "-b", "23")
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gexec.Exit(0))
+
+			Expect(sess.Out).To(gbytes.Say("build successfully continued"))
+		})
+
+		Context("when the build is not paused", func() {
+			BeforeEach(func() {
+				continueStatus = http.StatusConflict
+			})
+
+			It("returns a helpful error message", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "continue-build", "-b", "23")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(1))
+
+				Expect(sess.Err).To(gbytes.Say("error: build is not paused at a breakpoint"))
+			})
+		})
+	})
+
+	Context("when the build does not exist", func() {
+		BeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/builds/42"),
+					ghttp.RespondWith(http.StatusNotFound, ""),
+				),
+			)
+		})
+
+		It("returns a helpful error message", func() {
+			flyCmd := exec.Command(flyPath, "-t", targetName, "continue-build", "-b", "42")
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gexec.Exit(1))
+
+			Expect(sess.Err).To(gbytes.Say("error: build does not exist"))
+		})
+	})
+})
@@ -0,0 +1,138 @@
+package integration_test
+
+import (
+	"io/ioutil"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+
+	"github.com/concourse/concourse/atc"
+)
+
+var _ = Describe("DownloadArtifact", func() {
+	var (
+		outputDir string
+		artifacts []atc.WorkerArtifact
+	)
+
+	BeforeEach(func() {
+		var err error
+		outputDir, err = ioutil.TempDir("", "fly-download-artifact")
+		Expect(err).NotTo(HaveOccurred())
+
+		artifacts = []atc.WorkerArtifact{
+			{ID: 125, Name: "binary", BuildID: 23},
+			{ID: 126, Name: "reports", BuildID: 23},
+		}
+
+		atcServer.RouteToHandler("GET", "/api/v1/builds/23", ghttp.RespondWithJSONEncoded(http.StatusOK, atc.Build{
+			ID:       23,
+			Name:     "42",
+			Status:   "succeeded",
+			TeamName: "some-team",
+			JobName:  "myjob",
+		}))
+		atcServer.RouteToHandler("GET", "/api/v1/teams/some-team/artifacts/125", tarHandler)
+		atcServer.RouteToHandler("GET", "/api/v1/teams/some-team/artifacts/126", tarHandler)
+	})
+
+	JustBeforeEach(func() {
+		atcServer.RouteToHandler("GET", "/api/v1/builds/23/artifacts",
+			ghttp.RespondWithJSONEncoded(http.StatusOK, artifacts),
+		)
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(outputDir)
+	})
+
+	It("downloads each of the build's artifacts into the output dir", func() {
+		flyCmd := exec.Command(flyPath, "-t", targetName, "download-artifact", "-b", "23", "-o", outputDir)
+
+		sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+		Expect(err).NotTo(HaveOccurred())
+
+		Eventually(sess).Should(gexec.Exit(0))
+
+		for _, name := range []string{"binary", "reports"} {
+			data, err := ioutil.ReadFile(filepath.Join(outputDir, name, "some-file"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(data).To(Equal([]byte("tar-contents")))
+		}
+	})
+
+	Context("when an artifact is specified", func() {
+		It("only downloads that artifact", func() {
+			flyCmd := exec.Command(flyPath, "-t", targetName, "download-artifact", "-b", "23", "-a", "reports", "-o", outputDir)
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gexec.Exit(0))
+
+			Expect(filepath.Join(outputDir, "reports", "some-file")).To(BeAnExistingFile())
+			Expect(filepath.Join(outputDir, "binary")).ToNot(BeAnExistingFile())
+		})
+
+		Context("when the build has no such artifact", func() {
+			It("fails", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "download-artifact", "-b", "23", "-a", "bogus")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(1))
+
+				Expect(sess.Err).To(gbytes.Say("error: build has no artifact named 'bogus'"))
+			})
+		})
+	})
+
+	Context("when the build has no artifacts", func() {
+		BeforeEach(func() {
+			artifacts = []atc.WorkerArtifact{}
+		})
+
+		It("fails", func() {
+			flyCmd := exec.Command(flyPath, "-t", targetName, "download-artifact", "-b", "23")
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gexec.Exit(1))
+
+			Expect(sess.Err).To(gbytes.Say("error: build has no artifacts"))
+		})
+	})
+
+	Context("when the job is specified", func() {
+		BeforeEach(func() {
+			atcServer.RouteToHandler("GET", "/api/v1/teams/main/pipelines/mypipeline/jobs/myjob/builds/42", ghttp.RespondWithJSONEncoded(http.StatusOK, atc.Build{
+				ID:       23,
+				Name:     "42",
+				Status:   "succeeded",
+				TeamName: "some-team",
+				JobName:  "myjob",
+			}))
+		})
+
+		It("downloads the artifacts of the job's build", func() {
+			flyCmd := exec.Command(flyPath, "-t", targetName, "download-artifact", "-j", "mypipeline/myjob", "-b", "42", "-o", outputDir)
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gexec.Exit(0))
+
+			Expect(filepath.Join(outputDir, "binary", "some-file")).To(BeAnExistingFile())
+		})
+	})
+})
@@ -779,7 +779,7 @@ run:
 			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
 			Expect(err).NotTo(HaveOccurred())
 
-			Eventually(sess.Err).Should(gbytes.Say("argument format should be <pipeline>/<job>"))
+			Eventually(sess.Err).Should(gbytes.Say(`argument format should be <pipeline>\[/<instance vars>\]/<job>`))
 
 			<-sess.Exited
 			Expect(sess.ExitCode()).To(Equal(1))
@@ -806,6 +806,36 @@ var _ = Describe("Hijacking", func() {
 			})
 		})
 
+		Context("when called with a job of an instance of a pipeline", func() {
+			BeforeEach(func() {
+				containerArguments = `pipeline_name=some-pipeline&instance_vars={"branch":"master"}&job_name=some-job&step_name=some-step`
+				jobName = "some-job"
+				buildName = "3"
+				buildID = 13
+				stepType = "task"
+				stepName = "some-step"
+			})
+
+			It("hijacks the job's next build with 'pipelineName/instanceVars/jobName'", func() {
+				hijack("--job", "some-pipeline/branch:master/some-job", "--step", "some-step")
+			})
+
+			It("hijacks the job's next build when URL is specified", func() {
+				hijack("--url", atcServer.URL()+"/teams/"+teamName+`/pipelines/some-pipeline/jobs/some-job?instance_vars={"branch":"master"}`, "--step", "some-step")
+			})
+		})
+
+		Context("when called with a check container of an instance of a pipeline", func() {
+			BeforeEach(func() {
+				resourceName = "some-resource-name"
+				containerArguments = `type=check&resource_name=some-resource-name&pipeline_name=a-pipeline&instance_vars={"branch":"master"}`
+			})
+
+			It("can accept the check resources name and the instance of the pipeline", func() {
+				hijack("--check", "a-pipeline/branch:master/some-resource-name")
+			})
+		})
+
 		Context("when called with a specific attempt number", func() {
 			BeforeEach(func() {
 				containerArguments = "pipeline_name=some-pipeline&job_name=some-job&step_name=some-step&attempt=2.4"
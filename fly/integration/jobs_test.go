@@ -152,6 +152,25 @@ var _ = Describe("Fly CLI", func() {
 			})
 		})
 
+		Context("when specifying the instance vars of the pipeline", func() {
+			BeforeEach(func() {
+				flyCmd = exec.Command(flyPath, "-t", targetName, "jobs", "-p", "pipeline/branch:master")
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines/pipeline/jobs", `instance_vars={"branch":"master"}`),
+						ghttp.RespondWithJSONEncoded(200, []atc.Job{}),
+					),
+				)
+			})
+
+			It("gets the jobs of that instance", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(0))
+			})
+		})
+
 		Context("when the api returns an internal server error", func() {
 			BeforeEach(func() {
 				flyCmd = exec.Command(flyPath, "-t", targetName, "jobs", "-p", "pipeline")
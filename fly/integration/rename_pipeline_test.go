@@ -55,6 +55,20 @@ var _ = Describe("RenamePipeline", func() {
 		})
 	})
 
+	Context("when specifying instance vars in the new name", func() {
+		It("fails and says instance vars are not allowed", func() {
+			flyCmd := exec.Command(flyPath, "-t", targetName, "rename-pipeline", "-o", "some-pipeline", "-n", "brandnew/branch:master")
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			<-sess.Exited
+			Expect(sess.ExitCode()).To(Equal(1))
+
+			Expect(sess.Err).To(gbytes.Say("error: new pipeline name cannot contain instance vars"))
+		})
+	})
+
 	Context("when not specifying a new name", func() {
 		It("fails and says you should provide a new name for the pipeline", func() {
 			flyCmd := exec.Command(flyPath, "-t", targetName, "rename-pipeline", "-o", "some-pipeline")
@@ -80,6 +94,19 @@ var _ = Describe("RenamePipeline", func() {
 			Expect(sess.Out).To(gbytes.Say(fmt.Sprintf("pipeline successfully renamed to %s", newName)))
 		})
 
+		Context("when renaming an instance of a pipeline", func() {
+			It("identifies the instance by its instance vars", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "rename-pipeline", "-o", "some-pipeline/branch:master", "-n", newName)
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(0))
+				Expect(atcServer.ReceivedRequests()).To(HaveLen(4))
+				Expect(atcServer.ReceivedRequests()[3].URL.Query().Get("instance_vars")).To(MatchJSON(`{"branch":"master"}`))
+			})
+		})
+
 		Context("when the pipeline is not found", func() {
 			BeforeEach(func() {
 				atcServer.SetHandler(3, ghttp.RespondWith(http.StatusNotFound, ""))
@@ -0,0 +1,139 @@
+package integration_test
+
+import (
+	"net/http"
+	"os/exec"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+
+	"github.com/concourse/concourse/atc"
+)
+
+var _ = Describe("SetBreakpoint", func() {
+	var expectedBreakpointURL = "/api/v1/builds/23/breakpoints"
+
+	var expectedBuild = atc.Build{
+		ID:      23,
+		Name:    "42",
+		Status:  "started",
+		JobName: "myjob",
+		APIURL:  "api/v1/builds/23",
+	}
+
+	Context("when the job name is not specified", func() {
+		var (
+			expectedBody     string
+			breakpointStatus int
+		)
+
+		BeforeEach(func() {
+			expectedBody = `{"step":"unit","position":"before"}`
+			breakpointStatus = http.StatusNoContent
+		})
+
+		JustBeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/builds/23"),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, expectedBuild),
+				),
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("PUT", expectedBreakpointURL),
+					ghttp.VerifyJSON(expectedBody),
+					ghttp.RespondWith(breakpointStatus, ""),
+				),
+			)
+		})
+
+		It("sets a breakpoint before the step", func() {
+			flyCmd := exec.Command(flyPath, "-t", targetName, "set-breakpoint", "-b", "23", "--step", "unit")
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gexec.Exit(0))
+
+			Expect(sess.Out).To(gbytes.Say("build will pause before step 'unit'"))
+		})
+
+		Context("when --after is specified", func() {
+			BeforeEach(func() {
+				expectedBody = `{"step":"unit","position":"after"}`
+			})
+
+			It("sets a breakpoint after the step", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "set-breakpoint", "-b", "23", "--step", "unit", "--after")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(0))
+
+				Expect(sess.Out).To(gbytes.Say("build will pause after step 'unit'"))
+			})
+		})
+
+		Context("when the build has completed", func() {
+			BeforeEach(func() {
+				breakpointStatus = http.StatusConflict
+			})
+
+			It("returns a helpful error message", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "set-breakpoint", "-b", "23", "--step", "unit")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(1))
+
+				Expect(sess.Err).To(gbytes.Say("error: build has already completed"))
+			})
+		})
+	})
+
+	Context("when the job name is specified", func() {
+		BeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines/my-pipeline/jobs/my-job/builds/42"),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, expectedBuild),
+				),
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("PUT", expectedBreakpointURL),
+					ghttp.RespondWith(http.StatusNoContent, ""),
+				),
+			)
+		})
+
+		It("sets a breakpoint on the build", func() {
+			Expect(func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "set-breakpoint", "-j", "my-pipeline/my-job", "-b", "42", "-s", "unit")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(0))
+			}).To(Change(func() int {
+				return len(atcServer.ReceivedRequests())
+			}).By(3))
+		})
+	})
+
+	Context("when the step is not specified", func() {
+		It("fails", func() {
+			flyCmd := exec.Command(flyPath, "-t", targetName, "set-breakpoint", "-b", "23")
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gexec.Exit(1))
+
+			Expect(sess.Err).To(gbytes.Say("the required flag `-s, --step' was not specified"))
+		})
+	})
+})
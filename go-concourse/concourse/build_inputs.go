@@ -8,9 +8,9 @@ import (
 	"github.com/tedsuo/rata"
 )
 
-func (team *team) BuildInputsForJob(pipelineName string, jobName string) ([]atc.BuildInput, bool, error) {
+func (team *team) BuildInputsForJob(pipelineRef atc.PipelineRef, jobName string) ([]atc.BuildInput, bool, error) {
 	params := rata.Params{
-		"pipeline_name": pipelineName,
+		"pipeline_name": pipelineRef.Name,
 		"job_name":      jobName,
 		"team_name":     team.name,
 	}
@@ -19,6 +19,7 @@ func (team *team) BuildInputsForJob(pipelineName string, jobName string) ([]atc.
 	err := team.connection.Send(internal.Request{
 		RequestName: atc.ListJobInputs,
 		Params:      params,
+		Query:       pipelineRef.QueryParams(),
 	}, &internal.Response{
 		Result: &buildInputs,
 	})
@@ -33,9 +34,9 @@ func (team *team) BuildInputsForJob(pipelineName string, jobName string) ([]atc.
 	}
 }
 
-func (team *team) BuildsWithVersionAsInput(pipelineName string, resourceName string, resourceVersionID int) ([]atc.Build, bool, error) {
+func (team *team) BuildsWithVersionAsInput(pipelineRef atc.PipelineRef, resourceName string, resourceVersionID int) ([]atc.Build, bool, error) {
 	params := rata.Params{
-		"pipeline_name":              pipelineName,
+		"pipeline_name":              pipelineRef.Name,
 		"resource_name":              resourceName,
 		"resource_config_version_id": strconv.Itoa(resourceVersionID),
 		"team_name":                  team.name,
@@ -45,6 +46,7 @@ func (team *team) BuildsWithVersionAsInput(pipelineName string, resourceName str
 	err := team.connection.Send(internal.Request{
 		RequestName: atc.ListBuildsWithVersionAsInput,
 		Params:      params,
+		Query:       pipelineRef.QueryParams(),
 	}, &internal.Response{
 		Result: &builds,
 	})
@@ -0,0 +1,32 @@
+package concourse
+
+import (
+	"strconv"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/go-concourse/concourse/internal"
+	"github.com/tedsuo/rata"
+)
+
+func (client *client) BuildTests(buildID int) (atc.TestReport, bool, error) {
+	params := rata.Params{
+		"build_id": strconv.Itoa(buildID),
+	}
+
+	var report atc.TestReport
+	err := client.connection.Send(internal.Request{
+		RequestName: atc.GetBuildTests,
+		Params:      params,
+	}, &internal.Response{
+		Result: &report,
+	})
+
+	switch err.(type) {
+	case nil:
+		return report, true, nil
+	case internal.ResourceNotFoundError:
+		return report, false, nil
+	default:
+		return report, false, err
+	}
+}
@@ -0,0 +1,60 @@
+package concourse_test
+
+import (
+	"net/http"
+
+	"github.com/concourse/concourse/atc"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("ATC Handler Build Tests", func() {
+	Describe("BuildTests", func() {
+		expectedURL := "/api/v1/builds/1234/tests"
+
+		Context("when the build exists", func() {
+			expectedReport := atc.TestReport{
+				Passed: 1,
+				Failed: 1,
+				Tests: []atc.TestResult{
+					{Step: "unit", Name: "adds", Status: atc.TestPassed},
+					{Step: "unit", Name: "subtracts", Status: atc.TestFailed},
+				},
+			}
+
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", expectedURL),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, expectedReport),
+					),
+				)
+			})
+
+			It("returns the build's test report", func() {
+				report, found, err := client.BuildTests(1234)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(report).To(Equal(expectedReport))
+			})
+		})
+
+		Context("when the build does not exist", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", expectedURL),
+						ghttp.RespondWithJSONEncoded(http.StatusNotFound, nil),
+					),
+				)
+			})
+
+			It("returns false and no error", func() {
+				_, found, err := client.BuildTests(1234)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+		})
+	})
+})
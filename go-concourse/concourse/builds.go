@@ -35,10 +35,10 @@ func (team *team) CreateBuild(plan atc.Plan) (atc.Build, error) {
 	return build, err
 }
 
-func (team *team) CreateJobBuild(pipelineName string, jobName string) (atc.Build, error) {
+func (team *team) CreateJobBuild(pipelineRef atc.PipelineRef, jobName string) (atc.Build, error) {
 	params := rata.Params{
 		"job_name":      jobName,
-		"pipeline_name": pipelineName,
+		"pipeline_name": pipelineRef.Name,
 		"team_name":     team.name,
 	}
 
@@ -46,6 +46,7 @@ func (team *team) CreateJobBuild(pipelineName string, jobName string) (atc.Build
 	err := team.connection.Send(internal.Request{
 		RequestName: atc.CreateJobBuild,
 		Params:      params,
+		Query:       pipelineRef.QueryParams(),
 	}, &internal.Response{
 		Result: &build,
 	})
@@ -53,11 +54,11 @@ func (team *team) CreateJobBuild(pipelineName string, jobName string) (atc.Build
 	return build, err
 }
 
-func (team *team) RerunJobBuild(pipelineName string, jobName string, buildName string) (atc.Build, error) {
+func (team *team) RerunJobBuild(pipelineRef atc.PipelineRef, jobName string, buildName string) (atc.Build, error) {
 	params := rata.Params{
 		"build_name":    buildName,
 		"job_name":      jobName,
-		"pipeline_name": pipelineName,
+		"pipeline_name": pipelineRef.Name,
 		"team_name":     team.name,
 	}
 
@@ -65,6 +66,7 @@ func (team *team) RerunJobBuild(pipelineName string, jobName string, buildName s
 	err := team.connection.Send(internal.Request{
 		RequestName: atc.RerunJobBuild,
 		Params:      params,
+		Query:       pipelineRef.QueryParams(),
 	}, &internal.Response{
 		Result: &build,
 	})
@@ -72,11 +74,11 @@ func (team *team) RerunJobBuild(pipelineName string, jobName string, buildName s
 	return build, err
 }
 
-func (team *team) JobBuild(pipelineName, jobName, buildName string) (atc.Build, bool, error) {
+func (team *team) JobBuild(pipelineRef atc.PipelineRef, jobName, buildName string) (atc.Build, bool, error) {
 	params := rata.Params{
 		"job_name":      jobName,
 		"build_name":    buildName,
-		"pipeline_name": pipelineName,
+		"pipeline_name": pipelineRef.Name,
 		"team_name":     team.name,
 	}
 
@@ -84,6 +86,7 @@ func (team *team) JobBuild(pipelineName, jobName, buildName string) (atc.Build,
 	err := team.connection.Send(internal.Request{
 		RequestName: atc.GetJobBuild,
 		Params:      params,
+		Query:       pipelineRef.QueryParams(),
 	}, &internal.Response{
 		Result: &build,
 	})
@@ -157,6 +160,89 @@ func (client *client) AbortBuild(buildID string) error {
 	}, nil)
 }
 
+func (client *client) ApproveBuild(buildID string, approval atc.ApprovalRequest) (bool, error) {
+	params := rata.Params{
+		"build_id": buildID,
+	}
+
+	jsonBytes, err := json.Marshal(approval)
+	if err != nil {
+		return false, err
+	}
+
+	err = client.connection.Send(internal.Request{
+		RequestName: atc.ApproveBuild,
+		Params:      params,
+		Body:        bytes.NewBuffer(jsonBytes),
+		Header:      http.Header{"Content-Type": []string{"application/json"}},
+	}, nil)
+
+	switch e := err.(type) {
+	case nil:
+		return true, nil
+	case internal.UnexpectedResponseError:
+		if e.StatusCode == http.StatusConflict {
+			return false, nil
+		}
+		return false, err
+	default:
+		return false, err
+	}
+}
+
+func (client *client) SetBuildBreakpoint(buildID string, step string, position atc.BreakpointPosition) (bool, error) {
+	params := rata.Params{
+		"build_id": buildID,
+	}
+
+	jsonBytes, err := json.Marshal(atc.BreakpointRequest{Step: step, Position: position})
+	if err != nil {
+		return false, err
+	}
+
+	err = client.connection.Send(internal.Request{
+		RequestName: atc.SetBuildBreakpoint,
+		Params:      params,
+		Body:        bytes.NewBuffer(jsonBytes),
+		Header:      http.Header{"Content-Type": []string{"application/json"}},
+	}, nil)
+
+	switch e := err.(type) {
+	case nil:
+		return true, nil
+	case internal.UnexpectedResponseError:
+		if e.StatusCode == http.StatusConflict {
+			return false, nil
+		}
+		return false, err
+	default:
+		return false, err
+	}
+}
+
+func (client *client) ContinueBuild(buildID string) (bool, error) {
+	params := rata.Params{
+		"build_id": buildID,
+	}
+
+	err := client.connection.Send(internal.Request{
+		RequestName: atc.ContinueBuild,
+		Params:      params,
+	}, nil)
+
+	switch e := err.(type) {
+	case nil:
+		return true, nil
+	case internal.UnexpectedResponseError:
+		if e.StatusCode == http.StatusConflict {
+			return false, nil
+		}
+		return false, err
+	default:
+		return false, err
+	}
+}
+
 func (team *team) Builds(page Page) ([]atc.Build, Pagination, error) {
 	var builds []atc.Build
 
@@ -90,7 +90,7 @@ var _ = Describe("ATC Handler Builds", func() {
 		})
 
 		It("takes a pipeline and a job and creates the build", func() {
-			build, err := team.CreateJobBuild(pipelineName, jobName)
+			build, err := team.CreateJobBuild(atc.PipelineRef{Name: pipelineName}, jobName)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(build).To(Equal(expectedBuild))
 		})
@@ -127,7 +127,7 @@ var _ = Describe("ATC Handler Builds", func() {
 		})
 
 		It("takes a pipeline and a job and creates the build", func() {
-			build, err := team.RerunJobBuild(pipelineName, jobName, buildName)
+			build, err := team.RerunJobBuild(atc.PipelineRef{Name: pipelineName}, jobName, buildName)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(build).To(Equal(expectedBuild))
 		})
@@ -160,7 +160,7 @@ var _ = Describe("ATC Handler Builds", func() {
 			})
 
 			It("returns the given build", func() {
-				build, found, err := team.JobBuild("mypipeline", "myjob", "mybuild")
+				build, found, err := team.JobBuild(atc.PipelineRef{Name: "mypipeline"}, "myjob", "mybuild")
 				Expect(err).NotTo(HaveOccurred())
 				Expect(found).To(BeTrue())
 				Expect(build).To(Equal(expectedBuild))
@@ -180,7 +180,7 @@ var _ = Describe("ATC Handler Builds", func() {
 			})
 
 			It("return false and no error", func() {
-				_, found, err := team.JobBuild("mypipeline", "myjob", "mybuild")
+				_, found, err := team.JobBuild(atc.PipelineRef{Name: "mypipeline"}, "myjob", "mybuild")
 				Expect(err).NotTo(HaveOccurred())
 				Expect(found).To(BeFalse())
 			})
@@ -460,6 +460,164 @@ var _ = Describe("ATC Handler Builds", func() {
 		})
 	})
 
+	Describe("ApproveBuild", func() {
+		var (
+			status int
+
+			decided bool
+			err     error
+		)
+
+		BeforeEach(func() {
+			status = http.StatusNoContent
+		})
+
+		JustBeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("PUT", "/api/v1/builds/123/approval"),
+					ghttp.VerifyJSON(`{"approved":false,"step":"ship-it"}`),
+					ghttp.RespondWith(status, ""),
+				),
+			)
+
+			decided, err = client.ApproveBuild("123", atc.ApprovalRequest{Approved: false, Step: "ship-it"})
+		})
+
+		It("sends the decision to ATC", func() {
+			Expect(err).NotTo(HaveOccurred())
+			Expect(decided).To(BeTrue())
+			Expect(atcServer.ReceivedRequests()).To(HaveLen(1))
+		})
+
+		Context("when the build is not waiting for approval", func() {
+			BeforeEach(func() {
+				status = http.StatusConflict
+			})
+
+			It("returns false", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(decided).To(BeFalse())
+			})
+		})
+
+		Context("when the user may not approve the build", func() {
+			BeforeEach(func() {
+				status = http.StatusForbidden
+			})
+
+			It("returns an error", func() {
+				Expect(err).To(HaveOccurred())
+				Expect(decided).To(BeFalse())
+			})
+		})
+	})
+
+	Describe("SetBuildBreakpoint", func() {
+		var (
+			status int
+
+			set bool
+			err error
+		)
+
+		BeforeEach(func() {
+			status = http.StatusNoContent
+		})
+
+		JustBeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("PUT", "/api/v1/builds/123/breakpoints"),
+					ghttp.VerifyJSON(`{"step":"unit","position":"after"}`),
+					ghttp.RespondWith(status, ""),
+				),
+			)
+
+			set, err = client.SetBuildBreakpoint("123", "unit", atc.BreakpointAfter)
+		})
+
+		It("sets the breakpoint", func() {
+			Expect(err).NotTo(HaveOccurred())
+			Expect(set).To(BeTrue())
+			Expect(atcServer.ReceivedRequests()).To(HaveLen(1))
+		})
+
+		Context("when the build has completed", func() {
+			BeforeEach(func() {
+				status = http.StatusConflict
+			})
+
+			It("returns false", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(set).To(BeFalse())
+			})
+		})
+
+		Context("when the request is invalid", func() {
+			BeforeEach(func() {
+				status = http.StatusBadRequest
+			})
+
+			It("returns an error", func() {
+				Expect(err).To(HaveOccurred())
+				Expect(set).To(BeFalse())
+			})
+		})
+	})
+
+	Describe("ContinueBuild", func() {
+		var (
+			status int
+
+			continued bool
+			err       error
+		)
+
+		BeforeEach(func() {
+			status = http.StatusNoContent
+		})
+
+		JustBeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("PUT", "/api/v1/builds/123/continue"),
+					ghttp.RespondWith(status, ""),
+				),
+			)
+
+			continued, err = client.ContinueBuild("123")
+		})
+
+		It("continues the build", func() {
+			Expect(err).NotTo(HaveOccurred())
+			Expect(continued).To(BeTrue())
+			Expect(atcServer.ReceivedRequests()).To(HaveLen(1))
+		})
+
+		Context("when the build is not paused", func() {
+			BeforeEach(func() {
+				status = http.StatusConflict
+			})
+
+			It("returns false", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(continued).To(BeFalse())
+			})
+		})
+
+		Context("when the user may not continue the build", func() {
+			BeforeEach(func() {
+				status = http.StatusForbidden
+			})
+
+			It("returns an error", func() {
+				Expect(err).To(HaveOccurred())
+				Expect(continued).To(BeFalse())
+			})
+		})
+	})
+
 	Describe("team.Builds", func() {
 		expectedURL := "/api/v1/teams/some-team/builds"
 
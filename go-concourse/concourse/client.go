@@ -20,7 +20,11 @@ type Client interface {
 	BuildResources(buildID int) (atc.BuildInputsOutputs, bool, error)
 	ListBuildArtifacts(buildID string) ([]atc.WorkerArtifact, error)
 	AbortBuild(buildID string) error
+	ApproveBuild(buildID string, approval atc.ApprovalRequest) (bool, error)
+	SetBuildBreakpoint(buildID string, step string, position atc.BreakpointPosition) (bool, error)
+	ContinueBuild(buildID string) (bool, error)
 	BuildPlan(buildID int) (atc.PublicBuildPlan, bool, error)
+	BuildTests(buildID int) (atc.TestReport, bool, error)
 	SaveWorker(atc.Worker, *time.Duration) (*atc.Worker, error)
 	ListWorkers() ([]atc.Worker, error)
 	PruneWorker(workerName string) error
@@ -23,6 +23,20 @@ type FakeClient struct {
 	abortBuildReturnsOnCall map[int]struct {
 		result1 error
 	}
+	ApproveBuildStub        func(string, atc.ApprovalRequest) (bool, error)
+	approveBuildMutex       sync.RWMutex
+	approveBuildArgsForCall []struct {
+		arg1 string
+		arg2 atc.ApprovalRequest
+	}
+	approveBuildReturns struct {
+		result1 bool
+		result2 error
+	}
+	approveBuildReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
 	BuildStub        func(string) (atc.Build, bool, error)
 	buildMutex       sync.RWMutex
 	buildArgsForCall []struct {
@@ -81,6 +95,21 @@ type FakeClient struct {
 		result2 bool
 		result3 error
 	}
+	BuildTestsStub        func(int) (atc.TestReport, bool, error)
+	buildTestsMutex       sync.RWMutex
+	buildTestsArgsForCall []struct {
+		arg1 int
+	}
+	buildTestsReturns struct {
+		result1 atc.TestReport
+		result2 bool
+		result3 error
+	}
+	buildTestsReturnsOnCall map[int]struct {
+		result1 atc.TestReport
+		result2 bool
+		result3 error
+	}
 	BuildsStub        func(concourse.Page) ([]atc.Build, concourse.Pagination, error)
 	buildsMutex       sync.RWMutex
 	buildsArgsForCall []struct {
@@ -111,6 +140,19 @@ type FakeClient struct {
 		result2 bool
 		result3 error
 	}
+	ContinueBuildStub        func(string) (bool, error)
+	continueBuildMutex       sync.RWMutex
+	continueBuildArgsForCall []struct {
+		arg1 string
+	}
+	continueBuildReturns struct {
+		result1 bool
+		result2 error
+	}
+	continueBuildReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
 	FindTeamStub        func(string) (concourse.Team, error)
 	findTeamMutex       sync.RWMutex
 	findTeamArgsForCall []struct {
@@ -260,6 +302,21 @@ type FakeClient struct {
 		result1 *atc.Worker
 		result2 error
 	}
+	SetBuildBreakpointStub        func(string, string, atc.BreakpointPosition) (bool, error)
+	setBuildBreakpointMutex       sync.RWMutex
+	setBuildBreakpointArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 atc.BreakpointPosition
+	}
+	setBuildBreakpointReturns struct {
+		result1 bool
+		result2 error
+	}
+	setBuildBreakpointReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
 	TeamStub        func(string) concourse.Team
 	teamMutex       sync.RWMutex
 	teamArgsForCall []struct {
@@ -357,6 +414,70 @@ func (fake *FakeClient) AbortBuildReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeClient) ApproveBuild(arg1 string, arg2 atc.ApprovalRequest) (bool, error) {
+	fake.approveBuildMutex.Lock()
+	ret, specificReturn := fake.approveBuildReturnsOnCall[len(fake.approveBuildArgsForCall)]
+	fake.approveBuildArgsForCall = append(fake.approveBuildArgsForCall, struct {
+		arg1 string
+		arg2 atc.ApprovalRequest
+	}{arg1, arg2})
+	fake.recordInvocation("ApproveBuild", []interface{}{arg1, arg2})
+	fake.approveBuildMutex.Unlock()
+	if fake.ApproveBuildStub != nil {
+		return fake.ApproveBuildStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.approveBuildReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) ApproveBuildCallCount() int {
+	fake.approveBuildMutex.RLock()
+	defer fake.approveBuildMutex.RUnlock()
+	return len(fake.approveBuildArgsForCall)
+}
+
+func (fake *FakeClient) ApproveBuildCalls(stub func(string, atc.ApprovalRequest) (bool, error)) {
+	fake.approveBuildMutex.Lock()
+	defer fake.approveBuildMutex.Unlock()
+	fake.ApproveBuildStub = stub
+}
+
+func (fake *FakeClient) ApproveBuildArgsForCall(i int) (string, atc.ApprovalRequest) {
+	fake.approveBuildMutex.RLock()
+	defer fake.approveBuildMutex.RUnlock()
+	argsForCall := fake.approveBuildArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeClient) ApproveBuildReturns(result1 bool, result2 error) {
+	fake.approveBuildMutex.Lock()
+	defer fake.approveBuildMutex.Unlock()
+	fake.ApproveBuildStub = nil
+	fake.approveBuildReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) ApproveBuildReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.approveBuildMutex.Lock()
+	defer fake.approveBuildMutex.Unlock()
+	fake.ApproveBuildStub = nil
+	if fake.approveBuildReturnsOnCall == nil {
+		fake.approveBuildReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.approveBuildReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeClient) Build(arg1 string) (atc.Build, bool, error) {
 	fake.buildMutex.Lock()
 	ret, specificReturn := fake.buildReturnsOnCall[len(fake.buildArgsForCall)]
@@ -618,6 +739,72 @@ func (fake *FakeClient) BuildResourcesReturnsOnCall(i int, result1 atc.BuildInpu
 	}{result1, result2, result3}
 }
 
+func (fake *FakeClient) BuildTests(arg1 int) (atc.TestReport, bool, error) {
+	fake.buildTestsMutex.Lock()
+	ret, specificReturn := fake.buildTestsReturnsOnCall[len(fake.buildTestsArgsForCall)]
+	fake.buildTestsArgsForCall = append(fake.buildTestsArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.recordInvocation("BuildTests", []interface{}{arg1})
+	fake.buildTestsMutex.Unlock()
+	if fake.BuildTestsStub != nil {
+		return fake.BuildTestsStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.buildTestsReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeClient) BuildTestsCallCount() int {
+	fake.buildTestsMutex.RLock()
+	defer fake.buildTestsMutex.RUnlock()
+	return len(fake.buildTestsArgsForCall)
+}
+
+func (fake *FakeClient) BuildTestsCalls(stub func(int) (atc.TestReport, bool, error)) {
+	fake.buildTestsMutex.Lock()
+	defer fake.buildTestsMutex.Unlock()
+	fake.BuildTestsStub = stub
+}
+
+func (fake *FakeClient) BuildTestsArgsForCall(i int) int {
+	fake.buildTestsMutex.RLock()
+	defer fake.buildTestsMutex.RUnlock()
+	argsForCall := fake.buildTestsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeClient) BuildTestsReturns(result1 atc.TestReport, result2 bool, result3 error) {
+	fake.buildTestsMutex.Lock()
+	defer fake.buildTestsMutex.Unlock()
+	fake.BuildTestsStub = nil
+	fake.buildTestsReturns = struct {
+		result1 atc.TestReport
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeClient) BuildTestsReturnsOnCall(i int, result1 atc.TestReport, result2 bool, result3 error) {
+	fake.buildTestsMutex.Lock()
+	defer fake.buildTestsMutex.Unlock()
+	fake.BuildTestsStub = nil
+	if fake.buildTestsReturnsOnCall == nil {
+		fake.buildTestsReturnsOnCall = make(map[int]struct {
+			result1 atc.TestReport
+			result2 bool
+			result3 error
+		})
+	}
+	fake.buildTestsReturnsOnCall[i] = struct {
+		result1 atc.TestReport
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeClient) Builds(arg1 concourse.Page) ([]atc.Build, concourse.Pagination, error) {
 	fake.buildsMutex.Lock()
 	ret, specificReturn := fake.buildsReturnsOnCall[len(fake.buildsArgsForCall)]
@@ -750,6 +937,69 @@ func (fake *FakeClient) CheckReturnsOnCall(i int, result1 atc.Check, result2 boo
 	}{result1, result2, result3}
 }
 
+func (fake *FakeClient) ContinueBuild(arg1 string) (bool, error) {
+	fake.continueBuildMutex.Lock()
+	ret, specificReturn := fake.continueBuildReturnsOnCall[len(fake.continueBuildArgsForCall)]
+	fake.continueBuildArgsForCall = append(fake.continueBuildArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("ContinueBuild", []interface{}{arg1})
+	fake.continueBuildMutex.Unlock()
+	if fake.ContinueBuildStub != nil {
+		return fake.ContinueBuildStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.continueBuildReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) ContinueBuildCallCount() int {
+	fake.continueBuildMutex.RLock()
+	defer fake.continueBuildMutex.RUnlock()
+	return len(fake.continueBuildArgsForCall)
+}
+
+func (fake *FakeClient) ContinueBuildCalls(stub func(string) (bool, error)) {
+	fake.continueBuildMutex.Lock()
+	defer fake.continueBuildMutex.Unlock()
+	fake.ContinueBuildStub = stub
+}
+
+func (fake *FakeClient) ContinueBuildArgsForCall(i int) string {
+	fake.continueBuildMutex.RLock()
+	defer fake.continueBuildMutex.RUnlock()
+	argsForCall := fake.continueBuildArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeClient) ContinueBuildReturns(result1 bool, result2 error) {
+	fake.continueBuildMutex.Lock()
+	defer fake.continueBuildMutex.Unlock()
+	fake.ContinueBuildStub = nil
+	fake.continueBuildReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) ContinueBuildReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.continueBuildMutex.Lock()
+	defer fake.continueBuildMutex.Unlock()
+	fake.ContinueBuildStub = nil
+	if fake.continueBuildReturnsOnCall == nil {
+		fake.continueBuildReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.continueBuildReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeClient) FindTeam(arg1 string) (concourse.Team, error) {
 	fake.findTeamMutex.Lock()
 	ret, specificReturn := fake.findTeamReturnsOnCall[len(fake.findTeamArgsForCall)]
@@ -1462,6 +1712,71 @@ func (fake *FakeClient) SaveWorkerReturnsOnCall(i int, result1 *atc.Worker, resu
 	}{result1, result2}
 }
 
+func (fake *FakeClient) SetBuildBreakpoint(arg1 string, arg2 string, arg3 atc.BreakpointPosition) (bool, error) {
+	fake.setBuildBreakpointMutex.Lock()
+	ret, specificReturn := fake.setBuildBreakpointReturnsOnCall[len(fake.setBuildBreakpointArgsForCall)]
+	fake.setBuildBreakpointArgsForCall = append(fake.setBuildBreakpointArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 atc.BreakpointPosition
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("SetBuildBreakpoint", []interface{}{arg1, arg2, arg3})
+	fake.setBuildBreakpointMutex.Unlock()
+	if fake.SetBuildBreakpointStub != nil {
+		return fake.SetBuildBreakpointStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.setBuildBreakpointReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) SetBuildBreakpointCallCount() int {
+	fake.setBuildBreakpointMutex.RLock()
+	defer fake.setBuildBreakpointMutex.RUnlock()
+	return len(fake.setBuildBreakpointArgsForCall)
+}
+
+func (fake *FakeClient) SetBuildBreakpointCalls(stub func(string, string, atc.BreakpointPosition) (bool, error)) {
+	fake.setBuildBreakpointMutex.Lock()
+	defer fake.setBuildBreakpointMutex.Unlock()
+	fake.SetBuildBreakpointStub = stub
+}
+
+func (fake *FakeClient) SetBuildBreakpointArgsForCall(i int) (string, string, atc.BreakpointPosition) {
+	fake.setBuildBreakpointMutex.RLock()
+	defer fake.setBuildBreakpointMutex.RUnlock()
+	argsForCall := fake.setBuildBreakpointArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeClient) SetBuildBreakpointReturns(result1 bool, result2 error) {
+	fake.setBuildBreakpointMutex.Lock()
+	defer fake.setBuildBreakpointMutex.Unlock()
+	fake.SetBuildBreakpointStub = nil
+	fake.setBuildBreakpointReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) SetBuildBreakpointReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.setBuildBreakpointMutex.Lock()
+	defer fake.setBuildBreakpointMutex.Unlock()
+	fake.SetBuildBreakpointStub = nil
+	if fake.setBuildBreakpointReturnsOnCall == nil {
+		fake.setBuildBreakpointReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.setBuildBreakpointReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeClient) Team(arg1 string) concourse.Team {
 	fake.teamMutex.Lock()
 	ret, specificReturn := fake.teamReturnsOnCall[len(fake.teamArgsForCall)]
@@ -1634,6 +1949,8 @@ func (fake *FakeClient) Invocations() map[string][][]interface{} {
 	defer fake.invocationsMutex.RUnlock()
 	fake.abortBuildMutex.RLock()
 	defer fake.abortBuildMutex.RUnlock()
+	fake.approveBuildMutex.RLock()
+	defer fake.approveBuildMutex.RUnlock()
 	fake.buildMutex.RLock()
 	defer fake.buildMutex.RUnlock()
 	fake.buildEventsMutex.RLock()
@@ -1642,10 +1959,14 @@ func (fake *FakeClient) Invocations() map[string][][]interface{} {
 	defer fake.buildPlanMutex.RUnlock()
 	fake.buildResourcesMutex.RLock()
 	defer fake.buildResourcesMutex.RUnlock()
+	fake.buildTestsMutex.RLock()
+	defer fake.buildTestsMutex.RUnlock()
 	fake.buildsMutex.RLock()
 	defer fake.buildsMutex.RUnlock()
 	fake.checkMutex.RLock()
 	defer fake.checkMutex.RUnlock()
+	fake.continueBuildMutex.RLock()
+	defer fake.continueBuildMutex.RUnlock()
 	fake.findTeamMutex.RLock()
 	defer fake.findTeamMutex.RUnlock()
 	fake.getCLIReaderMutex.RLock()
@@ -1670,6 +1991,8 @@ func (fake *FakeClient) Invocations() map[string][][]interface{} {
 	defer fake.pruneWorkerMutex.RUnlock()
 	fake.saveWorkerMutex.RLock()
 	defer fake.saveWorkerMutex.RUnlock()
+	fake.setBuildBreakpointMutex.RLock()
+	defer fake.setBuildBreakpointMutex.RUnlock()
 	fake.teamMutex.RLock()
 	defer fake.teamMutex.RUnlock()
 	fake.uRLMutex.RLock()
@@ -39,7 +39,7 @@ var _ = Describe("ATC Handler Jobs", func() {
 		})
 
 		It("returns jobs that belong to the pipeline", func() {
-			pipelines, err := team.ListJobs("mypipeline")
+			pipelines, err := team.ListJobs(atc.PipelineRef{Name: "mypipeline"})
 			Expect(err).NotTo(HaveOccurred())
 			Expect(pipelines).To(Equal(expectedJobs))
 		})
@@ -101,7 +101,7 @@ var _ = Describe("ATC Handler Jobs", func() {
 			})
 
 			It("returns the given job for that pipeline", func() {
-				job, found, err := team.Job("mypipeline", "myjob")
+				job, found, err := team.Job(atc.PipelineRef{Name: "mypipeline"}, "myjob")
 				Expect(err).NotTo(HaveOccurred())
 				Expect(job).To(Equal(expectedJob))
 				Expect(found).To(BeTrue())
@@ -121,7 +121,7 @@ var _ = Describe("ATC Handler Jobs", func() {
 			})
 
 			It("returns false and no error", func() {
-				_, found, err := team.Job("mypipeline", "myjob")
+				_, found, err := team.Job(atc.PipelineRef{Name: "mypipeline"}, "myjob")
 				Expect(err).NotTo(HaveOccurred())
 				Expect(found).To(BeFalse())
 			})
@@ -159,7 +159,7 @@ var _ = Describe("ATC Handler Jobs", func() {
 			})
 
 			It("calls to get all builds", func() {
-				builds, _, found, err := team.JobBuilds("mypipeline", "myjob", concourse.Page{})
+				builds, _, found, err := team.JobBuilds(atc.PipelineRef{Name: "mypipeline"}, "myjob", concourse.Page{})
 				Expect(err).NotTo(HaveOccurred())
 				Expect(found).To(BeTrue())
 				Expect(builds).To(Equal(expectedBuilds))
@@ -173,7 +173,7 @@ var _ = Describe("ATC Handler Jobs", func() {
 			})
 
 			It("calls to get all builds since that id", func() {
-				builds, _, found, err := team.JobBuilds("mypipeline", "myjob", concourse.Page{Since: 24})
+				builds, _, found, err := team.JobBuilds(atc.PipelineRef{Name: "mypipeline"}, "myjob", concourse.Page{Since: 24})
 				Expect(err).NotTo(HaveOccurred())
 				Expect(found).To(BeTrue())
 				Expect(builds).To(Equal(expectedBuilds))
@@ -185,7 +185,7 @@ var _ = Describe("ATC Handler Jobs", func() {
 				})
 
 				It("appends limit to the url", func() {
-					builds, _, found, err := team.JobBuilds("mypipeline", "myjob", concourse.Page{Since: 24, Limit: 5})
+					builds, _, found, err := team.JobBuilds(atc.PipelineRef{Name: "mypipeline"}, "myjob", concourse.Page{Since: 24, Limit: 5})
 					Expect(err).NotTo(HaveOccurred())
 					Expect(found).To(BeTrue())
 					Expect(builds).To(Equal(expectedBuilds))
@@ -200,7 +200,7 @@ var _ = Describe("ATC Handler Jobs", func() {
 			})
 
 			It("calls to get all builds until that id", func() {
-				builds, _, found, err := team.JobBuilds("mypipeline", "myjob", concourse.Page{Until: 26})
+				builds, _, found, err := team.JobBuilds(atc.PipelineRef{Name: "mypipeline"}, "myjob", concourse.Page{Until: 26})
 				Expect(err).NotTo(HaveOccurred())
 				Expect(found).To(BeTrue())
 				Expect(builds).To(Equal(expectedBuilds))
@@ -212,7 +212,7 @@ var _ = Describe("ATC Handler Jobs", func() {
 				})
 
 				It("appends limit to the url", func() {
-					builds, _, found, err := team.JobBuilds("mypipeline", "myjob", concourse.Page{Until: 26, Limit: 15})
+					builds, _, found, err := team.JobBuilds(atc.PipelineRef{Name: "mypipeline"}, "myjob", concourse.Page{Until: 26, Limit: 15})
 					Expect(err).NotTo(HaveOccurred())
 					Expect(found).To(BeTrue())
 					Expect(builds).To(Equal(expectedBuilds))
@@ -227,7 +227,22 @@ var _ = Describe("ATC Handler Jobs", func() {
 			})
 
 			It("sends both the since and the until", func() {
-				builds, _, found, err := team.JobBuilds("mypipeline", "myjob", concourse.Page{Since: 24, Until: 26})
+				builds, _, found, err := team.JobBuilds(atc.PipelineRef{Name: "mypipeline"}, "myjob", concourse.Page{Since: 24, Until: 26})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(builds).To(Equal(expectedBuilds))
+			})
+		})
+
+		Context("when the pipeline is an instance", func() {
+			BeforeEach(func() {
+				expectedURL = fmt.Sprint("/api/v1/teams/some-team/pipelines/mypipeline/jobs/myjob/builds")
+				expectedQuery = fmt.Sprint(`instance_vars={"branch":"master"}&since=24`)
+			})
+
+			It("sends the instance vars along with the page", func() {
+				pipelineRef := atc.PipelineRef{Name: "mypipeline", InstanceVars: atc.InstanceVars{"branch": "master"}}
+				builds, _, found, err := team.JobBuilds(pipelineRef, "myjob", concourse.Page{Since: 24})
 				Expect(err).NotTo(HaveOccurred())
 				Expect(found).To(BeTrue())
 				Expect(builds).To(Equal(expectedBuilds))
@@ -247,7 +262,7 @@ var _ = Describe("ATC Handler Jobs", func() {
 			})
 
 			It("returns false and an error", func() {
-				_, _, found, err := team.JobBuilds("mypipeline", "myjob", concourse.Page{})
+				_, _, found, err := team.JobBuilds(atc.PipelineRef{Name: "mypipeline"}, "myjob", concourse.Page{})
 				Expect(err).To(HaveOccurred())
 				Expect(found).To(BeFalse())
 			})
@@ -266,7 +281,7 @@ var _ = Describe("ATC Handler Jobs", func() {
 			})
 
 			It("returns false and no error", func() {
-				_, _, found, err := team.JobBuilds("mypipeline", "myjob", concourse.Page{})
+				_, _, found, err := team.JobBuilds(atc.PipelineRef{Name: "mypipeline"}, "myjob", concourse.Page{})
 				Expect(err).NotTo(HaveOccurred())
 				Expect(found).To(BeFalse())
 			})
@@ -291,7 +306,7 @@ var _ = Describe("ATC Handler Jobs", func() {
 				})
 
 				It("returns the pagination data from the header", func() {
-					_, pagination, _, err := team.JobBuilds("mypipeline", "myjob", concourse.Page{})
+					_, pagination, _, err := team.JobBuilds(atc.PipelineRef{Name: "mypipeline"}, "myjob", concourse.Page{})
 					Expect(err).ToNot(HaveOccurred())
 
 					Expect(pagination.Previous).To(Equal(&concourse.Page{Since: 452, Limit: 123}))
@@ -313,7 +328,7 @@ var _ = Describe("ATC Handler Jobs", func() {
 			})
 
 			It("returns pagination data with nil pages", func() {
-				_, pagination, _, err := team.JobBuilds("mypipeline", "myjob", concourse.Page{})
+				_, pagination, _, err := team.JobBuilds(atc.PipelineRef{Name: "mypipeline"}, "myjob", concourse.Page{})
 				Expect(err).ToNot(HaveOccurred())
 
 				Expect(pagination.Previous).To(BeNil())
@@ -346,7 +361,7 @@ var _ = Describe("ATC Handler Jobs", func() {
 
 			It("calls the pause job and returns no error", func() {
 				Expect(func() {
-					paused, err := team.PauseJob(pipelineName, jobName)
+					paused, err := team.PauseJob(atc.PipelineRef{Name: pipelineName}, jobName)
 					Expect(err).NotTo(HaveOccurred())
 					Expect(paused).To(BeTrue())
 				}).To(Change(func() int {
@@ -362,7 +377,7 @@ var _ = Describe("ATC Handler Jobs", func() {
 
 			It("calls the pause job and returns an error", func() {
 				Expect(func() {
-					paused, err := team.PauseJob(pipelineName, jobName)
+					paused, err := team.PauseJob(atc.PipelineRef{Name: pipelineName}, jobName)
 					Expect(err).To(HaveOccurred())
 					Expect(paused).To(BeFalse())
 				}).To(Change(func() int {
@@ -378,7 +393,7 @@ var _ = Describe("ATC Handler Jobs", func() {
 
 			It("calls the pause job and returns an error", func() {
 				Expect(func() {
-					paused, err := team.PauseJob(pipelineName, jobName)
+					paused, err := team.PauseJob(atc.PipelineRef{Name: pipelineName}, jobName)
 					Expect(err).ToNot(HaveOccurred())
 					Expect(paused).To(BeFalse())
 				}).To(Change(func() int {
@@ -412,7 +427,7 @@ var _ = Describe("ATC Handler Jobs", func() {
 
 			It("calls the pause job and returns no error", func() {
 				Expect(func() {
-					paused, err := team.UnpauseJob(pipelineName, jobName)
+					paused, err := team.UnpauseJob(atc.PipelineRef{Name: pipelineName}, jobName)
 					Expect(err).NotTo(HaveOccurred())
 					Expect(paused).To(BeTrue())
 				}).To(Change(func() int {
@@ -428,7 +443,7 @@ var _ = Describe("ATC Handler Jobs", func() {
 
 			It("calls the pause job and returns an error", func() {
 				Expect(func() {
-					paused, err := team.UnpauseJob(pipelineName, jobName)
+					paused, err := team.UnpauseJob(atc.PipelineRef{Name: pipelineName}, jobName)
 					Expect(err).To(HaveOccurred())
 					Expect(paused).To(BeFalse())
 				}).To(Change(func() int {
@@ -444,7 +459,7 @@ var _ = Describe("ATC Handler Jobs", func() {
 
 			It("calls the pause job and returns an error", func() {
 				Expect(func() {
-					paused, err := team.UnpauseJob(pipelineName, jobName)
+					paused, err := team.UnpauseJob(atc.PipelineRef{Name: pipelineName}, jobName)
 					Expect(err).ToNot(HaveOccurred())
 					Expect(paused).To(BeFalse())
 				}).To(Change(func() int {
@@ -478,7 +493,7 @@ var _ = Describe("ATC Handler Jobs", func() {
 
 			It("calls the schedule job and returns no error", func() {
 				Expect(func() {
-					requested, err := team.ScheduleJob(pipelineName, jobName)
+					requested, err := team.ScheduleJob(atc.PipelineRef{Name: pipelineName}, jobName)
 					Expect(err).NotTo(HaveOccurred())
 					Expect(requested).To(BeTrue())
 				}).To(Change(func() int {
@@ -494,7 +509,7 @@ var _ = Describe("ATC Handler Jobs", func() {
 
 			It("calls the schedule job and returns an error", func() {
 				Expect(func() {
-					requested, err := team.ScheduleJob(pipelineName, jobName)
+					requested, err := team.ScheduleJob(atc.PipelineRef{Name: pipelineName}, jobName)
 					Expect(err).To(HaveOccurred())
 					Expect(requested).To(BeFalse())
 				}).To(Change(func() int {
@@ -510,7 +525,7 @@ var _ = Describe("ATC Handler Jobs", func() {
 
 			It("calls the schedule job and returns an error", func() {
 				Expect(func() {
-					requested, err := team.ScheduleJob(pipelineName, jobName)
+					requested, err := team.ScheduleJob(atc.PipelineRef{Name: pipelineName}, jobName)
 					Expect(err).ToNot(HaveOccurred())
 					Expect(requested).To(BeFalse())
 				}).To(Change(func() int {
@@ -544,7 +559,7 @@ var _ = Describe("ATC Handler Jobs", func() {
 			Context("when no cache path is given", func() {
 				It("succeeds", func() {
 					Expect(func() {
-						numDeleted, err := team.ClearTaskCache("mypipeline", "myjob", "mystep", "")
+						numDeleted, err := team.ClearTaskCache(atc.PipelineRef{Name: "mypipeline"}, "myjob", "mystep", "")
 						Expect(err).NotTo(HaveOccurred())
 						Expect(numDeleted).To(Equal(int64(1)))
 					}).To(Change(func() int {
@@ -557,7 +572,7 @@ var _ = Describe("ATC Handler Jobs", func() {
 				Context("when the cache path exists", func() {
 					It("succeeds", func() {
 						Expect(func() {
-							numDeleted, err := team.ClearTaskCache("mypipeline", "myjob", "mystep", "mycachepath")
+							numDeleted, err := team.ClearTaskCache(atc.PipelineRef{Name: "mypipeline"}, "myjob", "mystep", "mycachepath")
 							Expect(err).NotTo(HaveOccurred())
 							Expect(numDeleted).To(Equal(int64(1)))
 						}).To(Change(func() int {
@@ -582,7 +597,7 @@ var _ = Describe("ATC Handler Jobs", func() {
 
 			It("returns that 0 caches were deleted", func() {
 				Expect(func() {
-					numDeleted, err := team.ClearTaskCache("mypipeline", "myjob", "my-nonexistent-step", "mycachepath")
+					numDeleted, err := team.ClearTaskCache(atc.PipelineRef{Name: "mypipeline"}, "myjob", "my-nonexistent-step", "mycachepath")
 					Expect(err).NotTo(HaveOccurred())
 					Expect(numDeleted).To(BeZero())
 				}).To(Change(func() int {
@@ -25,7 +25,7 @@ var _ = Describe("ATC Handler Pipelines", func() {
 			})
 
 			It("return true and no error", func() {
-				found, err := team.PausePipeline("mypipeline")
+				found, err := team.PausePipeline(atc.PipelineRef{Name: "mypipeline"})
 				Expect(err).NotTo(HaveOccurred())
 				Expect(found).To(BeTrue())
 			})
@@ -42,7 +42,7 @@ var _ = Describe("ATC Handler Pipelines", func() {
 				)
 			})
 			It("returns false and no error", func() {
-				found, err := team.PausePipeline("mypipeline")
+				found, err := team.PausePipeline(atc.PipelineRef{Name: "mypipeline"})
 				Expect(err).NotTo(HaveOccurred())
 				Expect(found).To(BeFalse())
 			})
@@ -62,7 +62,7 @@ var _ = Describe("ATC Handler Pipelines", func() {
 			})
 
 			It("return true and no error", func() {
-				found, err := team.ArchivePipeline("mypipeline")
+				found, err := team.ArchivePipeline(atc.PipelineRef{Name: "mypipeline"})
 				Expect(err).NotTo(HaveOccurred())
 				Expect(found).To(BeTrue())
 			})
@@ -80,7 +80,7 @@ var _ = Describe("ATC Handler Pipelines", func() {
 			})
 
 			It("returns false and no error", func() {
-				found, err := team.ArchivePipeline("mypipeline")
+				found, err := team.ArchivePipeline(atc.PipelineRef{Name: "mypipeline"})
 				Expect(err).NotTo(HaveOccurred())
 				Expect(found).To(BeFalse())
 			})
@@ -100,7 +100,7 @@ var _ = Describe("ATC Handler Pipelines", func() {
 			})
 
 			It("return true and no error", func() {
-				found, err := team.UnpausePipeline("mypipeline")
+				found, err := team.UnpausePipeline(atc.PipelineRef{Name: "mypipeline"})
 				Expect(err).NotTo(HaveOccurred())
 				Expect(found).To(BeTrue())
 			})
@@ -117,7 +117,7 @@ var _ = Describe("ATC Handler Pipelines", func() {
 				)
 			})
 			It("returns false and no error", func() {
-				found, err := team.UnpausePipeline("mypipeline")
+				found, err := team.UnpausePipeline(atc.PipelineRef{Name: "mypipeline"})
 				Expect(err).NotTo(HaveOccurred())
 				Expect(found).To(BeFalse())
 			})
@@ -137,7 +137,7 @@ var _ = Describe("ATC Handler Pipelines", func() {
 			})
 
 			It("return true and no error", func() {
-				found, err := team.ExposePipeline("mypipeline")
+				found, err := team.ExposePipeline(atc.PipelineRef{Name: "mypipeline"})
 				Expect(err).NotTo(HaveOccurred())
 				Expect(found).To(BeTrue())
 			})
@@ -154,7 +154,7 @@ var _ = Describe("ATC Handler Pipelines", func() {
 				)
 			})
 			It("returns false and no error", func() {
-				found, err := team.ExposePipeline("mypipeline")
+				found, err := team.ExposePipeline(atc.PipelineRef{Name: "mypipeline"})
 				Expect(err).NotTo(HaveOccurred())
 				Expect(found).To(BeFalse())
 			})
@@ -174,7 +174,7 @@ var _ = Describe("ATC Handler Pipelines", func() {
 			})
 
 			It("return true and no error", func() {
-				found, err := team.HidePipeline("mypipeline")
+				found, err := team.HidePipeline(atc.PipelineRef{Name: "mypipeline"})
 				Expect(err).NotTo(HaveOccurred())
 				Expect(found).To(BeTrue())
 			})
@@ -191,7 +191,7 @@ var _ = Describe("ATC Handler Pipelines", func() {
 				)
 			})
 			It("returns false and no error", func() {
-				found, err := team.HidePipeline("mypipeline")
+				found, err := team.HidePipeline(atc.PipelineRef{Name: "mypipeline"})
 				Expect(err).NotTo(HaveOccurred())
 				Expect(found).To(BeFalse())
 			})
@@ -279,7 +279,7 @@ var _ = Describe("ATC Handler Pipelines", func() {
 			})
 
 			It("returns the requested pipeline", func() {
-				pipeline, found, err := team.Pipeline(pipelineName)
+				pipeline, found, err := team.Pipeline(atc.PipelineRef{Name: pipelineName})
 				Expect(err).NotTo(HaveOccurred())
 				Expect(found).To(BeTrue())
 				Expect(pipeline).To(Equal(expectedPipeline))
@@ -297,7 +297,7 @@ var _ = Describe("ATC Handler Pipelines", func() {
 			})
 
 			It("returns false", func() {
-				_, found, err := team.Pipeline(pipelineName)
+				_, found, err := team.Pipeline(atc.PipelineRef{Name: pipelineName})
 				Expect(err).NotTo(HaveOccurred())
 				Expect(found).To(BeFalse())
 			})
@@ -411,7 +411,7 @@ var _ = Describe("ATC Handler Pipelines", func() {
 
 			It("deletes the pipeline when called", func() {
 				Expect(func() {
-					found, err := team.DeletePipeline("mypipeline")
+					found, err := team.DeletePipeline(atc.PipelineRef{Name: "mypipeline"})
 					Expect(err).NotTo(HaveOccurred())
 					Expect(found).To(BeTrue())
 				}).To(Change(func() int {
@@ -431,7 +431,7 @@ var _ = Describe("ATC Handler Pipelines", func() {
 			})
 
 			It("returns false and no error", func() {
-				found, err := team.DeletePipeline("mypipeline")
+				found, err := team.DeletePipeline(atc.PipelineRef{Name: "mypipeline"})
 				Expect(err).NotTo(HaveOccurred())
 				Expect(found).To(BeFalse())
 			})
@@ -453,7 +453,7 @@ var _ = Describe("ATC Handler Pipelines", func() {
 			})
 
 			It("renames the pipeline when called", func() {
-				renamed, err := team.RenamePipeline("mypipeline", "newpipelinename")
+				renamed, err := team.RenamePipeline(atc.PipelineRef{Name: "mypipeline"}, "newpipelinename")
 				Expect(err).NotTo(HaveOccurred())
 				Expect(renamed).To(BeTrue())
 			})
@@ -467,7 +467,7 @@ var _ = Describe("ATC Handler Pipelines", func() {
 			})
 
 			It("returns false and no error", func() {
-				renamed, err := team.RenamePipeline("mypipeline", "newpipelinename")
+				renamed, err := team.RenamePipeline(atc.PipelineRef{Name: "mypipeline"}, "newpipelinename")
 				Expect(err).NotTo(HaveOccurred())
 				Expect(renamed).To(BeFalse())
 			})
@@ -481,7 +481,7 @@ var _ = Describe("ATC Handler Pipelines", func() {
 			})
 
 			It("returns an error", func() {
-				renamed, err := team.RenamePipeline("mypipeline", "newpipelinename")
+				renamed, err := team.RenamePipeline(atc.PipelineRef{Name: "mypipeline"}, "newpipelinename")
 				Expect(err).To(MatchError(ContainSubstring("418 I'm a teapot")))
 				Expect(renamed).To(BeFalse())
 			})
@@ -529,7 +529,7 @@ var _ = Describe("ATC Handler Pipelines", func() {
 			})
 
 			It("returns the build and no error", func() {
-				build, err := team.CreatePipelineBuild("mypipeline", plan)
+				build, err := team.CreatePipelineBuild(atc.PipelineRef{Name: "mypipeline"}, plan)
 				Expect(err).NotTo(HaveOccurred())
 				Expect(build).To(Equal(expectedBuild))
 			})
@@ -570,7 +570,7 @@ var _ = Describe("ATC Handler Pipelines", func() {
 			})
 
 			It("calls to get all builds", func() {
-				builds, _, found, err := team.PipelineBuilds("mypipeline", concourse.Page{})
+				builds, _, found, err := team.PipelineBuilds(atc.PipelineRef{Name: "mypipeline"}, concourse.Page{})
 				Expect(err).NotTo(HaveOccurred())
 				Expect(found).To(BeTrue())
 				Expect(builds).To(Equal(expectedBuilds))
@@ -583,7 +583,7 @@ var _ = Describe("ATC Handler Pipelines", func() {
 			})
 
 			It("calls to get all builds since that id", func() {
-				builds, _, found, err := team.PipelineBuilds("mypipeline", concourse.Page{Since: 24})
+				builds, _, found, err := team.PipelineBuilds(atc.PipelineRef{Name: "mypipeline"}, concourse.Page{Since: 24})
 				Expect(err).NotTo(HaveOccurred())
 				Expect(found).To(BeTrue())
 				Expect(builds).To(Equal(expectedBuilds))
@@ -595,7 +595,7 @@ var _ = Describe("ATC Handler Pipelines", func() {
 				})
 
 				It("appends limit to the url", func() {
-					builds, _, found, err := team.PipelineBuilds("mypipeline", concourse.Page{Since: 24, Limit: 5})
+					builds, _, found, err := team.PipelineBuilds(atc.PipelineRef{Name: "mypipeline"}, concourse.Page{Since: 24, Limit: 5})
 					Expect(err).NotTo(HaveOccurred())
 					Expect(found).To(BeTrue())
 					Expect(builds).To(Equal(expectedBuilds))
@@ -609,7 +609,7 @@ var _ = Describe("ATC Handler Pipelines", func() {
 			})
 
 			It("calls to get all builds until that id", func() {
-				builds, _, found, err := team.PipelineBuilds("mypipeline", concourse.Page{Until: 26})
+				builds, _, found, err := team.PipelineBuilds(atc.PipelineRef{Name: "mypipeline"}, concourse.Page{Until: 26})
 				Expect(err).NotTo(HaveOccurred())
 				Expect(found).To(BeTrue())
 				Expect(builds).To(Equal(expectedBuilds))
@@ -621,7 +621,7 @@ var _ = Describe("ATC Handler Pipelines", func() {
 				})
 
 				It("appends limit to the url", func() {
-					builds, _, found, err := team.PipelineBuilds("mypipeline", concourse.Page{Until: 26, Limit: 15})
+					builds, _, found, err := team.PipelineBuilds(atc.PipelineRef{Name: "mypipeline"}, concourse.Page{Until: 26, Limit: 15})
 					Expect(err).NotTo(HaveOccurred())
 					Expect(found).To(BeTrue())
 					Expect(builds).To(Equal(expectedBuilds))
@@ -635,7 +635,7 @@ var _ = Describe("ATC Handler Pipelines", func() {
 			})
 
 			It("sends both since and until", func() {
-				builds, _, found, err := team.PipelineBuilds("mypipeline", concourse.Page{Since: 24, Until: 26})
+				builds, _, found, err := team.PipelineBuilds(atc.PipelineRef{Name: "mypipeline"}, concourse.Page{Since: 24, Until: 26})
 				Expect(err).NotTo(HaveOccurred())
 				Expect(found).To(BeTrue())
 				Expect(builds).To(Equal(expectedBuilds))
@@ -653,7 +653,7 @@ var _ = Describe("ATC Handler Pipelines", func() {
 			})
 
 			It("returns false and an error", func() {
-				_, _, found, err := team.PipelineBuilds("mypipeline", concourse.Page{})
+				_, _, found, err := team.PipelineBuilds(atc.PipelineRef{Name: "mypipeline"}, concourse.Page{})
 				Expect(err).To(HaveOccurred())
 				Expect(found).To(BeFalse())
 			})
@@ -670,7 +670,7 @@ var _ = Describe("ATC Handler Pipelines", func() {
 			})
 
 			It("returns false and no error", func() {
-				_, _, found, err := team.PipelineBuilds("mypipeline", concourse.Page{})
+				_, _, found, err := team.PipelineBuilds(atc.PipelineRef{Name: "mypipeline"}, concourse.Page{})
 				Expect(err).NotTo(HaveOccurred())
 				Expect(found).To(BeFalse())
 			})
@@ -693,7 +693,7 @@ var _ = Describe("ATC Handler Pipelines", func() {
 				})
 
 				It("returns the pagination data from the header", func() {
-					_, pagination, _, err := team.PipelineBuilds("mypipeline", concourse.Page{})
+					_, pagination, _, err := team.PipelineBuilds(atc.PipelineRef{Name: "mypipeline"}, concourse.Page{})
 					Expect(err).ToNot(HaveOccurred())
 
 					Expect(pagination.Previous).To(Equal(&concourse.Page{Since: 452, Limit: 123}))
@@ -713,7 +713,7 @@ var _ = Describe("ATC Handler Pipelines", func() {
 			})
 
 			It("returns pagination data with nil pages", func() {
-				_, pagination, _, err := team.PipelineBuilds("mypipeline", concourse.Page{})
+				_, pagination, _, err := team.PipelineBuilds(atc.PipelineRef{Name: "mypipeline"}, concourse.Page{})
 				Expect(err).ToNot(HaveOccurred())
 
 				Expect(pagination.Previous).To(BeNil())
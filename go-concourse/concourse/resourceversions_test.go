@@ -41,7 +41,7 @@ var _ = Describe("ATC Handler Resource Versions", func() {
 		})
 
 		JustBeforeEach(func() {
-			versions, pagination, found, clientErr = team.ResourceVersions("mypipeline", "myresource", page, filter)
+			versions, pagination, found, clientErr = team.ResourceVersions(atc.PipelineRef{Name: "mypipeline"}, "myresource", page, filter)
 		})
 
 		Context("when since, until, and limit are 0", func() {
@@ -275,7 +275,7 @@ var _ = Describe("ATC Handler Resource Versions", func() {
 
 			It("calls the disable resource and returns no error", func() {
 				Expect(func() {
-					disabled, err := team.DisableResourceVersion(pipelineName, resourceName, resourceVersionID)
+					disabled, err := team.DisableResourceVersion(atc.PipelineRef{Name: pipelineName}, resourceName, resourceVersionID)
 					Expect(err).NotTo(HaveOccurred())
 					Expect(disabled).To(BeTrue())
 				}).To(Change(func() int {
@@ -291,7 +291,7 @@ var _ = Describe("ATC Handler Resource Versions", func() {
 
 			It("calls the disable resource and returns an error", func() {
 				Expect(func() {
-					disabled, err := team.DisableResourceVersion(pipelineName, resourceName, resourceVersionID)
+					disabled, err := team.DisableResourceVersion(atc.PipelineRef{Name: pipelineName}, resourceName, resourceVersionID)
 					Expect(err).To(HaveOccurred())
 					Expect(disabled).To(BeFalse())
 				}).To(Change(func() int {
@@ -307,7 +307,7 @@ var _ = Describe("ATC Handler Resource Versions", func() {
 
 			It("calls the disable resource and returns an error", func() {
 				Expect(func() {
-					disabled, err := team.DisableResourceVersion(pipelineName, resourceName, resourceVersionID)
+					disabled, err := team.DisableResourceVersion(atc.PipelineRef{Name: pipelineName}, resourceName, resourceVersionID)
 					Expect(err).ToNot(HaveOccurred())
 					Expect(disabled).To(BeFalse())
 				}).To(Change(func() int {
@@ -342,7 +342,7 @@ var _ = Describe("ATC Handler Resource Versions", func() {
 
 			It("calls the enable resource and returns no error", func() {
 				Expect(func() {
-					enabled, err := team.EnableResourceVersion(pipelineName, resourceName, resourceVersionID)
+					enabled, err := team.EnableResourceVersion(atc.PipelineRef{Name: pipelineName}, resourceName, resourceVersionID)
 					Expect(err).NotTo(HaveOccurred())
 					Expect(enabled).To(BeTrue())
 				}).To(Change(func() int {
@@ -358,7 +358,7 @@ var _ = Describe("ATC Handler Resource Versions", func() {
 
 			It("calls the enable resource and returns an error", func() {
 				Expect(func() {
-					enabled, err := team.EnableResourceVersion(pipelineName, resourceName, resourceVersionID)
+					enabled, err := team.EnableResourceVersion(atc.PipelineRef{Name: pipelineName}, resourceName, resourceVersionID)
 					Expect(err).To(HaveOccurred())
 					Expect(enabled).To(BeFalse())
 				}).To(Change(func() int {
@@ -374,7 +374,7 @@ var _ = Describe("ATC Handler Resource Versions", func() {
 
 			It("calls the enable resource and returns an error", func() {
 				Expect(func() {
-					enabled, err := team.EnableResourceVersion(pipelineName, resourceName, resourceVersionID)
+					enabled, err := team.EnableResourceVersion(atc.PipelineRef{Name: pipelineName}, resourceName, resourceVersionID)
 					Expect(err).ToNot(HaveOccurred())
 					Expect(enabled).To(BeFalse())
 				}).To(Change(func() int {
@@ -411,7 +411,7 @@ var _ = Describe("ATC Handler Resource Versions", func() {
 
 			It("calls the pin resource and returns no error", func() {
 				Expect(func() {
-					pinned, err := team.PinResourceVersion(pipelineName, resourceName, resourceVersionID)
+					pinned, err := team.PinResourceVersion(atc.PipelineRef{Name: pipelineName}, resourceName, resourceVersionID)
 					Expect(err).ToNot(HaveOccurred())
 					Expect(pinned).To(BeTrue())
 				}).To(Change(func() int {
@@ -427,7 +427,7 @@ var _ = Describe("ATC Handler Resource Versions", func() {
 
 			It("calls the pin resource and returns an error", func() {
 				Expect(func() {
-					pinned, err := team.PinResourceVersion(pipelineName, resourceName, resourceVersionID)
+					pinned, err := team.PinResourceVersion(atc.PipelineRef{Name: pipelineName}, resourceName, resourceVersionID)
 					Expect(err).ToNot(HaveOccurred())
 					Expect(pinned).To(BeFalse())
 				}).To(Change(func() int {
@@ -443,7 +443,7 @@ var _ = Describe("ATC Handler Resource Versions", func() {
 
 			It("calls the pin resource and returns an error", func() {
 				Expect(func() {
-					pinned, err := team.PinResourceVersion(pipelineName, resourceName, resourceVersionID)
+					pinned, err := team.PinResourceVersion(atc.PipelineRef{Name: pipelineName}, resourceName, resourceVersionID)
 					Expect(err).To(HaveOccurred())
 					Expect(pinned).To(BeFalse())
 				}).To(Change(func() int {
@@ -477,7 +477,7 @@ var _ = Describe("ATC Handler Resource Versions", func() {
 
 			It("calls the unpin resource and returns no error", func() {
 				Expect(func() {
-					pinned, err := team.UnpinResource(pipelineName, resourceName)
+					pinned, err := team.UnpinResource(atc.PipelineRef{Name: pipelineName}, resourceName)
 					Expect(err).ToNot(HaveOccurred())
 					Expect(pinned).To(BeTrue())
 				}).To(Change(func() int {
@@ -493,7 +493,7 @@ var _ = Describe("ATC Handler Resource Versions", func() {
 
 			It("calls the unpin resource and returns an error", func() {
 				Expect(func() {
-					pinned, err := team.UnpinResource(pipelineName, resourceName)
+					pinned, err := team.UnpinResource(atc.PipelineRef{Name: pipelineName}, resourceName)
 					Expect(err).ToNot(HaveOccurred())
 					Expect(pinned).To(BeFalse())
 				}).To(Change(func() int {
@@ -509,7 +509,7 @@ var _ = Describe("ATC Handler Resource Versions", func() {
 
 			It("calls the unpin resource and returns an error", func() {
 				Expect(func() {
-					pinned, err := team.UnpinResource(pipelineName, resourceName)
+					pinned, err := team.UnpinResource(atc.PipelineRef{Name: pipelineName}, resourceName)
 					Expect(err).To(HaveOccurred())
 					Expect(pinned).To(BeFalse())
 				}).To(Change(func() int {
@@ -549,7 +549,7 @@ var _ = Describe("ATC Handler Resource Versions", func() {
 
 				It("calls set pin comment and returns no error", func() {
 					Expect(func() {
-						setComment, err := team.SetPinComment(pipelineName, resourceName, "some comment")
+						setComment, err := team.SetPinComment(atc.PipelineRef{Name: pipelineName}, resourceName, "some comment")
 						Expect(err).ToNot(HaveOccurred())
 						Expect(setComment).To(BeTrue())
 					}).To(Change(func() int {
@@ -566,7 +566,7 @@ var _ = Describe("ATC Handler Resource Versions", func() {
 
 			It("calls the pin comment and returns an error", func() {
 				Expect(func() {
-					setComment, err := team.SetPinComment(pipelineName, resourceName, "some comment")
+					setComment, err := team.SetPinComment(atc.PipelineRef{Name: pipelineName}, resourceName, "some comment")
 					Expect(err).ToNot(HaveOccurred())
 					Expect(setComment).To(BeFalse())
 				}).To(Change(func() int {
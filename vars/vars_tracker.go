@@ -22,6 +22,11 @@ type CredVarsTracker interface {
 	Enabled() bool
 
 	AddLocalVar(string, interface{}, bool)
+
+	// NewLocalScope returns a tracker whose local vars shadow this tracker's
+	// local vars without modifying them. Creds interpolated through the new
+	// scope are still tracked by this tracker.
+	NewLocalScope() CredVarsTracker
 }
 
 func NewCredVarsTracker(credVars Variables, on bool) CredVarsTracker {
@@ -31,7 +36,7 @@ func NewCredVarsTracker(credVars Variables, on bool) CredVarsTracker {
 		enabled:           on,
 		interpolatedCreds: map[string]string{},
 		noRedactVarNames:  map[string]bool{},
-		lock:              sync.RWMutex{},
+		lock:              &sync.RWMutex{},
 	}
 }
 
@@ -39,6 +44,8 @@ type credVarsTracker struct {
 	credVars  Variables
 	localVars StaticVariables
 
+	parentScope *credVarsTracker
+
 	enabled bool
 
 	interpolatedCreds map[string]string
@@ -46,7 +53,7 @@ type credVarsTracker struct {
 	noRedactVarNames map[string]bool
 
 	// Considering in-parallel steps, a lock is need.
-	lock sync.RWMutex
+	lock *sync.RWMutex
 }
 
 func (t *credVarsTracker) Get(varDef VariableDefinition) (interface{}, bool, error) {
@@ -60,13 +67,7 @@ func (t *credVarsTracker) Get(varDef VariableDefinition) (interface{}, bool, err
 	parts := strings.Split(varDef.Name, ":")
 	if len(parts) == 2 && parts[0] == "." {
 		varDef.Name = parts[1]
-		val, found, err = t.localVars.Get(varDef)
-		if found {
-			parts = strings.Split(varDef.Name, ".")
-			if _, ok := t.noRedactVarNames[parts[0]]; ok {
-				redact = false
-			}
-		}
+		val, found, redact, err = t.getLocalVar(varDef)
 	} else {
 		val, found, err = t.credVars.Get(varDef)
 	}
@@ -80,6 +81,21 @@ func (t *credVarsTracker) Get(varDef VariableDefinition) (interface{}, bool, err
 	return val, found, err
 }
 
+func (t *credVarsTracker) getLocalVar(varDef VariableDefinition) (interface{}, bool, bool, error) {
+	val, found, err := t.localVars.Get(varDef)
+	if found {
+		parts := strings.Split(varDef.Name, ".")
+		_, noRedact := t.noRedactVarNames[parts[0]]
+		return val, true, !noRedact, err
+	}
+
+	if t.parentScope != nil {
+		return t.parentScope.getLocalVar(varDef)
+	}
+
+	return val, found, true, err
+}
+
 func (t *credVarsTracker) track(name string, val interface{}) {
 	switch v := val.(type) {
 	case map[interface{}]interface{}:
@@ -122,6 +138,18 @@ func (t *credVarsTracker) AddLocalVar(name string, value interface{}, redact boo
 	}
 }
 
+func (t *credVarsTracker) NewLocalScope() CredVarsTracker {
+	return &credVarsTracker{
+		localVars:         StaticVariables{},
+		parentScope:       t,
+		credVars:          t.credVars,
+		enabled:           t.enabled,
+		interpolatedCreds: t.interpolatedCreds,
+		noRedactVarNames:  map[string]bool{},
+		lock:              t.lock,
+	}
+}
+
 // MapCredVarsTrackerIterator implements a simple CredVarsTrackerIterator which just
 // populate interpolated secrets into a map. This could be useful in unit test.
 
@@ -101,6 +101,59 @@ var _ = Describe("vars_tracker", func() {
 		})
 	})
 
+	Describe("NewLocalScope", func() {
+		var scope CredVarsTracker
+
+		BeforeEach(func() {
+			v := StaticVariables{"k1": "v1"}
+			tracker = NewCredVarsTracker(v, true)
+			tracker.AddLocalVar("foo", "bar", true)
+
+			scope = tracker.NewLocalScope()
+			scope.AddLocalVar("foo", "shadowed", false)
+			scope.AddLocalVar("baz", "qux", true)
+		})
+
+		It("shadows local vars from the parent scope", func() {
+			val, found, err := scope.Get(VariableDefinition{Name: ".:foo"})
+			Expect(err).To(BeNil())
+			Expect(found).To(BeTrue())
+			Expect(val).To(Equal("shadowed"))
+		})
+
+		It("does not modify the parent scope", func() {
+			val, found, err := tracker.Get(VariableDefinition{Name: ".:foo"})
+			Expect(err).To(BeNil())
+			Expect(found).To(BeTrue())
+			Expect(val).To(Equal("bar"))
+
+			_, found, err = tracker.Get(VariableDefinition{Name: ".:baz"})
+			Expect(err).To(BeNil())
+			Expect(found).To(BeFalse())
+		})
+
+		It("falls back to local vars from the parent scope", func() {
+			tracker.AddLocalVar("added-later", "value", true)
+
+			val, found, err := scope.Get(VariableDefinition{Name: ".:added-later"})
+			Expect(err).To(BeNil())
+			Expect(found).To(BeTrue())
+			Expect(val).To(Equal("value"))
+		})
+
+		It("tracks fetched variables in the parent scope", func() {
+			scope.Get(VariableDefinition{Name: "k1"})
+			scope.Get(VariableDefinition{Name: ".:foo"})
+			scope.Get(VariableDefinition{Name: ".:baz"})
+
+			mapit := NewMapCredVarsTrackerIterator()
+			tracker.IterateInterpolatedCreds(mapit)
+			Expect(mapit.Data["k1"]).To(Equal("v1"))
+			Expect(mapit.Data["foo"]).To(BeNil())
+			Expect(mapit.Data["baz"]).To(Equal("qux"))
+		})
+	})
+
 	Describe("turn off track", func() {
 		BeforeEach(func() {
 			v := StaticVariables{"k1": "v1", "k2": "v2", "k3": "v3"}
@@ -28,6 +28,7 @@ type Backend struct {
 	network       Network
 	rootfsManager RootfsManager
 	userNamespace UserNamespace
+	portPool      PortPool
 }
 
 //go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . UserNamespace
@@ -63,6 +64,14 @@ func WithKiller(k Killer) BackendOpt {
 	}
 }
 
+// WithPortPool configures the pool of host ports mapped to containers.
+//
+func WithPortPool(p PortPool) BackendOpt {
+	return func(b *Backend) {
+		b.portPool = p
+	}
+}
+
 // WithNetwork configures the network used by the backend.
 //
 func WithNetwork(n Network) BackendOpt {
@@ -103,10 +112,15 @@ func New(client libcontainerd.Client, opts ...BackendOpt) (b Backend, err error)
 		b.userNamespace = NewUserNamespace()
 	}
 
+	if b.portPool == nil {
+		b.portPool = NewPortPool(DefaultPortPoolStart, DefaultPortPoolSize)
+	}
+
 	return b, nil
 }
 
-// Start initializes the client.
+// Start initializes the client and sets up the rules which keep containers
+// from reaching the networks denied to them.
 //
 func (b *Backend) Start() (err error) {
 	err = b.client.Init()
@@ -114,9 +128,73 @@ func (b *Backend) Start() (err error) {
 		return fmt.Errorf("client init: %w", err)
 	}
 
+	err = b.network.SetupDeniedNetworks()
+	if err != nil {
+		return fmt.Errorf("setup denied networks: %w", err)
+	}
+
+	err = b.claimMappedPorts()
+	if err != nil {
+		return fmt.Errorf("claim mapped ports: %w", err)
+	}
+
 	return
 }
 
+// claimMappedPorts takes the host ports which are still mapped to containers
+// out of the port pool, which starts out empty.
+//
+func (b *Backend) claimMappedPorts() error {
+	ctx := context.Background()
+
+	containers, err := b.client.Containers(ctx)
+	if err != nil {
+		return fmt.Errorf("list containers: %w", err)
+	}
+
+	b.portPool.Lock()
+	defer b.portPool.Unlock()
+
+	for _, container := range containers {
+		labels, err := container.Labels(ctx)
+		if err != nil {
+			return fmt.Errorf("get labels: %w", err)
+		}
+
+		mappings, err := mappedPorts(labels)
+		if err != nil {
+			return err
+		}
+
+		for _, mapping := range mappings {
+			// a port bound on the host since is not handed out either,
+			// so it is fine for claiming it to fail
+			_ = b.portPool.Claim(mapping.HostPort)
+		}
+	}
+
+	return nil
+}
+
+// releaseMappedPorts gives the host ports mapped to a container back to the
+// port pool.
+//
+func (b *Backend) releaseMappedPorts(labels map[string]string) error {
+	mappings, err := mappedPorts(labels)
+	if err != nil {
+		return err
+	}
+
+	b.portPool.Lock()
+	defer b.portPool.Unlock()
+
+	for _, mapping := range mappings {
+		b.portPool.Release(mapping.HostPort)
+	}
+
+	return nil
+}
+
 // Stop closes the client's underlying connections and frees any resources
 // associated with it.
 //
@@ -157,7 +235,7 @@ func (b *Backend) Create(gdnSpec garden.ContainerSpec) (garden.Container, error)
 
 	oci.Mounts = append(oci.Mounts, netMounts...)
 
-	cont, err := b.client.NewContainer(ctx, gdnSpec.Handle, gdnSpec.Properties, oci)
+	cont, err := b.client.NewContainer(ctx, gdnSpec.Handle, withAppliedProperties(gdnSpec.Properties), oci)
 	if err != nil {
 		return nil, fmt.Errorf("new container: %w", err)
 	}
@@ -167,9 +245,11 @@ func (b *Backend) Create(gdnSpec garden.ContainerSpec) (garden.Container, error)
 		return nil, fmt.Errorf("new task: %w", err)
 	}
 
-	err = b.network.Add(ctx, task)
-	if err != nil {
-		return nil, fmt.Errorf("network add: %w", err)
+	if gdnSpec.Properties[NetworkKey] != NetworkNone {
+		err = b.network.Add(ctx, task)
+		if err != nil {
+			return nil, fmt.Errorf("network add: %w", err)
+		}
 	}
 
 	err = task.Start(ctx)
@@ -181,6 +261,8 @@ func (b *Backend) Create(gdnSpec garden.ContainerSpec) (garden.Container, error)
 		cont,
 		b.killer,
 		b.rootfsManager,
+		b.network,
+		b.portPool,
 	), nil
 }
 
@@ -217,9 +299,21 @@ func (b *Backend) Destroy(handle string) error {
 		return fmt.Errorf("gracefully killing task: %w", err)
 	}
 
-	err = b.network.Remove(ctx, task)
+	labels, err := container.Labels(ctx)
 	if err != nil {
-		return fmt.Errorf("network remove: %w", err)
+		return fmt.Errorf("get labels: %w", err)
+	}
+
+	if labels[NetworkKey] != NetworkNone {
+		err = b.network.Remove(ctx, task)
+		if err != nil {
+			return fmt.Errorf("network remove: %w", err)
+		}
+	}
+
+	err = b.releaseMappedPorts(labels)
+	if err != nil {
+		return err
 	}
 
 	_, err = task.Delete(ctx, containerd.WithProcessKill)
@@ -256,6 +350,8 @@ func (b *Backend) Containers(properties garden.Properties) (containers []garden.
 			containerdContainer,
 			b.killer,
 			b.rootfsManager,
+			b.network,
+			b.portPool,
 		)
 	}
 
@@ -278,6 +374,8 @@ func (b *Backend) Lookup(handle string) (garden.Container, error) {
 		containerdContainer,
 		b.killer,
 		b.rootfsManager,
+		b.network,
+		b.portPool,
 	), nil
 }
 
@@ -304,16 +402,52 @@ func (b *Backend) Capacity() (capacity garden.Capacity, err error) {
 	return
 }
 
-// BulkInfo - Not Implemented
+// BulkInfo returns the info of each of the containers with the given
+// handles. Failing to get the info of a container is reported in its entry
+// rather than failing the whole call.
 //
-func (b *Backend) BulkInfo(handles []string) (info map[string]garden.ContainerInfoEntry, err error) {
-	err = ErrNotImplemented
-	return
+func (b *Backend) BulkInfo(handles []string) (map[string]garden.ContainerInfoEntry, error) {
+	info := make(map[string]garden.ContainerInfoEntry, len(handles))
+
+	for _, handle := range handles {
+		var entry garden.ContainerInfoEntry
+
+		container, err := b.Lookup(handle)
+		if err == nil {
+			entry.Info, err = container.Info()
+		}
+
+		if err != nil {
+			entry.Err = garden.NewError(err.Error())
+		}
+
+		info[handle] = entry
+	}
+
+	return info, nil
 }
 
-// BulkMetrics - Not Implemented
+// BulkMetrics returns the metrics of each of the containers with the given
+// handles. Failing to get the metrics of a container is reported in its
+// entry rather than failing the whole call.
 //
-func (b *Backend) BulkMetrics(handles []string) (metrics map[string]garden.ContainerMetricsEntry, err error) {
-	err = ErrNotImplemented
-	return
+func (b *Backend) BulkMetrics(handles []string) (map[string]garden.ContainerMetricsEntry, error) {
+	metrics := make(map[string]garden.ContainerMetricsEntry, len(handles))
+
+	for _, handle := range handles {
+		var entry garden.ContainerMetricsEntry
+
+		container, err := b.Lookup(handle)
+		if err == nil {
+			entry.Metrics, err = container.Metrics()
+		}
+
+		if err != nil {
+			entry.Err = garden.NewError(err.Error())
+		}
+
+		metrics[handle] = entry
+	}
+
+	return metrics, nil
 }
@@ -1,6 +1,7 @@
 package backend_test
 
 import (
+	"context"
 	"errors"
 	"testing"
 	"time"
@@ -10,6 +11,7 @@ import (
 	"github.com/concourse/concourse/worker/backend"
 	"github.com/concourse/concourse/worker/backend/backendfakes"
 	"github.com/concourse/concourse/worker/backend/libcontainerd/libcontainerdfakes"
+	bespec "github.com/concourse/concourse/worker/backend/spec"
 	"github.com/containerd/containerd"
 	"github.com/containerd/containerd/errdefs"
 	"github.com/stretchr/testify/require"
@@ -20,11 +22,12 @@ type BackendSuite struct {
 	suite.Suite
 	*require.Assertions
 
-	backend backend.Backend
-	client  *libcontainerdfakes.FakeClient
-	network *backendfakes.FakeNetwork
-	userns  *backendfakes.FakeUserNamespace
-	killer  *backendfakes.FakeKiller
+	backend  backend.Backend
+	client   *libcontainerdfakes.FakeClient
+	network  *backendfakes.FakeNetwork
+	userns   *backendfakes.FakeUserNamespace
+	killer   *backendfakes.FakeKiller
+	portPool *backendfakes.FakePortPool
 }
 
 func (s *BackendSuite) SetupTest() {
@@ -32,12 +35,14 @@ func (s *BackendSuite) SetupTest() {
 	s.killer = new(backendfakes.FakeKiller)
 	s.network = new(backendfakes.FakeNetwork)
 	s.userns = new(backendfakes.FakeUserNamespace)
+	s.portPool = new(backendfakes.FakePortPool)
 
 	var err error
 	s.backend, err = backend.New(s.client,
 		backend.WithKiller(s.killer),
 		backend.WithNetwork(s.network),
 		backend.WithUserNamespace(s.userns),
+		backend.WithPortPool(s.portPool),
 	)
 	s.NoError(err)
 }
@@ -144,6 +149,68 @@ func (s *BackendSuite) TestCreateContainerSetsHandle() {
 
 }
 
+func (s *BackendSuite) TestCreateContainerAcknowledgesAppliedProperties() {
+	fakeTask := new(libcontainerdfakes.FakeTask)
+	fakeContainer := new(libcontainerdfakes.FakeContainer)
+
+	fakeContainer.NewTaskReturns(fakeTask, nil)
+	s.client.NewContainerReturns(fakeContainer, nil)
+
+	gdnSpec := minimumValidGdnSpec
+	gdnSpec.Properties = garden.Properties{
+		"user":                     "some-user",
+		bespec.TmpfsMountsProperty: "/run/secrets",
+	}
+
+	_, err := s.backend.Create(gdnSpec)
+	s.NoError(err)
+
+	s.Equal(1, s.client.NewContainerCallCount())
+	_, _, labels, _ := s.client.NewContainerArgsForCall(0)
+	s.Equal(map[string]string{
+		"user":                       "some-user",
+		bespec.TmpfsMountsProperty:   "/run/secrets",
+		backend.AppliedPropertiesKey: bespec.TmpfsMountsProperty,
+	}, labels)
+
+	s.Len(gdnSpec.Properties, 2)
+}
+
+func (s *BackendSuite) TestCreateContainerAddsToNetwork() {
+	fakeTask := new(libcontainerdfakes.FakeTask)
+	fakeContainer := new(libcontainerdfakes.FakeContainer)
+
+	fakeContainer.NewTaskReturns(fakeTask, nil)
+	s.client.NewContainerReturns(fakeContainer, nil)
+
+	_, err := s.backend.Create(minimumValidGdnSpec)
+	s.NoError(err)
+
+	s.Equal(1, s.network.AddCallCount())
+	_, task := s.network.AddArgsForCall(0)
+	s.Equal(fakeTask, task)
+}
+
+func (s *BackendSuite) TestCreateContainerWithNoNetwork() {
+	fakeTask := new(libcontainerdfakes.FakeTask)
+	fakeContainer := new(libcontainerdfakes.FakeContainer)
+
+	fakeContainer.NewTaskReturns(fakeTask, nil)
+	s.client.NewContainerReturns(fakeContainer, nil)
+
+	gdnSpec := minimumValidGdnSpec
+	gdnSpec.Properties = garden.Properties{backend.NetworkKey: backend.NetworkNone}
+
+	_, err := s.backend.Create(gdnSpec)
+	s.NoError(err)
+
+	s.Equal(0, s.network.AddCallCount())
+	s.Equal(1, fakeTask.StartCallCount())
+
+	_, _, labels, _ := s.client.NewContainerArgsForCall(0)
+	s.Equal(backend.NetworkKey, labels[backend.AppliedPropertiesKey])
+}
+
 func (s *BackendSuite) TestContainersWithContainerdFailure() {
 	s.client.ContainersReturns(nil, errors.New("err"))
 
@@ -254,6 +321,49 @@ func (s *BackendSuite) TestLookupGetContainer() {
 	s.Equal("handle", container.Handle())
 }
 
+func (s *BackendSuite) TestBulkMetricsReportsErrorsPerContainer() {
+	fakeContainer := new(libcontainerdfakes.FakeContainer)
+	fakeTask := new(libcontainerdfakes.FakeTask)
+	fakeContainer.TaskReturns(fakeTask, nil)
+	fakeTask.MetricsReturns(nil, errors.New("metrics-err"))
+
+	s.client.GetContainerStub = func(_ context.Context, handle string) (containerd.Container, error) {
+		if handle == "missing" {
+			return nil, errors.New("not found")
+		}
+
+		return fakeContainer, nil
+	}
+
+	metrics, err := s.backend.BulkMetrics([]string{"missing", "broken"})
+	s.NoError(err)
+	s.Len(metrics, 2)
+	s.Contains(metrics["missing"].Err.Error(), "not found")
+	s.Contains(metrics["broken"].Err.Error(), "metrics-err")
+}
+
+func (s *BackendSuite) TestBulkInfoReportsErrorsPerContainer() {
+	fakeContainer := new(libcontainerdfakes.FakeContainer)
+	fakeContainer.LabelsReturns(map[string]string{"foo": "bar"}, nil)
+	fakeContainer.TaskReturns(nil, errdefs.ErrNotFound)
+
+	s.client.GetContainerStub = func(_ context.Context, handle string) (containerd.Container, error) {
+		if handle == "missing" {
+			return nil, errors.New("not found")
+		}
+
+		return fakeContainer, nil
+	}
+
+	info, err := s.backend.BulkInfo([]string{"missing", "stopped"})
+	s.NoError(err)
+	s.Len(info, 2)
+	s.Contains(info["missing"].Err.Error(), "not found")
+	s.Nil(info["stopped"].Err)
+	s.Equal("stopped", info["stopped"].Info.State)
+	s.Equal(garden.Properties{"foo": "bar"}, info["stopped"].Info.Properties)
+}
+
 func (s *BackendSuite) TestDestroyEmptyHandleError() {
 	err := s.backend.Destroy("")
 	s.EqualError(err, "empty handle")
@@ -333,6 +443,35 @@ func (s *BackendSuite) TestDestroyRemoveNetworkFails() {
 	s.True(errors.Is(err, expectedError))
 }
 
+func (s *BackendSuite) TestDestroyGetLabelsFails() {
+	fakeContainer := new(libcontainerdfakes.FakeContainer)
+	fakeTask := new(libcontainerdfakes.FakeTask)
+
+	s.client.GetContainerReturns(fakeContainer, nil)
+	fakeContainer.TaskReturns(fakeTask, nil)
+
+	expectedError := errors.New("get-labels-failed")
+	fakeContainer.LabelsReturns(nil, expectedError)
+
+	err := s.backend.Destroy("some handle")
+	s.True(errors.Is(err, expectedError))
+	s.Equal(0, s.network.RemoveCallCount())
+}
+
+func (s *BackendSuite) TestDestroyWithNoNetwork() {
+	fakeContainer := new(libcontainerdfakes.FakeContainer)
+	fakeTask := new(libcontainerdfakes.FakeTask)
+
+	s.client.GetContainerReturns(fakeContainer, nil)
+	fakeContainer.TaskReturns(fakeTask, nil)
+	fakeContainer.LabelsReturns(map[string]string{backend.NetworkKey: backend.NetworkNone}, nil)
+
+	err := s.backend.Destroy("some handle")
+	s.NoError(err)
+	s.Equal(0, s.network.RemoveCallCount())
+	s.Equal(1, fakeContainer.DeleteCallCount())
+}
+
 func (s *BackendSuite) TestDestroyDeleteTaskFails() {
 	fakeContainer := new(libcontainerdfakes.FakeContainer)
 	fakeTask := new(libcontainerdfakes.FakeTask)
@@ -371,10 +510,44 @@ func (s *BackendSuite) TestDestroySucceeds() {
 	s.NoError(err)
 }
 
+func (s *BackendSuite) TestDestroyReleasesMappedPorts() {
+	fakeContainer := new(libcontainerdfakes.FakeContainer)
+	fakeTask := new(libcontainerdfakes.FakeTask)
+	s.client.GetContainerReturns(fakeContainer, nil)
+	fakeContainer.TaskReturns(fakeTask, nil)
+	fakeContainer.LabelsReturns(map[string]string{
+		backend.MappedPortsKey: `[{"HostPort":61001,"ContainerPort":80},{"HostPort":8080,"ContainerPort":8080}]`,
+	}, nil)
+
+	err := s.backend.Destroy("some handle")
+	s.NoError(err)
+
+	s.Equal(1, s.portPool.LockCallCount())
+	s.Equal(2, s.portPool.ReleaseCallCount())
+	s.Equal(uint32(61001), s.portPool.ReleaseArgsForCall(0))
+	s.Equal(uint32(8080), s.portPool.ReleaseArgsForCall(1))
+	s.Equal(1, s.portPool.UnlockCallCount())
+}
+
 func (s *BackendSuite) TestStart() {
 	err := s.backend.Start()
 	s.NoError(err)
 	s.Equal(1, s.client.InitCallCount())
+	s.Equal(1, s.network.SetupDeniedNetworksCallCount())
+}
+
+func (s *BackendSuite) TestStartClaimsMappedPorts() {
+	fakeContainer := new(libcontainerdfakes.FakeContainer)
+	fakeContainer.LabelsReturns(map[string]string{
+		backend.MappedPortsKey: `[{"HostPort":61001,"ContainerPort":80}]`,
+	}, nil)
+	s.client.ContainersReturns([]containerd.Container{fakeContainer}, nil)
+
+	err := s.backend.Start()
+	s.NoError(err)
+
+	s.Equal(1, s.portPool.ClaimCallCount())
+	s.Equal(uint32(61001), s.portPool.ClaimArgsForCall(0))
 }
 
 func (s *BackendSuite) TestStartInitError() {
@@ -383,6 +556,12 @@ func (s *BackendSuite) TestStartInitError() {
 	s.EqualError(errors.Unwrap(err), "init failed")
 }
 
+func (s *BackendSuite) TestStartSetupDeniedNetworksError() {
+	s.network.SetupDeniedNetworksReturns(errors.New("setup failed"))
+	err := s.backend.Start()
+	s.EqualError(errors.Unwrap(err), "setup failed")
+}
+
 func (s *BackendSuite) TestStop() {
 	s.backend.Stop()
 	s.Equal(1, s.client.StopCallCount())
@@ -407,4 +586,4 @@ func (s *BackendSuite) TestGraceTimeReturnsDuration() {
 	fakeContainer.PropertyReturns("123", nil)
 	result := s.backend.GraceTime(fakeContainer)
 	s.Equal(time.Duration(123), result)
-}
\ No newline at end of file
+}
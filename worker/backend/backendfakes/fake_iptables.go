@@ -0,0 +1,812 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package backendfakes
+
+import (
+	"sync"
+
+	"github.com/concourse/concourse/worker/backend"
+)
+
+type FakeIptables struct {
+	AppendStub        func(string, string, ...string) error
+	appendMutex       sync.RWMutex
+	appendArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 []string
+	}
+	appendReturns struct {
+		result1 error
+	}
+	appendReturnsOnCall map[int]struct {
+		result1 error
+	}
+	AppendUniqueStub        func(string, string, ...string) error
+	appendUniqueMutex       sync.RWMutex
+	appendUniqueArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 []string
+	}
+	appendUniqueReturns struct {
+		result1 error
+	}
+	appendUniqueReturnsOnCall map[int]struct {
+		result1 error
+	}
+	ClearChainStub        func(string, string) error
+	clearChainMutex       sync.RWMutex
+	clearChainArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	clearChainReturns struct {
+		result1 error
+	}
+	clearChainReturnsOnCall map[int]struct {
+		result1 error
+	}
+	DeleteStub        func(string, string, ...string) error
+	deleteMutex       sync.RWMutex
+	deleteArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 []string
+	}
+	deleteReturns struct {
+		result1 error
+	}
+	deleteReturnsOnCall map[int]struct {
+		result1 error
+	}
+	DeleteChainStub        func(string, string) error
+	deleteChainMutex       sync.RWMutex
+	deleteChainArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	deleteChainReturns struct {
+		result1 error
+	}
+	deleteChainReturnsOnCall map[int]struct {
+		result1 error
+	}
+	ExistsStub        func(string, string, ...string) (bool, error)
+	existsMutex       sync.RWMutex
+	existsArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 []string
+	}
+	existsReturns struct {
+		result1 bool
+		result2 error
+	}
+	existsReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
+	InsertStub        func(string, string, int, ...string) error
+	insertMutex       sync.RWMutex
+	insertArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 int
+		arg4 []string
+	}
+	insertReturns struct {
+		result1 error
+	}
+	insertReturnsOnCall map[int]struct {
+		result1 error
+	}
+	ListStub        func(string, string) ([]string, error)
+	listMutex       sync.RWMutex
+	listArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	listReturns struct {
+		result1 []string
+		result2 error
+	}
+	listReturnsOnCall map[int]struct {
+		result1 []string
+		result2 error
+	}
+	ListChainsStub        func(string) ([]string, error)
+	listChainsMutex       sync.RWMutex
+	listChainsArgsForCall []struct {
+		arg1 string
+	}
+	listChainsReturns struct {
+		result1 []string
+		result2 error
+	}
+	listChainsReturnsOnCall map[int]struct {
+		result1 []string
+		result2 error
+	}
+	NewChainStub        func(string, string) error
+	newChainMutex       sync.RWMutex
+	newChainArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	newChainReturns struct {
+		result1 error
+	}
+	newChainReturnsOnCall map[int]struct {
+		result1 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeIptables) Append(arg1 string, arg2 string, arg3 ...string) error {
+	fake.appendMutex.Lock()
+	ret, specificReturn := fake.appendReturnsOnCall[len(fake.appendArgsForCall)]
+	fake.appendArgsForCall = append(fake.appendArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 []string
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("Append", []interface{}{arg1, arg2, arg3})
+	fake.appendMutex.Unlock()
+	if fake.AppendStub != nil {
+		return fake.AppendStub(arg1, arg2, arg3...)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.appendReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeIptables) AppendCallCount() int {
+	fake.appendMutex.RLock()
+	defer fake.appendMutex.RUnlock()
+	return len(fake.appendArgsForCall)
+}
+
+func (fake *FakeIptables) AppendCalls(stub func(string, string, ...string) error) {
+	fake.appendMutex.Lock()
+	defer fake.appendMutex.Unlock()
+	fake.AppendStub = stub
+}
+
+func (fake *FakeIptables) AppendArgsForCall(i int) (string, string, []string) {
+	fake.appendMutex.RLock()
+	defer fake.appendMutex.RUnlock()
+	argsForCall := fake.appendArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeIptables) AppendReturns(result1 error) {
+	fake.appendMutex.Lock()
+	defer fake.appendMutex.Unlock()
+	fake.AppendStub = nil
+	fake.appendReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeIptables) AppendReturnsOnCall(i int, result1 error) {
+	fake.appendMutex.Lock()
+	defer fake.appendMutex.Unlock()
+	fake.AppendStub = nil
+	if fake.appendReturnsOnCall == nil {
+		fake.appendReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.appendReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeIptables) AppendUnique(arg1 string, arg2 string, arg3 ...string) error {
+	fake.appendUniqueMutex.Lock()
+	ret, specificReturn := fake.appendUniqueReturnsOnCall[len(fake.appendUniqueArgsForCall)]
+	fake.appendUniqueArgsForCall = append(fake.appendUniqueArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 []string
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("AppendUnique", []interface{}{arg1, arg2, arg3})
+	fake.appendUniqueMutex.Unlock()
+	if fake.AppendUniqueStub != nil {
+		return fake.AppendUniqueStub(arg1, arg2, arg3...)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.appendUniqueReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeIptables) AppendUniqueCallCount() int {
+	fake.appendUniqueMutex.RLock()
+	defer fake.appendUniqueMutex.RUnlock()
+	return len(fake.appendUniqueArgsForCall)
+}
+
+func (fake *FakeIptables) AppendUniqueCalls(stub func(string, string, ...string) error) {
+	fake.appendUniqueMutex.Lock()
+	defer fake.appendUniqueMutex.Unlock()
+	fake.AppendUniqueStub = stub
+}
+
+func (fake *FakeIptables) AppendUniqueArgsForCall(i int) (string, string, []string) {
+	fake.appendUniqueMutex.RLock()
+	defer fake.appendUniqueMutex.RUnlock()
+	argsForCall := fake.appendUniqueArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeIptables) AppendUniqueReturns(result1 error) {
+	fake.appendUniqueMutex.Lock()
+	defer fake.appendUniqueMutex.Unlock()
+	fake.AppendUniqueStub = nil
+	fake.appendUniqueReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeIptables) AppendUniqueReturnsOnCall(i int, result1 error) {
+	fake.appendUniqueMutex.Lock()
+	defer fake.appendUniqueMutex.Unlock()
+	fake.AppendUniqueStub = nil
+	if fake.appendUniqueReturnsOnCall == nil {
+		fake.appendUniqueReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.appendUniqueReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeIptables) ClearChain(arg1 string, arg2 string) error {
+	fake.clearChainMutex.Lock()
+	ret, specificReturn := fake.clearChainReturnsOnCall[len(fake.clearChainArgsForCall)]
+	fake.clearChainArgsForCall = append(fake.clearChainArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("ClearChain", []interface{}{arg1, arg2})
+	fake.clearChainMutex.Unlock()
+	if fake.ClearChainStub != nil {
+		return fake.ClearChainStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.clearChainReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeIptables) ClearChainCallCount() int {
+	fake.clearChainMutex.RLock()
+	defer fake.clearChainMutex.RUnlock()
+	return len(fake.clearChainArgsForCall)
+}
+
+func (fake *FakeIptables) ClearChainCalls(stub func(string, string) error) {
+	fake.clearChainMutex.Lock()
+	defer fake.clearChainMutex.Unlock()
+	fake.ClearChainStub = stub
+}
+
+func (fake *FakeIptables) ClearChainArgsForCall(i int) (string, string) {
+	fake.clearChainMutex.RLock()
+	defer fake.clearChainMutex.RUnlock()
+	argsForCall := fake.clearChainArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeIptables) ClearChainReturns(result1 error) {
+	fake.clearChainMutex.Lock()
+	defer fake.clearChainMutex.Unlock()
+	fake.ClearChainStub = nil
+	fake.clearChainReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeIptables) ClearChainReturnsOnCall(i int, result1 error) {
+	fake.clearChainMutex.Lock()
+	defer fake.clearChainMutex.Unlock()
+	fake.ClearChainStub = nil
+	if fake.clearChainReturnsOnCall == nil {
+		fake.clearChainReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.clearChainReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeIptables) Delete(arg1 string, arg2 string, arg3 ...string) error {
+	fake.deleteMutex.Lock()
+	ret, specificReturn := fake.deleteReturnsOnCall[len(fake.deleteArgsForCall)]
+	fake.deleteArgsForCall = append(fake.deleteArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 []string
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("Delete", []interface{}{arg1, arg2, arg3})
+	fake.deleteMutex.Unlock()
+	if fake.DeleteStub != nil {
+		return fake.DeleteStub(arg1, arg2, arg3...)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.deleteReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeIptables) DeleteCallCount() int {
+	fake.deleteMutex.RLock()
+	defer fake.deleteMutex.RUnlock()
+	return len(fake.deleteArgsForCall)
+}
+
+func (fake *FakeIptables) DeleteCalls(stub func(string, string, ...string) error) {
+	fake.deleteMutex.Lock()
+	defer fake.deleteMutex.Unlock()
+	fake.DeleteStub = stub
+}
+
+func (fake *FakeIptables) DeleteArgsForCall(i int) (string, string, []string) {
+	fake.deleteMutex.RLock()
+	defer fake.deleteMutex.RUnlock()
+	argsForCall := fake.deleteArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeIptables) DeleteReturns(result1 error) {
+	fake.deleteMutex.Lock()
+	defer fake.deleteMutex.Unlock()
+	fake.DeleteStub = nil
+	fake.deleteReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeIptables) DeleteReturnsOnCall(i int, result1 error) {
+	fake.deleteMutex.Lock()
+	defer fake.deleteMutex.Unlock()
+	fake.DeleteStub = nil
+	if fake.deleteReturnsOnCall == nil {
+		fake.deleteReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeIptables) DeleteChain(arg1 string, arg2 string) error {
+	fake.deleteChainMutex.Lock()
+	ret, specificReturn := fake.deleteChainReturnsOnCall[len(fake.deleteChainArgsForCall)]
+	fake.deleteChainArgsForCall = append(fake.deleteChainArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("DeleteChain", []interface{}{arg1, arg2})
+	fake.deleteChainMutex.Unlock()
+	if fake.DeleteChainStub != nil {
+		return fake.DeleteChainStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.deleteChainReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeIptables) DeleteChainCallCount() int {
+	fake.deleteChainMutex.RLock()
+	defer fake.deleteChainMutex.RUnlock()
+	return len(fake.deleteChainArgsForCall)
+}
+
+func (fake *FakeIptables) DeleteChainCalls(stub func(string, string) error) {
+	fake.deleteChainMutex.Lock()
+	defer fake.deleteChainMutex.Unlock()
+	fake.DeleteChainStub = stub
+}
+
+func (fake *FakeIptables) DeleteChainArgsForCall(i int) (string, string) {
+	fake.deleteChainMutex.RLock()
+	defer fake.deleteChainMutex.RUnlock()
+	argsForCall := fake.deleteChainArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeIptables) DeleteChainReturns(result1 error) {
+	fake.deleteChainMutex.Lock()
+	defer fake.deleteChainMutex.Unlock()
+	fake.DeleteChainStub = nil
+	fake.deleteChainReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeIptables) DeleteChainReturnsOnCall(i int, result1 error) {
+	fake.deleteChainMutex.Lock()
+	defer fake.deleteChainMutex.Unlock()
+	fake.DeleteChainStub = nil
+	if fake.deleteChainReturnsOnCall == nil {
+		fake.deleteChainReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteChainReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeIptables) Exists(arg1 string, arg2 string, arg3 ...string) (bool, error) {
+	fake.existsMutex.Lock()
+	ret, specificReturn := fake.existsReturnsOnCall[len(fake.existsArgsForCall)]
+	fake.existsArgsForCall = append(fake.existsArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 []string
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("Exists", []interface{}{arg1, arg2, arg3})
+	fake.existsMutex.Unlock()
+	if fake.ExistsStub != nil {
+		return fake.ExistsStub(arg1, arg2, arg3...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.existsReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeIptables) ExistsCallCount() int {
+	fake.existsMutex.RLock()
+	defer fake.existsMutex.RUnlock()
+	return len(fake.existsArgsForCall)
+}
+
+func (fake *FakeIptables) ExistsCalls(stub func(string, string, ...string) (bool, error)) {
+	fake.existsMutex.Lock()
+	defer fake.existsMutex.Unlock()
+	fake.ExistsStub = stub
+}
+
+func (fake *FakeIptables) ExistsArgsForCall(i int) (string, string, []string) {
+	fake.existsMutex.RLock()
+	defer fake.existsMutex.RUnlock()
+	argsForCall := fake.existsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeIptables) ExistsReturns(result1 bool, result2 error) {
+	fake.existsMutex.Lock()
+	defer fake.existsMutex.Unlock()
+	fake.ExistsStub = nil
+	fake.existsReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeIptables) ExistsReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.existsMutex.Lock()
+	defer fake.existsMutex.Unlock()
+	fake.ExistsStub = nil
+	if fake.existsReturnsOnCall == nil {
+		fake.existsReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.existsReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeIptables) Insert(arg1 string, arg2 string, arg3 int, arg4 ...string) error {
+	fake.insertMutex.Lock()
+	ret, specificReturn := fake.insertReturnsOnCall[len(fake.insertArgsForCall)]
+	fake.insertArgsForCall = append(fake.insertArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 int
+		arg4 []string
+	}{arg1, arg2, arg3, arg4})
+	fake.recordInvocation("Insert", []interface{}{arg1, arg2, arg3, arg4})
+	fake.insertMutex.Unlock()
+	if fake.InsertStub != nil {
+		return fake.InsertStub(arg1, arg2, arg3, arg4...)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.insertReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeIptables) InsertCallCount() int {
+	fake.insertMutex.RLock()
+	defer fake.insertMutex.RUnlock()
+	return len(fake.insertArgsForCall)
+}
+
+func (fake *FakeIptables) InsertCalls(stub func(string, string, int, ...string) error) {
+	fake.insertMutex.Lock()
+	defer fake.insertMutex.Unlock()
+	fake.InsertStub = stub
+}
+
+func (fake *FakeIptables) InsertArgsForCall(i int) (string, string, int, []string) {
+	fake.insertMutex.RLock()
+	defer fake.insertMutex.RUnlock()
+	argsForCall := fake.insertArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeIptables) InsertReturns(result1 error) {
+	fake.insertMutex.Lock()
+	defer fake.insertMutex.Unlock()
+	fake.InsertStub = nil
+	fake.insertReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeIptables) InsertReturnsOnCall(i int, result1 error) {
+	fake.insertMutex.Lock()
+	defer fake.insertMutex.Unlock()
+	fake.InsertStub = nil
+	if fake.insertReturnsOnCall == nil {
+		fake.insertReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.insertReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeIptables) List(arg1 string, arg2 string) ([]string, error) {
+	fake.listMutex.Lock()
+	ret, specificReturn := fake.listReturnsOnCall[len(fake.listArgsForCall)]
+	fake.listArgsForCall = append(fake.listArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("List", []interface{}{arg1, arg2})
+	fake.listMutex.Unlock()
+	if fake.ListStub != nil {
+		return fake.ListStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.listReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeIptables) ListCallCount() int {
+	fake.listMutex.RLock()
+	defer fake.listMutex.RUnlock()
+	return len(fake.listArgsForCall)
+}
+
+func (fake *FakeIptables) ListCalls(stub func(string, string) ([]string, error)) {
+	fake.listMutex.Lock()
+	defer fake.listMutex.Unlock()
+	fake.ListStub = stub
+}
+
+func (fake *FakeIptables) ListArgsForCall(i int) (string, string) {
+	fake.listMutex.RLock()
+	defer fake.listMutex.RUnlock()
+	argsForCall := fake.listArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeIptables) ListReturns(result1 []string, result2 error) {
+	fake.listMutex.Lock()
+	defer fake.listMutex.Unlock()
+	fake.ListStub = nil
+	fake.listReturns = struct {
+		result1 []string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeIptables) ListReturnsOnCall(i int, result1 []string, result2 error) {
+	fake.listMutex.Lock()
+	defer fake.listMutex.Unlock()
+	fake.ListStub = nil
+	if fake.listReturnsOnCall == nil {
+		fake.listReturnsOnCall = make(map[int]struct {
+			result1 []string
+			result2 error
+		})
+	}
+	fake.listReturnsOnCall[i] = struct {
+		result1 []string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeIptables) ListChains(arg1 string) ([]string, error) {
+	fake.listChainsMutex.Lock()
+	ret, specificReturn := fake.listChainsReturnsOnCall[len(fake.listChainsArgsForCall)]
+	fake.listChainsArgsForCall = append(fake.listChainsArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("ListChains", []interface{}{arg1})
+	fake.listChainsMutex.Unlock()
+	if fake.ListChainsStub != nil {
+		return fake.ListChainsStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.listChainsReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeIptables) ListChainsCallCount() int {
+	fake.listChainsMutex.RLock()
+	defer fake.listChainsMutex.RUnlock()
+	return len(fake.listChainsArgsForCall)
+}
+
+func (fake *FakeIptables) ListChainsCalls(stub func(string) ([]string, error)) {
+	fake.listChainsMutex.Lock()
+	defer fake.listChainsMutex.Unlock()
+	fake.ListChainsStub = stub
+}
+
+func (fake *FakeIptables) ListChainsArgsForCall(i int) string {
+	fake.listChainsMutex.RLock()
+	defer fake.listChainsMutex.RUnlock()
+	argsForCall := fake.listChainsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeIptables) ListChainsReturns(result1 []string, result2 error) {
+	fake.listChainsMutex.Lock()
+	defer fake.listChainsMutex.Unlock()
+	fake.ListChainsStub = nil
+	fake.listChainsReturns = struct {
+		result1 []string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeIptables) ListChainsReturnsOnCall(i int, result1 []string, result2 error) {
+	fake.listChainsMutex.Lock()
+	defer fake.listChainsMutex.Unlock()
+	fake.ListChainsStub = nil
+	if fake.listChainsReturnsOnCall == nil {
+		fake.listChainsReturnsOnCall = make(map[int]struct {
+			result1 []string
+			result2 error
+		})
+	}
+	fake.listChainsReturnsOnCall[i] = struct {
+		result1 []string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeIptables) NewChain(arg1 string, arg2 string) error {
+	fake.newChainMutex.Lock()
+	ret, specificReturn := fake.newChainReturnsOnCall[len(fake.newChainArgsForCall)]
+	fake.newChainArgsForCall = append(fake.newChainArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("NewChain", []interface{}{arg1, arg2})
+	fake.newChainMutex.Unlock()
+	if fake.NewChainStub != nil {
+		return fake.NewChainStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.newChainReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeIptables) NewChainCallCount() int {
+	fake.newChainMutex.RLock()
+	defer fake.newChainMutex.RUnlock()
+	return len(fake.newChainArgsForCall)
+}
+
+func (fake *FakeIptables) NewChainCalls(stub func(string, string) error) {
+	fake.newChainMutex.Lock()
+	defer fake.newChainMutex.Unlock()
+	fake.NewChainStub = stub
+}
+
+func (fake *FakeIptables) NewChainArgsForCall(i int) (string, string) {
+	fake.newChainMutex.RLock()
+	defer fake.newChainMutex.RUnlock()
+	argsForCall := fake.newChainArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeIptables) NewChainReturns(result1 error) {
+	fake.newChainMutex.Lock()
+	defer fake.newChainMutex.Unlock()
+	fake.NewChainStub = nil
+	fake.newChainReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeIptables) NewChainReturnsOnCall(i int, result1 error) {
+	fake.newChainMutex.Lock()
+	defer fake.newChainMutex.Unlock()
+	fake.NewChainStub = nil
+	if fake.newChainReturnsOnCall == nil {
+		fake.newChainReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.newChainReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeIptables) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.appendMutex.RLock()
+	defer fake.appendMutex.RUnlock()
+	fake.appendUniqueMutex.RLock()
+	defer fake.appendUniqueMutex.RUnlock()
+	fake.clearChainMutex.RLock()
+	defer fake.clearChainMutex.RUnlock()
+	fake.deleteMutex.RLock()
+	defer fake.deleteMutex.RUnlock()
+	fake.deleteChainMutex.RLock()
+	defer fake.deleteChainMutex.RUnlock()
+	fake.existsMutex.RLock()
+	defer fake.existsMutex.RUnlock()
+	fake.insertMutex.RLock()
+	defer fake.insertMutex.RUnlock()
+	fake.listMutex.RLock()
+	defer fake.listMutex.RUnlock()
+	fake.listChainsMutex.RLock()
+	defer fake.listChainsMutex.RUnlock()
+	fake.newChainMutex.RLock()
+	defer fake.newChainMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeIptables) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ backend.Iptables = new(FakeIptables)
@@ -5,6 +5,7 @@ import (
 	"context"
 	"sync"
 
+	"code.cloudfoundry.org/garden"
 	"github.com/concourse/concourse/worker/backend"
 	"github.com/containerd/containerd"
 	specs "github.com/opencontainers/runtime-spec/specs-go"
@@ -23,6 +24,48 @@ type FakeNetwork struct {
 	addReturnsOnCall map[int]struct {
 		result1 error
 	}
+	AddressesStub        func(context.Context, containerd.Task) (string, string, error)
+	addressesMutex       sync.RWMutex
+	addressesArgsForCall []struct {
+		arg1 context.Context
+		arg2 containerd.Task
+	}
+	addressesReturns struct {
+		result1 string
+		result2 string
+		result3 error
+	}
+	addressesReturnsOnCall map[int]struct {
+		result1 string
+		result2 string
+		result3 error
+	}
+	NetInStub        func(context.Context, containerd.Task, []garden.PortMapping) error
+	netInMutex       sync.RWMutex
+	netInArgsForCall []struct {
+		arg1 context.Context
+		arg2 containerd.Task
+		arg3 []garden.PortMapping
+	}
+	netInReturns struct {
+		result1 error
+	}
+	netInReturnsOnCall map[int]struct {
+		result1 error
+	}
+	NetOutStub        func(context.Context, containerd.Task, garden.NetOutRule) error
+	netOutMutex       sync.RWMutex
+	netOutArgsForCall []struct {
+		arg1 context.Context
+		arg2 containerd.Task
+		arg3 garden.NetOutRule
+	}
+	netOutReturns struct {
+		result1 error
+	}
+	netOutReturnsOnCall map[int]struct {
+		result1 error
+	}
 	RemoveStub        func(context.Context, containerd.Task) error
 	removeMutex       sync.RWMutex
 	removeArgsForCall []struct {
@@ -35,6 +78,16 @@ type FakeNetwork struct {
 	removeReturnsOnCall map[int]struct {
 		result1 error
 	}
+	SetupDeniedNetworksStub        func() error
+	setupDeniedNetworksMutex       sync.RWMutex
+	setupDeniedNetworksArgsForCall []struct {
+	}
+	setupDeniedNetworksReturns struct {
+		result1 error
+	}
+	setupDeniedNetworksReturnsOnCall map[int]struct {
+		result1 error
+	}
 	SetupMountsStub        func(string) ([]specs.Mount, error)
 	setupMountsMutex       sync.RWMutex
 	setupMountsArgsForCall []struct {
@@ -113,6 +166,202 @@ func (fake *FakeNetwork) AddReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeNetwork) Addresses(arg1 context.Context, arg2 containerd.Task) (string, string, error) {
+	fake.addressesMutex.Lock()
+	ret, specificReturn := fake.addressesReturnsOnCall[len(fake.addressesArgsForCall)]
+	fake.addressesArgsForCall = append(fake.addressesArgsForCall, struct {
+		arg1 context.Context
+		arg2 containerd.Task
+	}{arg1, arg2})
+	fake.recordInvocation("Addresses", []interface{}{arg1, arg2})
+	fake.addressesMutex.Unlock()
+	if fake.AddressesStub != nil {
+		return fake.AddressesStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.addressesReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeNetwork) AddressesCallCount() int {
+	fake.addressesMutex.RLock()
+	defer fake.addressesMutex.RUnlock()
+	return len(fake.addressesArgsForCall)
+}
+
+func (fake *FakeNetwork) AddressesCalls(stub func(context.Context, containerd.Task) (string, string, error)) {
+	fake.addressesMutex.Lock()
+	defer fake.addressesMutex.Unlock()
+	fake.AddressesStub = stub
+}
+
+func (fake *FakeNetwork) AddressesArgsForCall(i int) (context.Context, containerd.Task) {
+	fake.addressesMutex.RLock()
+	defer fake.addressesMutex.RUnlock()
+	argsForCall := fake.addressesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeNetwork) AddressesReturns(result1 string, result2 string, result3 error) {
+	fake.addressesMutex.Lock()
+	defer fake.addressesMutex.Unlock()
+	fake.AddressesStub = nil
+	fake.addressesReturns = struct {
+		result1 string
+		result2 string
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeNetwork) AddressesReturnsOnCall(i int, result1 string, result2 string, result3 error) {
+	fake.addressesMutex.Lock()
+	defer fake.addressesMutex.Unlock()
+	fake.AddressesStub = nil
+	if fake.addressesReturnsOnCall == nil {
+		fake.addressesReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 string
+			result3 error
+		})
+	}
+	fake.addressesReturnsOnCall[i] = struct {
+		result1 string
+		result2 string
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeNetwork) NetIn(arg1 context.Context, arg2 containerd.Task, arg3 []garden.PortMapping) error {
+	var arg3Copy []garden.PortMapping
+	if arg3 != nil {
+		arg3Copy = make([]garden.PortMapping, len(arg3))
+		copy(arg3Copy, arg3)
+	}
+	fake.netInMutex.Lock()
+	ret, specificReturn := fake.netInReturnsOnCall[len(fake.netInArgsForCall)]
+	fake.netInArgsForCall = append(fake.netInArgsForCall, struct {
+		arg1 context.Context
+		arg2 containerd.Task
+		arg3 []garden.PortMapping
+	}{arg1, arg2, arg3Copy})
+	fake.recordInvocation("NetIn", []interface{}{arg1, arg2, arg3Copy})
+	fake.netInMutex.Unlock()
+	if fake.NetInStub != nil {
+		return fake.NetInStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.netInReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeNetwork) NetInCallCount() int {
+	fake.netInMutex.RLock()
+	defer fake.netInMutex.RUnlock()
+	return len(fake.netInArgsForCall)
+}
+
+func (fake *FakeNetwork) NetInCalls(stub func(context.Context, containerd.Task, []garden.PortMapping) error) {
+	fake.netInMutex.Lock()
+	defer fake.netInMutex.Unlock()
+	fake.NetInStub = stub
+}
+
+func (fake *FakeNetwork) NetInArgsForCall(i int) (context.Context, containerd.Task, []garden.PortMapping) {
+	fake.netInMutex.RLock()
+	defer fake.netInMutex.RUnlock()
+	argsForCall := fake.netInArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeNetwork) NetInReturns(result1 error) {
+	fake.netInMutex.Lock()
+	defer fake.netInMutex.Unlock()
+	fake.NetInStub = nil
+	fake.netInReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeNetwork) NetInReturnsOnCall(i int, result1 error) {
+	fake.netInMutex.Lock()
+	defer fake.netInMutex.Unlock()
+	fake.NetInStub = nil
+	if fake.netInReturnsOnCall == nil {
+		fake.netInReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.netInReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeNetwork) NetOut(arg1 context.Context, arg2 containerd.Task, arg3 garden.NetOutRule) error {
+	fake.netOutMutex.Lock()
+	ret, specificReturn := fake.netOutReturnsOnCall[len(fake.netOutArgsForCall)]
+	fake.netOutArgsForCall = append(fake.netOutArgsForCall, struct {
+		arg1 context.Context
+		arg2 containerd.Task
+		arg3 garden.NetOutRule
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("NetOut", []interface{}{arg1, arg2, arg3})
+	fake.netOutMutex.Unlock()
+	if fake.NetOutStub != nil {
+		return fake.NetOutStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.netOutReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeNetwork) NetOutCallCount() int {
+	fake.netOutMutex.RLock()
+	defer fake.netOutMutex.RUnlock()
+	return len(fake.netOutArgsForCall)
+}
+
+func (fake *FakeNetwork) NetOutCalls(stub func(context.Context, containerd.Task, garden.NetOutRule) error) {
+	fake.netOutMutex.Lock()
+	defer fake.netOutMutex.Unlock()
+	fake.NetOutStub = stub
+}
+
+func (fake *FakeNetwork) NetOutArgsForCall(i int) (context.Context, containerd.Task, garden.NetOutRule) {
+	fake.netOutMutex.RLock()
+	defer fake.netOutMutex.RUnlock()
+	argsForCall := fake.netOutArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeNetwork) NetOutReturns(result1 error) {
+	fake.netOutMutex.Lock()
+	defer fake.netOutMutex.Unlock()
+	fake.NetOutStub = nil
+	fake.netOutReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeNetwork) NetOutReturnsOnCall(i int, result1 error) {
+	fake.netOutMutex.Lock()
+	defer fake.netOutMutex.Unlock()
+	fake.NetOutStub = nil
+	if fake.netOutReturnsOnCall == nil {
+		fake.netOutReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.netOutReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeNetwork) Remove(arg1 context.Context, arg2 containerd.Task) error {
 	fake.removeMutex.Lock()
 	ret, specificReturn := fake.removeReturnsOnCall[len(fake.removeArgsForCall)]
@@ -174,6 +423,58 @@ func (fake *FakeNetwork) RemoveReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeNetwork) SetupDeniedNetworks() error {
+	fake.setupDeniedNetworksMutex.Lock()
+	ret, specificReturn := fake.setupDeniedNetworksReturnsOnCall[len(fake.setupDeniedNetworksArgsForCall)]
+	fake.setupDeniedNetworksArgsForCall = append(fake.setupDeniedNetworksArgsForCall, struct {
+	}{})
+	fake.recordInvocation("SetupDeniedNetworks", []interface{}{})
+	fake.setupDeniedNetworksMutex.Unlock()
+	if fake.SetupDeniedNetworksStub != nil {
+		return fake.SetupDeniedNetworksStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.setupDeniedNetworksReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeNetwork) SetupDeniedNetworksCallCount() int {
+	fake.setupDeniedNetworksMutex.RLock()
+	defer fake.setupDeniedNetworksMutex.RUnlock()
+	return len(fake.setupDeniedNetworksArgsForCall)
+}
+
+func (fake *FakeNetwork) SetupDeniedNetworksCalls(stub func() error) {
+	fake.setupDeniedNetworksMutex.Lock()
+	defer fake.setupDeniedNetworksMutex.Unlock()
+	fake.SetupDeniedNetworksStub = stub
+}
+
+func (fake *FakeNetwork) SetupDeniedNetworksReturns(result1 error) {
+	fake.setupDeniedNetworksMutex.Lock()
+	defer fake.setupDeniedNetworksMutex.Unlock()
+	fake.SetupDeniedNetworksStub = nil
+	fake.setupDeniedNetworksReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeNetwork) SetupDeniedNetworksReturnsOnCall(i int, result1 error) {
+	fake.setupDeniedNetworksMutex.Lock()
+	defer fake.setupDeniedNetworksMutex.Unlock()
+	fake.SetupDeniedNetworksStub = nil
+	if fake.setupDeniedNetworksReturnsOnCall == nil {
+		fake.setupDeniedNetworksReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.setupDeniedNetworksReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeNetwork) SetupMounts(arg1 string) ([]specs.Mount, error) {
 	fake.setupMountsMutex.Lock()
 	ret, specificReturn := fake.setupMountsReturnsOnCall[len(fake.setupMountsArgsForCall)]
@@ -242,8 +543,16 @@ func (fake *FakeNetwork) Invocations() map[string][][]interface{} {
 	defer fake.invocationsMutex.RUnlock()
 	fake.addMutex.RLock()
 	defer fake.addMutex.RUnlock()
+	fake.addressesMutex.RLock()
+	defer fake.addressesMutex.RUnlock()
+	fake.netInMutex.RLock()
+	defer fake.netInMutex.RUnlock()
+	fake.netOutMutex.RLock()
+	defer fake.netOutMutex.RUnlock()
 	fake.removeMutex.RLock()
 	defer fake.removeMutex.RUnlock()
+	fake.setupDeniedNetworksMutex.RLock()
+	defer fake.setupDeniedNetworksMutex.RUnlock()
 	fake.setupMountsMutex.RLock()
 	defer fake.setupMountsMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
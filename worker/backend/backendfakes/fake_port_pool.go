@@ -0,0 +1,275 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package backendfakes
+
+import (
+	"sync"
+
+	"github.com/concourse/concourse/worker/backend"
+)
+
+type FakePortPool struct {
+	AcquireStub        func() (uint32, error)
+	acquireMutex       sync.RWMutex
+	acquireArgsForCall []struct {
+	}
+	acquireReturns struct {
+		result1 uint32
+		result2 error
+	}
+	acquireReturnsOnCall map[int]struct {
+		result1 uint32
+		result2 error
+	}
+	ClaimStub        func(uint32) error
+	claimMutex       sync.RWMutex
+	claimArgsForCall []struct {
+		arg1 uint32
+	}
+	claimReturns struct {
+		result1 error
+	}
+	claimReturnsOnCall map[int]struct {
+		result1 error
+	}
+	LockStub        func()
+	lockMutex       sync.RWMutex
+	lockArgsForCall []struct {
+	}
+	ReleaseStub        func(uint32)
+	releaseMutex       sync.RWMutex
+	releaseArgsForCall []struct {
+		arg1 uint32
+	}
+	UnlockStub        func()
+	unlockMutex       sync.RWMutex
+	unlockArgsForCall []struct {
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakePortPool) Acquire() (uint32, error) {
+	fake.acquireMutex.Lock()
+	ret, specificReturn := fake.acquireReturnsOnCall[len(fake.acquireArgsForCall)]
+	fake.acquireArgsForCall = append(fake.acquireArgsForCall, struct {
+	}{})
+	fake.recordInvocation("Acquire", []interface{}{})
+	fake.acquireMutex.Unlock()
+	if fake.AcquireStub != nil {
+		return fake.AcquireStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.acquireReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePortPool) AcquireCallCount() int {
+	fake.acquireMutex.RLock()
+	defer fake.acquireMutex.RUnlock()
+	return len(fake.acquireArgsForCall)
+}
+
+func (fake *FakePortPool) AcquireCalls(stub func() (uint32, error)) {
+	fake.acquireMutex.Lock()
+	defer fake.acquireMutex.Unlock()
+	fake.AcquireStub = stub
+}
+
+func (fake *FakePortPool) AcquireReturns(result1 uint32, result2 error) {
+	fake.acquireMutex.Lock()
+	defer fake.acquireMutex.Unlock()
+	fake.AcquireStub = nil
+	fake.acquireReturns = struct {
+		result1 uint32
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePortPool) AcquireReturnsOnCall(i int, result1 uint32, result2 error) {
+	fake.acquireMutex.Lock()
+	defer fake.acquireMutex.Unlock()
+	fake.AcquireStub = nil
+	if fake.acquireReturnsOnCall == nil {
+		fake.acquireReturnsOnCall = make(map[int]struct {
+			result1 uint32
+			result2 error
+		})
+	}
+	fake.acquireReturnsOnCall[i] = struct {
+		result1 uint32
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePortPool) Claim(arg1 uint32) error {
+	fake.claimMutex.Lock()
+	ret, specificReturn := fake.claimReturnsOnCall[len(fake.claimArgsForCall)]
+	fake.claimArgsForCall = append(fake.claimArgsForCall, struct {
+		arg1 uint32
+	}{arg1})
+	fake.recordInvocation("Claim", []interface{}{arg1})
+	fake.claimMutex.Unlock()
+	if fake.ClaimStub != nil {
+		return fake.ClaimStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.claimReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakePortPool) ClaimCallCount() int {
+	fake.claimMutex.RLock()
+	defer fake.claimMutex.RUnlock()
+	return len(fake.claimArgsForCall)
+}
+
+func (fake *FakePortPool) ClaimCalls(stub func(uint32) error) {
+	fake.claimMutex.Lock()
+	defer fake.claimMutex.Unlock()
+	fake.ClaimStub = stub
+}
+
+func (fake *FakePortPool) ClaimArgsForCall(i int) uint32 {
+	fake.claimMutex.RLock()
+	defer fake.claimMutex.RUnlock()
+	argsForCall := fake.claimArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakePortPool) ClaimReturns(result1 error) {
+	fake.claimMutex.Lock()
+	defer fake.claimMutex.Unlock()
+	fake.ClaimStub = nil
+	fake.claimReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakePortPool) ClaimReturnsOnCall(i int, result1 error) {
+	fake.claimMutex.Lock()
+	defer fake.claimMutex.Unlock()
+	fake.ClaimStub = nil
+	if fake.claimReturnsOnCall == nil {
+		fake.claimReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.claimReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakePortPool) Lock() {
+	fake.lockMutex.Lock()
+	fake.lockArgsForCall = append(fake.lockArgsForCall, struct {
+	}{})
+	fake.recordInvocation("Lock", []interface{}{})
+	fake.lockMutex.Unlock()
+	if fake.LockStub != nil {
+		fake.LockStub()
+	}
+}
+
+func (fake *FakePortPool) LockCallCount() int {
+	fake.lockMutex.RLock()
+	defer fake.lockMutex.RUnlock()
+	return len(fake.lockArgsForCall)
+}
+
+func (fake *FakePortPool) LockCalls(stub func()) {
+	fake.lockMutex.Lock()
+	defer fake.lockMutex.Unlock()
+	fake.LockStub = stub
+}
+
+func (fake *FakePortPool) Release(arg1 uint32) {
+	fake.releaseMutex.Lock()
+	fake.releaseArgsForCall = append(fake.releaseArgsForCall, struct {
+		arg1 uint32
+	}{arg1})
+	fake.recordInvocation("Release", []interface{}{arg1})
+	fake.releaseMutex.Unlock()
+	if fake.ReleaseStub != nil {
+		fake.ReleaseStub(arg1)
+	}
+}
+
+func (fake *FakePortPool) ReleaseCallCount() int {
+	fake.releaseMutex.RLock()
+	defer fake.releaseMutex.RUnlock()
+	return len(fake.releaseArgsForCall)
+}
+
+func (fake *FakePortPool) ReleaseCalls(stub func(uint32)) {
+	fake.releaseMutex.Lock()
+	defer fake.releaseMutex.Unlock()
+	fake.ReleaseStub = stub
+}
+
+func (fake *FakePortPool) ReleaseArgsForCall(i int) uint32 {
+	fake.releaseMutex.RLock()
+	defer fake.releaseMutex.RUnlock()
+	argsForCall := fake.releaseArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakePortPool) Unlock() {
+	fake.unlockMutex.Lock()
+	fake.unlockArgsForCall = append(fake.unlockArgsForCall, struct {
+	}{})
+	fake.recordInvocation("Unlock", []interface{}{})
+	fake.unlockMutex.Unlock()
+	if fake.UnlockStub != nil {
+		fake.UnlockStub()
+	}
+}
+
+func (fake *FakePortPool) UnlockCallCount() int {
+	fake.unlockMutex.RLock()
+	defer fake.unlockMutex.RUnlock()
+	return len(fake.unlockArgsForCall)
+}
+
+func (fake *FakePortPool) UnlockCalls(stub func()) {
+	fake.unlockMutex.Lock()
+	defer fake.unlockMutex.Unlock()
+	fake.UnlockStub = stub
+}
+
+func (fake *FakePortPool) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.acquireMutex.RLock()
+	defer fake.acquireMutex.RUnlock()
+	fake.claimMutex.RLock()
+	defer fake.claimMutex.RUnlock()
+	fake.lockMutex.RLock()
+	defer fake.lockMutex.RUnlock()
+	fake.releaseMutex.RLock()
+	defer fake.releaseMutex.RUnlock()
+	fake.unlockMutex.RLock()
+	defer fake.unlockMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakePortPool) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ backend.PortPool = new(FakePortPool)
@@ -2,11 +2,18 @@ package backend
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"net"
+	"os"
 	"path/filepath"
+	"strings"
 
+	"code.cloudfoundry.org/garden"
 	"github.com/containerd/containerd"
 	"github.com/containerd/go-cni"
+	"github.com/coreos/go-iptables/iptables"
 	"github.com/opencontainers/runtime-spec/specs-go"
 )
 
@@ -41,6 +48,11 @@ const (
 	// binaries in.
 	//
 	binariesDir = "/usr/local/concourse/bin"
+
+	// addressesFile is the name of the file, within a container's directory
+	// in the file store, where the addresses it was given are kept.
+	//
+	addressesFile = "addresses.json"
 )
 
 var (
@@ -89,6 +101,68 @@ func (c CNINetworkConfig) ToJSON() string {
 	)
 }
 
+// portMapConfList is the configuration of a network made only of the portmap
+// plugin, used to map ports to a container which is already on the network.
+//
+// portmap is meant to be chained after the plugins which set up the
+// container's interface, taking its IP from their result. As it is run on its
+// own, that result is given to it up front, as if it had been chained.
+//
+type portMapConfList struct {
+	CNIVersion string          `json:"cniVersion"`
+	Name       string          `json:"name"`
+	Plugins    []portMapPlugin `json:"plugins"`
+}
+
+type portMapPlugin struct {
+	Type         string          `json:"type"`
+	Capabilities map[string]bool `json:"capabilities"`
+	PrevResult   portMapResult   `json:"prevResult"`
+}
+
+type portMapResult struct {
+	CNIVersion string            `json:"cniVersion"`
+	IPs        []portMapResultIP `json:"ips"`
+}
+
+type portMapResultIP struct {
+	Version string `json:"version"`
+	Address string `json:"address"`
+}
+
+// PortMapJSON returns the configuration of the network through which ports
+// are mapped to the container with the given IP.
+//
+func (c CNINetworkConfig) PortMapJSON(containerIP string) (string, error) {
+	ip := net.ParseIP(containerIP)
+	if ip == nil {
+		return "", ErrInvalidInput("invalid container ip: " + containerIP)
+	}
+
+	resultIP := portMapResultIP{Version: "4", Address: ip.String() + "/32"}
+	if ip.To4() == nil {
+		resultIP = portMapResultIP{Version: "6", Address: ip.String() + "/128"}
+	}
+
+	content, err := json.Marshal(portMapConfList{
+		CNIVersion: "0.4.0",
+		Name:       c.NetworkName + "-portmap",
+		Plugins: []portMapPlugin{{
+			Type:         "portmap",
+			Capabilities: map[string]bool{"portMappings": true},
+			PrevResult: portMapResult{
+				CNIVersion: "0.4.0",
+				IPs:        []portMapResultIP{resultIP},
+			},
+		}},
+	})
+	if err != nil {
+		return "", fmt.Errorf("marshal portmap config: %w", err)
+	}
+
+	return string(content), nil
+}
+
 // CNINetworkOpt defines a functional option that when applied, modifies the
 // configuration of a CNINetwork.
 //
@@ -121,6 +195,15 @@ func WithCNIClient(c cni.CNI) CNINetworkOpt {
 	}
 }
 
+// WithCNIPortMapClient changes how the CNI clients used for mapping ports to
+// the container with a given IP are created.
+//
+func WithCNIPortMapClient(f func(containerIP string) (cni.CNI, error)) CNINetworkOpt {
+	return func(n *cniNetwork) {
+		n.portMapClient = f
+	}
+}
+
 // WithCNINetworkConfig provides a custom CNINetworkConfig to be used by the CNI
 // client at startup time.
 //
@@ -130,6 +213,25 @@ func WithCNINetworkConfig(c CNINetworkConfig) CNINetworkOpt {
 	}
 }
 
+// WithIptables configures the Iptables used to restrict the traffic going out
+// of containers.
+//
+func WithIptables(i Iptables) CNINetworkOpt {
+	return func(n *cniNetwork) {
+		n.iptables = i
+	}
+}
+
+// WithDeniedNetworks sets the networks (in CIDR notation) that containers
+// cannot reach, unless allowed to through NetOut, e.g. cloud metadata
+// endpoints.
+//
+func WithDeniedNetworks(networks []string) CNINetworkOpt {
+	return func(n *cniNetwork) {
+		n.deniedNetworks = networks
+	}
+}
+
 // WithCNIFileStore changes the default FileStore used to store files that
 // belong to network configurations for containers.
 //
@@ -140,11 +242,14 @@ func WithCNIFileStore(f FileStore) CNINetworkOpt {
 }
 
 type cniNetwork struct {
-	client      cni.CNI
-	store       FileStore
-	config      CNINetworkConfig
-	nameServers []string
-	binariesDir string
+	client         cni.CNI
+	portMapClient  func(containerIP string) (cni.CNI, error)
+	iptables       Iptables
+	store          FileStore
+	config         CNINetworkConfig
+	nameServers    []string
+	deniedNetworks []string
+	binariesDir    string
 }
 
 var _ Network = (*cniNetwork)(nil)
@@ -166,6 +271,10 @@ func NewCNINetwork(opts ...CNINetworkOpt) (*cniNetwork, error) {
 		n.store = NewFileStore(fileStoreWorkDir)
 	}
 
+	if n.portMapClient == nil {
+		n.portMapClient = n.newPortMapClient
+	}
+
 	if n.client == nil {
 		n.client, err = cni.New(cni.WithPluginDir([]string{n.binariesDir}))
 		if err != nil {
@@ -184,6 +293,25 @@ func NewCNINetwork(opts ...CNINetworkOpt) (*cniNetwork, error) {
 	return n, nil
 }
 
+func (n cniNetwork) newPortMapClient(containerIP string) (cni.CNI, error) {
+	client, err := cni.New(cni.WithPluginDir([]string{n.binariesDir}))
+	if err != nil {
+		return nil, fmt.Errorf("cni init: %w", err)
+	}
+
+	confList, err := n.config.PortMapJSON(containerIP)
+	if err != nil {
+		return nil, err
+	}
+
+	err = client.Load(cni.WithConfListBytes([]byte(confList)))
+	if err != nil {
+		return nil, fmt.Errorf("cni configuration loading: %w", err)
+	}
+
+	return client, nil
+}
+
 func (n cniNetwork) SetupMounts(handle string) ([]specs.Mount, error) {
 	if handle == "" {
 		return nil, ErrInvalidInput("empty handle")
@@ -236,14 +364,192 @@ func (n cniNetwork) Add(ctx context.Context, task containerd.Task) error {
 
 	id, netns := netId(task), netNsPath(task)
 
-	_, err := n.client.Setup(ctx, id, netns)
+	result, err := n.client.Setup(ctx, id, netns)
 	if err != nil {
 		return fmt.Errorf("cni net setup: %w", err)
 	}
 
+	addresses := resultAddresses(result)
+
+	content, err := json.Marshal(addresses)
+	if err != nil {
+		return fmt.Errorf("marshal addresses: %w", err)
+	}
+
+	_, err = n.store.Create(filepath.Join(id, addressesFile), content)
+	if err != nil {
+		return fmt.Errorf("creating addresses file: %w", err)
+	}
+
+	if addresses.ContainerIP == "" {
+		return nil
+	}
+
+	chain := containerChain(id)
+
+	err = n.iptables.ClearChain(filterTable, chain)
+	if err != nil {
+		return fmt.Errorf("create egress chain: %w", err)
+	}
+
+	err = n.removeJumps(addresses.ContainerIP)
+	if err != nil {
+		return err
+	}
+
+	err = n.iptables.AppendUnique(filterTable, containersChain, "-s", addresses.ContainerIP, "-j", chain)
+	if err != nil {
+		return fmt.Errorf("jump to egress chain: %w", err)
+	}
+
 	return nil
 }
 
+// removeJumps deletes the jumps to container chains for the traffic from an
+// IP. A container which went away without being removed from the network
+// leaves its jump behind, which would otherwise apply its rules to the next
+// container given the IP.
+//
+func (n cniNetwork) removeJumps(ip string) error {
+	rules, err := n.iptables.List(filterTable, containersChain)
+	if err != nil {
+		return fmt.Errorf("list jumps to egress chains: %w", err)
+	}
+
+	for _, rule := range rules {
+		src, target := ruleArg(rule, "-s"), ruleArg(rule, "-j")
+		if target == "" || strings.SplitN(src, "/", 2)[0] != ip {
+			continue
+		}
+
+		err = n.iptables.Delete(filterTable, containersChain, "-s", src, "-j", target)
+		if err != nil {
+			return fmt.Errorf("delete stale jump to egress chain: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// ruleArg returns the value of a flag in a rule as listed by iptables -S.
+//
+func ruleArg(rule, flag string) string {
+	fields := strings.Fields(rule)
+	for i := 0; i < len(fields)-1; i++ {
+		if fields[i] == flag {
+			return fields[i+1]
+		}
+	}
+
+	return ""
+}
+
+// SetupDeniedNetworks also initializes the default Iptables, so that creating
+// the network doesn't depend on iptables being installed.
+//
+func (n *cniNetwork) SetupDeniedNetworks() error {
+	var err error
+
+	if n.iptables == nil {
+		n.iptables, err = iptables.New()
+		if err != nil {
+			return fmt.Errorf("iptables init: %w", err)
+		}
+	}
+
+	chains, err := n.iptables.ListChains(filterTable)
+	if err != nil {
+		return fmt.Errorf("list chains: %w", err)
+	}
+
+	// the containers' chain is kept across restarts so that the rules of
+	// containers which are still running are not lost
+	if !contains(chains, containersChain) {
+		err = n.iptables.NewChain(filterTable, containersChain)
+		if err != nil {
+			return fmt.Errorf("create containers chain: %w", err)
+		}
+	}
+
+	err = n.iptables.ClearChain(filterTable, egressChain)
+	if err != nil {
+		return fmt.Errorf("create egress chain: %w", err)
+	}
+
+	err = n.iptables.Append(filterTable, egressChain, "-j", containersChain)
+	if err != nil {
+		return fmt.Errorf("jump to containers chain: %w", err)
+	}
+
+	for _, network := range n.deniedNetworks {
+		err = n.iptables.Append(filterTable, egressChain, "-d", network, "-j", "REJECT")
+		if err != nil {
+			return fmt.Errorf("deny network %s: %w", network, err)
+		}
+	}
+
+	forward := []string{"-i", n.config.BridgeName, "-j", egressChain}
+
+	exists, err := n.iptables.Exists(filterTable, "FORWARD", forward...)
+	if err != nil {
+		return fmt.Errorf("check forward rule: %w", err)
+	}
+
+	if !exists {
+		err = n.iptables.Insert(filterTable, "FORWARD", 1, forward...)
+		if err != nil {
+			return fmt.Errorf("jump to egress chain: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (n cniNetwork) NetOut(ctx context.Context, task containerd.Task, rule garden.NetOutRule) error {
+	if task == nil {
+		return ErrInvalidInput("nil task")
+	}
+
+	rulespecs, err := netOutRulespecs(rule)
+	if err != nil {
+		return err
+	}
+
+	chain := containerChain(netId(task))
+
+	for _, rulespec := range rulespecs {
+		err = n.iptables.Append(filterTable, chain, rulespec...)
+		if err != nil {
+			return fmt.Errorf("append rule: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (n cniNetwork) Addresses(ctx context.Context, task containerd.Task) (string, string, error) {
+	if task == nil {
+		return "", "", ErrInvalidInput("nil task")
+	}
+
+	content, err := n.store.Read(filepath.Join(netId(task), addressesFile))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return "", "", nil
+		}
+
+		return "", "", fmt.Errorf("reading addresses file: %w", err)
+	}
+
+	var addresses networkAddresses
+	err = json.Unmarshal(content, &addresses)
+	if err != nil {
+		return "", "", fmt.Errorf("unmarshal addresses: %w", err)
+	}
+
+	return addresses.ContainerIP, addresses.HostIP, nil
+}
+
 func (n cniNetwork) Remove(ctx context.Context, task containerd.Task) error {
 	if task == nil {
 		return ErrInvalidInput("nil task")
@@ -251,14 +557,165 @@ func (n cniNetwork) Remove(ctx context.Context, task containerd.Task) error {
 
 	id, netns := netId(task), netNsPath(task)
 
-	err := n.client.Remove(ctx, id, netns)
+	containerIP, _, err := n.Addresses(ctx, task)
+	if err != nil {
+		return err
+	}
+
+	if containerIP != "" {
+		err = n.removeEgressChain(id, containerIP)
+		if err != nil {
+			return err
+		}
+
+		portMap, err := n.portMapClient(containerIP)
+		if err != nil {
+			return err
+		}
+
+		err = portMap.Remove(ctx, id, netns)
+		if err != nil {
+			return fmt.Errorf("cni port map teardown: %w", err)
+		}
+	}
+
+	err = n.client.Remove(ctx, id, netns)
 	if err != nil {
 		return fmt.Errorf("cni net teardown: %w", err)
 	}
 
+	err = n.store.Delete(filepath.Join(id, addressesFile))
+	if err != nil {
+		return fmt.Errorf("deleting addresses file: %w", err)
+	}
+
+	return nil
+}
+
+func (n cniNetwork) NetIn(ctx context.Context, task containerd.Task, mappings []garden.PortMapping) error {
+	if task == nil {
+		return ErrInvalidInput("nil task")
+	}
+
+	containerIP, _, err := n.Addresses(ctx, task)
+	if err != nil {
+		return err
+	}
+
+	if containerIP == "" {
+		return fmt.Errorf("task has no address on the network")
+	}
+
+	portMap, err := n.portMapClient(containerIP)
+	if err != nil {
+		return err
+	}
+
+	portMappings := make([]cni.PortMapping, len(mappings))
+	for i, mapping := range mappings {
+		portMappings[i] = cni.PortMapping{
+			HostPort:      int32(mapping.HostPort),
+			ContainerPort: int32(mapping.ContainerPort),
+			Protocol:      "tcp",
+		}
+	}
+
+	_, err = portMap.Setup(ctx, netId(task), netNsPath(task),
+		cni.WithCapabilityPortMap(portMappings),
+	)
+	if err != nil {
+		return fmt.Errorf("cni port map setup: %w", err)
+	}
+
 	return nil
 }
 
+// removeEgressChain removes the chain holding the net out rules of a
+// container, and the jump to it.
+//
+func (n cniNetwork) removeEgressChain(id, containerIP string) error {
+	chain := containerChain(id)
+	jump := []string{"-s", containerIP, "-j", chain}
+
+	exists, err := n.iptables.Exists(filterTable, containersChain, jump...)
+	if err != nil {
+		return fmt.Errorf("check jump to egress chain: %w", err)
+	}
+
+	if exists {
+		err = n.iptables.Delete(filterTable, containersChain, jump...)
+		if err != nil {
+			return fmt.Errorf("delete jump to egress chain: %w", err)
+		}
+	}
+
+	// clearing creates the chain if it is missing, so that deleting it
+	// succeeds for containers which never had one
+	err = n.iptables.ClearChain(filterTable, chain)
+	if err != nil {
+		return fmt.Errorf("clear egress chain: %w", err)
+	}
+
+	err = n.iptables.DeleteChain(filterTable, chain)
+	if err != nil {
+		return fmt.Errorf("delete egress chain: %w", err)
+	}
+
+	return nil
+}
+
+func contains(list []string, s string) bool {
+	for _, e := range list {
+		if e == s {
+			return true
+		}
+	}
+
+	return false
+}
+
+// networkAddresses are the IPs assigned to a task by the CNI plugins, kept
+// around so that they can be reported in the container's info.
+//
+type networkAddresses struct {
+	ContainerIP string `json:"container_ip"`
+	HostIP      string `json:"host_ip"`
+}
+
+// resultAddresses picks the IPv4 address of the container's interface, and
+// the gateway through which the host routes to it, out of the result of
+// setting up the network.
+//
+func resultAddresses(result *cni.CNIResult) networkAddresses {
+	var addresses networkAddresses
+
+	if result == nil {
+		return addresses
+	}
+
+	for name, iface := range result.Interfaces {
+		if iface == nil || iface.Sandbox == "" || name == "lo" {
+			continue
+		}
+
+		for _, ipConfig := range iface.IPConfigs {
+			if ipConfig.IP.To4() == nil {
+				continue
+			}
+
+			addresses.ContainerIP = ipConfig.IP.String()
+
+			if ipConfig.Gateway != nil {
+				addresses.HostIP = ipConfig.Gateway.String()
+			}
+
+			return addresses
+		}
+	}
+
+	return addresses
+}
+
 func netId(task containerd.Task) string {
 	return task.ID()
 }
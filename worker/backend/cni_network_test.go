@@ -3,10 +3,15 @@ package backend_test
 import (
 	"context"
 	"errors"
+	"fmt"
+	"net"
+	"os"
 
+	"code.cloudfoundry.org/garden"
 	"github.com/concourse/concourse/worker/backend"
 	"github.com/concourse/concourse/worker/backend/backendfakes"
 	"github.com/concourse/concourse/worker/backend/libcontainerd/libcontainerdfakes"
+	"github.com/containerd/go-cni"
 	"github.com/opencontainers/runtime-spec/specs-go"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
@@ -16,19 +21,31 @@ type CNINetworkSuite struct {
 	suite.Suite
 	*require.Assertions
 
-	network backend.Network
-	cni     *backendfakes.FakeCNI
-	store   *backendfakes.FakeFileStore
+	network    backend.Network
+	cni        *backendfakes.FakeCNI
+	portMap    *backendfakes.FakeCNI
+	portMapIPs []string
+	iptables   *backendfakes.FakeIptables
+	store      *backendfakes.FakeFileStore
 }
 
 func (s *CNINetworkSuite) SetupTest() {
 	var err error
 
 	s.store = new(backendfakes.FakeFileStore)
+	s.store.ReadReturns(nil, os.ErrNotExist)
 	s.cni = new(backendfakes.FakeCNI)
+	s.portMap = new(backendfakes.FakeCNI)
+	s.portMapIPs = nil
+	s.iptables = new(backendfakes.FakeIptables)
 	s.network, err = backend.NewCNINetwork(
 		backend.WithCNIFileStore(s.store),
 		backend.WithCNIClient(s.cni),
+		backend.WithCNIPortMapClient(func(containerIP string) (cni.CNI, error) {
+			s.portMapIPs = append(s.portMapIPs, containerIP)
+			return s.portMap, nil
+		}),
+		backend.WithIptables(s.iptables),
 	)
 	s.NoError(err)
 }
@@ -41,6 +58,7 @@ func (s *CNINetworkSuite) TestNewCNINetworkWithInvalidConfigDoesntFail() {
 		backend.WithCNINetworkConfig(backend.CNINetworkConfig{
 			Subnet: "_____________",
 		}),
+		backend.WithIptables(s.iptables),
 	)
 	s.NoError(err)
 }
@@ -101,6 +119,7 @@ func (s *CNINetworkSuite) TestSetupMountsReturnsMountpoints() {
 func (s *CNINetworkSuite) TestSetupMountsCallsStoreWithNoNameServer() {
 	network, err := backend.NewCNINetwork(
 		backend.WithCNIFileStore(s.store),
+		backend.WithIptables(s.iptables),
 	)
 	s.NoError(err)
 
@@ -115,6 +134,7 @@ func (s *CNINetworkSuite) TestSetupMountsCallsStoreWithOneNameServer() {
 	network, err := backend.NewCNINetwork(
 		backend.WithCNIFileStore(s.store),
 		backend.WithNameServers([]string{"6.6.7.7", "1.2.3.4"}),
+		backend.WithIptables(s.iptables),
 	)
 	s.NoError(err)
 
@@ -152,6 +172,135 @@ func (s *CNINetworkSuite) TestAdd() {
 	s.Equal("/proc/123/ns/net", netns)
 }
 
+func (s *CNINetworkSuite) TestAddStoresAddresses() {
+	s.cni.SetupReturns(&cni.CNIResult{
+		Interfaces: map[string]*cni.Config{
+			"lo": {
+				IPConfigs: []*cni.IPConfig{{IP: net.ParseIP("127.0.0.1")}},
+				Sandbox:   "/proc/123/ns/net",
+			},
+			"concourse0": {},
+			"eth0": {
+				IPConfigs: []*cni.IPConfig{{
+					IP:      net.ParseIP("10.80.0.2"),
+					Gateway: net.ParseIP("10.80.0.1"),
+				}},
+				Sandbox: "/proc/123/ns/net",
+			},
+		},
+	}, nil)
+	task := new(libcontainerdfakes.FakeTask)
+	task.IDReturns("id")
+
+	err := s.network.Add(context.Background(), task)
+	s.NoError(err)
+
+	s.Equal(1, s.store.CreateCallCount())
+	fname, content := s.store.CreateArgsForCall(0)
+	s.Equal("id/addresses.json", fname)
+	s.JSONEq(`{"container_ip":"10.80.0.2","host_ip":"10.80.0.1"}`, string(content))
+}
+
+func (s *CNINetworkSuite) TestAddCreatesEgressChain() {
+	s.cni.SetupReturns(&cni.CNIResult{
+		Interfaces: map[string]*cni.Config{
+			"eth0": {
+				IPConfigs: []*cni.IPConfig{{
+					IP:      net.ParseIP("10.80.0.2"),
+					Gateway: net.ParseIP("10.80.0.1"),
+				}},
+				Sandbox: "/proc/123/ns/net",
+			},
+		},
+	}, nil)
+	task := new(libcontainerdfakes.FakeTask)
+	task.IDReturns("id")
+
+	err := s.network.Add(context.Background(), task)
+	s.NoError(err)
+
+	s.Equal(1, s.iptables.ClearChainCallCount())
+	table, chain := s.iptables.ClearChainArgsForCall(0)
+	s.Equal("filter", table)
+	s.Regexp("^CONCOURSE-[0-9a-f]{16}$", chain)
+
+	s.Equal(1, s.iptables.AppendUniqueCallCount())
+	table, jumpChain, rulespec := s.iptables.AppendUniqueArgsForCall(0)
+	s.Equal("filter", table)
+	s.Equal("CONCOURSE-CONTAINERS", jumpChain)
+	s.Equal([]string{"-s", "10.80.0.2", "-j", chain}, rulespec)
+}
+
+func (s *CNINetworkSuite) TestAddRemovesStaleJumpsForTheContainerIP() {
+	s.cni.SetupReturns(&cni.CNIResult{
+		Interfaces: map[string]*cni.Config{
+			"eth0": {
+				IPConfigs: []*cni.IPConfig{{
+					IP:      net.ParseIP("10.80.0.2"),
+					Gateway: net.ParseIP("10.80.0.1"),
+				}},
+				Sandbox: "/proc/123/ns/net",
+			},
+		},
+	}, nil)
+	s.iptables.ListReturns([]string{
+		"-N CONCOURSE-CONTAINERS",
+		"-A CONCOURSE-CONTAINERS -s 10.80.0.2/32 -j CONCOURSE-0123456789abcdef",
+		"-A CONCOURSE-CONTAINERS -s 10.80.0.20/32 -j CONCOURSE-fedcba9876543210",
+	}, nil)
+	task := new(libcontainerdfakes.FakeTask)
+	task.IDReturns("id")
+
+	err := s.network.Add(context.Background(), task)
+	s.NoError(err)
+
+	table, chain := s.iptables.ListArgsForCall(0)
+	s.Equal("filter", table)
+	s.Equal("CONCOURSE-CONTAINERS", chain)
+
+	s.Equal(1, s.iptables.DeleteCallCount())
+	table, chain, rulespec := s.iptables.DeleteArgsForCall(0)
+	s.Equal("filter", table)
+	s.Equal("CONCOURSE-CONTAINERS", chain)
+	s.Equal([]string{"-s", "10.80.0.2/32", "-j", "CONCOURSE-0123456789abcdef"}, rulespec)
+
+	s.Equal(1, s.iptables.AppendUniqueCallCount())
+}
+
+func (s *CNINetworkSuite) TestAddWithoutAddressesDoesntCreateEgressChain() {
+	task := new(libcontainerdfakes.FakeTask)
+	task.IDReturns("id")
+
+	err := s.network.Add(context.Background(), task)
+	s.NoError(err)
+
+	s.Equal(0, s.iptables.ClearChainCallCount())
+	s.Equal(0, s.iptables.AppendUniqueCallCount())
+}
+
+func (s *CNINetworkSuite) TestAddressesReadsStoredAddresses() {
+	s.store.ReadReturns([]byte(`{"container_ip":"10.80.0.2","host_ip":"10.80.0.1"}`), nil)
+	task := new(libcontainerdfakes.FakeTask)
+	task.IDReturns("id")
+
+	containerIP, hostIP, err := s.network.Addresses(context.Background(), task)
+	s.NoError(err)
+	s.Equal("10.80.0.2", containerIP)
+	s.Equal("10.80.0.1", hostIP)
+
+	s.Equal("id/addresses.json", s.store.ReadArgsForCall(0))
+}
+
+func (s *CNINetworkSuite) TestAddressesWithoutStoredAddresses() {
+	s.store.ReadReturns(nil, fmt.Errorf("read file: %w", os.ErrNotExist))
+	task := new(libcontainerdfakes.FakeTask)
+
+	containerIP, hostIP, err := s.network.Addresses(context.Background(), task)
+	s.NoError(err)
+	s.Empty(containerIP)
+	s.Empty(hostIP)
+}
+
 func (s *CNINetworkSuite) TestRemoveNilTask() {
 	err := s.network.Remove(context.Background(), nil)
 	s.EqualError(err, "nil task")
@@ -177,4 +326,236 @@ func (s *CNINetworkSuite) TestRemove() {
 	_, id, netns, _ := s.cni.RemoveArgsForCall(0)
 	s.Equal("id", id)
 	s.Equal("/proc/123/ns/net", netns)
+
+	s.Equal(1, s.store.DeleteCallCount())
+	s.Equal("id/addresses.json", s.store.DeleteArgsForCall(0))
+}
+
+func (s *CNINetworkSuite) TestRemoveDeletesEgressChain() {
+	s.store.ReadReturns([]byte(`{"container_ip":"10.80.0.2","host_ip":"10.80.0.1"}`), nil)
+	s.iptables.ExistsReturns(true, nil)
+	task := new(libcontainerdfakes.FakeTask)
+	task.IDReturns("id")
+
+	err := s.network.Remove(context.Background(), task)
+	s.NoError(err)
+
+	s.Equal(1, s.iptables.DeleteCallCount())
+	table, jumpChain, rulespec := s.iptables.DeleteArgsForCall(0)
+	s.Equal("filter", table)
+	s.Equal("CONCOURSE-CONTAINERS", jumpChain)
+	s.Len(rulespec, 4)
+	s.Equal([]string{"-s", "10.80.0.2", "-j"}, rulespec[:3])
+
+	s.Equal(1, s.iptables.DeleteChainCallCount())
+	_, chain := s.iptables.DeleteChainArgsForCall(0)
+	s.Equal(rulespec[3], chain)
+}
+
+func (s *CNINetworkSuite) TestRemoveTearsDownPortMappings() {
+	s.store.ReadReturns([]byte(`{"container_ip":"10.80.0.2","host_ip":"10.80.0.1"}`), nil)
+	task := new(libcontainerdfakes.FakeTask)
+	task.PidReturns(123)
+	task.IDReturns("id")
+
+	err := s.network.Remove(context.Background(), task)
+	s.NoError(err)
+
+	s.Equal([]string{"10.80.0.2"}, s.portMapIPs)
+	s.Equal(1, s.portMap.RemoveCallCount())
+	_, id, netns, _ := s.portMap.RemoveArgsForCall(0)
+	s.Equal("id", id)
+	s.Equal("/proc/123/ns/net", netns)
+}
+
+func (s *CNINetworkSuite) TestRemovePortMapTeardownFails() {
+	s.store.ReadReturns([]byte(`{"container_ip":"10.80.0.2","host_ip":"10.80.0.1"}`), nil)
+	s.portMap.RemoveReturns(errors.New("remove-err"))
+	task := new(libcontainerdfakes.FakeTask)
+
+	err := s.network.Remove(context.Background(), task)
+	s.EqualError(errors.Unwrap(err), "remove-err")
+	s.Equal(0, s.cni.RemoveCallCount())
+}
+
+func (s *CNINetworkSuite) TestRemoveWithoutAddressesLeavesIptablesAlone() {
+	task := new(libcontainerdfakes.FakeTask)
+	task.IDReturns("id")
+
+	err := s.network.Remove(context.Background(), task)
+	s.NoError(err)
+
+	s.Equal(0, s.iptables.ExistsCallCount())
+	s.Equal(0, s.iptables.DeleteChainCallCount())
+	s.Equal(0, s.portMap.RemoveCallCount())
+}
+
+func (s *CNINetworkSuite) TestSetupDeniedNetworks() {
+	network, err := backend.NewCNINetwork(
+		backend.WithIptables(s.iptables),
+		backend.WithDeniedNetworks([]string{"169.254.169.254/32", "10.0.0.0/8"}),
+	)
+	s.NoError(err)
+
+	err = network.SetupDeniedNetworks()
+	s.NoError(err)
+
+	s.Equal(1, s.iptables.NewChainCallCount())
+	_, chain := s.iptables.NewChainArgsForCall(0)
+	s.Equal("CONCOURSE-CONTAINERS", chain)
+
+	s.Equal(1, s.iptables.ClearChainCallCount())
+	_, chain = s.iptables.ClearChainArgsForCall(0)
+	s.Equal("CONCOURSE-EGRESS", chain)
+
+	s.Equal(3, s.iptables.AppendCallCount())
+	_, chain, rulespec := s.iptables.AppendArgsForCall(0)
+	s.Equal("CONCOURSE-EGRESS", chain)
+	s.Equal([]string{"-j", "CONCOURSE-CONTAINERS"}, rulespec)
+	_, _, rulespec = s.iptables.AppendArgsForCall(1)
+	s.Equal([]string{"-d", "169.254.169.254/32", "-j", "REJECT"}, rulespec)
+	_, _, rulespec = s.iptables.AppendArgsForCall(2)
+	s.Equal([]string{"-d", "10.0.0.0/8", "-j", "REJECT"}, rulespec)
+
+	s.Equal(1, s.iptables.InsertCallCount())
+	_, chain, pos, rulespec := s.iptables.InsertArgsForCall(0)
+	s.Equal("FORWARD", chain)
+	s.Equal(1, pos)
+	s.Equal([]string{"-i", "concourse0", "-j", "CONCOURSE-EGRESS"}, rulespec)
+}
+
+func (s *CNINetworkSuite) TestSetupDeniedNetworksKeepsExistingRules() {
+	s.iptables.ListChainsReturns([]string{"INPUT", "FORWARD", "CONCOURSE-CONTAINERS"}, nil)
+	s.iptables.ExistsReturns(true, nil)
+
+	err := s.network.SetupDeniedNetworks()
+	s.NoError(err)
+
+	s.Equal(0, s.iptables.NewChainCallCount())
+	s.Equal(0, s.iptables.InsertCallCount())
+}
+
+func (s *CNINetworkSuite) TestNetOut() {
+	task := new(libcontainerdfakes.FakeTask)
+	task.IDReturns("id")
+
+	err := s.network.NetOut(context.Background(), task, garden.NetOutRule{
+		Protocol: garden.ProtocolTCP,
+		Networks: []garden.IPRange{
+			garden.IPRangeFromIP(net.ParseIP("1.2.3.4")),
+			{Start: net.ParseIP("10.0.0.1"), End: net.ParseIP("10.0.0.9")},
+		},
+		Ports: []garden.PortRange{garden.PortRangeFromPort(80), {Start: 8000, End: 8080}},
+	})
+	s.NoError(err)
+
+	var rulespecs [][]string
+	for i := 0; i < s.iptables.AppendCallCount(); i++ {
+		_, chain, rulespec := s.iptables.AppendArgsForCall(i)
+		s.Regexp("^CONCOURSE-[0-9a-f]{16}$", chain)
+		rulespecs = append(rulespecs, rulespec)
+	}
+
+	s.Equal([][]string{
+		{"-p", "tcp", "-d", "1.2.3.4", "--dport", "80", "-j", "ACCEPT"},
+		{"-p", "tcp", "-d", "1.2.3.4", "--dport", "8000:8080", "-j", "ACCEPT"},
+		{"-p", "tcp", "-m", "iprange", "--dst-range", "10.0.0.1-10.0.0.9", "--dport", "80", "-j", "ACCEPT"},
+		{"-p", "tcp", "-m", "iprange", "--dst-range", "10.0.0.1-10.0.0.9", "--dport", "8000:8080", "-j", "ACCEPT"},
+	}, rulespecs)
+}
+
+func (s *CNINetworkSuite) TestNetOutICMPWithLog() {
+	task := new(libcontainerdfakes.FakeTask)
+	code := garden.ICMPCode(0)
+
+	err := s.network.NetOut(context.Background(), task, garden.NetOutRule{
+		Protocol: garden.ProtocolICMP,
+		ICMPs:    &garden.ICMPControl{Type: 8, Code: &code},
+		Log:      true,
+	})
+	s.NoError(err)
+
+	s.Equal(1, s.iptables.AppendCallCount())
+	_, _, rulespec := s.iptables.AppendArgsForCall(0)
+	s.Equal([]string{"-p", "icmp", "--icmp-type", "8/0", "-j", "ACCEPT"}, rulespec)
+}
+
+func (s *CNINetworkSuite) TestNetOutAllWithLog() {
+	task := new(libcontainerdfakes.FakeTask)
+
+	err := s.network.NetOut(context.Background(), task, garden.NetOutRule{
+		Log: true,
+	})
+	s.NoError(err)
+
+	s.Equal(2, s.iptables.AppendCallCount())
+	_, _, rulespec := s.iptables.AppendArgsForCall(0)
+	s.Equal([]string{"-m", "conntrack", "--ctstate", "NEW", "-j", "LOG"}, rulespec)
+	_, _, rulespec = s.iptables.AppendArgsForCall(1)
+	s.Equal([]string{"-j", "ACCEPT"}, rulespec)
+}
+
+func (s *CNINetworkSuite) TestNetOutInvalidRule() {
+	task := new(libcontainerdfakes.FakeTask)
+
+	err := s.network.NetOut(context.Background(), task, garden.NetOutRule{
+		Protocol: garden.ProtocolICMP,
+		Ports:    []garden.PortRange{garden.PortRangeFromPort(80)},
+	})
+	s.True(errors.Is(err, backend.ErrInvalidInput("ports can only be specified for tcp or udp")))
+	s.Equal(0, s.iptables.AppendCallCount())
+}
+
+func (s *CNINetworkSuite) TestNetInWithoutAddressesFails() {
+	task := new(libcontainerdfakes.FakeTask)
+
+	err := s.network.NetIn(context.Background(), task, []garden.PortMapping{{HostPort: 8080, ContainerPort: 80}})
+	s.EqualError(err, "task has no address on the network")
+	s.Equal(0, s.portMap.SetupCallCount())
+}
+
+func (s *CNINetworkSuite) TestNetIn() {
+	s.store.ReadReturns([]byte(`{"container_ip":"10.80.0.2","host_ip":"10.80.0.1"}`), nil)
+	task := new(libcontainerdfakes.FakeTask)
+	task.PidReturns(123)
+	task.IDReturns("id")
+
+	err := s.network.NetIn(context.Background(), task, []garden.PortMapping{{HostPort: 8080, ContainerPort: 80}})
+	s.NoError(err)
+
+	s.Equal([]string{"10.80.0.2"}, s.portMapIPs)
+	s.Equal(1, s.portMap.SetupCallCount())
+	_, id, netns, opts := s.portMap.SetupArgsForCall(0)
+	s.Equal("id", id)
+	s.Equal("/proc/123/ns/net", netns)
+	s.Len(opts, 1)
+}
+
+func (s *CNINetworkSuite) TestNetInSetupFails() {
+	s.store.ReadReturns([]byte(`{"container_ip":"10.80.0.2","host_ip":"10.80.0.1"}`), nil)
+	s.portMap.SetupReturns(nil, errors.New("setup-err"))
+	task := new(libcontainerdfakes.FakeTask)
+
+	err := s.network.NetIn(context.Background(), task, []garden.PortMapping{{HostPort: 8080, ContainerPort: 80}})
+	s.EqualError(errors.Unwrap(err), "setup-err")
+}
+
+func (s *CNINetworkSuite) TestPortMapJSON() {
+	config, err := backend.CNINetworkConfig{NetworkName: "concourse"}.PortMapJSON("10.80.0.2")
+	s.NoError(err)
+	s.JSONEq(`{
+		"cniVersion": "0.4.0",
+		"name": "concourse-portmap",
+		"plugins": [{
+			"type": "portmap",
+			"capabilities": {"portMappings": true},
+			"prevResult": {
+				"cniVersion": "0.4.0",
+				"ips": [{"version": "4", "address": "10.80.0.2/32"}]
+			}
+		}]
+	}`, config)
+
+	_, err = backend.CNINetworkConfig{}.PortMapJSON("not-an-ip")
+	s.EqualError(err, "invalid container ip: not-an-ip")
 }
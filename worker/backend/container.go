@@ -1,35 +1,62 @@
 package backend
 
 import (
+	"archive/tar"
 	"context"
+	"encoding/json"
 	"fmt"
 	"io"
+	"os"
+	"path"
+	"strings"
 	"time"
 
 	"code.cloudfoundry.org/garden"
+	cgroupsv1 "github.com/containerd/cgroups/stats/v1"
 	"github.com/containerd/containerd"
 	"github.com/containerd/containerd/cio"
+	"github.com/containerd/containerd/errdefs"
+	"github.com/containerd/containerd/runtime/linux/runctypes"
+	"github.com/containerd/containerd/runtime/v2/runc/options"
+	"github.com/containerd/typeurl"
 	uuid "github.com/nu7hatch/gouuid"
 	"github.com/opencontainers/runtime-spec/specs-go"
 )
 
 const GraceTimeKey = "garden.grace-time"
 
+const (
+	// StateActive is the state of a container whose task is still around.
+	//
+	StateActive = "active"
+
+	// StateStopped is the state of a container whose task has exited or
+	// been deleted.
+	//
+	StateStopped = "stopped"
+)
+
 type Container struct {
 	container     containerd.Container
 	killer        Killer
 	rootfsManager RootfsManager
+	network       Network
+	portPool      PortPool
 }
 
 func NewContainer(
 	container containerd.Container,
 	killer Killer,
 	rootfsManager RootfsManager,
+	network Network,
+	portPool PortPool,
 ) *Container {
 	return &Container{
 		container:     container,
 		killer:        killer,
 		rootfsManager: rootfsManager,
+		network:       network,
+		portPool:      portPool,
 	}
 }
 
@@ -208,28 +235,235 @@ func (c *Container) RemoveProperty(name string) (err error) {
 	return
 }
 
-// Info - Not Implemented
-func (c *Container) Info() (info garden.ContainerInfo, err error) {
-	err = ErrNotImplemented
-	return
+// Info returns the state of the container, the IDs of the processes run in
+// it, its addresses on the network, the ports mapped to it and its
+// properties.
+//
+func (c *Container) Info() (garden.ContainerInfo, error) {
+	ctx := context.Background()
+
+	properties, err := c.Properties()
+	if err != nil {
+		return garden.ContainerInfo{}, err
+	}
+
+	info := garden.ContainerInfo{
+		State:      StateStopped,
+		Properties: properties,
+	}
+
+	task, err := c.container.Task(ctx, cio.Load)
+	if err != nil {
+		if errdefs.IsNotFound(err) {
+			return info, nil
+		}
+
+		return garden.ContainerInfo{}, fmt.Errorf("task lookup: %w", err)
+	}
+
+	status, err := task.Status(ctx)
+	if err != nil {
+		return garden.ContainerInfo{}, fmt.Errorf("task status: %w", err)
+	}
+
+	if status.Status != containerd.Stopped {
+		info.State = StateActive
+	}
+
+	info.ProcessIDs, err = execIDs(ctx, task)
+	if err != nil {
+		return garden.ContainerInfo{}, err
+	}
+
+	info.MappedPorts, err = mappedPorts(properties)
+	if err != nil {
+		return garden.ContainerInfo{}, err
+	}
+
+	if properties[NetworkKey] != NetworkNone {
+		info.ContainerIP, info.HostIP, err = c.network.Addresses(ctx, task)
+		if err != nil {
+			return garden.ContainerInfo{}, fmt.Errorf("network addresses: %w", err)
+		}
+	}
+
+	return info, nil
 }
 
-// Metrics - Not Implemented
-func (c *Container) Metrics() (metrics garden.Metrics, err error) {
-	err = ErrNotImplemented
-	return
+// Metrics returns the CPU, memory and pid usage of the container, as read
+// from its cgroup through the task's metrics.
+//
+// Block IO stats are collected by containerd too, but garden.Metrics has no
+// field to report them through.
+//
+func (c *Container) Metrics() (garden.Metrics, error) {
+	ctx := context.Background()
+
+	info, err := c.container.Info(ctx)
+	if err != nil {
+		return garden.Metrics{}, fmt.Errorf("container info: %w", err)
+	}
+
+	task, err := c.container.Task(ctx, cio.Load)
+	if err != nil {
+		return garden.Metrics{}, fmt.Errorf("task lookup: %w", err)
+	}
+
+	metric, err := task.Metrics(ctx)
+	if err != nil {
+		return garden.Metrics{}, fmt.Errorf("task metrics: %w", err)
+	}
+
+	data, err := typeurl.UnmarshalAny(metric.Data)
+	if err != nil {
+		return garden.Metrics{}, fmt.Errorf("unmarshal metrics: %w", err)
+	}
+
+	stats, ok := data.(*cgroupsv1.Metrics)
+	if !ok {
+		return garden.Metrics{}, fmt.Errorf("unexpected metrics type %T", data)
+	}
+
+	metrics := gardenMetrics(stats)
+	metrics.Age = time.Since(info.CreatedAt)
+
+	return metrics, nil
 }
 
-// StreamIn - Not Implemented
-func (c *Container) StreamIn(spec garden.StreamInSpec) (err error) {
-	err = ErrNotImplemented
-	return
+// StreamIn extracts a tar stream into a directory in the container, creating
+// it if needed. The files are written from the host, straight into the
+// container's root filesystem or the mount the directory is in.
+//
+// Files streamed in as a user other than root are owned by that user, while
+// those streamed in as root keep the ownership recorded in the stream, as
+// with tar.
+//
+func (c *Container) StreamIn(spec garden.StreamInSpec) error {
+	fs, err := c.fs(context.Background())
+	if err != nil {
+		return err
+	}
+
+	o, err := fs.owner(spec.User)
+	if err != nil {
+		return fmt.Errorf("lookup user: %w", err)
+	}
+
+	dest := path.Clean("/" + spec.Path)
+
+	err = fs.mkdirAll(dest, o)
+	if err != nil {
+		return fmt.Errorf("create destination: %w", err)
+	}
+
+	tr := tar.NewReader(spec.TarStream)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("read tar: %w", err)
+		}
+
+		if hdr.Typeflag == tar.TypeXGlobalHeader {
+			continue
+		}
+
+		target, err := streamInPath(dest, hdr.Name)
+		if err != nil {
+			return err
+		}
+
+		if target == dest {
+			continue
+		}
+
+		var linkTarget string
+		if hdr.Typeflag == tar.TypeLink {
+			linkTarget, err = streamInPath(dest, hdr.Linkname)
+			if err != nil {
+				return err
+			}
+		}
+
+		err = fs.extract(target, hdr, tr, o, linkTarget)
+		if err != nil {
+			return err
+		}
+	}
 }
 
-// StreamOut - Not Implemented
-func (c *Container) StreamOut(spec garden.StreamOutSpec) (readCloser io.ReadCloser, err error) {
-	err = ErrNotImplemented
-	return
+// StreamOut streams a path in the container out as a tar stream, including
+// any mounts under it. When the path ends in a slash only the contents of the
+// directory are streamed, otherwise the path itself is.
+//
+func (c *Container) StreamOut(spec garden.StreamOutSpec) (io.ReadCloser, error) {
+	fs, err := c.fs(context.Background())
+	if err != nil {
+		return nil, err
+	}
+
+	src := path.Clean("/" + spec.Path)
+	name := path.Base(src)
+	if strings.HasSuffix(spec.Path, "/") {
+		name = "."
+	}
+
+	srcPath, err := fs.hostPath(src)
+	if err != nil {
+		return nil, fmt.Errorf("stream out %s: %w", spec.Path, err)
+	}
+
+	_, err = os.Lstat(srcPath.path())
+	_ = srcPath.Close()
+	if err != nil {
+		return nil, fmt.Errorf("stream out %s: %w", spec.Path, err)
+	}
+
+	r, w := io.Pipe()
+
+	go func() {
+		tw := tar.NewWriter(w)
+
+		err := fs.compress(tw, src, name)
+		if err == nil {
+			err = tw.Close()
+		}
+
+		_ = w.CloseWithError(err)
+	}()
+
+	return r, nil
+}
+
+// fs gives access to the container's files from the host. Mounts that only
+// exist in the container's mount namespace are reached through its init
+// process, if it is running.
+//
+func (c *Container) fs(ctx context.Context) (containerFS, error) {
+	containerSpec, err := c.container.Spec(ctx)
+	if err != nil {
+		return containerFS{}, fmt.Errorf("container spec: %w", err)
+	}
+
+	fs := containerFS{spec: containerSpec}
+
+	task, err := c.container.Task(ctx, nil)
+	if err != nil {
+		if errdefs.IsNotFound(err) {
+			return fs, nil
+		}
+
+		return containerFS{}, fmt.Errorf("task lookup: %w", err)
+	}
+
+	if task != nil {
+		fs.procRoot = fmt.Sprintf("/proc/%d/root", task.Pid())
+	}
+
+	return fs, nil
 }
 
 // SetGraceTime stores the grace time as a containerd label with key "garden.grace-time"
@@ -293,22 +527,143 @@ func (c *Container) CurrentMemoryLimits() (limits garden.MemoryLimits, err error
 	}, nil
 }
 
-// NetIn - Not Implemented
-func (c *Container) NetIn(hostPort, containerPort uint32) (a, b uint32, err error) {
-	err = ErrNotImplemented
-	return
+// NetIn maps a port on the host to a port of the container, recording the
+// mapping in the container's properties.
+//
+// When hostPort is 0, a free port is taken from the port pool. Otherwise the
+// port must not be mapped to a container or bound on the host already. When
+// containerPort is 0, it is the same as hostPort.
+//
+func (c *Container) NetIn(hostPort, containerPort uint32) (uint32, uint32, error) {
+	ctx := context.Background()
+
+	c.portPool.Lock()
+	defer c.portPool.Unlock()
+
+	properties, err := c.Properties()
+	if err != nil {
+		return 0, 0, err
+	}
+
+	if properties[NetworkKey] == NetworkNone {
+		return 0, 0, fmt.Errorf("container is not on the network")
+	}
+
+	mappedPorts, err := mappedPorts(properties)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	task, err := c.container.Task(ctx, nil)
+	if err != nil {
+		return 0, 0, fmt.Errorf("task lookup: %w", err)
+	}
+
+	if hostPort == 0 {
+		hostPort, err = c.portPool.Acquire()
+	} else {
+		err = c.portPool.Claim(hostPort)
+	}
+
+	if err != nil {
+		return 0, 0, err
+	}
+
+	if containerPort == 0 {
+		containerPort = hostPort
+	}
+
+	mapping := garden.PortMapping{HostPort: hostPort, ContainerPort: containerPort}
+
+	err = c.network.NetIn(ctx, task, []garden.PortMapping{mapping})
+	if err != nil {
+		c.portPool.Release(hostPort)
+		return 0, 0, fmt.Errorf("net in: %w", err)
+	}
+
+	// a port that is not recorded in the container's properties would never
+	// be released when it is destroyed
+	value, err := json.Marshal(append(mappedPorts, mapping))
+	if err != nil {
+		c.portPool.Release(hostPort)
+		return 0, 0, fmt.Errorf("marshal mapped ports: %w", err)
+	}
+
+	err = c.SetProperty(MappedPortsKey, string(value))
+	if err != nil {
+		c.portPool.Release(hostPort)
+		return 0, 0, err
+	}
+
+	return hostPort, containerPort, nil
 }
 
-// NetOut - Not Implemented
+// NetOut allows the container to reach the networks and ports of a rule,
+// even if they are denied to containers by the worker.
+//
 func (c *Container) NetOut(netOutRule garden.NetOutRule) (err error) {
-	err = ErrNotImplemented
-	return
+	return c.BulkNetOut([]garden.NetOutRule{netOutRule})
 }
 
-// BulkNetOut - Not Implemented
-func (c *Container) BulkNetOut(netOutRules []garden.NetOutRule) (err error) {
-	err = ErrNotImplemented
-	return
+// BulkNetOut allows the container to reach the networks and ports of each
+// of the rules.
+//
+func (c *Container) BulkNetOut(netOutRules []garden.NetOutRule) error {
+	ctx := context.Background()
+
+	properties, err := c.Properties()
+	if err != nil {
+		return err
+	}
+
+	if properties[NetworkKey] == NetworkNone {
+		return fmt.Errorf("container is not on the network")
+	}
+
+	task, err := c.container.Task(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("task lookup: %w", err)
+	}
+
+	for _, rule := range netOutRules {
+		err = c.network.NetOut(ctx, task, rule)
+		if err != nil {
+			return fmt.Errorf("net out: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// mappedPorts returns the port mappings recorded in a container's
+// properties.
+//
+func mappedPorts(properties garden.Properties) ([]garden.PortMapping, error) {
+	value, found := properties[MappedPortsKey]
+	if !found {
+		return nil, nil
+	}
+
+	var mappings []garden.PortMapping
+	err := json.Unmarshal([]byte(value), &mappings)
+	if err != nil {
+		return nil, fmt.Errorf("unmarshal mapped ports: %w", err)
+	}
+
+	return mappings, nil
+}
+
+// streamInPath returns where an entry of a tar stream goes in the container,
+// refusing entries that would end up outside of the destination.
+//
+func streamInPath(dest, name string) (string, error) {
+	target := path.Join(dest, name)
+
+	if target != dest && !strings.HasPrefix(target, strings.TrimSuffix(dest, "/")+"/") {
+		return "", fmt.Errorf("tar entry '%s' is outside of the destination", name)
+	}
+
+	return target, nil
 }
 
 func procID(gdnProcSpec garden.ProcessSpec) string {
@@ -325,6 +680,37 @@ func procID(gdnProcSpec garden.ProcessSpec) string {
 	return id
 }
 
+// execIDs returns the IDs of the processes run in a task, leaving out its
+// init process. These are the IDs that processes can be attached to by.
+//
+func execIDs(ctx context.Context, task containerd.Task) ([]string, error) {
+	procs, err := task.Pids(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("task pids: %w", err)
+	}
+
+	ids := []string{}
+	for _, proc := range procs {
+		if proc.Info == nil {
+			continue
+		}
+
+		details, err := typeurl.UnmarshalAny(proc.Info)
+		if err != nil {
+			return nil, fmt.Errorf("unmarshal process details: %w", err)
+		}
+
+		switch d := details.(type) {
+		case *options.ProcessDetails:
+			ids = append(ids, d.ExecID)
+		case *runctypes.ProcessDetails:
+			ids = append(ids, d.ExecID)
+		}
+	}
+
+	return ids, nil
+}
+
 func setupContainerdProcSpec(gdnProcSpec garden.ProcessSpec, procSpec *specs.Process) {
 	procSpec.Args = append([]string{gdnProcSpec.Path}, gdnProcSpec.Args...)
 	procSpec.Env = append(procSpec.Env, gdnProcSpec.Env...)
@@ -363,3 +749,67 @@ func containerdCIO(gdnProcIO garden.ProcessIO, tty bool) []cio.Opt {
 
 	return cioOpts
 }
+
+func gardenMetrics(stats *cgroupsv1.Metrics) garden.Metrics {
+	var metrics garden.Metrics
+
+	if stats.CPU != nil && stats.CPU.Usage != nil {
+		metrics.CPUStat = garden.ContainerCPUStat{
+			Usage:  stats.CPU.Usage.Total,
+			User:   stats.CPU.Usage.User,
+			System: stats.CPU.Usage.Kernel,
+		}
+	}
+
+	if stats.Pids != nil {
+		metrics.PidStat = garden.ContainerPidStat{
+			Current: stats.Pids.Current,
+			Max:     stats.Pids.Limit,
+		}
+	}
+
+	if mem := stats.Memory; mem != nil {
+		metrics.MemoryStat = garden.ContainerMemoryStat{
+			ActiveAnon:              mem.ActiveAnon,
+			ActiveFile:              mem.ActiveFile,
+			Cache:                   mem.Cache,
+			HierarchicalMemoryLimit: mem.HierarchicalMemoryLimit,
+			InactiveAnon:            mem.InactiveAnon,
+			InactiveFile:            mem.InactiveFile,
+			MappedFile:              mem.MappedFile,
+			Pgfault:                 mem.PgFault,
+			Pgmajfault:              mem.PgMajFault,
+			Pgpgin:                  mem.PgPgIn,
+			Pgpgout:                 mem.PgPgOut,
+			Rss:                     mem.RSS,
+			TotalActiveAnon:         mem.TotalActiveAnon,
+			TotalActiveFile:         mem.TotalActiveFile,
+			TotalCache:              mem.TotalCache,
+			TotalInactiveAnon:       mem.TotalInactiveAnon,
+			TotalInactiveFile:       mem.TotalInactiveFile,
+			TotalMappedFile:         mem.TotalMappedFile,
+			TotalPgfault:            mem.TotalPgFault,
+			TotalPgmajfault:         mem.TotalPgMajFault,
+			TotalPgpgin:             mem.TotalPgPgIn,
+			TotalPgpgout:            mem.TotalPgPgOut,
+			TotalRss:                mem.TotalRSS,
+			TotalUnevictable:        mem.TotalUnevictable,
+			Unevictable:             mem.Unevictable,
+			HierarchicalMemswLimit:  mem.HierarchicalSwapLimit,
+		}
+
+		if mem.Swap != nil {
+			metrics.MemoryStat.Swap = mem.Swap.Usage
+			metrics.MemoryStat.TotalSwap = mem.Swap.Usage
+		}
+
+		// the kernel counts inactive file pages towards usage, but reclaims
+		// them before enforcing the limit, so leave them out like Guardian
+		// does.
+		if mem.Usage != nil && mem.Usage.Usage > mem.TotalInactiveFile {
+			metrics.MemoryStat.TotalUsageTowardLimit = mem.Usage.Usage - mem.TotalInactiveFile
+		}
+	}
+
+	return metrics
+}
@@ -1,13 +1,26 @@
 package backend_test
 
 import (
+	"archive/tar"
+	"bytes"
 	"errors"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
 
 	"code.cloudfoundry.org/garden"
 	"github.com/concourse/concourse/worker/backend"
 	"github.com/concourse/concourse/worker/backend/backendfakes"
 	"github.com/concourse/concourse/worker/backend/libcontainerd/libcontainerdfakes"
+	cgroupsv1 "github.com/containerd/cgroups/stats/v1"
 	"github.com/containerd/containerd"
+	"github.com/containerd/containerd/api/types"
+	"github.com/containerd/containerd/containers"
+	"github.com/containerd/containerd/errdefs"
+	"github.com/containerd/containerd/runtime/v2/runc/options"
+	"github.com/containerd/typeurl"
 	"github.com/opencontainers/runtime-spec/specs-go"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
@@ -23,6 +36,9 @@ type ContainerSuite struct {
 	containerdTask      *libcontainerdfakes.FakeTask
 	rootfsManager       *backendfakes.FakeRootfsManager
 	killer              *backendfakes.FakeKiller
+	network             *backendfakes.FakeNetwork
+	portPool            *backendfakes.FakePortPool
+	tempDirs            []string
 }
 
 func (s *ContainerSuite) SetupTest() {
@@ -31,14 +47,26 @@ func (s *ContainerSuite) SetupTest() {
 	s.containerdTask = new(libcontainerdfakes.FakeTask)
 	s.rootfsManager = new(backendfakes.FakeRootfsManager)
 	s.killer = new(backendfakes.FakeKiller)
+	s.network = new(backendfakes.FakeNetwork)
+	s.portPool = new(backendfakes.FakePortPool)
 
 	s.container = backend.NewContainer(
 		s.containerdContainer,
 		s.killer,
 		s.rootfsManager,
+		s.network,
+		s.portPool,
 	)
 }
 
+func (s *ContainerSuite) TearDownTest() {
+	for _, dir := range s.tempDirs {
+		os.RemoveAll(dir)
+	}
+
+	s.tempDirs = nil
+}
+
 // func (s *ContainerSuite) TestStopWithKillUngracefullyStops() {
 // 	err := s.container.Stop(true)
 // 	s.NoError(err)
@@ -297,3 +325,531 @@ func (s *ContainerSuite) TestCurrentMemoryLimitsReturnsLimit() {
 	s.NoError(err)
 	s.Equal(garden.MemoryLimits{LimitInBytes: uint64(limitBytes)}, limits)
 }
+
+func (s *ContainerSuite) TestMetricsTaskLookupFails() {
+	expectedErr := errors.New("task-err")
+	s.containerdContainer.TaskReturns(nil, expectedErr)
+
+	_, err := s.container.Metrics()
+	s.True(errors.Is(err, expectedErr))
+}
+
+func (s *ContainerSuite) TestMetricsTaskMetricsFails() {
+	expectedErr := errors.New("metrics-err")
+	s.containerdContainer.TaskReturns(s.containerdTask, nil)
+	s.containerdTask.MetricsReturns(nil, expectedErr)
+
+	_, err := s.container.Metrics()
+	s.True(errors.Is(err, expectedErr))
+}
+
+func (s *ContainerSuite) TestMetricsReturnsCgroupStats() {
+	data, err := typeurl.MarshalAny(&cgroupsv1.Metrics{
+		CPU: &cgroupsv1.CPUStat{
+			Usage: &cgroupsv1.CPUUsage{Total: 300, User: 200, Kernel: 100},
+		},
+		Memory: &cgroupsv1.MemoryStat{
+			RSS:               1024,
+			TotalRSS:          2048,
+			TotalInactiveFile: 512,
+			Usage:             &cgroupsv1.MemoryEntry{Usage: 4096},
+			Swap:              &cgroupsv1.MemoryEntry{Usage: 128},
+		},
+		Pids: &cgroupsv1.PidsStat{Current: 3, Limit: 100},
+	})
+	s.NoError(err)
+
+	s.containerdContainer.InfoReturns(containers.Container{CreatedAt: time.Now().Add(-time.Minute)}, nil)
+	s.containerdContainer.TaskReturns(s.containerdTask, nil)
+	s.containerdTask.MetricsReturns(&types.Metric{Data: data}, nil)
+
+	metrics, err := s.container.Metrics()
+	s.NoError(err)
+	s.Equal(garden.ContainerCPUStat{Usage: 300, User: 200, System: 100}, metrics.CPUStat)
+	s.Equal(garden.ContainerPidStat{Current: 3, Max: 100}, metrics.PidStat)
+	s.Equal(uint64(1024), metrics.MemoryStat.Rss)
+	s.Equal(uint64(2048), metrics.MemoryStat.TotalRss)
+	s.Equal(uint64(128), metrics.MemoryStat.Swap)
+	s.Equal(uint64(4096-512), metrics.MemoryStat.TotalUsageTowardLimit)
+	s.True(metrics.Age >= time.Minute)
+}
+
+func (s *ContainerSuite) TestInfoLabelsFails() {
+	expectedErr := errors.New("labels-err")
+	s.containerdContainer.LabelsReturns(nil, expectedErr)
+
+	_, err := s.container.Info()
+	s.True(errors.Is(err, expectedErr))
+}
+
+func (s *ContainerSuite) TestInfoWithoutTaskIsStopped() {
+	s.containerdContainer.LabelsReturns(map[string]string{"foo": "bar"}, nil)
+	s.containerdContainer.TaskReturns(nil, errdefs.ErrNotFound)
+
+	info, err := s.container.Info()
+	s.NoError(err)
+	s.Equal(garden.ContainerInfo{
+		State:      "stopped",
+		Properties: garden.Properties{"foo": "bar"},
+	}, info)
+}
+
+func (s *ContainerSuite) TestInfoTaskStatusFails() {
+	expectedErr := errors.New("status-err")
+	s.containerdContainer.TaskReturns(s.containerdTask, nil)
+	s.containerdTask.StatusReturns(containerd.Status{}, expectedErr)
+
+	_, err := s.container.Info()
+	s.True(errors.Is(err, expectedErr))
+}
+
+func (s *ContainerSuite) TestInfoReturnsStateProcessesAndAddresses() {
+	details, err := typeurl.MarshalAny(&options.ProcessDetails{ExecID: "some-process"})
+	s.NoError(err)
+
+	s.containerdContainer.LabelsReturns(map[string]string{"foo": "bar"}, nil)
+	s.containerdContainer.TaskReturns(s.containerdTask, nil)
+	s.containerdTask.StatusReturns(containerd.Status{Status: containerd.Running}, nil)
+	s.containerdTask.PidsReturns([]containerd.ProcessInfo{
+		{Pid: 1},
+		{Pid: 123, Info: details},
+	}, nil)
+	s.network.AddressesReturns("10.80.0.2", "10.80.0.1", nil)
+
+	info, err := s.container.Info()
+	s.NoError(err)
+	s.Equal(garden.ContainerInfo{
+		State:       "active",
+		ContainerIP: "10.80.0.2",
+		HostIP:      "10.80.0.1",
+		ProcessIDs:  []string{"some-process"},
+		Properties:  garden.Properties{"foo": "bar"},
+	}, info)
+}
+
+func (s *ContainerSuite) TestInfoWithNoNetworkHasNoAddresses() {
+	s.containerdContainer.LabelsReturns(map[string]string{backend.NetworkKey: backend.NetworkNone}, nil)
+	s.containerdContainer.TaskReturns(s.containerdTask, nil)
+	s.containerdTask.StatusReturns(containerd.Status{Status: containerd.Running}, nil)
+
+	info, err := s.container.Info()
+	s.NoError(err)
+	s.Equal(0, s.network.AddressesCallCount())
+	s.Empty(info.ContainerIP)
+}
+
+func (s *ContainerSuite) TestInfoReturnsMappedPorts() {
+	s.containerdContainer.LabelsReturns(map[string]string{
+		backend.MappedPortsKey: `[{"HostPort":8080,"ContainerPort":80}]`,
+	}, nil)
+	s.containerdContainer.TaskReturns(s.containerdTask, nil)
+	s.containerdTask.StatusReturns(containerd.Status{Status: containerd.Running}, nil)
+
+	info, err := s.container.Info()
+	s.NoError(err)
+	s.Equal([]garden.PortMapping{{HostPort: 8080, ContainerPort: 80}}, info.MappedPorts)
+}
+
+func (s *ContainerSuite) TestNetInWithNoNetworkFails() {
+	s.containerdContainer.LabelsReturns(map[string]string{backend.NetworkKey: backend.NetworkNone}, nil)
+
+	_, _, err := s.container.NetIn(8080, 80)
+	s.EqualError(err, "container is not on the network")
+	s.Equal(0, s.network.NetInCallCount())
+}
+
+func (s *ContainerSuite) TestNetInMapsAndRecordsPort() {
+	s.containerdContainer.LabelsReturns(map[string]string{
+		backend.MappedPortsKey: `[{"HostPort":8080,"ContainerPort":80}]`,
+	}, nil)
+	s.containerdContainer.TaskReturns(s.containerdTask, nil)
+
+	hostPort, containerPort, err := s.container.NetIn(9090, 90)
+	s.NoError(err)
+	s.Equal(uint32(9090), hostPort)
+	s.Equal(uint32(90), containerPort)
+
+	s.Equal(1, s.portPool.ClaimCallCount())
+	s.Equal(uint32(9090), s.portPool.ClaimArgsForCall(0))
+	s.Equal(0, s.portPool.AcquireCallCount())
+
+	s.Equal(1, s.network.NetInCallCount())
+	_, task, mappings := s.network.NetInArgsForCall(0)
+	s.Equal(s.containerdTask, task)
+	s.Equal([]garden.PortMapping{{HostPort: 9090, ContainerPort: 90}}, mappings)
+
+	s.Equal(1, s.containerdContainer.SetLabelsCallCount())
+	_, labels := s.containerdContainer.SetLabelsArgsForCall(0)
+	s.JSONEq(`[
+		{"HostPort":8080,"ContainerPort":80},
+		{"HostPort":9090,"ContainerPort":90}
+	]`, labels[backend.MappedPortsKey])
+
+	s.Equal(1, s.portPool.LockCallCount())
+	s.Equal(1, s.portPool.UnlockCallCount())
+}
+
+func (s *ContainerSuite) TestNetInPicksPorts() {
+	s.containerdContainer.TaskReturns(s.containerdTask, nil)
+	s.portPool.AcquireReturns(61001, nil)
+
+	hostPort, containerPort, err := s.container.NetIn(0, 0)
+	s.NoError(err)
+	s.Equal(uint32(61001), hostPort)
+	s.Equal(hostPort, containerPort)
+	s.Equal(0, s.portPool.ClaimCallCount())
+}
+
+func (s *ContainerSuite) TestNetInWithPortInUseFails() {
+	s.containerdContainer.TaskReturns(s.containerdTask, nil)
+	s.portPool.ClaimReturns(backend.ErrPortInUse(8080))
+
+	_, _, err := s.container.NetIn(8080, 80)
+	s.EqualError(err, "port 8080 is already in use")
+	s.Equal(0, s.network.NetInCallCount())
+	s.Equal(0, s.containerdContainer.SetLabelsCallCount())
+}
+
+func (s *ContainerSuite) TestNetInFailsDoesntRecordPort() {
+	expectedErr := errors.New("net-in-err")
+	s.containerdContainer.TaskReturns(s.containerdTask, nil)
+	s.network.NetInReturns(expectedErr)
+
+	_, _, err := s.container.NetIn(8080, 80)
+	s.True(errors.Is(err, expectedErr))
+	s.Equal(0, s.containerdContainer.SetLabelsCallCount())
+
+	s.Equal(1, s.portPool.ReleaseCallCount())
+	s.Equal(uint32(8080), s.portPool.ReleaseArgsForCall(0))
+}
+
+func (s *ContainerSuite) TestNetInRecordingPortFailsReleasesPort() {
+	expectedErr := errors.New("set-labels-err")
+	s.containerdContainer.TaskReturns(s.containerdTask, nil)
+	s.containerdContainer.SetLabelsReturns(nil, expectedErr)
+
+	_, _, err := s.container.NetIn(8080, 80)
+	s.True(errors.Is(err, expectedErr))
+
+	s.Equal(1, s.portPool.ReleaseCallCount())
+	s.Equal(uint32(8080), s.portPool.ReleaseArgsForCall(0))
+	s.Equal(1, s.portPool.UnlockCallCount())
+}
+
+func (s *ContainerSuite) TestBulkNetOutWithNoNetworkFails() {
+	s.containerdContainer.LabelsReturns(map[string]string{backend.NetworkKey: backend.NetworkNone}, nil)
+
+	err := s.container.BulkNetOut([]garden.NetOutRule{{}})
+	s.EqualError(err, "container is not on the network")
+	s.Equal(0, s.network.NetOutCallCount())
+}
+
+func (s *ContainerSuite) TestBulkNetOutTaskLookupFails() {
+	expectedErr := errors.New("task-err")
+	s.containerdContainer.TaskReturns(nil, expectedErr)
+
+	err := s.container.BulkNetOut([]garden.NetOutRule{{}})
+	s.True(errors.Is(err, expectedErr))
+}
+
+func (s *ContainerSuite) TestBulkNetOutAddsEachRule() {
+	s.containerdContainer.TaskReturns(s.containerdTask, nil)
+	rules := []garden.NetOutRule{
+		{Protocol: garden.ProtocolTCP, Ports: []garden.PortRange{garden.PortRangeFromPort(80)}},
+		{Protocol: garden.ProtocolUDP, Ports: []garden.PortRange{garden.PortRangeFromPort(53)}},
+	}
+
+	err := s.container.BulkNetOut(rules)
+	s.NoError(err)
+
+	s.Equal(2, s.network.NetOutCallCount())
+	for i, rule := range rules {
+		_, task, actualRule := s.network.NetOutArgsForCall(i)
+		s.Equal(s.containerdTask, task)
+		s.Equal(rule, actualRule)
+	}
+}
+
+func (s *ContainerSuite) TestNetOutFails() {
+	expectedErr := errors.New("net-out-err")
+	s.containerdContainer.TaskReturns(s.containerdTask, nil)
+	s.network.NetOutReturns(expectedErr)
+
+	err := s.container.NetOut(garden.NetOutRule{})
+	s.True(errors.Is(err, expectedErr))
+}
+
+func (s *ContainerSuite) TestStreamInContainerSpecFails() {
+	expectedErr := errors.New("spec-err")
+	s.containerdContainer.SpecReturns(nil, expectedErr)
+
+	err := s.container.StreamIn(garden.StreamInSpec{Path: "/dest"})
+	s.True(errors.Is(err, expectedErr))
+}
+
+func (s *ContainerSuite) TestStreamInExtractsIntoRootfs() {
+	rootfs := s.tempDir()
+	s.containerdContainer.SpecReturns(&specs.Spec{Root: &specs.Root{Path: rootfs}}, nil)
+
+	err := s.container.StreamIn(garden.StreamInSpec{
+		Path: "/some/dest",
+		TarStream: s.tarStream(
+			&tar.Header{Name: "./", Typeflag: tar.TypeDir, Mode: 0755},
+			&tar.Header{Name: "dir/", Typeflag: tar.TypeDir, Mode: 0700},
+			&tar.Header{Name: "dir/file", Typeflag: tar.TypeReg, Mode: 0644, Size: 5},
+			&tar.Header{Name: "link", Typeflag: tar.TypeSymlink, Linkname: "dir/file"},
+		),
+	})
+	s.NoError(err)
+
+	content, err := ioutil.ReadFile(filepath.Join(rootfs, "some/dest/dir/file"))
+	s.NoError(err)
+	s.Equal("hello", string(content))
+
+	info, err := os.Stat(filepath.Join(rootfs, "some/dest/dir"))
+	s.NoError(err)
+	s.Equal(os.FileMode(0700), info.Mode().Perm())
+
+	link, err := os.Readlink(filepath.Join(rootfs, "some/dest/link"))
+	s.NoError(err)
+	s.Equal("dir/file", link)
+}
+
+func (s *ContainerSuite) TestStreamInIntoBindMount() {
+	rootfs, mount := s.tempDir(), s.tempDir()
+	s.containerdContainer.SpecReturns(&specs.Spec{
+		Root: &specs.Root{Path: rootfs},
+		Mounts: []specs.Mount{
+			{Destination: "/tmp/build/input", Type: "bind", Source: mount},
+		},
+	}, nil)
+
+	err := s.container.StreamIn(garden.StreamInSpec{
+		Path:      "/tmp/build/input/sub",
+		TarStream: s.tarStream(&tar.Header{Name: "file", Typeflag: tar.TypeReg, Mode: 0644, Size: 5}),
+	})
+	s.NoError(err)
+
+	content, err := ioutil.ReadFile(filepath.Join(mount, "sub/file"))
+	s.NoError(err)
+	s.Equal("hello", string(content))
+
+	_, err = os.Stat(filepath.Join(rootfs, "tmp/build/input/sub/file"))
+	s.True(os.IsNotExist(err))
+}
+
+func (s *ContainerSuite) TestStreamInIntoTmpfsMount() {
+	// the test process stands in for the container's init process, sharing
+	// the host's mount namespace, so the tmpfs is the directory it is at
+	rootfs, mount := s.tempDir(), s.tempDir()
+	s.containerdContainer.SpecReturns(&specs.Spec{
+		Root: &specs.Root{Path: rootfs},
+		Mounts: []specs.Mount{
+			{Destination: mount, Type: "tmpfs", Source: "tmpfs"},
+		},
+	}, nil)
+	s.containerdContainer.TaskReturns(s.containerdTask, nil)
+	s.containerdTask.PidReturns(uint32(os.Getpid()))
+
+	err := s.container.StreamIn(garden.StreamInSpec{
+		Path:      mount,
+		TarStream: s.tarStream(&tar.Header{Name: "secret", Typeflag: tar.TypeReg, Mode: 0400, Size: 5}),
+	})
+	s.NoError(err)
+
+	content, err := ioutil.ReadFile(filepath.Join(mount, "secret"))
+	s.NoError(err)
+	s.Equal("hello", string(content))
+
+	_, err = os.Stat(filepath.Join(rootfs, mount, "secret"))
+	s.True(os.IsNotExist(err))
+}
+
+func (s *ContainerSuite) TestStreamInIntoTmpfsMountOfStoppedContainer() {
+	s.containerdContainer.SpecReturns(&specs.Spec{
+		Root: &specs.Root{Path: s.tempDir()},
+		Mounts: []specs.Mount{
+			{Destination: "/run/secrets", Type: "tmpfs", Source: "tmpfs"},
+		},
+	}, nil)
+	s.containerdContainer.TaskReturns(nil, errdefs.ErrNotFound)
+
+	err := s.container.StreamIn(garden.StreamInSpec{
+		Path:      "/run/secrets",
+		TarStream: s.tarStream(&tar.Header{Name: "secret", Typeflag: tar.TypeReg, Mode: 0400, Size: 5}),
+	})
+	s.EqualError(err, "create destination: tmpfs mount at /run/secrets is only reachable while the container is running")
+}
+
+func (s *ContainerSuite) TestStreamInIntoReadOnlyMount() {
+	rootfs, mount := s.tempDir(), s.tempDir()
+	s.containerdContainer.SpecReturns(&specs.Spec{
+		Root: &specs.Root{Path: rootfs},
+		Mounts: []specs.Mount{
+			{Destination: "/input", Type: "bind", Source: mount, Options: []string{"bind", "ro"}},
+		},
+	}, nil)
+
+	err := s.container.StreamIn(garden.StreamInSpec{
+		Path:      "/input",
+		TarStream: s.tarStream(&tar.Header{Name: "file", Typeflag: tar.TypeReg, Mode: 0644, Size: 5}),
+	})
+	s.Equal(backend.ErrReadOnly("/input/file"), err)
+}
+
+func (s *ContainerSuite) TestStreamInResolvesSymlinksWithinRootfs() {
+	rootfs, outside := s.tempDir(), s.tempDir()
+	s.NoError(os.Symlink(outside, filepath.Join(rootfs, "escape")))
+	s.containerdContainer.SpecReturns(&specs.Spec{Root: &specs.Root{Path: rootfs}}, nil)
+
+	err := s.container.StreamIn(garden.StreamInSpec{
+		Path:      "/escape",
+		TarStream: s.tarStream(&tar.Header{Name: "file", Typeflag: tar.TypeReg, Mode: 0644, Size: 5}),
+	})
+	s.NoError(err)
+
+	_, err = os.Stat(filepath.Join(outside, "file"))
+	s.True(os.IsNotExist(err))
+
+	_, err = os.Stat(filepath.Join(rootfs, outside, "file"))
+	s.NoError(err)
+}
+
+func (s *ContainerSuite) TestStreamInReplacesSymlinksWithoutFollowingThem() {
+	rootfs, outside := s.tempDir(), s.tempDir()
+	s.NoError(ioutil.WriteFile(filepath.Join(outside, "file"), []byte("outside"), 0600))
+	s.NoError(os.MkdirAll(filepath.Join(rootfs, "dest"), 0755))
+	s.NoError(os.Symlink(filepath.Join(outside, "file"), filepath.Join(rootfs, "dest/file")))
+	s.containerdContainer.SpecReturns(&specs.Spec{Root: &specs.Root{Path: rootfs}}, nil)
+
+	err := s.container.StreamIn(garden.StreamInSpec{
+		Path:      "/dest",
+		TarStream: s.tarStream(&tar.Header{Name: "file", Typeflag: tar.TypeReg, Mode: 0777, Size: 5}),
+	})
+	s.NoError(err)
+
+	content, err := ioutil.ReadFile(filepath.Join(rootfs, "dest/file"))
+	s.NoError(err)
+	s.Equal("hello", string(content))
+
+	info, err := os.Stat(filepath.Join(outside, "file"))
+	s.NoError(err)
+	s.Equal(os.FileMode(0600), info.Mode().Perm())
+
+	content, err = ioutil.ReadFile(filepath.Join(outside, "file"))
+	s.NoError(err)
+	s.Equal("outside", string(content))
+}
+
+func (s *ContainerSuite) TestStreamInRejectsEntriesOutsideDestination() {
+	rootfs := s.tempDir()
+	s.containerdContainer.SpecReturns(&specs.Spec{Root: &specs.Root{Path: rootfs}}, nil)
+
+	err := s.container.StreamIn(garden.StreamInSpec{
+		Path:      "/dest",
+		TarStream: s.tarStream(&tar.Header{Name: "../evil", Typeflag: tar.TypeReg, Mode: 0644, Size: 5}),
+	})
+	s.EqualError(err, "tar entry '../evil' is outside of the destination")
+}
+
+func (s *ContainerSuite) TestStreamOutMissingPath() {
+	s.containerdContainer.SpecReturns(&specs.Spec{Root: &specs.Root{Path: s.tempDir()}}, nil)
+
+	_, err := s.container.StreamOut(garden.StreamOutSpec{Path: "/missing"})
+	s.True(errors.Is(err, os.ErrNotExist))
+}
+
+func (s *ContainerSuite) TestStreamOutPath() {
+	rootfs := s.tempDir()
+	s.NoError(os.MkdirAll(filepath.Join(rootfs, "some/dir"), 0755))
+	s.NoError(ioutil.WriteFile(filepath.Join(rootfs, "some/dir/file"), []byte("hello"), 0644))
+	s.containerdContainer.SpecReturns(&specs.Spec{Root: &specs.Root{Path: rootfs}}, nil)
+
+	stream, err := s.container.StreamOut(garden.StreamOutSpec{Path: "/some/dir"})
+	s.NoError(err)
+	s.Equal(map[string]string{"dir/": "", "dir/file": "hello"}, s.readTar(stream))
+
+	stream, err = s.container.StreamOut(garden.StreamOutSpec{Path: "/some/dir/"})
+	s.NoError(err)
+	s.Equal(map[string]string{"./": "", "file": "hello"}, s.readTar(stream))
+}
+
+func (s *ContainerSuite) TestStreamOutResolvesSymlinksWithinRootfs() {
+	rootfs, outside := s.tempDir(), s.tempDir()
+	s.NoError(ioutil.WriteFile(filepath.Join(outside, "file"), []byte("outside"), 0644))
+	s.NoError(os.MkdirAll(filepath.Join(rootfs, outside), 0755))
+	s.NoError(ioutil.WriteFile(filepath.Join(rootfs, outside, "file"), []byte("hello"), 0644))
+	s.NoError(os.Symlink(outside, filepath.Join(rootfs, "escape")))
+	s.containerdContainer.SpecReturns(&specs.Spec{Root: &specs.Root{Path: rootfs}}, nil)
+
+	stream, err := s.container.StreamOut(garden.StreamOutSpec{Path: "/escape/file"})
+	s.NoError(err)
+	s.Equal(map[string]string{"file": "hello"}, s.readTar(stream))
+}
+
+func (s *ContainerSuite) TestStreamOutIncludesMounts() {
+	rootfs, mount := s.tempDir(), s.tempDir()
+	s.NoError(os.MkdirAll(filepath.Join(rootfs, "build/output"), 0755))
+	s.NoError(ioutil.WriteFile(filepath.Join(mount, "file"), []byte("hello"), 0644))
+	s.containerdContainer.SpecReturns(&specs.Spec{
+		Root: &specs.Root{Path: rootfs},
+		Mounts: []specs.Mount{
+			{Destination: "/build/output", Type: "bind", Source: mount},
+		},
+	}, nil)
+
+	stream, err := s.container.StreamOut(garden.StreamOutSpec{Path: "/build"})
+	s.NoError(err)
+	s.Equal(map[string]string{"build/": "", "build/output/": "", "build/output/file": "hello"}, s.readTar(stream))
+}
+
+func (s *ContainerSuite) tempDir() string {
+	dir, err := ioutil.TempDir("", "backend-container")
+	s.NoError(err)
+
+	s.tempDirs = append(s.tempDirs, dir)
+
+	return dir
+}
+
+// tarStream builds a tar stream out of the given headers, owned by the
+// current user, with regular files containing "hello".
+//
+func (s *ContainerSuite) tarStream(hdrs ...*tar.Header) io.Reader {
+	buf := new(bytes.Buffer)
+	tw := tar.NewWriter(buf)
+
+	for _, hdr := range hdrs {
+		hdr.Uid, hdr.Gid = os.Getuid(), os.Getgid()
+		s.NoError(tw.WriteHeader(hdr))
+
+		if hdr.Typeflag == tar.TypeReg {
+			_, err := tw.Write([]byte("hello"))
+			s.NoError(err)
+		}
+	}
+
+	s.NoError(tw.Close())
+
+	return buf
+}
+
+func (s *ContainerSuite) readTar(stream io.ReadCloser) map[string]string {
+	defer stream.Close()
+
+	entries := map[string]string{}
+
+	tr := tar.NewReader(stream)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			return entries
+		}
+		s.NoError(err)
+
+		content, err := ioutil.ReadAll(tr)
+		s.NoError(err)
+
+		entries[hdr.Name] = string(content)
+	}
+}
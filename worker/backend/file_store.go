@@ -16,6 +16,10 @@ type FileStore interface {
 	//
 	Create(name string, content []byte) (absPath string, err error)
 
+	// Read returns the content of a file previously created in the store.
+	//
+	Read(name string) (content []byte, err error)
+
 	// DeleteFile removes a file previously created in the store.
 	//
 	Delete(name string) (err error)
@@ -50,6 +54,17 @@ func (f fileStore) Create(name string, content []byte) (string, error) {
 	return absPath, nil
 }
 
+func (f fileStore) Read(name string) ([]byte, error) {
+	absPath := filepath.Join(f.root, name)
+
+	content, err := ioutil.ReadFile(absPath)
+	if err != nil {
+		return nil, fmt.Errorf("read file: %w", err)
+	}
+
+	return content, nil
+}
+
 func (f fileStore) Delete(path string) error {
 	absPath := filepath.Join(f.root, path)
 
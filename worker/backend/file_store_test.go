@@ -1,6 +1,7 @@
 package backend_test
 
 import (
+	"errors"
 	"io/ioutil"
 	"os"
 	"path/filepath"
@@ -49,6 +50,20 @@ func (s *FileStoreSuite) TestCreateFileInDir() {
 	s.Equal("hey", string(content))
 }
 
+func (s *FileStoreSuite) TestReadFile() {
+	_, err := s.store.Create("dir/name", []byte("hey"))
+	s.NoError(err)
+
+	content, err := s.store.Read("dir/name")
+	s.NoError(err)
+	s.Equal("hey", string(content))
+}
+
+func (s *FileStoreSuite) TestReadMissingFile() {
+	_, err := s.store.Read("dir/missing")
+	s.True(errors.Is(err, os.ErrNotExist))
+}
+
 func (s *FileStoreSuite) TestDeleteFile() {
 	fpath, err := s.store.Create("dir/name", []byte("hey"))
 	s.NoError(err)
@@ -1,9 +1,12 @@
 package integration_test
 
 import (
+	"archive/tar"
 	"bytes"
 	"fmt"
+	"io"
 	"io/ioutil"
+	"net/http"
 	"os"
 	"os/exec"
 	"path/filepath"
@@ -13,6 +16,7 @@ import (
 	"code.cloudfoundry.org/garden"
 	"github.com/concourse/concourse/worker/backend"
 	"github.com/concourse/concourse/worker/backend/libcontainerd"
+	bespec "github.com/concourse/concourse/worker/backend/spec"
 	"github.com/concourse/concourse/worker/workercmd"
 	"github.com/containerd/containerd"
 	"github.com/stretchr/testify/require"
@@ -456,3 +460,348 @@ func (s *IntegrationSuite) testStop(kill bool) {
 	s.NoError(err)
 	s.NoError(container.Stop(kill))
 }
+
+// TestStreamInAndOut validates that files streamed into a container can be
+// seen by processes in it, and streamed back out.
+//
+func (s *IntegrationSuite) TestStreamInAndOut() {
+	handle := uuid()
+
+	container, err := s.backend.Create(garden.ContainerSpec{
+		Handle:     handle,
+		RootFSPath: "raw://" + s.rootfs,
+		Privileged: true,
+	})
+	s.NoError(err)
+
+	defer func() {
+		s.NoError(s.backend.Destroy(handle))
+	}()
+
+	err = container.StreamIn(garden.StreamInSpec{
+		Path:      "/some/dir",
+		TarStream: tarStream("file", "hello"),
+	})
+	s.NoError(err)
+
+	buf := new(buffer)
+	proc, err := container.Run(
+		garden.ProcessSpec{
+			Path: "/executable",
+			Args: []string{"-cat", "/some/dir/file"},
+		},
+		garden.ProcessIO{
+			Stdout: buf,
+			Stderr: buf,
+		},
+	)
+	s.NoError(err)
+
+	exitCode, err := proc.Wait()
+	s.NoError(err)
+
+	s.Equal(exitCode, 0)
+	s.Equal("hello", buf.String())
+
+	stream, err := container.StreamOut(garden.StreamOutSpec{Path: "/some/dir/file"})
+	s.NoError(err)
+
+	defer stream.Close()
+
+	tr := tar.NewReader(stream)
+
+	hdr, err := tr.Next()
+	s.NoError(err)
+	s.Equal("file", hdr.Name)
+
+	content, err := ioutil.ReadAll(tr)
+	s.NoError(err)
+	s.Equal("hello", string(content))
+}
+
+// TestStreamInTmpfs validates that files streamed into a tmpfs mount can be
+// read by processes in the container without touching its root filesystem.
+//
+func (s *IntegrationSuite) TestStreamInTmpfs() {
+	handle := uuid()
+
+	container, err := s.backend.Create(garden.ContainerSpec{
+		Handle:     handle,
+		RootFSPath: "raw://" + s.rootfs,
+		Privileged: true,
+		Properties: garden.Properties{
+			bespec.TmpfsMountsProperty: "/run/secrets",
+		},
+	})
+	s.NoError(err)
+
+	defer func() {
+		s.NoError(s.backend.Destroy(handle))
+	}()
+
+	err = container.StreamIn(garden.StreamInSpec{
+		Path:      "/run/secrets",
+		TarStream: tarStream("password", "hunter2"),
+	})
+	s.NoError(err)
+
+	buf := new(buffer)
+	proc, err := container.Run(
+		garden.ProcessSpec{
+			Path: "/executable",
+			Args: []string{"-cat", "/run/secrets/password"},
+		},
+		garden.ProcessIO{
+			Stdout: buf,
+			Stderr: buf,
+		},
+	)
+	s.NoError(err)
+
+	exitCode, err := proc.Wait()
+	s.NoError(err)
+
+	s.Equal(0, exitCode)
+	s.Equal("hunter2", buf.String())
+
+	_, err = os.Stat(filepath.Join(s.rootfs, "run", "secrets", "password"))
+	s.True(os.IsNotExist(err))
+
+	properties, err := container.Properties()
+	s.NoError(err)
+	s.Equal(bespec.TmpfsMountsProperty, properties[backend.AppliedPropertiesKey])
+}
+
+// TestLimits validates that the cpu quota and pids limits end up in the
+// container's cgroups, and that disk limits, which cannot be enforced, are
+// rejected unless they are best-effort.
+//
+func (s *IntegrationSuite) TestLimits() {
+	handle := uuid()
+
+	container, err := s.backend.Create(garden.ContainerSpec{
+		Handle:     handle,
+		RootFSPath: "raw://" + s.rootfs,
+		Privileged: true,
+		Limits: garden.Limits{
+			Pid: garden.PidLimits{Max: 100},
+		},
+		Properties: garden.Properties{
+			bespec.CPUQuotaProperty: "150",
+		},
+	})
+	s.NoError(err)
+
+	defer func() {
+		s.NoError(s.backend.Destroy(handle))
+	}()
+
+	for file, expected := range map[string]string{
+		"/sys/fs/cgroup/cpu/cpu.cfs_quota_us":  "150000\n",
+		"/sys/fs/cgroup/cpu/cpu.cfs_period_us": "100000\n",
+		"/sys/fs/cgroup/pids/pids.max":         "100\n",
+	} {
+		buf := new(buffer)
+		proc, err := container.Run(
+			garden.ProcessSpec{
+				Path: "/executable",
+				Args: []string{"-cat", file},
+			},
+			garden.ProcessIO{
+				Stdout: buf,
+				Stderr: buf,
+			},
+		)
+		s.NoError(err)
+
+		exitCode, err := proc.Wait()
+		s.NoError(err)
+
+		s.Equal(0, exitCode)
+		s.Equal(expected, buf.String(), file)
+	}
+
+	_, err = s.backend.Create(garden.ContainerSpec{
+		Handle:     uuid(),
+		RootFSPath: "raw://" + s.rootfs,
+		Privileged: true,
+		Limits: garden.Limits{
+			Disk: garden.DiskLimits{ByteHard: 1024 * 1024, Scope: garden.DiskLimitScopeExclusive},
+		},
+	})
+	s.Error(err)
+
+	bestEffortHandle := uuid()
+
+	_, err = s.backend.Create(garden.ContainerSpec{
+		Handle:     bestEffortHandle,
+		RootFSPath: "raw://" + s.rootfs,
+		Privileged: true,
+		Limits: garden.Limits{
+			Disk: garden.DiskLimits{ByteHard: 1024 * 1024, Scope: garden.DiskLimitScopeExclusive},
+		},
+		Properties: garden.Properties{
+			bespec.BestEffortLimitsProperty: "disk",
+		},
+	})
+	s.NoError(err)
+	s.NoError(s.backend.Destroy(bestEffortHandle))
+}
+
+// TestNetIn validates that a port mapped to a container can be reached from
+// the host, and that the mapping is reported in the container's info.
+//
+func (s *IntegrationSuite) TestNetIn() {
+	handle := uuid()
+
+	container, err := s.backend.Create(garden.ContainerSpec{
+		Handle:     handle,
+		RootFSPath: "raw://" + s.rootfs,
+		Privileged: true,
+	})
+	s.NoError(err)
+
+	defer func() {
+		s.NoError(s.backend.Destroy(handle))
+	}()
+
+	_, err = container.Run(
+		garden.ProcessSpec{
+			Path: "/executable",
+			Args: []string{"-http-serve=:8080"},
+		},
+		garden.ProcessIO{
+			Stdout: new(buffer),
+			Stderr: new(buffer),
+		},
+	)
+	s.NoError(err)
+
+	hostPort, containerPort, err := container.NetIn(0, 8080)
+	s.NoError(err)
+	s.Equal(uint32(8080), containerPort)
+
+	_, _, err = container.NetIn(hostPort, 8081)
+	s.Equal(backend.ErrPortInUse(hostPort), err)
+
+	info, err := container.Info()
+	s.NoError(err)
+	s.Equal([]garden.PortMapping{{HostPort: hostPort, ContainerPort: 8080}}, info.MappedPorts)
+
+	url := fmt.Sprintf("http://%s:%d", info.HostIP, hostPort)
+
+	var body []byte
+	s.Eventually(func() bool {
+		resp, err := http.Get(url)
+		if err != nil {
+			return false
+		}
+
+		defer resp.Body.Close()
+
+		body, err = ioutil.ReadAll(resp.Body)
+		return err == nil
+	}, 10*time.Second, 100*time.Millisecond)
+
+	s.Equal("hello world", string(body))
+}
+
+// TestStreamInOwnership validates that files streamed in as root keep the
+// ownership recorded in the stream, and those streamed in as a user are owned
+// by that user, as seen from within an unprivileged container even though its
+// root is mapped to another user on the host.
+//
+func (s *IntegrationSuite) TestStreamInOwnership() {
+	err := os.MkdirAll(filepath.Join(s.rootfs, "etc"), 0755)
+	s.NoError(err)
+
+	err = ioutil.WriteFile(
+		filepath.Join(s.rootfs, "etc", "passwd"),
+		[]byte("root:x:0:0:root:/root:/bin/sh\nsomeone:x:1000:1000::/home/someone:/bin/sh\n"),
+		0644,
+	)
+	s.NoError(err)
+
+	handle := uuid()
+
+	container, err := s.backend.Create(garden.ContainerSpec{
+		Handle:     handle,
+		RootFSPath: "raw://" + s.rootfs,
+		Privileged: false,
+	})
+	s.NoError(err)
+
+	defer func() {
+		s.NoError(s.backend.Destroy(handle))
+	}()
+
+	err = container.StreamIn(garden.StreamInSpec{
+		Path:      "/root",
+		TarStream: tarStream("file", "hello"),
+	})
+	s.NoError(err)
+
+	err = container.StreamIn(garden.StreamInSpec{
+		Path:      "/home/someone",
+		User:      "someone",
+		TarStream: tarStream("file", "hello"),
+	})
+	s.NoError(err)
+
+	maxUid, _, err := backend.NewUserNamespace().MaxValidIds()
+	s.NoError(err)
+
+	info, err := os.Stat(filepath.Join(s.rootfs, "root", "file"))
+	s.NoError(err)
+	s.Equal(maxUid, info.Sys().(*syscall.Stat_t).Uid)
+
+	s.Equal(map[string]int{"root/": 0, "root/file": 0}, s.streamedOutOwners(container, "/root"))
+	s.Equal(map[string]int{"someone/": 1000, "someone/file": 1000}, s.streamedOutOwners(container, "/home/someone"))
+}
+
+func (s *IntegrationSuite) streamedOutOwners(container garden.Container, path string) map[string]int {
+	stream, err := container.StreamOut(garden.StreamOutSpec{Path: path})
+	s.NoError(err)
+
+	defer stream.Close()
+
+	owners := map[string]int{}
+
+	tr := tar.NewReader(stream)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			return owners
+		}
+		s.NoError(err)
+
+		owners[hdr.Name] = hdr.Uid
+	}
+}
+
+func tarStream(name, content string) io.Reader {
+	buf := new(bytes.Buffer)
+	tw := tar.NewWriter(buf)
+
+	err := tw.WriteHeader(&tar.Header{
+		Name:     name,
+		Typeflag: tar.TypeReg,
+		Mode:     0644,
+		Size:     int64(len(content)),
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	_, err = tw.Write([]byte(content))
+	if err != nil {
+		panic(err)
+	}
+
+	err = tw.Close()
+	if err != nil {
+		panic(err)
+	}
+
+	return buf
+}
@@ -18,6 +18,7 @@ var (
 	flagHttpGet       = flag.String("http-get", "", "website to perform an HTTP GET request against")
 	flagWriteTenTimes = flag.String("write-many-times", "", "writes a string to stdout many times")
 	flagCatFile = flag.String("cat", "", "writes contents of file to stdout")
+	flagHttpServe     = flag.String("http-serve", "", "address to serve the default message over HTTP on")
 
 	signals = map[string]os.Signal{
 		"sighup":  syscall.SIGHUP,
@@ -77,6 +78,14 @@ func catFile(pathToFile string){
 	fmt.Print(string(bytes))
 }
 
+func httpServe(addr string) {
+	http.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, defaultMessage)
+	})
+
+	log.Fatal(http.ListenAndServe(addr, nil))
+}
+
 func main() {
 	flag.Parse()
 
@@ -89,6 +98,8 @@ func main() {
 		writeTenTimes(*flagWriteTenTimes)
 	case *flagCatFile != "":
 		catFile(*flagCatFile)
+	case *flagHttpServe != "":
+		httpServe(*flagHttpServe)
 	default:
 		fmt.Println(defaultMessage)
 	}
@@ -0,0 +1,181 @@
+package backend
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+
+	"code.cloudfoundry.org/garden"
+)
+
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . Iptables
+
+// Iptables is the set of iptables operations used to restrict the traffic
+// going out of containers. It is satisfied by go-iptables.
+//
+type Iptables interface {
+	// ListChains lists the chains in a table.
+	//
+	ListChains(table string) (chains []string, err error)
+
+	// NewChain creates a chain, failing if it already exists.
+	//
+	NewChain(table, chain string) (err error)
+
+	// List lists the rules in a chain, as given to iptables -S.
+	//
+	List(table, chain string) (rules []string, err error)
+
+	// ClearChain flushes a chain, creating it if it does not exist.
+	//
+	ClearChain(table, chain string) (err error)
+
+	// DeleteChain deletes an empty chain.
+	//
+	DeleteChain(table, chain string) (err error)
+
+	// Exists checks whether a rule is in a chain.
+	//
+	Exists(table, chain string, rulespec ...string) (exists bool, err error)
+
+	// Insert adds a rule to a chain at a position, starting at 1.
+	//
+	Insert(table, chain string, pos int, rulespec ...string) (err error)
+
+	// Append adds a rule to the end of a chain.
+	//
+	Append(table, chain string, rulespec ...string) (err error)
+
+	// AppendUnique adds a rule to the end of a chain unless it is already
+	// in it.
+	//
+	AppendUnique(table, chain string, rulespec ...string) (err error)
+
+	// Delete removes a rule from a chain.
+	//
+	Delete(table, chain string, rulespec ...string) (err error)
+}
+
+const (
+	// filterTable is the iptables table that egress rules are kept in.
+	//
+	filterTable = "filter"
+
+	// egressChain is jumped to for all of the traffic forwarded from the
+	// containers' bridge. It first jumps to containersChain, so that the
+	// rules of the containers take precedence, and then rejects traffic to
+	// the denied networks.
+	//
+	egressChain = "CONCOURSE-EGRESS"
+
+	// containersChain jumps to the chain of each container for the traffic
+	// coming from its IP.
+	//
+	containersChain = "CONCOURSE-CONTAINERS"
+)
+
+// containerChain is the name of the chain holding the net out rules of a
+// container. iptables limits chain names to 28 characters, too short for a
+// handle, so a hash of it is used instead.
+//
+func containerChain(handle string) string {
+	sum := sha256.Sum256([]byte(handle))
+	return "CONCOURSE-" + hex.EncodeToString(sum[:])[:16]
+}
+
+// netOutRulespecs converts a net out rule into the iptables rules which
+// accept the traffic it allows. A rule is needed for each combination of the
+// rule's networks and ports.
+//
+func netOutRulespecs(rule garden.NetOutRule) ([][]string, error) {
+	var protocol []string
+
+	switch rule.Protocol {
+	case garden.ProtocolAll:
+	case garden.ProtocolTCP:
+		protocol = []string{"-p", "tcp"}
+	case garden.ProtocolUDP:
+		protocol = []string{"-p", "udp"}
+	case garden.ProtocolICMP:
+		protocol = []string{"-p", "icmp"}
+	default:
+		return nil, ErrInvalidInput(fmt.Sprintf("invalid protocol: %d", rule.Protocol))
+	}
+
+	if len(rule.Ports) > 0 && rule.Protocol != garden.ProtocolTCP && rule.Protocol != garden.ProtocolUDP {
+		return nil, ErrInvalidInput("ports can only be specified for tcp or udp")
+	}
+
+	if rule.ICMPs != nil && rule.Protocol != garden.ProtocolICMP {
+		return nil, ErrInvalidInput("icmp types can only be specified for icmp")
+	}
+
+	networks := [][]string{nil}
+	if len(rule.Networks) > 0 {
+		networks = nil
+
+		for _, network := range rule.Networks {
+			if network.Start == nil {
+				return nil, ErrInvalidInput("network range without a start")
+			}
+
+			if network.End == nil || network.End.Equal(network.Start) {
+				networks = append(networks, []string{"-d", network.Start.String()})
+				continue
+			}
+
+			networks = append(networks, []string{
+				"-m", "iprange",
+				"--dst-range", network.Start.String() + "-" + network.End.String(),
+			})
+		}
+	}
+
+	ports := [][]string{nil}
+	if len(rule.Ports) > 0 {
+		ports = nil
+
+		for _, port := range rule.Ports {
+			dport := strconv.Itoa(int(port.Start))
+			if port.End != 0 && port.End != port.Start {
+				dport += ":" + strconv.Itoa(int(port.End))
+			}
+
+			ports = append(ports, []string{"--dport", dport})
+		}
+	}
+
+	var icmp []string
+	if rule.ICMPs != nil {
+		icmpType := strconv.Itoa(int(rule.ICMPs.Type))
+		if rule.ICMPs.Code != nil {
+			icmpType += "/" + strconv.Itoa(int(*rule.ICMPs.Code))
+		}
+
+		icmp = []string{"--icmp-type", icmpType}
+	}
+
+	log := rule.Log && (rule.Protocol == garden.ProtocolAll || rule.Protocol == garden.ProtocolTCP)
+
+	var rulespecs [][]string
+	for _, network := range networks {
+		for _, port := range ports {
+			var match []string
+			match = append(match, protocol...)
+			match = append(match, network...)
+			match = append(match, port...)
+			match = append(match, icmp...)
+
+			if log {
+				rulespecs = append(rulespecs, append(append([]string{}, match...),
+					"-m", "conntrack", "--ctstate", "NEW", "-j", "LOG",
+				))
+			}
+
+			rulespecs = append(rulespecs, append(match, "-j", "ACCEPT"))
+		}
+	}
+
+	return rulespecs, nil
+}
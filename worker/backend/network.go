@@ -3,10 +3,27 @@ package backend
 import (
 	"context"
 
+	"code.cloudfoundry.org/garden"
 	"github.com/containerd/containerd"
 	"github.com/opencontainers/runtime-spec/specs-go"
 )
 
+const (
+	// NetworkKey is the property through which a container can be kept out
+	// of the network by setting it to NetworkNone, leaving it with only a
+	// loopback interface.
+	//
+	NetworkKey = "concourse:network"
+
+	NetworkNone = "none"
+
+	// MappedPortsKey is the property in which the ports mapped to a
+	// container through NetIn are recorded, as a JSON list of
+	// garden.PortMapping.
+	//
+	MappedPortsKey = "concourse:mapped-ports"
+)
+
 //go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . Network
 
 type Network interface {
@@ -22,4 +39,24 @@ type Network interface {
 	// Removes a task from the network.
 	//
 	Remove(ctx context.Context, task containerd.Task) (err error)
+
+	// Addresses returns the IPs of a task and of its gateway, as assigned
+	// when the task was added to the network.
+	//
+	Addresses(ctx context.Context, task containerd.Task) (containerIP, hostIP string, err error)
+
+	// SetupDeniedNetworks sets up the rules that keep containers from
+	// reaching the networks denied to them by the operator.
+	//
+	SetupDeniedNetworks() (err error)
+
+	// NetOut allows a task to reach the networks and ports of a rule, even
+	// if they are denied to containers by default.
+	//
+	NetOut(ctx context.Context, task containerd.Task, rule garden.NetOutRule) (err error)
+
+	// NetIn maps ports on the host to ports of a task. The mappings set up
+	// before for the task are kept, so only new ones need to be given.
+	//
+	NetIn(ctx context.Context, task containerd.Task, mappings []garden.PortMapping) (err error)
 }
@@ -0,0 +1,124 @@
+package backend
+
+import (
+	"fmt"
+	"net"
+	"sync"
+)
+
+const (
+	// DefaultPortPoolStart is the first host port handed out by the default
+	// port pool, as with Guardian.
+	//
+	DefaultPortPoolStart = 61001
+
+	// DefaultPortPoolSize is how many host ports the default port pool
+	// hands out, running up to the last valid port.
+	//
+	DefaultPortPoolSize = 4534
+)
+
+// ErrPortInUse is returned when claiming a host port that is either mapped to
+// a container already or bound by a process on the host.
+//
+type ErrPortInUse uint32
+
+func (e ErrPortInUse) Error() string {
+	return fmt.Sprintf("port %d is already in use", uint32(e))
+}
+
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . PortPool
+
+// PortPool keeps track of the host ports that are mapped to containers, so
+// that no two containers get the same port.
+//
+// The lock guards every change to the mapped ports, which are recorded both in
+// the pool and in the properties of the containers they are mapped to. The
+// other methods must only be called while holding it.
+//
+type PortPool interface {
+	sync.Locker
+
+	// Acquire takes a free port out of the pool's range.
+	//
+	Acquire() (port uint32, err error)
+
+	// Claim takes a given port, which need not be in the pool's range,
+	// failing with ErrPortInUse if it is taken or bound on the host.
+	//
+	Claim(port uint32) (err error)
+
+	// Release gives a port back, once it is no longer mapped.
+	//
+	Release(port uint32)
+}
+
+type portPool struct {
+	sync.Mutex
+
+	start uint32
+	size  uint32
+	next  uint32
+	taken map[uint32]bool
+}
+
+var _ PortPool = (*portPool)(nil)
+
+// NewPortPool creates a pool handing out the size ports from start.
+//
+func NewPortPool(start, size uint32) *portPool {
+	return &portPool{
+		start: start,
+		size:  size,
+		taken: map[uint32]bool{},
+	}
+}
+
+// Acquire hands out the ports in turn rather than reusing a released port
+// straight away, so that a port is less likely to reach another container
+// while connections to the one it was mapped to linger.
+//
+func (p *portPool) Acquire() (uint32, error) {
+	for i := uint32(0); i < p.size; i++ {
+		port := p.start + (p.next+i)%p.size
+
+		if p.taken[port] || !availableOnHost(port) {
+			continue
+		}
+
+		p.next = (p.next + i + 1) % p.size
+		p.taken[port] = true
+
+		return port, nil
+	}
+
+	return 0, fmt.Errorf("no free ports in %d-%d", p.start, p.start+p.size-1)
+}
+
+func (p *portPool) Claim(port uint32) error {
+	if p.taken[port] || !availableOnHost(port) {
+		return ErrPortInUse(port)
+	}
+
+	p.taken[port] = true
+
+	return nil
+}
+
+func (p *portPool) Release(port uint32) {
+	delete(p.taken, port)
+}
+
+// availableOnHost checks that no process on the host is listening on a TCP
+// port, which would otherwise be shadowed by the mapping.
+//
+func availableOnHost(port uint32) bool {
+	listener, err := net.Listen("tcp", fmt.Sprintf(":%d", port))
+	if err != nil {
+		return false
+	}
+
+	_ = listener.Close()
+
+	return true
+}
@@ -0,0 +1,96 @@
+package backend_test
+
+import (
+	"fmt"
+	"net"
+
+	"github.com/concourse/concourse/worker/backend"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type PortPoolSuite struct {
+	suite.Suite
+	*require.Assertions
+}
+
+func (s *PortPoolSuite) TestAcquireHandsOutPortsInTurn() {
+	pool := backend.NewPortPool(s.freeRange(3), 3)
+	start := s.acquire(pool)
+
+	s.Equal(start+1, s.acquire(pool))
+
+	pool.Release(start)
+	s.Equal(start+2, s.acquire(pool))
+	s.Equal(start, s.acquire(pool))
+
+	_, err := pool.Acquire()
+	s.Error(err)
+}
+
+func (s *PortPoolSuite) TestAcquireSkipsClaimedPorts() {
+	start := s.freeRange(2)
+	pool := backend.NewPortPool(start, 2)
+
+	s.NoError(pool.Claim(start))
+	s.Equal(start+1, s.acquire(pool))
+}
+
+func (s *PortPoolSuite) TestAcquireSkipsPortsBoundOnTheHost() {
+	start := s.freeRange(2)
+	pool := backend.NewPortPool(start, 2)
+
+	listener, err := net.Listen("tcp", fmt.Sprintf(":%d", start))
+	s.NoError(err)
+	defer listener.Close()
+
+	s.Equal(start+1, s.acquire(pool))
+}
+
+func (s *PortPoolSuite) TestClaimFailsForPortsInUse() {
+	start := s.freeRange(2)
+	pool := backend.NewPortPool(start, 2)
+
+	s.NoError(pool.Claim(start))
+	s.Equal(backend.ErrPortInUse(start), pool.Claim(start))
+
+	listener, err := net.Listen("tcp", fmt.Sprintf(":%d", start+1))
+	s.NoError(err)
+	defer listener.Close()
+
+	s.Equal(backend.ErrPortInUse(start+1), pool.Claim(start+1))
+
+	pool.Release(start)
+	s.NoError(pool.Claim(start))
+}
+
+func (s *PortPoolSuite) acquire(pool backend.PortPool) uint32 {
+	port, err := pool.Acquire()
+	s.NoError(err)
+
+	return port
+}
+
+// freeRange finds size consecutive ports that nothing is listening on.
+//
+func (s *PortPoolSuite) freeRange(size uint32) uint32 {
+	for start := uint32(40000); start < 50000; start += size {
+		free := true
+		for port := start; port < start+size; port++ {
+			listener, err := net.Listen("tcp", fmt.Sprintf(":%d", port))
+			if err != nil {
+				free = false
+				break
+			}
+
+			listener.Close()
+		}
+
+		if free {
+			return start
+		}
+	}
+
+	s.FailNow("no free port range")
+	return 0
+}
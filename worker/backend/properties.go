@@ -2,10 +2,30 @@ package backend
 
 import (
 	"fmt"
+	"strings"
 
 	"code.cloudfoundry.org/garden"
+	bespec "github.com/concourse/concourse/worker/backend/spec"
 )
 
+// AppliedPropertiesKey is the property through which the backend acknowledges
+// the properties that change how a container is created, as a comma-separated
+// list of the ones it was created with.
+//
+// Garden servers that ignore those properties, such as Guardian, never set it,
+// so clients can refuse to use a container that lacks what they asked for.
+//
+const AppliedPropertiesKey = "concourse:applied-properties"
+
+// appliedProperties are the properties that change how a container is
+// created.
+//
+var appliedProperties = []string{
+	bespec.TmpfsMountsProperty,
+	NetworkKey,
+	bespec.CPUQuotaProperty,
+}
+
 // propertiesToFilterList converts a set of garden properties to a list of
 // filters as expected by containerd.
 //
@@ -37,3 +57,26 @@ func propertiesToFilterList(properties garden.Properties) (filters []string, err
 
 	return
 }
+
+// withAppliedProperties returns a copy of a container's properties which
+// acknowledges the ones that are applied when creating it.
+//
+func withAppliedProperties(properties garden.Properties) garden.Properties {
+	labels := garden.Properties{}
+	for k, v := range properties {
+		labels[k] = v
+	}
+
+	var applied []string
+	for _, name := range appliedProperties {
+		if _, ok := properties[name]; ok {
+			applied = append(applied, name)
+		}
+	}
+
+	if len(applied) > 0 {
+		labels[AppliedPropertiesKey] = strings.Join(applied, ",")
+	}
+
+	return labels
+}
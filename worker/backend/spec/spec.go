@@ -3,6 +3,7 @@ package spec
 import (
 	"fmt"
 	"path/filepath"
+	"strconv"
 	"strings"
 
 	"code.cloudfoundry.org/garden"
@@ -17,6 +18,26 @@ const (
 
 const baseCgroupsPath = "garden"
 
+// TmpfsMountsProperty is the container property through which a client can
+// request tmpfs mounts, given as a comma-separated list of absolute paths.
+//
+const TmpfsMountsProperty = "concourse:tmpfs-mounts"
+
+// CPUQuotaProperty is the container property through which a client can set a
+// hard limit on the container's CPU time, as a percentage of one CPU.
+//
+const CPUQuotaProperty = "concourse:cpu-quota"
+
+// BestEffortLimitsProperty is the container property through which a client
+// can list, comma-separated, the limits that are only to be applied if they
+// are supported, e.g. "disk", rather than failing the container.
+//
+const BestEffortLimitsProperty = "concourse:best-effort-limits"
+
+// cpuPeriod is the period, in microseconds, over which a CPU quota applies.
+//
+const cpuPeriod = 100000
+
 // OciSpec converts a given `garden` container specification to an OCI spec.
 //
 func OciSpec(gdn garden.ContainerSpec, maxUid, maxGid uint32) (oci *specs.Spec, err error) {
@@ -29,6 +50,17 @@ func OciSpec(gdn garden.ContainerSpec, maxUid, maxGid uint32) (oci *specs.Spec,
 		gdn.RootFSPath = gdn.Image.URI
 	}
 
+	// there is no quota on the snapshots that root filesystems are made of,
+	// so fail rather than letting the container fill up the disk, unless the
+	// limit is only best-effort
+	disk := gdn.Limits.Disk
+	if disk.ByteHard > 0 || disk.ByteSoft > 0 || disk.InodeHard > 0 || disk.InodeSoft > 0 {
+		if !isBestEffortLimit(gdn.Properties, "disk") {
+			err = fmt.Errorf("disk limits are not supported")
+			return
+		}
+	}
+
 	var rootfs string
 	rootfs, err = rootfsDir(gdn.RootFSPath)
 	if err != nil {
@@ -41,7 +73,19 @@ func OciSpec(gdn garden.ContainerSpec, maxUid, maxGid uint32) (oci *specs.Spec,
 		return
 	}
 
-	resources := OciResources(gdn.Limits)
+	var tmpfsMounts []specs.Mount
+	tmpfsMounts, err = OciSpecTmpfsMounts(gdn.Properties[TmpfsMountsProperty])
+	if err != nil {
+		return
+	}
+
+	mounts = append(mounts, tmpfsMounts...)
+
+	var resources *specs.LinuxResources
+	resources, err = OciCPUQuota(OciResources(gdn.Limits), gdn.Properties[CPUQuotaProperty])
+	if err != nil {
+		return
+	}
 	cgroupsPath := OciCgroupsPath(baseCgroupsPath, gdn.Handle, gdn.Privileged)
 
 	oci = merge(
@@ -107,6 +151,31 @@ func OciSpecBindMounts(bindMounts []garden.BindMount) (mounts []specs.Mount, err
 	return
 }
 
+// OciSpecTmpfsMounts converts a comma-separated list of paths to tmpfs oci
+// spec mounts.
+//
+func OciSpecTmpfsMounts(paths string) (mounts []specs.Mount, err error) {
+	if paths == "" {
+		return
+	}
+
+	for _, path := range strings.Split(paths, ",") {
+		if !filepath.IsAbs(path) {
+			err = fmt.Errorf("tmpfs mount path '%s' must be absolute", path)
+			return
+		}
+
+		mounts = append(mounts, specs.Mount{
+			Source:      "tmpfs",
+			Destination: path,
+			Type:        "tmpfs",
+			Options:     []string{"nosuid", "nodev", "noexec", "mode=1777", "size=65536k"},
+		})
+	}
+
+	return
+}
+
 // OciIDMappings provides the uid/gid mappings for user namespaces (if
 // necessary, based on `privileged`).
 //
@@ -171,6 +240,53 @@ func OciResources(limits garden.Limits) *specs.LinuxResources {
 	}
 }
 
+// isBestEffortLimit tells whether a limit is listed in a container's
+// BestEffortLimitsProperty.
+//
+func isBestEffortLimit(properties garden.Properties, limit string) bool {
+	for _, name := range strings.Split(properties[BestEffortLimitsProperty], ",") {
+		if name == limit {
+			return true
+		}
+	}
+
+	return false
+}
+
+// OciCPUQuota adds a hard limit on CPU time, given as a percentage of one CPU,
+// to a set of resources.
+//
+func OciCPUQuota(resources *specs.LinuxResources, percent string) (*specs.LinuxResources, error) {
+	if percent == "" {
+		return resources, nil
+	}
+
+	pct, err := strconv.ParseUint(percent, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid cpu quota '%s': %w", percent, err)
+	}
+
+	if pct == 0 {
+		return resources, nil
+	}
+
+	if resources == nil {
+		resources = &specs.LinuxResources{}
+	}
+
+	if resources.CPU == nil {
+		resources.CPU = &specs.LinuxCPU{}
+	}
+
+	quota := int64(pct * cpuPeriod / 100)
+	period := uint64(cpuPeriod)
+
+	resources.CPU.Quota = &quota
+	resources.CPU.Period = &period
+
+	return resources, nil
+}
+
 func OciCgroupsPath(basePath, handle string, privileged bool) string {
 	if privileged {
 		return ""
@@ -81,6 +81,16 @@ func (s *SpecSuite) TestContainerSpecValidations() {
 				Image:  garden.ImageRef{URI: "weird://bar"},
 			},
 		},
+		{
+			desc: "disk limit specified",
+			spec: garden.ContainerSpec{
+				Handle:     "handle",
+				RootFSPath: "raw:///rootfs",
+				Limits: garden.Limits{
+					Disk: garden.DiskLimits{ByteHard: 1024, Scope: garden.DiskLimitScopeExclusive},
+				},
+			},
+		},
 	} {
 		s.T().Run(tc.desc, func(t *testing.T) {
 			_, err := spec.OciSpec(tc.spec, dummyMaxUid, dummyMaxGid)
@@ -184,6 +194,55 @@ func (s *SpecSuite) TestOciSpecBindMounts() {
 	}
 }
 
+func (s *SpecSuite) TestOciSpecTmpfsMounts() {
+	for _, tc := range []struct {
+		desc     string
+		paths    string
+		expected []specs.Mount
+		succeeds bool
+	}{
+		{
+			desc:     "no paths",
+			succeeds: true,
+		},
+		{
+			desc:     "non-absolute path",
+			paths:    "/a,b",
+			succeeds: false,
+		},
+		{
+			desc:     "absolute paths",
+			paths:    "/a,/b",
+			succeeds: true,
+			expected: []specs.Mount{
+				{
+					Source:      "tmpfs",
+					Destination: "/a",
+					Type:        "tmpfs",
+					Options:     []string{"nosuid", "nodev", "noexec", "mode=1777", "size=65536k"},
+				},
+				{
+					Source:      "tmpfs",
+					Destination: "/b",
+					Type:        "tmpfs",
+					Options:     []string{"nosuid", "nodev", "noexec", "mode=1777", "size=65536k"},
+				},
+			},
+		},
+	} {
+		s.T().Run(tc.desc, func(t *testing.T) {
+			actual, err := spec.OciSpecTmpfsMounts(tc.paths)
+			if !tc.succeeds {
+				s.Error(err)
+				return
+			}
+
+			s.NoError(err)
+			s.Equal(tc.expected, actual)
+		})
+	}
+}
+
 func (s *SpecSuite) TestOciNamespaces() {
 	for _, tc := range []struct {
 		desc       string
@@ -315,6 +374,70 @@ func (s *SpecSuite) TestOciResourceLimits() {
 	}
 }
 
+func (s *SpecSuite) TestOciCPUQuota() {
+	for _, tc := range []struct {
+		desc      string
+		resources *specs.LinuxResources
+		percent   string
+		expected  *specs.LinuxResources
+		succeeds  bool
+	}{
+		{
+			desc:     "no quota",
+			succeeds: true,
+		},
+		{
+			desc:     "zero quota",
+			percent:  "0",
+			succeeds: true,
+		},
+		{
+			desc:     "invalid quota",
+			percent:  "lots",
+			succeeds: false,
+		},
+		{
+			desc:     "quota without other resources",
+			percent:  "150",
+			succeeds: true,
+			expected: &specs.LinuxResources{
+				CPU: &specs.LinuxCPU{
+					Quota:  int64Ptr(150000),
+					Period: uint64Ptr(100000),
+				},
+			},
+		},
+		{
+			desc: "quota with cpu shares",
+			resources: &specs.LinuxResources{
+				CPU: &specs.LinuxCPU{
+					Shares: uint64Ptr(512),
+				},
+			},
+			percent:  "50",
+			succeeds: true,
+			expected: &specs.LinuxResources{
+				CPU: &specs.LinuxCPU{
+					Shares: uint64Ptr(512),
+					Quota:  int64Ptr(50000),
+					Period: uint64Ptr(100000),
+				},
+			},
+		},
+	} {
+		s.T().Run(tc.desc, func(t *testing.T) {
+			actual, err := spec.OciCPUQuota(tc.resources, tc.percent)
+			if !tc.succeeds {
+				s.Error(err)
+				return
+			}
+
+			s.NoError(err)
+			s.Equal(tc.expected, actual)
+		})
+	}
+}
+
 func (s *SpecSuite) TestOciCgroupsPath() {
 	for _, tc := range []struct {
 		desc       string
@@ -441,6 +564,61 @@ func (s *SpecSuite) TestContainerSpec() {
 				})
 			},
 		},
+		{
+			desc: "tmpfs mounts",
+			gdn: garden.ContainerSpec{
+				Handle: "handle", RootFSPath: "raw:///rootfs",
+				Properties: garden.Properties{
+					spec.TmpfsMountsProperty: "/run/secrets,/scratch",
+				},
+			},
+			check: func(oci *specs.Spec) {
+				s.Contains(oci.Mounts, specs.Mount{
+					Source:      "tmpfs",
+					Destination: "/run/secrets",
+					Type:        "tmpfs",
+					Options:     []string{"nosuid", "nodev", "noexec", "mode=1777", "size=65536k"},
+				})
+				s.Contains(oci.Mounts, specs.Mount{
+					Source:      "tmpfs",
+					Destination: "/scratch",
+					Type:        "tmpfs",
+					Options:     []string{"nosuid", "nodev", "noexec", "mode=1777", "size=65536k"},
+				})
+			},
+		},
+		{
+			desc: "limits",
+			gdn: garden.ContainerSpec{
+				Handle: "handle", RootFSPath: "raw:///rootfs",
+				Limits: garden.Limits{
+					Pid: garden.PidLimits{Max: 1000},
+				},
+				Properties: garden.Properties{
+					spec.CPUQuotaProperty: "200",
+				},
+			},
+			check: func(oci *specs.Spec) {
+				s.Equal(int64(1000), oci.Linux.Resources.Pids.Limit)
+				s.Equal(int64Ptr(200000), oci.Linux.Resources.CPU.Quota)
+				s.Equal(uint64Ptr(100000), oci.Linux.Resources.CPU.Period)
+			},
+		},
+		{
+			desc: "best-effort disk limit",
+			gdn: garden.ContainerSpec{
+				Handle: "handle", RootFSPath: "raw:///rootfs",
+				Limits: garden.Limits{
+					Disk: garden.DiskLimits{ByteHard: 1024, Scope: garden.DiskLimitScopeExclusive},
+				},
+				Properties: garden.Properties{
+					spec.BestEffortLimitsProperty: "cpu_quota,disk",
+				},
+			},
+			check: func(oci *specs.Spec) {
+				s.Equal("/", oci.Process.Cwd)
+			},
+		},
 		{
 			desc: "seccomp is not empty for unprivileged",
 			gdn: garden.ContainerSpec{
@@ -0,0 +1,710 @@
+package backend
+
+import (
+	"archive/tar"
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/opencontainers/runtime-spec/specs-go"
+	"golang.org/x/sys/unix"
+)
+
+// maxSymlinks is how many symlinks are followed when resolving a path in a
+// container before giving up, as the kernel does.
+//
+const maxSymlinks = 40
+
+// overflowID is the id that files owned by ids outside of a container's user
+// namespace appear to be owned by from within it.
+//
+const overflowID = 65534
+
+// ErrReadOnly is returned when streaming into a path of a container that is
+// mounted read-only.
+//
+type ErrReadOnly string
+
+func (e ErrReadOnly) Error() string {
+	return fmt.Sprintf("read-only path: %s", string(e))
+}
+
+// containerFS gives access to the files of a container from the host, through
+// the root filesystem and bind mounts in its spec.
+//
+// Other mounts, such as tmpfs mounts, only exist in the container's mount
+// namespace, and are reached through procRoot, the root of its init process as
+// seen from the host. It is empty when the container is not running.
+//
+type containerFS struct {
+	spec     *specs.Spec
+	procRoot string
+}
+
+// owner is who files streamed into a container end up owned by.
+//
+type owner struct {
+	// root is set when streaming in as root, in which case files keep the
+	// ownership recorded in the stream, as they do when tar is run as root.
+	//
+	root bool
+	uid  uint32
+	gid  uint32
+}
+
+// hostFile is a file in a container, held on the host as an open handle to
+// its parent directory along with its base name.
+//
+// The container's processes can change its files while they are streamed in
+// or out. Holding on to the parent directory keeps them from redirecting the
+// host to a file outside of the container by swapping a directory for a
+// symlink once it has been resolved.
+//
+type hostFile struct {
+	dir      *os.File
+	base     string
+	readOnly bool
+}
+
+// path is a path through which the host can reach the file without resolving
+// its parent directories again. Its last element is not followed by calls
+// such as Lstat, Lchown, Mkdir or Symlink.
+//
+func (p hostFile) path() string {
+	return fmt.Sprintf("/proc/self/fd/%d/%s", p.dir.Fd(), p.base)
+}
+
+func (p hostFile) Close() error {
+	return p.dir.Close()
+}
+
+// hostPath opens the parent directory of a path in the container from the
+// host, noting whether the path is in a read-only mount.
+//
+// Symlinks in the path's parent directories are resolved as they would be in
+// the container, without being able to point outside of the root filesystem
+// or mount that the path is in. The path's last element is left as is, so a
+// symlink can be replaced or streamed out rather than followed.
+//
+func (fs containerFS) hostPath(containerPath string) (hostFile, error) {
+	containerPath = path.Clean("/" + containerPath)
+
+	rel, readOnly := containerPath, fs.spec.Root.Readonly
+
+	var (
+		mount     specs.Mount
+		mountDest string
+	)
+
+	for _, m := range fs.spec.Mounts {
+		dest := path.Clean(m.Destination)
+		if len(dest) <= len(mountDest) {
+			continue
+		}
+
+		if containerPath != dest && !strings.HasPrefix(containerPath, strings.TrimSuffix(dest, "/")+"/") {
+			continue
+		}
+
+		mount = m
+		mountDest = dest
+		rel = strings.TrimPrefix(containerPath, dest)
+		readOnly = hasOption(m, "ro")
+	}
+
+	var (
+		root *os.File
+		err  error
+	)
+
+	switch {
+	case mountDest == "":
+		root, err = openDir(fs.spec.Root.Path)
+	case isBindMount(mount):
+		root, err = openDir(mount.Source)
+	case fs.procRoot == "":
+		return hostFile{}, fmt.Errorf("%s mount at %s is only reachable while the container is running", mount.Type, mountDest)
+	default:
+		root, err = openInRoot(fs.procRoot, mountDest)
+	}
+
+	if err != nil {
+		return hostFile{}, err
+	}
+
+	defer root.Close()
+
+	dir, base := path.Split(path.Clean("/" + rel))
+
+	parent, err := walkInRoot(root, dir)
+	if err != nil {
+		return hostFile{}, err
+	}
+
+	return hostFile{dir: parent, base: base, readOnly: readOnly}, nil
+}
+
+// owner looks up the ids of a user in the container's /etc/passwd. The user
+// can also be given as a uid. An empty user is root.
+//
+func (fs containerFS) owner(user string) (owner, error) {
+	if user == "" || user == "root" {
+		return owner{root: true}, nil
+	}
+
+	if uid, err := strconv.ParseUint(user, 10, 32); err == nil {
+		return owner{uid: uint32(uid), gid: uint32(uid)}, nil
+	}
+
+	passwd, err := fs.hostPath("/etc/passwd")
+	if err != nil {
+		return owner{}, fmt.Errorf("open passwd: %w", err)
+	}
+
+	defer passwd.Close()
+
+	file, err := os.OpenFile(passwd.path(), os.O_RDONLY|unix.O_NOFOLLOW, 0)
+	if err != nil {
+		return owner{}, fmt.Errorf("open passwd: %w", err)
+	}
+
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		fields := strings.Split(scanner.Text(), ":")
+		if len(fields) < 4 || fields[0] != user {
+			continue
+		}
+
+		uid, err := strconv.ParseUint(fields[2], 10, 32)
+		if err != nil {
+			return owner{}, fmt.Errorf("invalid uid for user %s: %w", user, err)
+		}
+
+		gid, err := strconv.ParseUint(fields[3], 10, 32)
+		if err != nil {
+			return owner{}, fmt.Errorf("invalid gid for user %s: %w", user, err)
+		}
+
+		return owner{uid: uint32(uid), gid: uint32(gid)}, nil
+	}
+
+	err = scanner.Err()
+	if err != nil {
+		return owner{}, fmt.Errorf("read passwd: %w", err)
+	}
+
+	return owner{}, ErrNotFound("user " + user)
+}
+
+// mkdirAll creates a directory in the container along with any missing
+// parents, owned by the given owner.
+//
+func (fs containerFS) mkdirAll(containerPath string, o owner) error {
+	return fs.mkdirAllLinks(containerPath, o, 0)
+}
+
+func (fs containerFS) mkdirAllLinks(containerPath string, o owner, links int) error {
+	containerPath = path.Clean("/" + containerPath)
+	if containerPath == "/" {
+		return nil
+	}
+
+	dest, err := fs.hostPath(containerPath)
+	if os.IsNotExist(err) {
+		err = fs.mkdirAllLinks(path.Dir(containerPath), o, links)
+		if err != nil {
+			return err
+		}
+
+		dest, err = fs.hostPath(containerPath)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	defer dest.Close()
+
+	info, err := os.Lstat(dest.path())
+	if err == nil {
+		if info.Mode()&os.ModeSymlink != 0 {
+			if links >= maxSymlinks {
+				return fmt.Errorf("too many levels of symbolic links: %s", containerPath)
+			}
+
+			target, err := os.Readlink(dest.path())
+			if err != nil {
+				return fmt.Errorf("readlink: %w", err)
+			}
+
+			if !path.IsAbs(target) {
+				target = path.Join(path.Dir(containerPath), target)
+			}
+
+			return fs.mkdirAllLinks(target, o, links+1)
+		}
+
+		if !info.IsDir() {
+			return fmt.Errorf("not a directory: %s", containerPath)
+		}
+
+		return nil
+	}
+
+	if !os.IsNotExist(err) {
+		return fmt.Errorf("stat: %w", err)
+	}
+
+	if dest.readOnly {
+		return ErrReadOnly(containerPath)
+	}
+
+	err = os.Mkdir(dest.path(), 0755)
+	if err != nil {
+		return fmt.Errorf("mkdir: %w", err)
+	}
+
+	return os.Lchown(dest.path(), fs.hostUID(o.uid), fs.hostGID(o.gid))
+}
+
+// extract writes a tar entry to a path in the container, replacing whatever
+// is there unless both are directories.
+//
+func (fs containerFS) extract(containerPath string, hdr *tar.Header, content io.Reader, o owner, linkPath string) error {
+	dest, err := fs.hostPath(containerPath)
+	if err != nil {
+		return err
+	}
+
+	defer dest.Close()
+
+	if dest.readOnly {
+		return ErrReadOnly(containerPath)
+	}
+
+	hostPath := dest.path()
+
+	existing, err := os.Lstat(hostPath)
+	exists := err == nil
+	if exists && !(existing.IsDir() && hdr.Typeflag == tar.TypeDir) {
+		err = os.RemoveAll(hostPath)
+		if err != nil {
+			return fmt.Errorf("remove existing: %w", err)
+		}
+
+		exists = false
+	}
+
+	mode := hdr.FileInfo().Mode()
+
+	switch hdr.Typeflag {
+	case tar.TypeDir:
+		if !exists {
+			err = os.Mkdir(hostPath, 0755)
+		}
+
+	case tar.TypeReg, tar.TypeRegA:
+		err = writeFile(hostPath, content)
+
+	case tar.TypeSymlink:
+		err = os.Symlink(hdr.Linkname, hostPath)
+
+	case tar.TypeLink:
+		var target hostFile
+		target, err = fs.hostPath(linkPath)
+		if err == nil {
+			err = os.Link(target.path(), hostPath)
+			_ = target.Close()
+		}
+
+	default:
+		return fmt.Errorf("%s: unsupported entry type (%c)", hdr.Name, hdr.Typeflag)
+	}
+
+	if err != nil {
+		return fmt.Errorf("extract %s: %w", hdr.Name, err)
+	}
+
+	uid, gid := o.uid, o.gid
+	if o.root {
+		uid, gid = uint32(hdr.Uid), uint32(hdr.Gid)
+	}
+
+	err = os.Lchown(hostPath, fs.hostUID(uid), fs.hostGID(gid))
+	if err != nil {
+		return fmt.Errorf("chown %s: %w", hdr.Name, err)
+	}
+
+	if hdr.Typeflag == tar.TypeSymlink || hdr.Typeflag == tar.TypeLink {
+		return nil
+	}
+
+	// must be done after chown, which clears the setuid and setgid bits
+	err = chmodAndChtimes(hostPath, mode, hdr.ModTime)
+	if err != nil {
+		return fmt.Errorf("%s: %w", hdr.Name, err)
+	}
+
+	return nil
+}
+
+// compress writes a path in the container, and everything under it, to a tar
+// stream under the given name. Mounts under the path are included.
+//
+func (fs containerFS) compress(tw *tar.Writer, containerPath, name string) error {
+	src, err := fs.hostPath(containerPath)
+	if err != nil {
+		return err
+	}
+
+	defer src.Close()
+
+	hostPath := src.path()
+
+	info, err := os.Lstat(hostPath)
+	if err != nil {
+		return fmt.Errorf("lstat: %w", err)
+	}
+
+	var link string
+	if info.Mode()&os.ModeSymlink != 0 {
+		link, err = os.Readlink(hostPath)
+		if err != nil {
+			return fmt.Errorf("readlink: %w", err)
+		}
+	}
+
+	hdr, err := tar.FileInfoHeader(info, link)
+	if err != nil {
+		return fmt.Errorf("tar header: %w", err)
+	}
+
+	hdr.Name = name
+	if info.IsDir() {
+		hdr.Name += "/"
+	}
+
+	// the names of the host's users and groups mean nothing in the container
+	hdr.Uname, hdr.Gname = "", ""
+	hdr.Uid = int(containerID(fs.linux().UIDMappings, uint32(hdr.Uid)))
+	hdr.Gid = int(containerID(fs.linux().GIDMappings, uint32(hdr.Gid)))
+
+	switch {
+	case info.Mode().IsRegular():
+		file, err := os.OpenFile(hostPath, os.O_RDONLY|unix.O_NOFOLLOW, 0)
+		if err != nil {
+			return fmt.Errorf("open: %w", err)
+		}
+
+		defer file.Close()
+
+		err = tw.WriteHeader(hdr)
+		if err != nil {
+			return fmt.Errorf("write header: %w", err)
+		}
+
+		// copy no more than the header promised, should the file have grown
+		_, err = io.Copy(tw, io.LimitReader(file, hdr.Size))
+		if err != nil {
+			return fmt.Errorf("copy %s: %w", name, err)
+		}
+
+	case info.IsDir():
+		dir, err := os.OpenFile(hostPath, os.O_RDONLY|unix.O_DIRECTORY|unix.O_NOFOLLOW, 0)
+		if err != nil {
+			return fmt.Errorf("open: %w", err)
+		}
+
+		defer dir.Close()
+
+		children, err := dir.Readdirnames(-1)
+		if err != nil {
+			return fmt.Errorf("read dir: %w", err)
+		}
+
+		sort.Strings(children)
+
+		err = tw.WriteHeader(hdr)
+		if err != nil {
+			return fmt.Errorf("write header: %w", err)
+		}
+
+		for _, child := range children {
+			err = fs.compress(tw, path.Join(containerPath, child), path.Join(name, child))
+			if err != nil {
+				return err
+			}
+		}
+
+	default:
+		err = tw.WriteHeader(hdr)
+		if err != nil {
+			return fmt.Errorf("write header: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (fs containerFS) hostUID(uid uint32) int {
+	return int(hostID(fs.linux().UIDMappings, uid))
+}
+
+func (fs containerFS) hostGID(gid uint32) int {
+	return int(hostID(fs.linux().GIDMappings, gid))
+}
+
+func (fs containerFS) linux() *specs.Linux {
+	if fs.spec.Linux == nil {
+		return &specs.Linux{}
+	}
+
+	return fs.spec.Linux
+}
+
+// hostID maps an id in a container's user namespace to the id on the host. A
+// container without mappings shares the host's ids.
+//
+func hostID(mappings []specs.LinuxIDMapping, id uint32) uint32 {
+	if len(mappings) == 0 {
+		return id
+	}
+
+	for _, mapping := range mappings {
+		if id >= mapping.ContainerID && id-mapping.ContainerID < mapping.Size {
+			return mapping.HostID + id - mapping.ContainerID
+		}
+	}
+
+	return overflowID
+}
+
+// containerID maps an id on the host to the id in a container's user
+// namespace. Ids outside of the namespace show up as the overflow id.
+//
+func containerID(mappings []specs.LinuxIDMapping, id uint32) uint32 {
+	if len(mappings) == 0 {
+		return id
+	}
+
+	for _, mapping := range mappings {
+		if id >= mapping.HostID && id-mapping.HostID < mapping.Size {
+			return mapping.ContainerID + id - mapping.HostID
+		}
+	}
+
+	return overflowID
+}
+
+// openDir opens a directory on the host by a trusted path, such as the root
+// filesystem or the source of a bind mount.
+//
+func openDir(hostPath string) (*os.File, error) {
+	fd, err := unix.Open(hostPath, unix.O_PATH|unix.O_DIRECTORY|unix.O_CLOEXEC, 0)
+	if err != nil {
+		return nil, &os.PathError{Op: "open", Path: hostPath, Err: err}
+	}
+
+	return os.NewFile(uintptr(fd), hostPath), nil
+}
+
+// openInRoot opens a directory as if root were "/".
+//
+func openInRoot(root, unsafePath string) (*os.File, error) {
+	rootDir, err := openDir(root)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rootDir.Close()
+
+	return walkInRoot(rootDir, unsafePath)
+}
+
+// walkInRoot opens a directory under root, resolving the symlinks in its path
+// as if root were "/" so that the result cannot be outside of root.
+//
+// Each element is opened relative to the directory before it without
+// following symlinks, which are instead resolved by hand. A symlink swapped
+// in for a directory while walking is therefore never followed by the
+// kernel.
+//
+func walkInRoot(root *os.File, unsafePath string) (*os.File, error) {
+	var dirs []*os.File
+	defer func() {
+		for _, dir := range dirs {
+			_ = dir.Close()
+		}
+	}()
+
+	current := func() *os.File {
+		if len(dirs) == 0 {
+			return root
+		}
+
+		return dirs[len(dirs)-1]
+	}
+
+	remaining := strings.Split(unsafePath, "/")
+	links := 0
+
+	for len(remaining) > 0 {
+		elem := remaining[0]
+		remaining = remaining[1:]
+
+		switch elem {
+		case "", ".":
+			continue
+		case "..":
+			if len(dirs) > 0 {
+				_ = dirs[len(dirs)-1].Close()
+				dirs = dirs[:len(dirs)-1]
+			}
+
+			continue
+		}
+
+		fd, err := unix.Openat(int(current().Fd()), elem, unix.O_PATH|unix.O_NOFOLLOW|unix.O_CLOEXEC, 0)
+		if err != nil {
+			return nil, &os.PathError{Op: "openat", Path: unsafePath, Err: err}
+		}
+
+		var stat unix.Stat_t
+		err = unix.Fstat(fd, &stat)
+		if err != nil {
+			_ = unix.Close(fd)
+			return nil, &os.PathError{Op: "fstat", Path: unsafePath, Err: err}
+		}
+
+		switch stat.Mode & unix.S_IFMT {
+		case unix.S_IFDIR:
+			dirs = append(dirs, os.NewFile(uintptr(fd), elem))
+			continue
+
+		case unix.S_IFLNK:
+		default:
+			_ = unix.Close(fd)
+			return nil, &os.PathError{Op: "openat", Path: unsafePath, Err: unix.ENOTDIR}
+		}
+
+		links++
+		if links > maxSymlinks {
+			_ = unix.Close(fd)
+			return nil, fmt.Errorf("too many levels of symbolic links: %s", unsafePath)
+		}
+
+		target, err := readlinkFd(fd)
+		_ = unix.Close(fd)
+		if err != nil {
+			return nil, &os.PathError{Op: "readlink", Path: unsafePath, Err: err}
+		}
+
+		if path.IsAbs(target) {
+			for _, dir := range dirs {
+				_ = dir.Close()
+			}
+
+			dirs = nil
+		}
+
+		remaining = append(strings.Split(target, "/"), remaining...)
+	}
+
+	fd, err := unix.Openat(int(current().Fd()), ".", unix.O_PATH|unix.O_DIRECTORY|unix.O_CLOEXEC, 0)
+	if err != nil {
+		return nil, &os.PathError{Op: "openat", Path: unsafePath, Err: err}
+	}
+
+	return os.NewFile(uintptr(fd), unsafePath), nil
+}
+
+// readlinkFd reads the target of a symlink opened with O_PATH.
+//
+func readlinkFd(fd int) (string, error) {
+	for size := 128; ; size *= 2 {
+		buf := make([]byte, size)
+
+		n, err := unix.Readlinkat(fd, "", buf)
+		if err != nil {
+			return "", err
+		}
+
+		if n < size {
+			return string(buf[:n]), nil
+		}
+	}
+}
+
+// chmodAndChtimes sets the mode and times of a file or directory, refusing
+// to follow it should it have been replaced by a symlink.
+//
+func chmodAndChtimes(hostPath string, mode os.FileMode, modTime time.Time) error {
+	fd, err := unix.Open(hostPath, unix.O_PATH|unix.O_NOFOLLOW|unix.O_CLOEXEC, 0)
+	if err != nil {
+		return &os.PathError{Op: "open", Path: hostPath, Err: err}
+	}
+
+	defer unix.Close(fd)
+
+	var stat unix.Stat_t
+	err = unix.Fstat(fd, &stat)
+	if err != nil {
+		return &os.PathError{Op: "fstat", Path: hostPath, Err: err}
+	}
+
+	if stat.Mode&unix.S_IFMT == unix.S_IFLNK {
+		return fmt.Errorf("replaced by a symlink while extracting")
+	}
+
+	// the file itself, rather than whatever its path now leads to
+	self := fmt.Sprintf("/proc/self/fd/%d", fd)
+
+	err = os.Chmod(self, mode)
+	if err != nil {
+		return fmt.Errorf("chmod: %w", err)
+	}
+
+	err = os.Chtimes(self, modTime, modTime)
+	if err != nil {
+		return fmt.Errorf("chtimes: %w", err)
+	}
+
+	return nil
+}
+
+func writeFile(hostPath string, content io.Reader) error {
+	file, err := os.OpenFile(hostPath, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+
+	_, err = io.Copy(file, content)
+	if err != nil {
+		file.Close()
+		return err
+	}
+
+	return file.Close()
+}
+
+func isBindMount(mount specs.Mount) bool {
+	return mount.Type == "bind" || hasOption(mount, "bind") || hasOption(mount, "rbind")
+}
+
+func hasOption(mount specs.Mount, option string) bool {
+	for _, o := range mount.Options {
+		if o == option {
+			return true
+		}
+	}
+
+	return false
+}
@@ -13,6 +13,7 @@ func TestSuite(t *testing.T) {
 	suite.Run(t, &ContainerSuite{Assertions: require.New(t)})
 	suite.Run(t, &FileStoreSuite{Assertions: require.New(t)})
 	suite.Run(t, &KillerSuite{Assertions: require.New(t)})
+	suite.Run(t, &PortPoolSuite{Assertions: require.New(t)})
 	suite.Run(t, &ProcessKillerSuite{Assertions: require.New(t)})
 	suite.Run(t, &ProcessSuite{Assertions: require.New(t)})
 	suite.Run(t, &RootfsManagerSuite{Assertions: require.New(t)})
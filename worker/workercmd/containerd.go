@@ -29,6 +29,7 @@ func containerdGardenServerRunner(
 	requestTimeout time.Duration,
 	dnsServers []string,
 	networkPool string,
+	deniedNetworks []string,
 ) (ifrit.Runner, error) {
 	const (
 		graceTime = 0
@@ -42,6 +43,10 @@ func containerdGardenServerRunner(
 		networkOpts = append(networkOpts, containerd.WithNameServers(dnsServers))
 	}
 
+	if len(deniedNetworks) > 0 {
+		networkOpts = append(networkOpts, containerd.WithDeniedNetworks(deniedNetworks))
+	}
+
 	if networkPool != "" {
 		networkOpts = append(networkOpts, containerd.WithCNINetworkConfig(
 			containerd.CNINetworkConfig{
@@ -177,6 +182,7 @@ func (cmd *WorkerCommand) containerdRunner(logger lager.Logger) (ifrit.Runner, e
 		cmd.Garden.RequestTimeout,
 		dnsServers,
 		cmd.ContainerNetworkPool,
+		cmd.Garden.DenyNetworks,
 	)
 	if err != nil {
 		return nil, fmt.Errorf("containerd garden server runner: %w", err)
@@ -36,6 +36,8 @@ type GardenBackend struct {
 	DNSServers []string  `long:"dns-server" description:"DNS server IP address to use instead of automatically determined servers. Can be specified multiple times."`
 	DNS            DNSConfig     `group:"DNS Proxy Configuration" namespace:"dns-proxy"`
 
+	DenyNetworks []string `long:"deny-network" description:"Network ranges to which traffic from containers will be restricted, unless allowed by net-out rules, e.g. 169.254.169.254/32 for cloud metadata endpoints. Can be specified multiple times."`
+
 	RequestTimeout time.Duration `long:"request-timeout" default:"5m" description:"How long to wait for requests to Garden to complete. 0 means no timeout."`
 }
 
@@ -136,6 +138,10 @@ func (cmd *WorkerCommand) gdnRunner(logger lager.Logger) (ifrit.Runner, error) {
 		gdnServerFlags = append(gdnServerFlags, "--dns-server", dnsServer)
 	}
 
+	for _, network := range cmd.Garden.DenyNetworks {
+		gdnServerFlags = append(gdnServerFlags, "--deny-network", network)
+	}
+
 	if cmd.ContainerNetworkPool != "" {
 		gdnServerFlags = append(gdnServerFlags, "--network-pool", cmd.ContainerNetworkPool)
 	}